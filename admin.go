@@ -0,0 +1,130 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// pauseStatus is the response of admin endpoints.
+type pauseStatus struct {
+	Paused bool       `json:"paused"`
+	Gaps   []PauseGap `json:"gaps"`
+	Phases []Phase    `json:"phases,omitempty"`
+}
+
+// newAdminHandler returns the admin HTTP handler of the pauser:
+//
+//	POST /pause?reason=...  pauses request generation
+//	POST /resume            resumes request generation
+//	POST /phase?name=...    begins the workload phase (e.g. 'fault-injection')
+//	GET  /status            returns paused intervals and phases
+func newAdminHandler(lg *zap.Logger, p *Pauser, phases *phaseLog) http.Handler {
+	mux := http.NewServeMux()
+	status := func(w http.ResponseWriter) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(pauseStatus{Paused: p.Paused(), Gaps: p.Gaps(), Phases: phases.Phases()})
+	}
+	mux.HandleFunc("/pause", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		reason := r.URL.Query().Get("reason")
+		if err := p.Pause(reason); err != nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		lg.Info("paused request generation", zap.String("reason", reason))
+		status(w)
+	})
+	mux.HandleFunc("/resume", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if err := p.Resume(); err != nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		gaps := p.Gaps()
+		lg.Info("resumed request generation", zap.Duration("paused", gaps[len(gaps)-1].End.Sub(gaps[len(gaps)-1].Start)))
+		status(w)
+	})
+	mux.HandleFunc("/phase", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		name := r.URL.Query().Get("name")
+		if !IsValidPhase(name) {
+			http.Error(w, fmt.Sprintf("unknown phase %q", name), http.StatusBadRequest)
+			return
+		}
+		phases.begin(name)
+		lg.Info("began workload phase", zap.String("phase", name))
+		status(w)
+	})
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		status(w)
+	})
+	return mux
+}
+
+// ServeAdmin serves the admin HTTP endpoints to pause and resume
+// benchmarks at addr, which is either a TCP address (e.g. 'localhost:3600')
+// or a Unix socket path with 'unix://' prefix (e.g. 'unix:///tmp/dbtester.sock').
+// The returned function stops the server.
+func (cfg *Config) ServeAdmin(addr string) (stop func(), err error) {
+	lg, p := cfg.lg, NewPauser()
+	network, address := "tcp", addr
+	if strings.HasPrefix(addr, "unix://") {
+		network, address = "unix", strings.TrimPrefix(addr, "unix://")
+		// remove the stale socket of a previous run
+		if err = os.RemoveAll(address); err != nil {
+			return nil, err
+		}
+	}
+	ln, err := net.Listen(network, address)
+	if err != nil {
+		return nil, fmt.Errorf("cannot listen admin address %q (%v)", addr, err)
+	}
+
+	if cfg.phases == nil {
+		cfg.phases = &phaseLog{}
+	}
+	srv := &http.Server{Handler: newAdminHandler(lg, p, cfg.phases), ReadTimeout: 10 * time.Second}
+	go func() {
+		if err := srv.Serve(ln); err != nil && err != http.ErrServerClosed {
+			lg.Warn("admin server error", zap.Error(err))
+		}
+	}()
+	cfg.pauser = p
+	lg.Info("started admin server", zap.String("address", addr))
+	return func() {
+		srv.Close()
+		if network == "unix" {
+			os.RemoveAll(address)
+		}
+	}, nil
+}
@@ -27,13 +27,13 @@ import (
 // startCetcd starts cetcd. This assumes that etcd is already started.
 func startCetcd(fs *flags, t *transporterServer) error {
 	if !exist(fs.cetcdExec) {
-		return fmt.Errorf("cetcd binary %q does not exist", globalFlags.cetcdExec)
+		return fmt.Errorf("cetcd binary %q does not exist", fs.cetcdExec)
 	}
 
 	peerIPs := strings.Split(t.req.PeerIPsString, "___")
 	clientURLs := make([]string, len(peerIPs))
 	for i, u := range peerIPs {
-		clientURLs[i] = fmt.Sprintf("http://%s:2379", u)
+		clientURLs[i] = fmt.Sprintf("http://%s:%d", u, t.port(2379))
 	}
 
 	var flags []string
@@ -41,7 +41,7 @@ func startCetcd(fs *flags, t *transporterServer) error {
 	case dbtesterpb.DatabaseID_cetcd__beta:
 		flags = []string{
 			// "-consuladdr", "0.0.0.0:8500",
-			"-consuladdr", fmt.Sprintf("%s:8500", peerIPs[t.req.IPIndex]),
+			"-consuladdr", fmt.Sprintf("%s:%d", peerIPs[t.req.IPIndex], t.port(8500)),
 			"-etcd", clientURLs[t.req.IPIndex], // etcd endpoint
 		}
 
@@ -54,6 +54,9 @@ func startCetcd(fs *flags, t *transporterServer) error {
 	cmd := exec.Command(fs.cetcdExec, flags...)
 	cmd.Stdout = t.proxyDatabaseLogfile
 	cmd.Stderr = t.proxyDatabaseLogfile
+	if err := fs.restrict(cmd, ""); err != nil {
+		return err
+	}
 	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
 
 	t.lg.Info("starting database", zap.String("command", cs))
@@ -25,41 +25,86 @@ import (
 	"go.uber.org/zap"
 )
 
-// startConsul starts Consul.
+// startConsul starts Consul with an empty data directory.
 func startConsul(fs *flags, t *transporterServer) error {
-	if !exist(fs.consulExec) {
-		return fmt.Errorf("Consul binary %q does not exist", globalFlags.consulExec)
-	}
-
 	if err := os.RemoveAll(fs.consulDataDir); err != nil {
 		return err
 	}
+	return execConsul(fs, t)
+}
+
+// execConsul starts Consul on the existing data directory, if any.
+func execConsul(fs *flags, t *transporterServer) error {
+	if !exist(fs.consulExec) {
+		return fmt.Errorf("Consul binary %q does not exist", fs.consulExec)
+	}
 
 	peerIPs := strings.Split(t.req.PeerIPsString, "___")
 
 	var flags []string
 	switch t.req.DatabaseID {
 	case dbtesterpb.DatabaseID_consul__v1_0_2:
-		switch t.req.IPIndex {
-		case 0: // leader
+		switch {
+		case int(t.req.IPIndex) >= len(peerIPs): // client agent on loader machine
+			if t.req.Flag_Consul_V1_0_2 == nil || int(t.req.IPIndex)-len(peerIPs) >= len(t.req.Flag_Consul_V1_0_2.ClientAgentIPs) {
+				return fmt.Errorf("no Consul client agent IP for index %d", t.req.IPIndex)
+			}
+			clientIP := t.req.Flag_Consul_V1_0_2.ClientAgentIPs[int(t.req.IPIndex)-len(peerIPs)]
 			flags = []string{
 				"agent",
-				"-server",
 				"-data-dir", fs.consulDataDir,
-				"-bind", peerIPs[t.req.IPIndex],
-				"-client", peerIPs[t.req.IPIndex],
-				"-bootstrap-expect", fmt.Sprintf("%d", len(peerIPs)),
+				"-bind", clientIP,
+				"-client", clientIP,
+				"-join", fmt.Sprintf("%s:%d", peerIPs[0], t.port(8301)),
 			}
 		default:
+			// servers of each datacenter join its first server,
+			// which joins the first datacenter over WAN
+			dc, first, size := t.req.Flag_Consul_V1_0_2.Datacenter(int(t.req.IPIndex))
+			if size == 0 {
+				size = len(peerIPs)
+			}
 			flags = []string{
 				"agent",
 				"-server",
+				"-datacenter", dc,
 				"-data-dir", fs.consulDataDir,
 				"-bind", peerIPs[t.req.IPIndex],
 				"-client", peerIPs[t.req.IPIndex],
-				"-join", peerIPs[0],
+			}
+			if int(t.req.IPIndex) == first { // leader of the datacenter
+				flags = append(flags, "-bootstrap-expect", fmt.Sprintf("%d", size))
+				if first > 0 {
+					flags = append(flags, "-retry-join-wan", fmt.Sprintf("%s:%d", peerIPs[0], t.port(8302)))
+				}
+			} else {
+				flags = append(flags, "-join", fmt.Sprintf("%s:%d", peerIPs[first], t.port(8301)))
 			}
 		}
+		if t.req.PortOffset > 0 {
+			flags = append(flags,
+				"-http-port", fmt.Sprint(t.port(8500)),
+				"-dns-port", fmt.Sprint(t.port(8600)),
+				"-server-port", fmt.Sprint(t.port(8300)),
+				"-serf-lan-port", fmt.Sprint(t.port(8301)),
+				"-serf-wan-port", fmt.Sprint(t.port(8302)),
+			)
+		}
+		switch t.req.DatabaseLogLevel {
+		case "":
+		case dbtesterpb.LogLevelError:
+			flags = append(flags, "-log-level", "err")
+		default:
+			flags = append(flags, "-log-level", t.req.DatabaseLogLevel)
+		}
+		if t.req.Flag_Consul_V1_0_2 != nil && t.req.Flag_Consul_V1_0_2.ACLMasterToken != "" {
+			// anonymous requests are allowed, to compare with authorized ones
+			flags = append(flags,
+				"-hcl", `acl_datacenter = "dc1"`,
+				"-hcl", `acl_default_policy = "allow"`,
+				"-hcl", fmt.Sprintf("acl_master_token = %q", t.req.Flag_Consul_V1_0_2.ACLMasterToken),
+			)
+		}
 
 	default:
 		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
@@ -70,6 +115,9 @@ func startConsul(fs *flags, t *transporterServer) error {
 	cmd := exec.Command(fs.consulExec, flags...)
 	cmd.Stdout = t.databaseLogFile
 	cmd.Stderr = t.databaseLogFile
+	if err := fs.restrict(cmd, fs.consulDataDir); err != nil {
+		return err
+	}
 	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
 
 	t.lg.Info("starting database", zap.String("command", cs))
@@ -15,25 +15,35 @@
 package agent
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"os"
 	"os/exec"
+	"runtime"
 	"strings"
+	"time"
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
 
 	"go.uber.org/zap"
 )
 
-// startEtcd starts etcd v3.
+// startEtcd starts etcd v3 with an empty data directory.
 func startEtcd(fs *flags, t *transporterServer) error {
-	if !exist(fs.etcdExec) {
-		return fmt.Errorf("etcd binary %q does not exist", globalFlags.etcdExec)
-	}
-
 	if err := os.RemoveAll(fs.etcdDataDir); err != nil {
 		return err
 	}
+	return execEtcd(fs, t)
+}
+
+// execEtcd starts etcd v3 on the existing data directory, if any.
+func execEtcd(fs *flags, t *transporterServer) error {
+	if !exist(fs.etcdExec) {
+		return fmt.Errorf("etcd binary %q does not exist", fs.etcdExec)
+	}
 
 	peerIPs := strings.Split(t.req.PeerIPsString, "___")
 
@@ -43,11 +53,38 @@ func startEtcd(fs *flags, t *transporterServer) error {
 	members := make([]string, len(peerIPs))
 	for i, u := range peerIPs {
 		names[i] = fmt.Sprintf("etcd-%d", i+1)
-		clientURLs[i] = fmt.Sprintf("http://%s:2379", u)
-		peerURLs[i] = fmt.Sprintf("http://%s:2380", u)
+		clientURLs[i] = fmt.Sprintf("http://%s:%d", u, t.port(2379))
+		peerURLs[i] = fmt.Sprintf("http://%s:%d", u, t.port(2380))
 		members[i] = fmt.Sprintf("%s=%s", names[i], peerURLs[i])
 	}
 
+	// gRPC proxies are signaled after all members
+	if grpcProxyEtcd(t.req) {
+		return startGRPCProxyEtcd(fs, t, clientURLs)
+	}
+
+	// cluster may be formed by the first members only (e.g. scaling benchmark)
+	if t.req.ClusterSize > 0 && int(t.req.ClusterSize) < len(members) {
+		members = members[:t.req.ClusterSize]
+	}
+	initialCluster, initialClusterState := strings.Join(members, ","), "new"
+	if t.req.JoinExisting {
+		initialClusterState = "existing"
+	}
+
+	// learner is added to the running cluster, as the last member
+	if learnerEtcd(t.req) {
+		last := len(peerIPs) - 1
+		if int(t.req.IPIndex) == last {
+			if err := addLearnerEtcd(t.lg, clientURLs[0], peerURLs[last]); err != nil {
+				return err
+			}
+			initialClusterState = "existing"
+		} else {
+			initialCluster = strings.Join(members[:last], ",")
+		}
+	}
+
 	var flags []string
 	switch t.req.DatabaseID {
 	case dbtesterpb.DatabaseID_etcd__other:
@@ -65,8 +102,8 @@ func startEtcd(fs *flags, t *transporterServer) error {
 			"--initial-advertise-peer-urls", peerURLs[t.req.IPIndex],
 
 			"--initial-cluster-token", "mytoken",
-			"--initial-cluster", strings.Join(members, ","),
-			"--initial-cluster-state", "new",
+			"--initial-cluster", initialCluster,
+			"--initial-cluster-state", initialClusterState,
 			"--logger", "zap",
 			"--log-outputs", "stderr",
 		}
@@ -86,8 +123,8 @@ func startEtcd(fs *flags, t *transporterServer) error {
 			"--initial-advertise-peer-urls", peerURLs[t.req.IPIndex],
 
 			"--initial-cluster-token", "mytoken",
-			"--initial-cluster", strings.Join(members, ","),
-			"--initial-cluster-state", "new",
+			"--initial-cluster", initialCluster,
+			"--initial-cluster-state", initialClusterState,
 			"--logger", "zap",
 			"--log-outputs", "stderr",
 		}
@@ -107,8 +144,8 @@ func startEtcd(fs *flags, t *transporterServer) error {
 			"--initial-advertise-peer-urls", peerURLs[t.req.IPIndex],
 
 			"--initial-cluster-token", "mytoken",
-			"--initial-cluster", strings.Join(members, ","),
-			"--initial-cluster-state", "new",
+			"--initial-cluster", initialCluster,
+			"--initial-cluster-state", initialClusterState,
 		}
 
 	case dbtesterpb.DatabaseID_etcd__v3_3:
@@ -126,19 +163,38 @@ func startEtcd(fs *flags, t *transporterServer) error {
 			"--initial-advertise-peer-urls", peerURLs[t.req.IPIndex],
 
 			"--initial-cluster-token", "mytoken",
-			"--initial-cluster", strings.Join(members, ","),
-			"--initial-cluster-state", "new",
+			"--initial-cluster", initialCluster,
+			"--initial-cluster-state", initialClusterState,
 		}
 
 	default:
 		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
 	}
+	if n := maxRequestBytesEtcd(t.req); n > 0 {
+		flags = append(flags, "--max-request-bytes", fmt.Sprintf("%d", n))
+	}
+	if t.req.Durability == dbtesterpb.DurabilityNoFsync {
+		// requires etcd v3.5+, validated for 'etcd__tip' and 'etcd__other' only
+		flags = append(flags, "--unsafe-no-fsync")
+	}
+	if lvl := t.req.DatabaseLogLevel; lvl != "" {
+		switch t.req.DatabaseID {
+		case dbtesterpb.DatabaseID_etcd__v3_2, dbtesterpb.DatabaseID_etcd__v3_3:
+			flags = append(flags, "--debug")
+		default:
+			flags = append(flags, "--log-level", lvl)
+		}
+	}
+	flags = append(flags, extraFlagsEtcd(t.req)...)
 
 	flagString := strings.Join(flags, " ")
 
 	cmd := exec.Command(fs.etcdExec, flags...)
 	cmd.Stdout = t.databaseLogFile
 	cmd.Stderr = t.databaseLogFile
+	if err := fs.restrict(cmd, fs.etcdDataDir, etcdArchEnv()...); err != nil {
+		return err
+	}
 	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
 
 	t.lg.Info("starting database", zap.String("command", cs))
@@ -152,3 +208,145 @@ func startEtcd(fs *flags, t *transporterServer) error {
 
 	return nil
 }
+
+// extraFlagsEtcd returns flags to pass to etcd as they are.
+func extraFlagsEtcd(req dbtesterpb.Request) []string {
+	switch req.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__other:
+		if req.Flag_Etcd_Other != nil {
+			return req.Flag_Etcd_Other.ExtraFlags
+		}
+	case dbtesterpb.DatabaseID_etcd__tip:
+		if req.Flag_Etcd_Tip != nil {
+			return req.Flag_Etcd_Tip.ExtraFlags
+		}
+	}
+	return nil
+}
+
+// grpcProxyEtcd returns true if the agent runs a gRPC proxy
+// in front of the cluster, instead of a member.
+func grpcProxyEtcd(req dbtesterpb.Request) bool {
+	return int(req.IPIndex) >= len(strings.Split(req.PeerIPsString, "___"))
+}
+
+// startGRPCProxyEtcd starts etcd gRPC proxy that forwards
+// client requests to all members.
+func startGRPCProxyEtcd(fs *flags, t *transporterServer, clientURLs []string) error {
+	var proxyIPs []string
+	switch t.req.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__other:
+		if t.req.Flag_Etcd_Other != nil {
+			proxyIPs = t.req.Flag_Etcd_Other.GRPCProxyIPs
+		}
+	case dbtesterpb.DatabaseID_etcd__tip:
+		if t.req.Flag_Etcd_Tip != nil {
+			proxyIPs = t.req.Flag_Etcd_Tip.GRPCProxyIPs
+		}
+	}
+	idx := int(t.req.IPIndex) - len(clientURLs)
+	if idx >= len(proxyIPs) {
+		return fmt.Errorf("no etcd gRPC proxy IP for index %d", t.req.IPIndex)
+	}
+
+	// proxy has no data, but database size is measured on stop
+	if err := os.MkdirAll(fs.etcdDataDir, 0777); err != nil {
+		return err
+	}
+
+	flags := []string{
+		"grpc-proxy", "start",
+		"--endpoints", strings.Join(clientURLs, ","),
+		"--listen-addr", fmt.Sprintf("%s:%d", proxyIPs[idx], t.port(2379)),
+	}
+	flagString := strings.Join(flags, " ")
+
+	cmd := exec.Command(fs.etcdExec, flags...)
+	cmd.Stdout = t.databaseLogFile
+	cmd.Stderr = t.databaseLogFile
+	if err := fs.restrict(cmd, fs.etcdDataDir, etcdArchEnv()...); err != nil {
+		return err
+	}
+	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
+
+	t.lg.Info("starting gRPC proxy", zap.String("command", cs))
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	t.cmd = cmd
+	t.cmdWait = make(chan struct{})
+	t.pid = int64(cmd.Process.Pid)
+	t.lg.Info("started gRPC proxy", zap.String("command", cs), zap.Int64("pid", t.pid))
+
+	return nil
+}
+
+// learnerEtcd returns true if the last member should start as a learner.
+func learnerEtcd(req dbtesterpb.Request) bool {
+	switch req.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__other:
+		return req.Flag_Etcd_Other != nil && req.Flag_Etcd_Other.Learner
+	case dbtesterpb.DatabaseID_etcd__tip:
+		return req.Flag_Etcd_Tip != nil && req.Flag_Etcd_Tip.Learner
+	}
+	return false
+}
+
+// etcdArchEnv returns the environment to run etcd on architectures other
+// than amd64, which etcd before v3.5 refuses to start on without
+// 'ETCD_UNSUPPORTED_ARCH' (e.g. arm64).
+func etcdArchEnv() []string {
+	if runtime.GOARCH == "amd64" {
+		return nil
+	}
+	return []string{"ETCD_UNSUPPORTED_ARCH=" + runtime.GOARCH}
+}
+
+// maxRequestBytesEtcd returns the '--max-request-bytes' flag value,
+// or 0 to use etcd default.
+func maxRequestBytesEtcd(req dbtesterpb.Request) int64 {
+	switch req.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__other:
+		return req.Flag_Etcd_Other.MaxRequestBytes
+	case dbtesterpb.DatabaseID_etcd__tip:
+		return req.Flag_Etcd_Tip.MaxRequestBytes
+	case dbtesterpb.DatabaseID_etcd__v3_2:
+		return req.Flag_Etcd_V3_2.MaxRequestBytes
+	case dbtesterpb.DatabaseID_etcd__v3_3:
+		return req.Flag_Etcd_V3_3.MaxRequestBytes
+	}
+	return 0
+}
+
+// addLearnerEtcd adds a learner member via etcd gRPC gateway,
+// retrying until the other members are ready.
+// Vendored etcd client does not support learner APIs.
+func addLearnerEtcd(lg *zap.Logger, clientURL, peerURL string) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"peerURLs":  []string{peerURL},
+		"isLearner": true,
+	})
+	if err != nil {
+		return err
+	}
+	ep := clientURL + "/v3/cluster/member/add"
+	for i := 0; i < 60; i++ {
+		var resp *http.Response
+		resp, err = http.Post(ep, "application/json", bytes.NewReader(body))
+		if err == nil {
+			var b []byte
+			b, err = ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err == nil && resp.StatusCode != http.StatusOK {
+				err = fmt.Errorf("%s (%q)", resp.Status, string(b))
+			}
+			if err == nil {
+				lg.Info("added etcd learner", zap.String("endpoint", ep), zap.String("peer-url", peerURL))
+				return nil
+			}
+		}
+		lg.Warn("failed to add etcd learner; retrying", zap.String("endpoint", ep), zap.Error(err))
+		time.Sleep(time.Second)
+	}
+	return err
+}
@@ -27,13 +27,13 @@ import (
 // startZetcd starts zetcd. This assumes that etcd is already started.
 func startZetcd(fs *flags, t *transporterServer) error {
 	if !exist(fs.zetcdExec) {
-		return fmt.Errorf("zetcd binary %q does not exist", globalFlags.zetcdExec)
+		return fmt.Errorf("zetcd binary %q does not exist", fs.zetcdExec)
 	}
 
 	peerIPs := strings.Split(t.req.PeerIPsString, "___")
 	clientURLs := make([]string, len(peerIPs))
 	for i, u := range peerIPs {
-		clientURLs[i] = fmt.Sprintf("http://%s:2379", u)
+		clientURLs[i] = fmt.Sprintf("http://%s:%d", u, t.port(2379))
 	}
 
 	var flags []string
@@ -41,7 +41,7 @@ func startZetcd(fs *flags, t *transporterServer) error {
 	case dbtesterpb.DatabaseID_zetcd__beta:
 		flags = []string{
 			// "-zkaddr", "0.0.0.0:2181",
-			"-zkaddr", fmt.Sprintf("%s:2181", peerIPs[t.req.IPIndex]),
+			"-zkaddr", fmt.Sprintf("%s:%d", peerIPs[t.req.IPIndex], t.port(2181)),
 			"-endpoint", clientURLs[t.req.IPIndex],
 		}
 
@@ -54,6 +54,9 @@ func startZetcd(fs *flags, t *transporterServer) error {
 	cmd := exec.Command(fs.zetcdExec, flags...)
 	cmd.Stdout = t.proxyDatabaseLogfile
 	cmd.Stderr = t.proxyDatabaseLogfile
+	if err := fs.restrict(cmd, ""); err != nil {
+		return err
+	}
 	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
 
 	t.lg.Info("starting database", zap.String("command", cs))
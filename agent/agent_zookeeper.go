@@ -36,7 +36,9 @@ initLimit={{.InitLimit}}
 syncLimit={{.SyncLimit}}
 maxClientCnxns={{.MaxClientConnections}}
 snapCount={{.SnapCount}}
-{{range .Peers}}server.{{.MyID}}={{.IP}}:2888:3888
+{{if .PeerType}}peerType={{.PeerType}}
+{{end}}{{if .AdminServerPort}}admin.serverPort={{.AdminServerPort}}
+{{end}}{{range .Peers}}server.{{.MyID}}={{.IP}}:{{$.QuorumPort}}:{{$.ElectionPort}}{{if .Observer}}:observer{{end}}
 {{end}}
 `
 )
@@ -51,13 +53,22 @@ type ZookeeperConfig struct {
 	SyncLimit            int64
 	MaxClientConnections int64
 	SnapCount            int64
-	Peers                []ZookeeperPeer
+	// PeerType is "observer" for observer members, empty otherwise.
+	PeerType string
+	// QuorumPort and ElectionPort are the ports for followers
+	// to connect to the leader, and for leader election.
+	QuorumPort   int64
+	ElectionPort int64
+	// AdminServerPort is the port of admin server, if not default.
+	AdminServerPort int64
+	Peers           []ZookeeperPeer
 }
 
 // ZookeeperPeer defines Zookeeper peer configuration.
 type ZookeeperPeer struct {
-	MyID int
-	IP   string
+	MyID     int
+	IP       string
+	Observer bool
 }
 
 var shell = os.Getenv("SHELL")
@@ -79,14 +90,19 @@ const (
 	JavaClassPathZookeeperr353beta = `-cp zookeeper-3.5.3-beta.jar:lib/slf4j-api-1.7.5.jar:lib/slf4j-log4j12-1.7.5.jar:lib/log4j-1.2.17.jar:conf org.apache.zookeeper.server.quorum.QuorumPeerMain`
 )
 
-// startZookeeper starts Zookeeper.
+// startZookeeper starts Zookeeper with an empty data directory.
 func startZookeeper(fs *flags, t *transporterServer) error {
-	if !exist(fs.javaExec) {
-		return fmt.Errorf("Java binary %q does not exist", globalFlags.javaExec)
-	}
 	if err := os.RemoveAll(fs.zkDataDir); err != nil {
 		return err
 	}
+	return execZookeeper(fs, t)
+}
+
+// execZookeeper starts Zookeeper on the existing data directory, if any.
+func execZookeeper(fs *flags, t *transporterServer) error {
+	if !exist(fs.javaExec) {
+		return fmt.Errorf("Java binary %q does not exist", fs.javaExec)
+	}
 	if err := os.MkdirAll(fs.zkDataDir, 0777); err != nil {
 		return err
 	}
@@ -119,20 +135,38 @@ func startZookeeper(fs *flags, t *transporterServer) error {
 	var cfg ZookeeperConfig
 	peerIPs := strings.Split(t.req.PeerIPsString, "___")
 	peers := []ZookeeperPeer{}
-	for i := range peerIPs {
-		peers = append(peers, ZookeeperPeer{MyID: i + 1, IP: peerIPs[i]})
+	var peerType string
+	switch t.req.DatabaseID {
+	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		// last 'ObserverNumber' peers are observers
+		votersN := len(peerIPs) - int(t.req.Flag_Zookeeper_R3_5_3Beta.ObserverNumber)
+		for i := range peerIPs {
+			peers = append(peers, ZookeeperPeer{MyID: i + 1, IP: peerIPs[i], Observer: i >= votersN})
+		}
+		if int(t.req.IPIndex) >= votersN {
+			peerType = "observer"
+			t.lg.Info("starting Zookeeper as observer", zap.Uint32("ip-index", t.req.IPIndex))
+		}
+	default:
+		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
 	}
 	switch t.req.DatabaseID {
 	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
 		cfg = ZookeeperConfig{
 			TickTime:             t.req.Flag_Zookeeper_R3_5_3Beta.TickTime,
 			DataDir:              fs.zkDataDir,
-			ClientPort:           t.req.Flag_Zookeeper_R3_5_3Beta.ClientPort,
+			ClientPort:           t.port(t.req.Flag_Zookeeper_R3_5_3Beta.ClientPort),
 			InitLimit:            t.req.Flag_Zookeeper_R3_5_3Beta.InitLimit,
 			SyncLimit:            t.req.Flag_Zookeeper_R3_5_3Beta.SyncLimit,
 			MaxClientConnections: t.req.Flag_Zookeeper_R3_5_3Beta.MaxClientConnections,
 			Peers:                peers,
 			SnapCount:            t.req.Flag_Zookeeper_R3_5_3Beta.SnapCount,
+			PeerType:             peerType,
+			QuorumPort:           t.port(2888),
+			ElectionPort:         t.port(3888),
+		}
+		if t.req.PortOffset > 0 {
+			cfg.AdminServerPort = t.port(8080)
 		}
 	default:
 		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
@@ -169,6 +203,19 @@ func startZookeeper(fs *flags, t *transporterServer) error {
 			}
 			flagString += fmt.Sprintf("-Xmx%s", t.req.Flag_Zookeeper_R3_5_3Beta.JavaXmx)
 		}
+		if t.req.Durability == dbtesterpb.DurabilityNoFsync {
+			if len(flagString) > 0 {
+				flagString += " "
+			}
+			flagString += "-Dzookeeper.forceSync=no"
+		}
+		if t.req.DatabaseLogLevel != "" {
+			if len(flagString) > 0 {
+				flagString += " "
+			}
+			// overrides the root logger of 'conf/log4j.properties'
+			flagString += fmt.Sprintf("-Dzookeeper.root.logger=%s,CONSOLE", strings.ToUpper(t.req.DatabaseLogLevel))
+		}
 		// -Djute.maxbuffer=33554432 -Xms50G -Xmx50G
 		if len(flagString) > 0 {
 			flagString += " "
@@ -183,6 +230,9 @@ func startZookeeper(fs *flags, t *transporterServer) error {
 	cmd := exec.Command(args[0], args[1:]...)
 	cmd.Stdout = t.databaseLogFile
 	cmd.Stderr = t.databaseLogFile
+	if err := fs.restrict(cmd, fs.zkDataDir); err != nil {
+		return err
+	}
 	cs := fmt.Sprintf("%s %s", cmd.Path, strings.Join(args[1:], " "))
 
 	t.lg.Info("starting database", zap.String("command", cs))
@@ -0,0 +1,344 @@
+// Copyright 2016 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/fileinspect"
+
+	"github.com/coreos/etcd/clientv3"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/samuel/go-zookeeper/zk"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// restored database listens on different ports
+// so as not to conflict with the running database
+const (
+	restoreEtcdClientPort    = 22379
+	restoreEtcdPeerPort      = 22380
+	restoreZkClientPort      = 22181
+	restoreConsulHTTPPort    = 28500
+	restoreConsulDNSPort     = 28600
+	restoreConsulServerPort  = 28300
+	restoreConsulSerfLANPort = 28301
+	restoreConsulSerfWANPort = 28302
+)
+
+// backupDatabase backs up the running database into the backup directory.
+func backupDatabase(fs *flags, t *transporterServer) (size int64, took time.Duration, err error) {
+	if err = os.RemoveAll(fs.backupDir); err != nil {
+		return 0, 0, err
+	}
+	if err = os.MkdirAll(fs.backupDir, 0777); err != nil {
+		return 0, 0, err
+	}
+	peerIPs := strings.Split(t.req.PeerIPsString, "___")
+	ip := peerIPs[t.req.IPIndex]
+
+	t.lg.Info("starting backup", zap.String("database", t.req.DatabaseID.String()), zap.String("backup-dir", fs.backupDir))
+	now := time.Now()
+	switch t.req.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__other,
+		dbtesterpb.DatabaseID_etcd__tip,
+		dbtesterpb.DatabaseID_etcd__v3_2,
+		dbtesterpb.DatabaseID_etcd__v3_3,
+		dbtesterpb.DatabaseID_zetcd__beta,
+		dbtesterpb.DatabaseID_cetcd__beta:
+		err = backupEtcd(fmt.Sprintf("%s:%d", ip, t.port(2379)), filepath.Join(fs.backupDir, "snapshot.db"))
+
+	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		// snapshot and transaction logs
+		err = copyDir(filepath.Join(fs.zkDataDir, "version-2"), filepath.Join(fs.backupDir, "version-2"))
+
+	case dbtesterpb.DatabaseID_consul__v1_0_2:
+		err = runCommand(t, fs.consulExec, "snapshot", "save", "-http-addr", fmt.Sprintf("%s:%d", ip, t.port(8500)), filepath.Join(fs.backupDir, "backup.snap"))
+
+	default:
+		err = fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
+	}
+	took = time.Since(now)
+	if err != nil {
+		return 0, took, err
+	}
+
+	size, err = fileinspect.Size(fs.backupDir)
+	t.lg.Info("finished backup", zap.String("database", t.req.DatabaseID.String()), zap.Int64("size-bytes", size), zap.Duration("took", took))
+	return size, took, err
+}
+
+// restoreDatabase restores the backup into a new standalone database,
+// and measures the time until it serves requests.
+func restoreDatabase(fs *flags, t *transporterServer) (took time.Duration, err error) {
+	restoreDir := filepath.Join(fs.backupDir, "restored")
+	if err = os.RemoveAll(restoreDir); err != nil {
+		return 0, err
+	}
+
+	t.lg.Info("starting restore", zap.String("database", t.req.DatabaseID.String()), zap.String("restore-dir", restoreDir))
+	var cmd *exec.Cmd
+	now := time.Now()
+	switch t.req.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__other,
+		dbtesterpb.DatabaseID_etcd__tip,
+		dbtesterpb.DatabaseID_etcd__v3_2,
+		dbtesterpb.DatabaseID_etcd__v3_3,
+		dbtesterpb.DatabaseID_zetcd__beta,
+		dbtesterpb.DatabaseID_cetcd__beta:
+		cmd, err = restoreEtcd(fs, t, restoreDir)
+
+	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		cmd, err = restoreZookeeper(fs, t, restoreDir)
+
+	case dbtesterpb.DatabaseID_consul__v1_0_2:
+		cmd, err = restoreConsul(fs, t, restoreDir)
+
+	default:
+		err = fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
+	}
+	took = time.Since(now)
+	if cmd != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}
+	if err != nil {
+		return took, err
+	}
+
+	t.lg.Info("finished restore", zap.String("database", t.req.DatabaseID.String()), zap.Duration("took", took))
+	return took, os.RemoveAll(restoreDir)
+}
+
+func backupEtcd(ep, fpath string) error {
+	cli, err := clientv3.New(clientv3.Config{Endpoints: []string{ep}})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	rd, err := cli.Snapshot(context.Background())
+	if err != nil {
+		return err
+	}
+	defer rd.Close()
+
+	f, err := os.Create(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err = io.Copy(f, rd); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+func restoreEtcd(fs *flags, t *transporterServer, restoreDir string) (*exec.Cmd, error) {
+	if !exist(fs.etcdctlExec) {
+		return nil, fmt.Errorf("etcdctl binary %q does not exist", fs.etcdctlExec)
+	}
+	restoreEtcdClientURL := fmt.Sprintf("http://127.0.0.1:%d", t.port(restoreEtcdClientPort))
+	restoreEtcdPeerURL := fmt.Sprintf("http://127.0.0.1:%d", t.port(restoreEtcdPeerPort))
+	cmd := exec.Command(fs.etcdctlExec,
+		"snapshot", "restore", filepath.Join(fs.backupDir, "snapshot.db"),
+		"--name", "restored",
+		"--data-dir", restoreDir,
+		"--initial-cluster", "restored="+restoreEtcdPeerURL,
+		"--initial-advertise-peer-urls", restoreEtcdPeerURL,
+	)
+	cmd.Stdout = t.databaseLogFile
+	cmd.Stderr = t.databaseLogFile
+	if err := fs.restrict(cmd, fs.backupDir, "ETCDCTL_API=3"); err != nil {
+		return nil, err
+	}
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+
+	cmd, err := startProcess(fs, t, restoreDir, fs.etcdExec,
+		"--name", "restored",
+		"--data-dir", restoreDir,
+		"--listen-client-urls", restoreEtcdClientURL,
+		"--advertise-client-urls", restoreEtcdClientURL,
+		"--listen-peer-urls", restoreEtcdPeerURL,
+		"--initial-advertise-peer-urls", restoreEtcdPeerURL,
+		"--initial-cluster", "restored="+restoreEtcdPeerURL,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmd, waitUsable(t.lg, func() error {
+		cli, err := clientv3.New(clientv3.Config{Endpoints: []string{restoreEtcdClientURL}, DialTimeout: time.Second})
+		if err != nil {
+			return err
+		}
+		defer cli.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		_, err = cli.Get(ctx, "foo")
+		cancel()
+		return err
+	})
+}
+
+func restoreZookeeper(fs *flags, t *transporterServer, restoreDir string) (*exec.Cmd, error) {
+	if err := copyDir(filepath.Join(fs.backupDir, "version-2"), filepath.Join(restoreDir, "version-2")); err != nil {
+		return nil, err
+	}
+
+	// standalone, without peers
+	fz := t.req.Flag_Zookeeper_R3_5_3Beta
+	if fz == nil {
+		return nil, fmt.Errorf("request 'Flag_Zookeeper_R3_5_3Beta' is nil")
+	}
+	cfgPath := filepath.Join(fs.backupDir, "zookeeper-restored.config")
+	cfgTxt := fmt.Sprintf("tickTime=%d\ndataDir=%s\nclientPort=%d\ninitLimit=%d\nsyncLimit=%d\nmaxClientCnxns=%d\nsnapCount=%d\n",
+		fz.TickTime, restoreDir, t.port(restoreZkClientPort), fz.InitLimit, fz.SyncLimit, fz.MaxClientConnections, fz.SnapCount)
+	if err := toFile(cfgTxt, cfgPath); err != nil {
+		return nil, err
+	}
+
+	cmd, err := startProcess(fs, t, restoreDir, shell, "-c", fs.javaExec+" "+JavaClassPathZookeeperr353beta+" "+cfgPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmd, waitUsable(t.lg, func() error {
+		conn, _, err := zk.Connect([]string{fmt.Sprintf("127.0.0.1:%d", t.port(restoreZkClientPort))}, time.Second)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		_, _, err = conn.Get("/")
+		return err
+	})
+}
+
+func restoreConsul(fs *flags, t *transporterServer, restoreDir string) (*exec.Cmd, error) {
+	restoreConsulHTTP := fmt.Sprintf("127.0.0.1:%d", t.port(restoreConsulHTTPPort))
+	cmd, err := startProcess(fs, t, restoreDir, fs.consulExec,
+		"agent",
+		"-server",
+		"-bootstrap-expect", "1",
+		"-data-dir", restoreDir,
+		"-bind", "127.0.0.1",
+		"-client", "127.0.0.1",
+		"-http-port", fmt.Sprint(t.port(restoreConsulHTTPPort)),
+		"-dns-port", fmt.Sprint(t.port(restoreConsulDNSPort)),
+		"-server-port", fmt.Sprint(t.port(restoreConsulServerPort)),
+		"-serf-lan-port", fmt.Sprint(t.port(restoreConsulSerfLANPort)),
+		"-serf-wan-port", fmt.Sprint(t.port(restoreConsulSerfWANPort)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// snapshot restore requires the leader
+	if err = waitUsable(t.lg, func() error {
+		return runCommand(t, fs.consulExec, "snapshot", "restore", "-http-addr", restoreConsulHTTP, filepath.Join(fs.backupDir, "backup.snap"))
+	}); err != nil {
+		return cmd, err
+	}
+
+	return cmd, waitUsable(t.lg, func() error {
+		dcfg := consulapi.DefaultConfig()
+		dcfg.Address = restoreConsulHTTP
+		cli, err := consulapi.NewClient(dcfg)
+		if err != nil {
+			return err
+		}
+		_, _, err = cli.KV().Get("foo", &consulapi.QueryOptions{RequireConsistent: true})
+		return err
+	})
+}
+
+// waitUsable retries until the database serves requests.
+func waitUsable(lg *zap.Logger, check func() error) (err error) {
+	for i := 0; i < 600; i++ {
+		if err = check(); err == nil {
+			return nil
+		}
+		if i%20 == 0 {
+			lg.Info("waiting for restored database", zap.Error(err))
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return err
+}
+
+func startProcess(fs *flags, t *transporterServer, dataDir, name string, args ...string) (*exec.Cmd, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = t.databaseLogFile
+	cmd.Stderr = t.databaseLogFile
+	if err := fs.restrict(cmd, dataDir); err != nil {
+		return nil, err
+	}
+	t.lg.Info("starting process", zap.String("command", fmt.Sprintf("%s %s", name, strings.Join(args, " "))))
+	return cmd, cmd.Start()
+}
+
+func runCommand(t *transporterServer, name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = t.databaseLogFile
+	cmd.Stderr = t.databaseLogFile
+	return cmd.Run()
+}
+
+// copyDir copies all regular files in the source directory.
+func copyDir(src, dst string) error {
+	fis, err := ioutil.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(dst, 0777); err != nil {
+		return err
+	}
+	for _, fi := range fis {
+		if !fi.Mode().IsRegular() {
+			continue
+		}
+		if err = copyFile(filepath.Join(src, fi.Name()), filepath.Join(dst, fi.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyFile(src, dst string) error {
+	r, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	if _, err = io.Copy(w, r); err != nil {
+		return err
+	}
+	return w.Sync()
+}
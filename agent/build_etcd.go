@@ -0,0 +1,162 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+// etcdBuildMu serializes builds of concurrent tests, which share the
+// etcd source directory, so that one does not check out another commit
+// while the other builds.
+var etcdBuildMu sync.Mutex
+
+// gitSHARegex matches full or abbreviated commit SHAs.
+var gitSHARegex = regexp.MustCompile(`^[0-9a-f]{7,40}$`)
+
+// validateGitRef returns error if the ref is neither a commit SHA nor
+// a valid ref name, so that requested refs are never parsed as git
+// options (e.g. '--upload-pack=<command>').
+func validateGitRef(ref string) error {
+	if ref == "" || strings.HasPrefix(ref, "-") {
+		return fmt.Errorf("invalid etcd git ref %q", ref)
+	}
+	if gitSHARegex.MatchString(ref) {
+		return nil
+	}
+	if err := exec.Command("git", "check-ref-format", "--allow-onelevel", ref).Run(); err != nil {
+		return fmt.Errorf("invalid etcd git ref %q (%v)", ref, err)
+	}
+	return nil
+}
+
+// buildEtcd builds etcd at the git ref, and returns the path of built
+// binary and its commit SHA. Built binaries are cached by commit SHA and
+// architecture, so that agents of different architectures may share the
+// cache directory.
+func buildEtcd(fs *flags, t *transporterServer, ref string) (string, string, error) {
+	if err := validateGitRef(ref); err != nil {
+		return "", "", err
+	}
+	etcdBuildMu.Lock()
+	defer etcdBuildMu.Unlock()
+
+	if !exist(filepath.Join(fs.etcdSrcDir, ".git")) {
+		t.lg.Info("cloning etcd", zap.String("url", fs.etcdGitURL), zap.String("directory", fs.etcdSrcDir))
+		if err := runCommand(t, "git", "clone", fs.etcdGitURL, fs.etcdSrcDir); err != nil {
+			return "", "", fmt.Errorf("failed to clone %q (%v)", fs.etcdGitURL, err)
+		}
+	}
+	if err := runGit(t, fs.etcdSrcDir, "fetch", "--tags", "origin"); err != nil {
+		return "", "", err
+	}
+
+	var sha string
+	for _, rev := range []string{"origin/" + ref, ref} {
+		o, err := gitOutput(fs.etcdSrcDir, "rev-parse", "--verify", "--quiet", rev+"^{commit}")
+		if err == nil {
+			sha = o
+			break
+		}
+	}
+	if sha == "" {
+		// refs outside the default refspec, such as pull requests
+		// ('refs/pull/<number>/head') or commits of forks
+		if err := runGit(t, fs.etcdSrcDir, "fetch", "origin", "--", ref); err == nil {
+			sha, _ = gitOutput(fs.etcdSrcDir, "rev-parse", "--verify", "--quiet", "FETCH_HEAD^{commit}")
+		}
+	}
+	if sha == "" {
+		return "", "", fmt.Errorf("cannot resolve etcd git ref %q", ref)
+	}
+
+	bin := filepath.Join(fs.etcdBuildDir, sha, runtime.GOOS+"-"+runtime.GOARCH, "etcd")
+	if exist(bin) {
+		t.lg.Info("found cached etcd build", zap.String("ref", ref), zap.String("sha", sha), zap.String("path", bin))
+		return bin, sha, nil
+	}
+
+	t.lg.Info("building etcd", zap.String("ref", ref), zap.String("sha", sha))
+	if err := runGit(t, fs.etcdSrcDir, "checkout", "--force", sha); err != nil {
+		return "", "", err
+	}
+	cmd := exec.Command("./build")
+	cmd.Dir = fs.etcdSrcDir
+	cmd.Stdout = t.databaseLogFile
+	cmd.Stderr = t.databaseLogFile
+	if err := cmd.Run(); err != nil {
+		return "", "", fmt.Errorf("failed to build etcd at %q (%v)", sha, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(bin), 0777); err != nil {
+		return "", "", err
+	}
+	// copy and rename, so that the cache never has a partial binary
+	tmp := bin + ".tmp"
+	if err := copyFile(filepath.Join(fs.etcdSrcDir, "bin", "etcd"), tmp); err != nil {
+		return "", "", err
+	}
+	if err := os.Chmod(tmp, 0777); err != nil {
+		return "", "", err
+	}
+	if err := os.Rename(tmp, bin); err != nil {
+		return "", "", err
+	}
+	t.lg.Info("built etcd", zap.String("ref", ref), zap.String("sha", sha), zap.String("path", bin))
+	return bin, sha, nil
+}
+
+// setLabel sets the label of uploaded logs, without modifying the labels of original request.
+func setLabel(req *dbtesterpb.Request, k, v string) {
+	if req.ConfigClientMachineInitial == nil {
+		return
+	}
+	c := *req.ConfigClientMachineInitial
+	c.Labels = make(map[string]string, len(req.ConfigClientMachineInitial.Labels)+1)
+	for lk, lv := range req.ConfigClientMachineInitial.Labels {
+		c.Labels[lk] = lv
+	}
+	c.Labels[k] = v
+	req.ConfigClientMachineInitial = &c
+}
+
+func runGit(t *transporterServer, dir string, args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Stdout = t.databaseLogFile
+	cmd.Stderr = t.databaseLogFile
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("git %s failed (%v)", strings.Join(args, " "), err)
+	}
+	return nil
+}
+
+func gitOutput(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	o, err := cmd.Output()
+	return strings.TrimSpace(string(o)), err
+}
@@ -0,0 +1,40 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import "testing"
+
+func TestValidateGitRef(t *testing.T) {
+	tests := []struct {
+		ref string
+		ok  bool
+	}{
+		{"v3.3.0", true},
+		{"master", true},
+		{"refs/pull/9000/head", true},
+		{"5ef5b4a", true},
+		{"5ef5b4a6a0f77f6c4bd6b7a5b1e4d7c0cd6e4e53", true},
+		{"", false},
+		{"--upload-pack=touch /tmp/pwned", false},
+		{"-b", false},
+		{"a b", false},
+		{"master..HEAD", false},
+	}
+	for i, tt := range tests {
+		if err := validateGitRef(tt.ref); (err == nil) != tt.ok {
+			t.Fatalf("#%d: %q expected valid %v, got %v", i, tt.ref, tt.ok, err)
+		}
+	}
+}
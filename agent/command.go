@@ -19,9 +19,13 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"runtime"
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/agentauth"
+	"github.com/etcd-io/dbtester/pkg/logrotate"
 	"github.com/etcd-io/dbtester/pkg/ntp"
+	"github.com/etcd-io/dbtester/pkg/syslimit"
 	"go.uber.org/zap"
 
 	"github.com/coreos/etcd/pkg/netutil"
@@ -35,23 +39,57 @@ type flags struct {
 	databaseLog                  string
 	systemMetricsCSV             string
 	systemMetricsCSVInterpolated string
-
-	javaExec   string
-	etcdExec   string
-	zetcdExec  string
-	cetcdExec  string
-	consulExec string
+	etcdStatusCSV                string
+	zkStatusCSV                  string
+	zkStatusReset                bool
+	consulTelemetryCSV           string
+	diskStatusCSV                string
+	readStatusCSV                string
+	databaseEventsCSV            string
+	oomKernelLog                 string
+	uploadManifest               string
+	systemMetricsRotateRows      int
+	monitorBackend               string
+
+	javaExec    string
+	etcdExec    string
+	etcdctlExec string
+	etcdGitURL  string
+	zetcdExec   string
+	cetcdExec   string
+	consulExec  string
 
 	zkWorkDir     string
 	zkDataDir     string
 	zkConfig      string
 	etcdDataDir   string
 	consulDataDir string
+	backupDir     string
+	etcdSrcDir    string
+	etcdBuildDir  string
+	runDir        string
+	keepRunData   bool
+
+	diskDelayDir       string
+	diskDelaySizeBytes int64
+
+	antagonistDir string
+
+	databaseUID          int
+	databaseGID          int
+	databaseEnvAllowlist []string
+
+	diskWarnFreeBytes int64
+	diskMinFreeBytes  int64
+
+	logRotate logrotate.Config
 
 	grpcPort         string
 	diskDevice       string
 	networkInterface string
 	clientNumPath    string
+	authSecretPath   string
+	execAllowlist    []string
 }
 
 var globalFlags flags
@@ -75,9 +113,24 @@ func init() {
 	Command.PersistentFlags().StringVar(&globalFlags.databaseLog, "database-log", filepath.Join(homeDir(), "database.log"), "Database log path.")
 	Command.PersistentFlags().StringVar(&globalFlags.systemMetricsCSV, "system-metrics-csv", filepath.Join(homeDir(), "server-system-metrics.csv"), "Raw system metrics data path.")
 	Command.PersistentFlags().StringVar(&globalFlags.systemMetricsCSVInterpolated, "system-metrics-csv-interpolated", filepath.Join(homeDir(), "server-system-metrics-interpolated.csv"), "Interpolated system metrics data path.")
-
-	Command.PersistentFlags().StringVar(&globalFlags.javaExec, "java-exec", "/usr/bin/java", "Java executable binary path (needed for Zookeeper).")
+	Command.PersistentFlags().StringVar(&globalFlags.etcdStatusCSV, "etcd-status-csv", filepath.Join(homeDir(), "server-etcd-status.csv"), "Health, alarms, and disk latencies of the local etcd member, polled every second.")
+	Command.PersistentFlags().StringVar(&globalFlags.zkStatusCSV, "zk-status-csv", filepath.Join(homeDir(), "server-zk-status.csv"), "Request latencies, outstanding requests, and znode counts of the local Zookeeper server, polled every second. 'AVG-LATENCY-MS' and 'MAX-LATENCY-MS' are cumulative since the server start (per second with '--zk-status-reset'), and 'SECOND-AVG-LATENCY-MS' is the average of each second, estimated from the cumulative average in whole milliseconds without the reset.")
+	Command.PersistentFlags().BoolVar(&globalFlags.zkStatusReset, "zk-status-reset", false, "'true' to reset the Zookeeper server statistics after each poll, so that all latency columns are exact per second (clears the statistics for other monitors).")
+	Command.PersistentFlags().StringVar(&globalFlags.consulTelemetryCSV, "consul-telemetry-csv", filepath.Join(homeDir(), "server-consul-telemetry.csv"), "Raft commit time, FSM apply time, and leadership changes of the local Consul server, polled every second.")
+	Command.PersistentFlags().StringVar(&globalFlags.diskStatusCSV, "disk-status-csv", filepath.Join(homeDir(), "server-disk-status.csv"), "Free space of the run and data directories, polled every second.")
+	Command.PersistentFlags().StringVar(&globalFlags.readStatusCSV, "read-status-csv", filepath.Join(homeDir(), "server-read-status.csv"), "Disk and logical reads of the database process, and page cache of the host, polled every second.")
+	Command.PersistentFlags().StringVar(&globalFlags.databaseEventsCSV, "database-events-csv", filepath.Join(homeDir(), "server-database-events.csv"), "Elections, snapshots, and compactions parsed from the database log on stop.")
+	Command.PersistentFlags().StringVar(&globalFlags.oomKernelLog, "oom-kernel-log", filepath.Join(homeDir(), "server-oom-kernel.log"), "Kernel log excerpt of the OOM killer, written when it killed the database.")
+	Command.PersistentFlags().StringVar(&globalFlags.uploadManifest, "upload-manifest", filepath.Join(homeDir(), "upload-manifest.json"), "Manifest path of uploaded logs and metrics.")
+
+	javaExec := "/usr/bin/java"
+	if runtime.GOOS == "windows" {
+		javaExec = "java"
+	}
+	Command.PersistentFlags().StringVar(&globalFlags.javaExec, "java-exec", javaExec, "Java executable binary path (needed for Zookeeper).")
 	Command.PersistentFlags().StringVar(&globalFlags.etcdExec, "etcd-exec", filepath.Join(os.Getenv("GOPATH"), "bin/etcd"), "etcd executable binary path.")
+	Command.PersistentFlags().StringVar(&globalFlags.etcdctlExec, "etcdctl-exec", filepath.Join(os.Getenv("GOPATH"), "bin/etcdctl"), "etcdctl executable binary path (needed for etcd snapshot restore).")
+	Command.PersistentFlags().StringVar(&globalFlags.etcdGitURL, "etcd-git-url", "https://github.com/coreos/etcd.git", "etcd git repository URL (needed for building etcd at 'etcd_git_ref').")
 	Command.PersistentFlags().StringVar(&globalFlags.zetcdExec, "zetcd-exec", filepath.Join(os.Getenv("GOPATH"), "bin/zetcd"), "zetcd executable binary path .")
 	Command.PersistentFlags().StringVar(&globalFlags.cetcdExec, "cetcd-exec", filepath.Join(os.Getenv("GOPATH"), "bin/cetcd"), "cetcd executable binary path .")
 	Command.PersistentFlags().StringVar(&globalFlags.consulExec, "consul-exec", filepath.Join(os.Getenv("GOPATH"), "bin/consul"), "Consul executable binary path.")
@@ -87,13 +140,50 @@ func init() {
 	Command.PersistentFlags().StringVar(&globalFlags.zkConfig, "zookeeper-config", filepath.Join(homeDir(), "zookeeper/zookeeper.config"), "Zookeeper configuration file path.")
 	Command.PersistentFlags().StringVar(&globalFlags.etcdDataDir, "etcd-data-dir", filepath.Join(homeDir(), "etcd.data"), "etcd data directory.")
 	Command.PersistentFlags().StringVar(&globalFlags.consulDataDir, "consul-data-dir", filepath.Join(homeDir(), "consul.data"), "Consul data directory.")
+	Command.PersistentFlags().StringVar(&globalFlags.backupDir, "backup-dir", filepath.Join(homeDir(), "backup"), "Database backup directory.")
+	Command.PersistentFlags().StringVar(&globalFlags.etcdSrcDir, "etcd-src-dir", filepath.Join(homeDir(), "etcd-src"), "etcd source directory to build from.")
+	Command.PersistentFlags().StringVar(&globalFlags.diskDelayDir, "disk-delay-dir", filepath.Join(homeDir(), "disk-delay"), "Mount directory of the delayed disk, for 'disk_write_delay_ms' and 'disk_read_delay_ms' (backed by a sparse file of the same name with '.img' suffix).")
+	Command.PersistentFlags().Int64Var(&globalFlags.diskDelaySizeBytes, "disk-delay-size-bytes", 32*1024*1024*1024, "Size of the delayed disk.")
+	Command.PersistentFlags().StringVar(&globalFlags.antagonistDir, "antagonist-dir", filepath.Join(homeDir(), "antagonist"), "Directory of disk writes, for 'antagonist_disk_write_bytes_per_second'.")
+	Command.PersistentFlags().Int64Var(&globalFlags.diskWarnFreeBytes, "disk-warn-free-bytes", 5*1024*1024*1024, "Warn when free space of the run or data directory falls below (0 to disable).")
+	Command.PersistentFlags().Int64Var(&globalFlags.diskMinFreeBytes, "disk-min-free-bytes", 1024*1024*1024, "Refuse to start, or stop the database cleanly, when free space of the run or data directory falls below (0 to disable).")
+	Command.PersistentFlags().StringVar(&globalFlags.runDir, "run-dir", filepath.Join(homeDir(), "runs"), "Directory of runs. Database logs, system metrics, and data directories are created under '<run-dir>/<test-name>/<run-timestamp>' for each run, with the base names of their flags.")
+	Command.PersistentFlags().BoolVar(&globalFlags.keepRunData, "keep-run-data", false, "'true' to keep database data directories of the run after stop.")
+	Command.PersistentFlags().StringVar(&globalFlags.etcdBuildDir, "etcd-build-dir", filepath.Join(homeDir(), "etcd-builds"), "Directory to cache etcd binaries by commit SHA and architecture.")
+
+	Command.PersistentFlags().IntVar(&globalFlags.databaseUID, "database-uid", -1, "User ID to run databases as (-1 to inherit the agent's, requires the agent to run as root otherwise).")
+	Command.PersistentFlags().IntVar(&globalFlags.databaseGID, "database-gid", -1, "Group ID to run databases as (-1 to inherit the agent's, requires the agent to run as root otherwise).")
+	Command.PersistentFlags().StringSliceVar(&globalFlags.databaseEnvAllowlist, "database-env-allowlist", nil, "Comma-separated environment variables passed to databases (empty to pass the agent's whole environment).")
+
+	Command.PersistentFlags().Int64Var(&globalFlags.logRotate.MaxBytes, "log-rotate-max-bytes", 0, "Maximum size of database log before rotation (0 to disable).")
+	Command.PersistentFlags().DurationVar(&globalFlags.logRotate.MaxAge, "log-rotate-max-age", 0, "Maximum age of database log and system metrics CSV before rotation (0 to disable).")
+	Command.PersistentFlags().IntVar(&globalFlags.logRotate.MaxBackups, "log-rotate-max-backups", 0, "Maximum number of rotated files to keep (0 to keep all).")
+	Command.PersistentFlags().BoolVar(&globalFlags.logRotate.Compress, "log-rotate-compress", false, "'true' to gzip rotated files.")
+	Command.PersistentFlags().IntVar(&globalFlags.systemMetricsRotateRows, "system-metrics-rotate-rows", 0, "Maximum number of system metrics rows before rotating the CSV (0 to disable).")
+	Command.PersistentFlags().StringVar(&globalFlags.monitorBackend, "monitor-backend", "auto", "System metrics backend on Linux: 'proc' for '/proc' and 'top' of the database process, 'cgroup2' for CPU, memory, and I/O of the database's cgroup v2 (host disk and network still from '/proc'), or 'auto' for 'cgroup2' when the database runs in its own cgroup v2.")
 
 	Command.PersistentFlags().StringVar(&globalFlags.grpcPort, "agent-port", ":3500", "Port to server agent gRPC server.")
 	Command.PersistentFlags().StringVar(&globalFlags.diskDevice, "disk-device", dn, "Disk device to collect disk statistics metrics from.")
 	Command.PersistentFlags().StringVar(&globalFlags.networkInterface, "network-interface", nt, "Network interface to record in/outgoing packets.")
+	Command.PersistentFlags().StringVar(&globalFlags.authSecretPath, "auth-secret-path", "", "Path to the secret shared with control ('agent_auth_secret_path'), to reject unsigned requests (empty to accept all).")
+	Command.PersistentFlags().StringSliceVar(&globalFlags.execAllowlist, "exec-allowlist", nil, "Comma-separated executables that control may run on the agent for 'agents' hooks, as given in 'command' (empty to reject all).")
 	Command.PersistentFlags().StringVar(&globalFlags.clientNumPath, "client-num-path", filepath.Join(homeDir(), "client-num"), "File path to store client number.")
 }
 
+// scoped returns the flags with state paths under the test ID sub-directory,
+// so that concurrent tests do not share runs or working directories.
+func (fs flags) scoped(testID string) flags {
+	if testID == "" {
+		return fs
+	}
+	scope := func(p string) string {
+		return filepath.Join(filepath.Dir(p), testID, filepath.Base(p))
+	}
+	fs.runDir = scope(fs.runDir)
+	fs.etcdSrcDir = scope(fs.etcdSrcDir)
+	return fs
+}
+
 // Command implements 'agent' command.
 var Command = &cobra.Command{
 	Use:   "agent",
@@ -125,9 +215,24 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 	if lerr != nil {
 		return lerr
 	}
+	if err := globalFlags.validatePrivilege(); err != nil {
+		lg.Warn("cannot run databases as dedicated user", zap.Error(err))
+		return err
+	}
+	lg.Info("set GOMAXPROCS", zap.Int("gomaxprocs", syslimit.SetMaxProcs()))
+
+	var secret []byte
+	if globalFlags.authSecretPath != "" {
+		s, err := agentauth.ReadSecret(globalFlags.authSecretPath)
+		if err != nil {
+			lg.Warn("cannot read auth secret", zap.Error(err))
+			return err
+		}
+		secret = s
+	}
 
 	var (
-		grpcServer = grpc.NewServer()
+		grpcServer = grpc.NewServer(agentauth.ServerOptions(secret)...)
 		sender     = NewServer(lg)
 	)
 	ln, err := net.Listen("tcp", globalFlags.grpcPort)
@@ -0,0 +1,216 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+// consulTelemetryHeader is the header of the Consul telemetry CSV.
+// Consul aggregates metrics in 10-second intervals, so rows of the
+// same 'INTERVAL' repeat the same values.
+var consulTelemetryHeader = []string{
+	"UNIX-SECOND", "INTERVAL",
+	"RAFT-COMMIT-COUNT", "RAFT-COMMIT-MEAN-MS", "RAFT-COMMIT-MAX-MS",
+	"FSM-APPLY-COUNT", "FSM-APPLY-MEAN-MS", "FSM-APPLY-MAX-MS",
+	"LEADER-CHANGES",
+	"ROLE",
+	"ERROR",
+}
+
+// consulTelemetry polls '/v1/agent/metrics' of the local Consul server
+// every second, so that Raft internals are recorded with system metrics.
+type consulTelemetry struct {
+	lg    *zap.Logger
+	ip    string
+	addr  string
+	f     *os.File
+	w     *csv.Writer
+	stopc chan struct{}
+	donec chan struct{}
+}
+
+// consulMetrics is the subset of '/v1/agent/metrics' response.
+type consulMetrics struct {
+	Timestamp string
+	Counters  []consulSample
+	Samples   []consulSample
+}
+
+type consulSample struct {
+	Name  string
+	Count int64
+	Mean  float64
+	Max   float64
+}
+
+// consulServer returns true if the agent runs a Consul server,
+// not a client agent on a loader machine.
+func consulServer(req dbtesterpb.Request) bool {
+	return req.DatabaseID == dbtesterpb.DatabaseID_consul__v1_0_2 &&
+		int(req.IPIndex) < len(strings.Split(req.PeerIPsString, "___"))
+}
+
+// startConsulTelemetry starts polling the local Consul server
+// into 'fs.consulTelemetryCSV'.
+func startConsulTelemetry(fs *flags, t *transporterServer) (*consulTelemetry, error) {
+	peerIPs := strings.Split(t.req.PeerIPsString, "___")
+	f, err := os.Create(fs.consulTelemetryCSV)
+	if err != nil {
+		return nil, err
+	}
+	c := &consulTelemetry{
+		lg:    t.lg,
+		ip:    peerIPs[t.req.IPIndex],
+		addr:  fmt.Sprintf("http://%s:%d", peerIPs[t.req.IPIndex], t.port(8500)),
+		f:     f,
+		w:     csv.NewWriter(f),
+		stopc: make(chan struct{}),
+		donec: make(chan struct{}),
+	}
+	if err = c.write(consulTelemetryHeader); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	t.lg.Info("starting polling Consul telemetry", zap.String("endpoint", c.addr), zap.String("path", fs.consulTelemetryCSV))
+	go c.run()
+	return c, nil
+}
+
+func (c *consulTelemetry) run() {
+	defer close(c.donec)
+
+	for {
+		select {
+		case <-c.stopc:
+			return
+		case <-time.After(time.Second):
+		}
+
+		now := time.Now()
+		row := make([]string, len(consulTelemetryHeader))
+		row[0] = fmt.Sprintf("%d", now.Unix())
+		m, err := c.poll()
+		if err != nil {
+			row[len(row)-1] = err.Error()
+		} else {
+			commit := m.sample("consul.raft.commitTime")
+			apply := m.sample("consul.raft.fsm.apply")
+			row[1] = m.Timestamp
+			row[2] = fmt.Sprintf("%d", commit.Count)
+			row[3] = fmt.Sprintf("%f", commit.Mean)
+			row[4] = fmt.Sprintf("%f", commit.Max)
+			row[5] = fmt.Sprintf("%d", apply.Count)
+			row[6] = fmt.Sprintf("%f", apply.Mean)
+			row[7] = fmt.Sprintf("%f", apply.Max)
+			row[8] = fmt.Sprintf("%d", m.counter("consul.raft.state.leader").Count)
+		}
+		if role, err := c.role(); err != nil {
+			c.lg.Warn("failed to get Consul leader", zap.Error(err))
+		} else {
+			row[9] = role
+		}
+		if err := c.write(row); err != nil {
+			c.lg.Warn("failed to write Consul telemetry", zap.Error(err))
+		}
+	}
+}
+
+func (c *consulTelemetry) poll() (m consulMetrics, err error) {
+	hc := &http.Client{Timeout: time.Second}
+	resp, err := hc.Get(c.addr + "/v1/agent/metrics")
+	if err != nil {
+		return m, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return m, fmt.Errorf("%q returned %q", c.addr+"/v1/agent/metrics", resp.Status)
+	}
+	err = json.NewDecoder(resp.Body).Decode(&m)
+	return m, err
+}
+
+// role returns leader if the local server is the Raft leader
+// of its datacenter, or follower otherwise.
+func (c *consulTelemetry) role() (string, error) {
+	hc := &http.Client{Timeout: time.Second}
+	resp, err := hc.Get(c.addr + "/v1/status/leader")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	// e.g. "10.240.0.7:8300"
+	var leader string
+	if err = json.NewDecoder(resp.Body).Decode(&leader); err != nil {
+		return "", err
+	}
+	host, _, err := net.SplitHostPort(leader)
+	if err != nil {
+		return "", err
+	}
+	if host == c.ip {
+		return "leader", nil
+	}
+	return "follower", nil
+}
+
+// sample returns the named sample, zero if not observed in the interval.
+func (m consulMetrics) sample(name string) consulSample {
+	for _, s := range m.Samples {
+		if s.Name == name {
+			return s
+		}
+	}
+	return consulSample{}
+}
+
+// counter returns the named counter, zero if not counted in the interval.
+func (m consulMetrics) counter(name string) consulSample {
+	for _, s := range m.Counters {
+		if s.Name == name {
+			return s
+		}
+	}
+	return consulSample{}
+}
+
+func (c *consulTelemetry) write(row []string) error {
+	if err := c.w.Write(row); err != nil {
+		return err
+	}
+	// flush every row, to keep the rows before a crash
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// stop stops polling, and closes the CSV.
+func (c *consulTelemetry) stop() {
+	close(c.stopc)
+	<-c.donec
+	c.f.Close()
+}
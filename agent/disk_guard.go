@@ -0,0 +1,216 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	humanize "github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// diskStatusHeader is the header of the disk status CSV.
+var diskStatusHeader = []string{"UNIX-SECOND", "PATH", "FREE-BYTES", "STATE", "ERROR"}
+
+const (
+	diskStateOK      = "ok"
+	diskStateWarning = "warning"
+	diskStateAbort   = "abort"
+)
+
+// diskGuard polls free space of the run and data directories every second.
+// It warns below '--disk-warn-free-bytes', and stops the database cleanly
+// below '--disk-min-free-bytes', so that the database does not die on
+// ENOSPC and logs and system metrics of the run are still flushed and
+// uploaded.
+type diskGuard struct {
+	lg *zap.Logger
+	t  *transporterServer
+	// stopReq stops the database when the disk is almost full
+	stopReq dbtesterpb.Request
+
+	dirs  []string
+	warn  int64
+	min   int64
+	f     *os.File
+	w     *csv.Writer
+	stopc chan struct{}
+	donec chan struct{}
+}
+
+// diskGuardDirs returns the run directory, and the data directory
+// of the database, which may be on another disk (e.g. delayed disk).
+func diskGuardDirs(fs *flags, rdir string, id dbtesterpb.DatabaseID) []string {
+	dirs := []string{rdir}
+	switch {
+	case etcdBased(id):
+		dirs = append(dirs, fs.etcdDataDir)
+	case id == dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		dirs = append(dirs, fs.zkDataDir)
+	case id == dbtesterpb.DatabaseID_consul__v1_0_2:
+		dirs = append(dirs, fs.consulDataDir)
+	}
+	return dirs
+}
+
+// freeDiskBytes returns the free bytes available to the agent on the disk
+// of the path. The path may not exist yet (e.g. data directory before the
+// database starts), then its nearest existing parent is checked.
+func freeDiskBytes(p string) (int64, error) {
+	for !exist(p) && filepath.Dir(p) != p {
+		p = filepath.Dir(p)
+	}
+	return diskFreeBytes(p)
+}
+
+// checkFreeDisk returns an error if any of the directories has less
+// free space than '--disk-min-free-bytes'.
+func checkFreeDisk(fs *flags, dirs []string) error {
+	if fs.diskMinFreeBytes <= 0 {
+		return nil
+	}
+	for _, dir := range dirs {
+		free, err := freeDiskBytes(dir)
+		if err != nil {
+			return err
+		}
+		if free < fs.diskMinFreeBytes {
+			return fmt.Errorf("not enough free disk space at %q (%s free, expected at least %s)",
+				dir, humanize.Bytes(uint64(free)), humanize.Bytes(uint64(fs.diskMinFreeBytes)))
+		}
+	}
+	return nil
+}
+
+// startDiskGuard starts polling free disk space into 'fs.diskStatusCSV'.
+func startDiskGuard(fs *flags, t *transporterServer, dirs []string) (*diskGuard, error) {
+	f, err := os.Create(fs.diskStatusCSV)
+	if err != nil {
+		return nil, err
+	}
+	g := &diskGuard{
+		lg: t.lg,
+		t:  t,
+		stopReq: dbtesterpb.Request{
+			Operation:  dbtesterpb.Operation_Stop,
+			DatabaseID: t.req.DatabaseID,
+			TestID:     t.req.TestID,
+		},
+		dirs:  dirs,
+		warn:  fs.diskWarnFreeBytes,
+		min:   fs.diskMinFreeBytes,
+		f:     f,
+		w:     csv.NewWriter(f),
+		stopc: make(chan struct{}),
+		donec: make(chan struct{}),
+	}
+	if err = g.write(diskStatusHeader); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	t.lg.Info("starting polling free disk space",
+		zap.Strings("directories", dirs),
+		zap.Int64("warn-free-bytes", g.warn),
+		zap.Int64("min-free-bytes", g.min),
+		zap.String("path", fs.diskStatusCSV),
+	)
+	go g.run()
+	return g, nil
+}
+
+func (g *diskGuard) run() {
+	defer close(g.donec)
+
+	prev := make(map[string]string, len(g.dirs))
+	for {
+		select {
+		case <-g.stopc:
+			return
+		case <-time.After(time.Second):
+		}
+
+		now := time.Now()
+		abort := false
+		for _, dir := range g.dirs {
+			free, err := freeDiskBytes(dir)
+			row := []string{fmt.Sprintf("%d", now.Unix()), dir, "", "", ""}
+			state := diskStateOK
+			switch {
+			case err != nil:
+				row[4] = err.Error()
+			case g.min > 0 && free < g.min:
+				state, abort = diskStateAbort, true
+			case g.warn > 0 && free < g.warn:
+				state = diskStateWarning
+			}
+			if err == nil {
+				row[2], row[3] = fmt.Sprintf("%d", free), state
+			}
+			if err == nil && state != prev[dir] && state != diskStateOK {
+				g.lg.Warn("low free disk space", zap.String("directory", dir), zap.String("free", humanize.Bytes(uint64(free))), zap.String("state", state))
+			}
+			prev[dir] = state
+			if werr := g.write(row); werr != nil {
+				g.lg.Warn("failed to write disk status", zap.Error(werr))
+			}
+		}
+
+		if abort {
+			// stop from another goroutine, since stopping
+			// the database waits for this poller to exit
+			go g.t.abortOnDiskFull(g.stopReq)
+			return
+		}
+	}
+}
+
+// abortOnDiskFull stops the database as requested by control, so that
+// logs and metrics are flushed and uploaded. Later 'Stop' requests from
+// control are acknowledged with the response of this stop.
+func (t *transporterServer) abortOnDiskFull(req dbtesterpb.Request) {
+	t.lg.Warn("stopping database before disk is full")
+	if _, err := t.Transfer(context.Background(), &req); err != nil {
+		t.lg.Warn("failed to stop database before disk is full", zap.Error(err))
+	}
+}
+
+func (g *diskGuard) write(row []string) error {
+	if err := g.w.Write(row); err != nil {
+		return err
+	}
+	// flush every row, to keep the rows before a crash
+	g.w.Flush()
+	return g.w.Error()
+}
+
+// stop stops polling, and closes the CSV. It is safe
+// to call after polling stopped to abort the run.
+func (g *diskGuard) stop() {
+	select {
+	case <-g.donec:
+	default:
+		close(g.stopc)
+		<-g.donec
+	}
+	g.f.Close()
+}
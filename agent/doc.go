@@ -12,5 +12,6 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package agent is a database agent in remote servers.
+// Package agent is a database agent in remote servers. It can run as
+// 'dbtester agent', or be embedded in other programs with 'New'.
 package agent
@@ -0,0 +1,54 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// dropCachesPath drops clean page cache, dentries, and inodes on write of "3".
+const dropCachesPath = "/proc/sys/vm/drop_caches"
+
+// dropPageCache syncs dirty pages and drops the page cache, so that the
+// following reads are served from disk. If restart, the database is stopped
+// before and restarted after, so that its own caches are cold as well.
+func dropPageCache(fs *flags, t *transporterServer, restart bool) error {
+	// gRPC proxies hold no data, and reconnect to restarted members
+	restart = restart && !grpcProxyEtcd(t.req)
+	if restart {
+		if processExited(t) {
+			return fmt.Errorf("database process %d has already exited", t.pid)
+		}
+		stopProcess(t)
+		t.lg.Info("stopped database to drop caches", zap.String("database", t.req.DatabaseID.String()))
+	}
+
+	now := time.Now()
+	if err := dropCaches(); err != nil {
+		return fmt.Errorf("cannot drop page cache (%v)", err)
+	}
+	t.lg.Info("dropped page cache", zap.Duration("took", time.Since(now)))
+
+	if restart {
+		if err := execDatabase(fs, t); err != nil {
+			return err
+		}
+		t.lg.Info("restarted database with cold caches", zap.String("database", t.req.DatabaseID.String()), zap.Int64("pid", t.pid))
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/logrotate"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// Config configures an agent embedded in another program. Zero fields
+// default to the defaults of the 'agent' command flags.
+type Config struct {
+	// RunDir is the directory of runs ('--run-dir').
+	RunDir string
+	// KeepRunData is true to keep database data directories after stop.
+	KeepRunData bool
+
+	// JavaExec, EtcdExec, EtcdctlExec, ZetcdExec, CetcdExec, and ConsulExec
+	// are the executable binary paths of databases and their tools.
+	JavaExec    string
+	EtcdExec    string
+	EtcdctlExec string
+	ZetcdExec   string
+	CetcdExec   string
+	ConsulExec  string
+	// ZookeeperWorkDir is the working directory of Zookeeper.
+	ZookeeperWorkDir string
+
+	// DiskDevice and NetworkInterface are monitored for system metrics.
+	DiskDevice       string
+	NetworkInterface string
+	// MonitorBackend is 'proc', 'cgroup2', or 'auto' ('--monitor-backend').
+	MonitorBackend string
+
+	// LogRotate rotates database logs and system metrics CSVs.
+	LogRotate logrotate.Config
+	// ExecAllowlist is the executables that requests may run.
+	ExecAllowlist []string
+}
+
+// flags returns the base flags overridden by non-zero fields.
+func (c Config) flags(base flags) flags {
+	set := func(dst *string, v string) {
+		if v != "" {
+			*dst = v
+		}
+	}
+	set(&base.runDir, c.RunDir)
+	set(&base.javaExec, c.JavaExec)
+	set(&base.etcdExec, c.EtcdExec)
+	set(&base.etcdctlExec, c.EtcdctlExec)
+	set(&base.zetcdExec, c.ZetcdExec)
+	set(&base.cetcdExec, c.CetcdExec)
+	set(&base.consulExec, c.ConsulExec)
+	set(&base.zkWorkDir, c.ZookeeperWorkDir)
+	set(&base.diskDevice, c.DiskDevice)
+	set(&base.networkInterface, c.NetworkInterface)
+	set(&base.monitorBackend, c.MonitorBackend)
+	if c.KeepRunData {
+		base.keepRunData = true
+	}
+	if c.LogRotate != (logrotate.Config{}) {
+		base.logRotate = c.LogRotate
+	}
+	if len(c.ExecAllowlist) > 0 {
+		base.execAllowlist = c.ExecAllowlist
+	}
+	return base
+}
+
+// Agent manages database processes and their monitoring on the local
+// machine, for programs (e.g. custom orchestrators) that embed the agent
+// instead of running 'dbtester agent'. It shares the state of the gRPC
+// server returned by 'Server', so that dbtester control can still drive
+// the same databases.
+type Agent struct {
+	srv *server
+}
+
+// New returns a new embedded agent.
+func New(lg *zap.Logger, cfg Config) *Agent {
+	return &Agent{srv: newServer(lg, cfg.flags(globalFlags))}
+}
+
+// Server returns the gRPC interface of the agent, to register with
+// 'dbtesterpb.RegisterTransporterServer'.
+func (a *Agent) Server() dbtesterpb.TransporterServer {
+	return a.srv
+}
+
+// Start starts the database of the request, as control does on 'Start'.
+func (a *Agent) Start(ctx context.Context, req dbtesterpb.Request) (*dbtesterpb.Response, error) {
+	req.Operation = dbtesterpb.Operation_Start
+	return a.srv.Transfer(ctx, &req)
+}
+
+// Stop stops the database of the test, and uploads its logs if requested
+// on start.
+func (a *Agent) Stop(ctx context.Context, testID string) (*dbtesterpb.Response, error) {
+	return a.srv.Transfer(ctx, &dbtesterpb.Request{Operation: dbtesterpb.Operation_Stop, TestID: testID})
+}
+
+// Restart stops the database member of the test, keeping its data
+// directory, and starts it again. It returns once the member catches up
+// with the rest of the cluster, with the time taken.
+func (a *Agent) Restart(ctx context.Context, testID string) (*dbtesterpb.Response, error) {
+	if _, err := a.srv.Transfer(ctx, &dbtesterpb.Request{Operation: dbtesterpb.Operation_StopMember, TestID: testID}); err != nil {
+		return nil, err
+	}
+	return a.srv.Transfer(ctx, &dbtesterpb.Request{Operation: dbtesterpb.Operation_RestartMember, TestID: testID})
+}
+
+// Status is the state of the database of a test.
+type Status struct {
+	// State is "Idle", "Running", or "Stopping".
+	State      string
+	DatabaseID dbtesterpb.DatabaseID
+	// PID is the process ID of the database, zero before start.
+	PID int64
+	// Exited is true if the database process has exited, even though
+	// the state is "Running" (e.g. crashed, or stopped member).
+	Exited bool
+	// RunDir is the directory of logs and system metrics of the run.
+	RunDir string
+}
+
+// Status returns the state of the database of the test.
+func (a *Agent) Status(testID string) Status {
+	t := a.srv.lookup(testID)
+	if t == nil {
+		return Status{State: stateIdle.String()}
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	st := Status{State: t.state.String(), DatabaseID: t.statusDatabaseID, PID: t.statusPID}
+	if t.statusCmdWait != nil {
+		select {
+		case <-t.statusCmdWait:
+			st.Exited = true
+		default:
+		}
+	}
+	if t.run != nil {
+		st.RunDir = t.run.dir
+	}
+	return st
+}
+
+// Monitor sends the status of the database of the test every interval,
+// and closes the channel when the context is canceled.
+func (a *Agent) Monitor(ctx context.Context, testID string, interval time.Duration) <-chan Status {
+	ch := make(chan Status, 1)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case ch <- a.Status(testID):
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
@@ -0,0 +1,256 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// etcdStatusHeader is the header of the etcd status CSV.
+var etcdStatusHeader = []string{
+	"UNIX-SECOND", "HEALTH", "ALARMS", "ERROR",
+	"WAL-FSYNC-COUNT", "WAL-FSYNC-P99-MS",
+	"BACKEND-COMMIT-COUNT", "BACKEND-COMMIT-P99-MS",
+	"ROLE",
+}
+
+// etcdDiskHistograms are the disk latency histograms of etcd
+// scraped every second, in 'etcdStatusHeader' order.
+var etcdDiskHistograms = []string{
+	"etcd_disk_wal_fsync_duration_seconds",
+	"etcd_disk_backend_commit_duration_seconds",
+}
+
+// etcdStatus polls the health endpoint, the alarm list, and the disk
+// latency histograms of the local etcd member every second, so that
+// health transitions seen by the server (e.g. NOSPACE, CORRUPT alarms)
+// and disk stalls are recorded with system metrics.
+type etcdStatus struct {
+	lg    *zap.Logger
+	ep    string
+	cli   *clientv3.Client
+	f     *os.File
+	w     *csv.Writer
+	stopc chan struct{}
+	donec chan struct{}
+
+	// prevHists are the last scraped cumulative histograms,
+	// to compute latencies within each second
+	prevHists map[string]promHistogram
+}
+
+// etcdStatusRow is the status of the member at a second.
+type etcdStatusRow struct {
+	health string
+	alarms string
+	err    string
+	// disk is the count and p99 of each of 'etcdDiskHistograms'
+	// within the second, empty if not scraped
+	disk []string
+	// role is leader or follower, empty if unknown
+	role string
+}
+
+// etcdBased returns true if the database runs etcd
+// (including zetcd and cetcd proxies backed by etcd).
+func etcdBased(id dbtesterpb.DatabaseID) bool {
+	switch id {
+	case dbtesterpb.DatabaseID_etcd__other,
+		dbtesterpb.DatabaseID_etcd__tip,
+		dbtesterpb.DatabaseID_etcd__v3_2,
+		dbtesterpb.DatabaseID_etcd__v3_3,
+		dbtesterpb.DatabaseID_zetcd__beta,
+		dbtesterpb.DatabaseID_cetcd__beta:
+		return true
+	}
+	return false
+}
+
+// startEtcdStatus starts polling the local etcd member
+// into 'fs.etcdStatusCSV'.
+func startEtcdStatus(fs *flags, t *transporterServer) (*etcdStatus, error) {
+	peerIPs := strings.Split(t.req.PeerIPsString, "___")
+	ep := fmt.Sprintf("%s:%d", peerIPs[t.req.IPIndex], t.port(2379))
+
+	// no dial timeout, since the member may not be serving yet
+	cli, err := clientv3.New(clientv3.Config{Endpoints: []string{ep}})
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Create(fs.etcdStatusCSV)
+	if err != nil {
+		cli.Close()
+		return nil, err
+	}
+	s := &etcdStatus{
+		lg:    t.lg,
+		ep:    ep,
+		cli:   cli,
+		f:     f,
+		w:     csv.NewWriter(f),
+		stopc: make(chan struct{}),
+		donec: make(chan struct{}),
+	}
+	if err = s.write(etcdStatusHeader); err != nil {
+		s.close()
+		return nil, err
+	}
+
+	t.lg.Info("starting polling etcd status", zap.String("endpoint", ep), zap.String("path", fs.etcdStatusCSV))
+	go s.run()
+	return s, nil
+}
+
+func (s *etcdStatus) run() {
+	defer close(s.donec)
+
+	var prev etcdStatusRow
+	for {
+		select {
+		case <-s.stopc:
+			return
+		case <-time.After(time.Second):
+		}
+
+		now := time.Now()
+		cur := s.poll()
+		if cur.health != prev.health || cur.alarms != prev.alarms || cur.role != prev.role {
+			s.lg.Info(
+				"etcd status changed",
+				zap.String("endpoint", s.ep),
+				zap.String("health", cur.health),
+				zap.String("alarms", cur.alarms),
+				zap.String("role", cur.role),
+				zap.String("error", cur.err),
+			)
+		}
+		prev = cur
+
+		row := []string{fmt.Sprintf("%d", now.Unix()), cur.health, cur.alarms, cur.err}
+		row = append(append(row, cur.disk...), cur.role)
+		if err := s.write(row); err != nil {
+			s.lg.Warn("failed to write etcd status", zap.Error(err))
+		}
+	}
+}
+
+// poll returns the health, active alarms, disk latencies, and role
+// of the member, with errors of all requests.
+func (s *etcdStatus) poll() (row etcdStatusRow) {
+	var errs []string
+	healthy, err := etcdHealth(s.ep)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+	row.health = fmt.Sprintf("%t", healthy)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	resp, err := s.cli.AlarmList(ctx)
+	cancel()
+	if err != nil {
+		errs = append(errs, err.Error())
+	} else {
+		var alarms []string
+		for _, a := range resp.Alarms {
+			alarms = append(alarms, fmt.Sprintf("%s:%x", a.Alarm, a.MemberID))
+		}
+		sort.Strings(alarms)
+		row.alarms = strings.Join(alarms, ";")
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	st, err := s.cli.Status(ctx, s.ep)
+	cancel()
+	if err != nil {
+		errs = append(errs, err.Error())
+	} else {
+		row.role = "follower"
+		if st.Header.MemberId == st.Leader {
+			row.role = "leader"
+		}
+	}
+
+	row.disk = make([]string, 2*len(etcdDiskHistograms))
+	hists, err := scrapeHistograms(s.ep, etcdDiskHistograms...)
+	if err != nil {
+		errs = append(errs, err.Error())
+	} else {
+		for i, name := range etcdDiskHistograms {
+			prev, ok := s.prevHists[name]
+			if !ok {
+				continue // no interval yet
+			}
+			delta := hists[name].sub(prev)
+			row.disk[2*i] = fmt.Sprintf("%d", delta.count)
+			row.disk[2*i+1] = fmt.Sprintf("%f", delta.quantile(0.99)*1000)
+		}
+		s.prevHists = hists
+	}
+
+	row.err = strings.Join(errs, ";")
+	return row
+}
+
+// etcdHealth returns true if the health endpoint reports healthy.
+func etcdHealth(ep string) (bool, error) {
+	hc := &http.Client{Timeout: time.Second}
+	resp, err := hc.Get("http://" + ep + "/health")
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	var h struct {
+		Health string `json:"health"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&h); err != nil {
+		return false, err
+	}
+	return h.Health == "true", nil
+}
+
+func (s *etcdStatus) write(row []string) error {
+	if err := s.w.Write(row); err != nil {
+		return err
+	}
+	// flush every row, to keep the rows before a crash
+	s.w.Flush()
+	return s.w.Error()
+}
+
+// stop stops polling, and closes the CSV.
+func (s *etcdStatus) stop() {
+	close(s.stopc)
+	<-s.donec
+	s.close()
+}
+
+func (s *etcdStatus) close() {
+	s.cli.Close()
+	s.f.Close()
+}
@@ -0,0 +1,64 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// maxExecOutputBytes limits the command output returned to control.
+const maxExecOutputBytes = 64 * 1024
+
+// defaultExecTimeout is the timeout of commands without one.
+const defaultExecTimeout = time.Minute
+
+// runExec runs the command for control hooks, if its executable is in
+// '--exec-allowlist' as given, and returns its combined output.
+func runExec(fs *flags, t *transporterServer, command []string, timeout time.Duration) (string, error) {
+	if len(command) == 0 {
+		return "", fmt.Errorf("empty command")
+	}
+	allowed := false
+	for _, name := range fs.execAllowlist {
+		allowed = allowed || name == command[0]
+	}
+	if !allowed {
+		return "", fmt.Errorf("%q is not in '--exec-allowlist'", command[0])
+	}
+	if timeout <= 0 {
+		timeout = defaultExecTimeout
+	}
+
+	t.lg.Info("running command", zap.Strings("command", command), zap.Duration("timeout", timeout))
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	o, err := exec.CommandContext(ctx, command[0], command[1:]...).CombinedOutput()
+	if len(o) > maxExecOutputBytes {
+		o = o[len(o)-maxExecOutputBytes:]
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("timed out after %v", timeout)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%q failed (%v, output %q)", command, err, o)
+	}
+	t.lg.Info("ran command", zap.Strings("command", command), zap.String("output", string(o)))
+	return string(o), nil
+}
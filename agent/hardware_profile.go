@@ -0,0 +1,119 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// hardwareProfile returns the machine profile of the agent. Fields that
+// cannot be read are left empty, rather than failing the request.
+func hardwareProfile(fs *flags) *dbtesterpb.HardwareProfile {
+	p := &dbtesterpb.HardwareProfile{
+		CPUModel:        cpuModel(),
+		CPUCount:        int64(runtime.NumCPU()),
+		DiskType:        diskType(fs.diskDevice),
+		CPUArchitecture: runtime.GOARCH,
+	}
+	if v, err := procValue("/proc/meminfo", "MemTotal"); err == nil {
+		p.MemoryBytes = int64(v)
+	}
+	if bts, err := ioutil.ReadFile("/proc/sys/kernel/osrelease"); err == nil {
+		p.KernelVersion = strings.TrimSpace(string(bts))
+	}
+	return p
+}
+
+// cpuModel returns the first 'model name' in /proc/cpuinfo. arm64 kernels
+// report no model name, but the implementer and part number of the core.
+func cpuModel() string {
+	f, err := os.Open("/proc/cpuinfo")
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	return parseCPUModel(f)
+}
+
+// armCPUParts maps the 'CPU implementer' and 'CPU part' of arm64 cores
+// in /proc/cpuinfo to their names, for common server processors.
+var armCPUParts = map[string]string{
+	"0x41/0xd08": "ARM Cortex-A72",
+	"0x41/0xd0c": "ARM Neoverse-N1",
+	"0x41/0xd40": "ARM Neoverse-V1",
+	"0x41/0xd49": "ARM Neoverse-N2",
+	"0x41/0xd4f": "ARM Neoverse-V2",
+	"0xc0/0xac3": "Ampere-1",
+	"0xc0/0xac4": "Ampere-1a",
+}
+
+func parseCPUModel(r io.Reader) string {
+	var implementer, part string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		kv := strings.SplitN(sc.Text(), ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		k, v := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		switch {
+		case k == "model name":
+			return v
+		case k == "CPU implementer" && implementer == "":
+			implementer = v
+		case k == "CPU part" && part == "":
+			part = v
+		}
+	}
+	if implementer == "" || part == "" {
+		return ""
+	}
+	if name, ok := armCPUParts[implementer+"/"+part]; ok {
+		return name
+	}
+	return fmt.Sprintf("implementer %s part %s", implementer, part)
+}
+
+// diskType returns 'ssd' or 'hdd' from the rotational flag of the device
+// (e.g. '/dev/sda1'), or of its parent device if it is a partition.
+func diskType(device string) string {
+	if device == "" {
+		return ""
+	}
+	dir, err := filepath.EvalSymlinks(filepath.Join("/sys/class/block", filepath.Base(device)))
+	if err != nil {
+		return ""
+	}
+	for _, d := range []string{dir, filepath.Dir(dir)} {
+		bts, err := ioutil.ReadFile(filepath.Join(d, "queue", "rotational"))
+		if err != nil {
+			continue
+		}
+		if strings.TrimSpace(string(bts)) == "1" {
+			return "hdd"
+		}
+		return "ssd"
+	}
+	return ""
+}
@@ -0,0 +1,54 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/syslimit"
+
+	"go.uber.org/zap"
+)
+
+// openFilesMargin is added to the number of client connections,
+// for the write-ahead log, snapshot, and peer connections of the database.
+const openFilesMargin = 4096
+
+// checkDatabaseLimits raises the open file limit of the agent, which the
+// database process inherits, to accept all client connections of the
+// benchmark, and verifies the listen backlog for the connections dialed
+// to this member at once.
+func checkDatabaseLimits(lg *zap.Logger, req *dbtesterpb.Request) error {
+	conns := req.ConnectionNumber
+	perMember := conns
+	if n := int64(len(strings.Split(req.PeerIPsString, "___"))); n > 1 {
+		perMember = (conns + n - 1) / n
+	}
+	r := syslimit.Requirement{
+		OpenFiles:     uint64(conns) + openFilesMargin,
+		ListenBacklog: perMember,
+	}
+	lg.Info("checking database limits",
+		zap.Int64("connections", conns),
+		zap.Uint64("open-files", r.OpenFiles),
+		zap.Int64("listen-backlog", r.ListenBacklog),
+	)
+	if err := syslimit.Check(r); err != nil {
+		return fmt.Errorf("%d client connections: %v", conns, err)
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/logrotate"
+)
+
+// databaseEventsHeader is the header of the database events CSV.
+var databaseEventsHeader = []string{"UNIX-SECOND", "TIMESTAMP", "EVENT", "MESSAGE"}
+
+// Notable events of database servers.
+const (
+	eventElection   = "election"
+	eventSnapshot   = "snapshot"
+	eventCompaction = "compaction"
+	eventOOMKill    = "oom-kill"
+)
+
+// logEventPattern maps a substring of a log message to an event.
+type logEventPattern struct {
+	substr string
+	event  string
+}
+
+var (
+	etcdLogEvents = []logEventPattern{
+		{"starting a new election", eventElection},
+		{"became leader", eventElection},
+		{"elected leader", eventElection},
+		{"lost leader", eventElection},
+		{"triggering snapshot", eventSnapshot},
+		{"saved snapshot", eventSnapshot},
+		{"finished scheduled compaction", eventCompaction},
+		{"compacted raft log", eventCompaction},
+	}
+	zkLogEvents = []logEventPattern{
+		{"] - LOOKING", eventElection},
+		{"] - LEADING", eventElection},
+		{"] - FOLLOWING", eventElection},
+		{"] - OBSERVING", eventElection},
+		{"Snapshotting:", eventSnapshot},
+		{"Purge task completed", eventCompaction},
+	}
+	consulLogEvents = []logEventPattern{
+		{"entering candidate state", eventElection},
+		{"Election won", eventElection},
+		{"New leader elected", eventElection},
+		{"cluster leadership lost", eventElection},
+		{"Starting snapshot up to", eventSnapshot},
+		{"complete up to", eventSnapshot},
+		{"Compacting logs from", eventCompaction},
+	}
+)
+
+// logTimestampFormats are the timestamp prefixes of text logs, of etcd
+// before structured logging, Zookeeper log4j, and Consul, in local time.
+var logTimestampFormats = []struct {
+	re     *regexp.Regexp
+	layout string
+}{
+	{regexp.MustCompile(`^(\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}\.\d+)`), "2006-01-02 15:04:05.999999999"},
+	{regexp.MustCompile(`^(\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2},\d{3})`), "2006-01-02 15:04:05,000"},
+	{regexp.MustCompile(`^\s*(\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2})`), "2006/01/02 15:04:05"},
+}
+
+// databaseEvent is a notable event in the database log.
+type databaseEvent struct {
+	at      time.Time
+	event   string
+	message string
+}
+
+// writeDatabaseEvents parses the database log, including rotated files,
+// into the events CSV, to align elections, snapshots, and compactions
+// with client latencies and server metrics.
+func writeDatabaseEvents(fs *flags, t *transporterServer) error {
+	var patterns []logEventPattern
+	switch {
+	case etcdBased(t.req.DatabaseID):
+		patterns = etcdLogEvents
+	case t.req.DatabaseID == dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		patterns = zkLogEvents
+	case t.req.DatabaseID == dbtesterpb.DatabaseID_consul__v1_0_2:
+		patterns = consulLogEvents
+	default:
+		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
+	}
+
+	srcs, err := logrotate.Backups(fs.databaseLog)
+	if err != nil {
+		return err
+	}
+	srcs = append(srcs, fs.databaseLog)
+
+	var evs []databaseEvent
+	for _, src := range srcs {
+		es, err := parseDatabaseEventsFile(src, patterns)
+		if err != nil {
+			return err
+		}
+		evs = append(evs, es...)
+	}
+	if !t.oomKilledAt.IsZero() {
+		evs = append(evs, databaseEvent{at: t.oomKilledAt, event: eventOOMKill, message: fmt.Sprintf("killed process %d", t.pid)})
+	}
+	sort.SliceStable(evs, func(i, j int) bool { return evs[i].at.Before(evs[j].at) })
+
+	f, err := os.Create(fs.databaseEventsCSV)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := csv.NewWriter(f)
+	if err = w.Write(databaseEventsHeader); err != nil {
+		return err
+	}
+	for _, ev := range evs {
+		row := []string{fmt.Sprintf("%d", ev.at.Unix()), ev.at.Format(time.RFC3339Nano), ev.event, ev.message}
+		if err = w.Write(row); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	if err = w.Error(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+func parseDatabaseEventsFile(fpath string, patterns []logEventPattern) ([]databaseEvent, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var rd io.Reader = f
+	if strings.HasSuffix(fpath, ".gz") {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		rd = gr
+	}
+	return parseDatabaseEvents(rd, patterns)
+}
+
+// parseDatabaseEvents returns the events of the log lines matching the
+// patterns. Lines without timestamps are skipped.
+func parseDatabaseEvents(rd io.Reader, patterns []logEventPattern) ([]databaseEvent, error) {
+	var evs []databaseEvent
+	sc := bufio.NewScanner(rd)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := sc.Text()
+		event := ""
+		for _, p := range patterns {
+			if strings.Contains(line, p.substr) {
+				event = p.event
+				break
+			}
+		}
+		if event == "" {
+			continue
+		}
+		at, msg, ok := parseLogLine(line)
+		if !ok {
+			continue
+		}
+		evs = append(evs, databaseEvent{at: at, event: event, message: msg})
+	}
+	return evs, sc.Err()
+}
+
+// parseLogLine returns the timestamp and message of a structured (zap JSON)
+// or text log line.
+func parseLogLine(line string) (time.Time, string, bool) {
+	if strings.HasPrefix(line, "{") {
+		var entry struct {
+			TS  interface{} `json:"ts"`
+			Msg string      `json:"msg"`
+		}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return time.Time{}, "", false
+		}
+		switch ts := entry.TS.(type) {
+		case string:
+			at, err := time.Parse(time.RFC3339Nano, ts)
+			return at, entry.Msg, err == nil
+		case float64:
+			sec := int64(ts)
+			return time.Unix(sec, int64((ts-float64(sec))*1e9)), entry.Msg, true
+		}
+		return time.Time{}, "", false
+	}
+	for _, tf := range logTimestampFormats {
+		m := tf.re.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		at, err := time.ParseInLocation(tf.layout, m[1], time.Local)
+		if err != nil {
+			return time.Time{}, "", false
+		}
+		return at, strings.TrimSpace(line[len(m[0]):]), true
+	}
+	return time.Time{}, "", false
+}
@@ -0,0 +1,252 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/gyuho/linux-inspect/proc"
+	"github.com/samuel/go-zookeeper/zk"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// memberCatchUpTimeout is the maximum time for a restarted member
+// to catch up with the cluster.
+const memberCatchUpTimeout = 10 * time.Minute
+
+// stopProcess stops the database process, and waits until it exits.
+func stopProcess(t *transporterServer) {
+	addDiskWriteBytes(t)
+	interruptProcess(t.lg, t.cmd, t.pid)
+	<-t.cmdWait
+}
+
+// addDiskWriteBytes adds the bytes written to storage by the running
+// database process, before it exits and its I/O statistics are gone.
+func addDiskWriteBytes(t *transporterServer) {
+	io, err := proc.GetIOByPID(t.pid)
+	if err != nil {
+		t.lg.Warn("failed to read process I/O", zap.Int64("pid", t.pid), zap.Error(err))
+		return
+	}
+	t.diskWriteBytes += int64(io.WriteBytes)
+}
+
+// processExited returns true if the database process has exited.
+func processExited(t *transporterServer) bool {
+	select {
+	case <-t.cmdWait:
+		return true
+	default:
+		return false
+	}
+}
+
+// stopMember stops the database member, keeping its data directory.
+func stopMember(t *transporterServer) error {
+	if grpcProxyEtcd(t.req) || int(t.req.IPIndex) >= len(strings.Split(t.req.PeerIPsString, "___")) {
+		return fmt.Errorf("%q agent %d is not a member", t.req.DatabaseID, t.req.IPIndex)
+	}
+	if processExited(t) {
+		return fmt.Errorf("database process %d has already exited", t.pid)
+	}
+	stopProcess(t)
+	t.lg.Info("stopped member", zap.String("database", t.req.DatabaseID.String()), zap.Int64("pid", t.pid))
+	return nil
+}
+
+// restartMember restarts the member stopped by 'stopMember', and waits
+// until it catches up with the rest of the cluster. It returns the time
+// to catch up and the number of bytes received meanwhile.
+func restartMember(fs *flags, t *transporterServer) (took time.Duration, received int64, err error) {
+	if !processExited(t) {
+		return 0, 0, fmt.Errorf("database process %d is still running", t.pid)
+	}
+
+	// progress of the rest of the cluster, to catch up to
+	target, err := clusterProgress(t)
+	if err != nil {
+		return 0, 0, err
+	}
+	rx, err := receivedBytes()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	t.lg.Info("restarting member", zap.String("database", t.req.DatabaseID.String()), zap.Int64("target-index", target))
+	now := time.Now()
+	if err = execDatabase(fs, t); err != nil {
+		return 0, 0, err
+	}
+
+	deadline := now.Add(memberCatchUpTimeout)
+	for {
+		var idx int64
+		idx, err = memberProgress(t)
+		if err == nil && idx >= target {
+			break
+		}
+		if time.Now().After(deadline) {
+			return 0, 0, fmt.Errorf("member did not catch up to %d in %v (index %d, %v)", target, memberCatchUpTimeout, idx, err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	took = time.Since(now)
+
+	rx2, err := receivedBytes()
+	if err != nil {
+		return took, 0, err
+	}
+	received = int64(rx2 - rx)
+	t.lg.Info("restarted member", zap.String("database", t.req.DatabaseID.String()), zap.Duration("took", took), zap.Int64("received-bytes", received))
+	return took, received, nil
+}
+
+// execDatabase starts the stopped database process again,
+// keeping its data directory.
+func execDatabase(fs *flags, t *transporterServer) error {
+	var err error
+	switch t.req.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__other,
+		dbtesterpb.DatabaseID_etcd__tip,
+		dbtesterpb.DatabaseID_etcd__v3_2,
+		dbtesterpb.DatabaseID_etcd__v3_3:
+		err = execEtcd(fs, t)
+	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		err = execZookeeper(fs, t)
+	case dbtesterpb.DatabaseID_consul__v1_0_2:
+		err = execConsul(fs, t)
+	default:
+		err = fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
+	}
+	if err != nil {
+		return err
+	}
+	go t.waitCmd(t.cmd, t.cmdWait)
+	t.restarted()
+	return nil
+}
+
+// clusterProgress returns the highest index of the other members.
+// Zookeeper does not serve clients until synced with the leader,
+// so it returns 0.
+func clusterProgress(t *transporterServer) (int64, error) {
+	var target int64
+	answered := 0
+	peerIPs := strings.Split(t.req.PeerIPsString, "___")
+	for i, ip := range peerIPs {
+		if i == int(t.req.IPIndex) {
+			continue
+		}
+		idx, err := indexOf(t, ip)
+		if err != nil {
+			t.lg.Warn("failed to get member index", zap.String("ip", ip), zap.Error(err))
+			continue
+		}
+		answered++
+		if target < idx {
+			target = idx
+		}
+	}
+	if answered == 0 {
+		return 0, fmt.Errorf("no other member of %q is serving", t.req.DatabaseID)
+	}
+	return target, nil
+}
+
+// memberProgress returns the index of the local member.
+func memberProgress(t *transporterServer) (int64, error) {
+	peerIPs := strings.Split(t.req.PeerIPsString, "___")
+	return indexOf(t, peerIPs[t.req.IPIndex])
+}
+
+// indexOf returns the applied Raft index of the member at the IP.
+func indexOf(t *transporterServer, ip string) (int64, error) {
+	switch t.req.DatabaseID {
+	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		stats, ok := zk.FLWSrvr([]string{fmt.Sprintf("%s:%d", ip, t.port(2181))}, time.Second)
+		if !ok || len(stats) == 0 || stats[0].Error != nil {
+			return 0, fmt.Errorf("zookeeper %q is not serving", ip)
+		}
+		return 0, nil
+
+	case dbtesterpb.DatabaseID_consul__v1_0_2:
+		return consulAppliedIndex(fmt.Sprintf("http://%s:%d", ip, t.port(8500)))
+
+	default:
+		ep := fmt.Sprintf("%s:%d", ip, t.port(2379))
+		cli, err := clientv3.New(clientv3.Config{Endpoints: []string{ep}, DialTimeout: time.Second})
+		if err != nil {
+			return 0, err
+		}
+		defer cli.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		resp, err := cli.Status(ctx, ep)
+		cancel()
+		if err != nil {
+			return 0, err
+		}
+		return int64(resp.RaftIndex), nil
+	}
+}
+
+// consulAppliedIndex returns the applied Raft index of the Consul server.
+func consulAppliedIndex(addr string) (int64, error) {
+	hc := &http.Client{Timeout: time.Second}
+	resp, err := hc.Get(addr + "/v1/agent/self")
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("%q returned %q", addr, resp.Status)
+	}
+
+	var self struct {
+		Stats struct {
+			Raft map[string]string `json:"raft"`
+		} `json:"Stats"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&self); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(self.Stats.Raft["applied_index"], 10, 64)
+}
+
+// receivedBytes returns the total bytes received by
+// network interfaces of the host, except loopback.
+func receivedBytes() (uint64, error) {
+	nds, err := proc.GetNetDev()
+	if err != nil {
+		return 0, err
+	}
+	var n uint64
+	for _, nd := range nds {
+		if nd.Interface == "lo" {
+			continue
+		}
+		n += nd.ReceiveBytes
+	}
+	return n, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/etcd-io/dbtester/pkg/oomkill"
+
+	"go.uber.org/zap"
+)
+
+// detectOOM returns true if the OOM killer killed the database process,
+// which exited before stop, as reported by the kernel log or the memory
+// events of its cgroup. The kernel log excerpt of the kill is written to
+// 'fs.oomKernelLog', to upload with the database log.
+func detectOOM(fs *flags, t *transporterServer) bool {
+	if t.cmd == nil || t.cmd.ProcessState == nil {
+		return false
+	}
+	ws, ok := t.cmd.ProcessState.Sys().(syscall.WaitStatus)
+	if !ok || !ws.Signaled() || ws.Signal() != syscall.SIGKILL {
+		return false
+	}
+
+	lines, err := oomkill.ReadKernelLog()
+	if err != nil {
+		t.lg.Warn("cannot read kernel log", zap.Error(err))
+	}
+	if ev, ok := oomkill.Find(lines, t.pid); ok {
+		t.oomKilledAt = ev.At
+		if t.oomKilledAt.IsZero() {
+			t.oomKilledAt = time.Now()
+		}
+		if err = toFile(strings.Join(ev.Excerpt, "\n")+"\n", fs.oomKernelLog); err != nil {
+			t.lg.Warn("failed to write kernel log excerpt", zap.String("path", fs.oomKernelLog), zap.Error(err))
+		}
+		t.lg.Warn("database was killed by OOM killer", zap.Int64("pid", t.pid), zap.Time("killed-at", t.oomKilledAt), zap.String("kernel-log", fs.oomKernelLog))
+		return true
+	}
+
+	if t.metricsCgroup != nil {
+		killed, err := t.metricsCgroup.OOMKilled()
+		if err != nil {
+			t.lg.Warn("cannot read cgroup memory events", zap.String("cgroup", t.metricsCgroup.Dir), zap.Error(err))
+		} else if killed {
+			t.oomKilledAt = time.Now()
+			t.lg.Warn("database was killed by OOM killer of its cgroup", zap.Int64("pid", t.pid), zap.String("cgroup", t.metricsCgroup.Dir))
+			return true
+		}
+	}
+	t.lg.Warn("database was killed by SIGKILL, not by OOM killer", zap.Int64("pid", t.pid))
+	return false
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"encoding/csv"
+	"os"
+	"strconv"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// annotatePhases appends a PHASE column to the monitor CSV, with the
+// workload phase of the 'UNIX-SECOND' of each row, so that monitor
+// CSVs are segmented by the same phases as client results.
+func annotatePhases(fpath string, phases []*dbtesterpb.Phase) error {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return err
+	}
+	rows, err := csv.NewReader(f).ReadAll()
+	f.Close()
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 || rows[0][0] != "UNIX-SECOND" {
+		return nil
+	}
+	rows[0] = append(rows[0], "PHASE")
+	for i := 1; i < len(rows); i++ {
+		name := ""
+		if sec, err := strconv.ParseInt(rows[i][0], 10, 64); err == nil {
+			name = dbtesterpb.PhaseAt(phases, sec)
+		}
+		rows[i] = append(rows[i], name)
+	}
+
+	f, err = os.Create(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := csv.NewWriter(f)
+	if err = w.WriteAll(rows); err != nil {
+		return err
+	}
+	return f.Sync()
+}
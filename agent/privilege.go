@@ -0,0 +1,103 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// privileged returns true if databases are run as a dedicated user,
+// instead of inheriting the user of the agent.
+func (fs *flags) privileged() bool {
+	return fs.databaseUID >= 0 || fs.databaseGID >= 0
+}
+
+// validatePrivilege returns an error if the agent cannot switch to the
+// configured database user.
+func (fs *flags) validatePrivilege() error {
+	if !fs.privileged() {
+		return nil
+	}
+	if fs.databaseUID < 0 || fs.databaseGID < 0 {
+		return fmt.Errorf("'database-uid' and 'database-gid' must be set together (got %d and %d)", fs.databaseUID, fs.databaseGID)
+	}
+	if runtime.GOOS == "windows" {
+		return fmt.Errorf("'database-uid' and 'database-gid' are not supported on Windows")
+	}
+	if os.Geteuid() != 0 {
+		return fmt.Errorf("agent must run as root to run databases as uid %d, gid %d", fs.databaseUID, fs.databaseGID)
+	}
+	return nil
+}
+
+// restrict runs the command as the database user, with the environment
+// limited to the allowlist and the extra variables. The data directory is
+// created and handed over to the database user, if not empty.
+func (fs *flags) restrict(cmd *exec.Cmd, dataDir string, extraEnv ...string) error {
+	if fs.privileged() {
+		if dataDir != "" {
+			if err := os.MkdirAll(dataDir, 0777); err != nil {
+				return err
+			}
+			if err := chownTree(dataDir, fs.databaseUID, fs.databaseGID); err != nil {
+				return err
+			}
+		}
+		if err := setCredential(cmd, fs.databaseUID, fs.databaseGID); err != nil {
+			return err
+		}
+	}
+	if len(fs.databaseEnvAllowlist) > 0 {
+		cmd.Env = append(allowedEnv(os.Environ(), fs.databaseEnvAllowlist), extraEnv...)
+	} else if len(extraEnv) > 0 {
+		cmd.Env = append(os.Environ(), extraEnv...)
+	}
+	return nil
+}
+
+// allowedEnv returns the 'KEY=VALUE' pairs whose keys are in the allowlist.
+func allowedEnv(env, allowlist []string) []string {
+	allowed := make(map[string]struct{}, len(allowlist))
+	for _, k := range allowlist {
+		allowed[strings.TrimSpace(k)] = struct{}{}
+	}
+	var kept []string
+	for _, kv := range env {
+		k := kv
+		if i := strings.Index(kv, "="); i >= 0 {
+			k = kv[:i]
+		}
+		if _, ok := allowed[k]; ok {
+			kept = append(kept, kv)
+		}
+	}
+	return kept
+}
+
+// chownTree changes the owner of the directory and all files under it,
+// so that the database user can write to directories created by the agent.
+func chownTree(dir string, uid, gid int) error {
+	return filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		return os.Lchown(p, uid, gid)
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+// +build !windows
+
+package agent
+
+import (
+	"io/ioutil"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// interruptProcess asks the process to exit, and terminates it if
+// the interrupt cannot be sent.
+func interruptProcess(lg *zap.Logger, cmd *exec.Cmd, pid int64) {
+	lg.Info("sending", zap.String("syscall", syscall.SIGINT.String()), zap.Int64("pid", pid), zap.String("executable-path", cmd.Path))
+	if err := cmd.Process.Signal(syscall.SIGINT); err != nil {
+		lg.Warn("syscall.SIGINT failed", zap.Error(err))
+
+		time.Sleep(3 * time.Second)
+		lg.Info("sending", zap.String("syscall", syscall.SIGTERM.String()), zap.Int64("pid", pid), zap.String("executable-path", cmd.Path))
+		if err := syscall.Kill(int(pid), syscall.SIGTERM); err != nil {
+			lg.Warn("syscall.Kill failed", zap.Error(err))
+		}
+	}
+}
+
+// trackProcess is a no-op, since database processes are in the process
+// group of the agent, and signaled with it.
+func trackProcess(lg *zap.Logger, cmd *exec.Cmd) {}
+
+// setCredential runs the command as the user and group.
+func setCredential(cmd *exec.Cmd, uid, gid int) error {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Credential: &syscall.Credential{Uid: uint32(uid), Gid: uint32(gid)},
+	}
+	return nil
+}
+
+// diskFreeBytes returns the free bytes available to the agent on the
+// disk of the existing path.
+func diskFreeBytes(p string) (int64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(p, &st); err != nil {
+		return 0, err
+	}
+	return int64(st.Bavail) * int64(st.Bsize), nil
+}
+
+// dropCaches syncs dirty pages, and drops clean page cache, dentries,
+// and inodes.
+func dropCaches() error {
+	syscall.Sync()
+	return ioutil.WriteFile(dropCachesPath, []byte("3"), 0200)
+}
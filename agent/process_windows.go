@@ -0,0 +1,238 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"go.uber.org/zap"
+)
+
+var (
+	kernel32 = syscall.NewLazyDLL("kernel32.dll")
+
+	procCreateJobObjectW         = kernel32.NewProc("CreateJobObjectW")
+	procSetInformationJobObject  = kernel32.NewProc("SetInformationJobObject")
+	procAssignProcessToJobObject = kernel32.NewProc("AssignProcessToJobObject")
+	procGetDiskFreeSpaceExW      = kernel32.NewProc("GetDiskFreeSpaceExW")
+	procGetProcessIoCounters     = kernel32.NewProc("GetProcessIoCounters")
+	procGetProcessMemoryInfo     = kernel32.NewProc("K32GetProcessMemoryInfo")
+)
+
+const (
+	jobObjectInfoClassExtendedLimit = 9
+	jobObjectLimitKillOnJobClose    = 0x2000
+
+	processSetQuota  = 0x0100
+	processTerminate = 0x0001
+	processQueryInfo = 0x0400
+	processVMRead    = 0x0010
+)
+
+type jobObjectBasicLimitInformation struct {
+	PerProcessUserTimeLimit int64
+	PerJobUserTimeLimit     int64
+	LimitFlags              uint32
+	MinimumWorkingSetSize   uintptr
+	MaximumWorkingSetSize   uintptr
+	ActiveProcessLimit      uint32
+	Affinity                uintptr
+	PriorityClass           uint32
+	SchedulingClass         uint32
+}
+
+type ioCounters struct {
+	ReadOperationCount  uint64
+	WriteOperationCount uint64
+	OtherOperationCount uint64
+	ReadTransferCount   uint64
+	WriteTransferCount  uint64
+	OtherTransferCount  uint64
+}
+
+type jobObjectExtendedLimitInformation struct {
+	BasicLimitInformation jobObjectBasicLimitInformation
+	IoInfo                ioCounters
+	ProcessMemoryLimit    uintptr
+	JobMemoryLimit        uintptr
+	PeakProcessMemoryUsed uintptr
+	PeakJobMemoryUsed     uintptr
+}
+
+type processMemoryCounters struct {
+	CB                         uint32
+	PageFaultCount             uint32
+	PeakWorkingSetSize         uintptr
+	WorkingSetSize             uintptr
+	QuotaPeakPagedPoolUsage    uintptr
+	QuotaPagedPoolUsage        uintptr
+	QuotaPeakNonPagedPoolUsage uintptr
+	QuotaNonPagedPoolUsage     uintptr
+	PagefileUsage              uintptr
+	PeakPagefileUsage          uintptr
+}
+
+var (
+	jobOnce sync.Once
+	job     syscall.Handle
+	jobErr  error
+)
+
+// agentJob returns the job object of database processes, which kills
+// them when the agent exits, since Windows does not signal child
+// processes with their parent.
+func agentJob() (syscall.Handle, error) {
+	jobOnce.Do(func() {
+		h, _, err := procCreateJobObjectW.Call(0, 0)
+		if h == 0 {
+			jobErr = fmt.Errorf("CreateJobObject failed (%v)", err)
+			return
+		}
+		var info jobObjectExtendedLimitInformation
+		info.BasicLimitInformation.LimitFlags = jobObjectLimitKillOnJobClose
+		r, _, err := procSetInformationJobObject.Call(
+			h,
+			jobObjectInfoClassExtendedLimit,
+			uintptr(unsafe.Pointer(&info)),
+			unsafe.Sizeof(info),
+		)
+		if r == 0 {
+			syscall.CloseHandle(syscall.Handle(h))
+			jobErr = fmt.Errorf("SetInformationJobObject failed (%v)", err)
+			return
+		}
+		job = syscall.Handle(h)
+	})
+	return job, jobErr
+}
+
+// interruptProcess stops the process tree with 'taskkill', and forces
+// it if the process does not accept to close (e.g. console programs).
+func interruptProcess(lg *zap.Logger, cmd *exec.Cmd, pid int64) {
+	lg.Info("sending", zap.String("command", "taskkill /T"), zap.Int64("pid", pid), zap.String("executable-path", cmd.Path))
+	if out, err := exec.Command("taskkill", "/T", "/PID", strconv.FormatInt(pid, 10)).CombinedOutput(); err != nil {
+		lg.Warn("taskkill failed", zap.String("output", string(out)), zap.Error(err))
+
+		time.Sleep(3 * time.Second)
+		lg.Info("sending", zap.String("command", "taskkill /T /F"), zap.Int64("pid", pid), zap.String("executable-path", cmd.Path))
+		if out, err := exec.Command("taskkill", "/T", "/F", "/PID", strconv.FormatInt(pid, 10)).CombinedOutput(); err != nil {
+			lg.Warn("taskkill failed", zap.String("output", string(out)), zap.Error(err))
+		}
+	}
+}
+
+// trackProcess assigns the started process to the job object of the
+// agent, so that it does not outlive the agent. Processes it started
+// before the assignment are not tracked.
+func trackProcess(lg *zap.Logger, cmd *exec.Cmd) {
+	j, err := agentJob()
+	if err != nil {
+		lg.Warn("cannot create job object", zap.Error(err))
+		return
+	}
+	h, err := syscall.OpenProcess(processSetQuota|processTerminate, false, uint32(cmd.Process.Pid))
+	if err != nil {
+		lg.Warn("cannot open process", zap.Int("pid", cmd.Process.Pid), zap.Error(err))
+		return
+	}
+	defer syscall.CloseHandle(h)
+	if r, _, err := procAssignProcessToJobObject.Call(uintptr(j), uintptr(h)); r == 0 {
+		lg.Warn("AssignProcessToJobObject failed", zap.Int("pid", cmd.Process.Pid), zap.Error(err))
+	}
+}
+
+// setCredential returns an error, since Windows processes cannot be
+// started as a user by its ID.
+func setCredential(cmd *exec.Cmd, uid, gid int) error {
+	return fmt.Errorf("cannot run databases as uid %d, gid %d on Windows", uid, gid)
+}
+
+// diskFreeBytes returns the free bytes available to the agent on the
+// disk of the existing path.
+func diskFreeBytes(p string) (int64, error) {
+	ptr, err := syscall.UTF16PtrFromString(p)
+	if err != nil {
+		return 0, err
+	}
+	var avail, total, free uint64
+	r, _, err := procGetDiskFreeSpaceExW.Call(
+		uintptr(unsafe.Pointer(ptr)),
+		uintptr(unsafe.Pointer(&avail)),
+		uintptr(unsafe.Pointer(&total)),
+		uintptr(unsafe.Pointer(&free)),
+	)
+	if r == 0 {
+		return 0, fmt.Errorf("GetDiskFreeSpaceEx %q failed (%v)", p, err)
+	}
+	return int64(avail), nil
+}
+
+// dropCaches returns an error, since Windows has no interface to drop
+// the file cache of other processes.
+func dropCaches() error {
+	return fmt.Errorf("dropping page cache is not supported on Windows")
+}
+
+// processUsage is the resource usage of a process.
+type processUsage struct {
+	// cpu is the user and kernel time.
+	cpu time.Duration
+	// workingSetBytes is the resident memory.
+	workingSetBytes uint64
+	readBytes       uint64
+	writeBytes      uint64
+}
+
+// filetimeDuration returns the duration of the file time, which counts
+// 100-nanosecond intervals ('Nanoseconds' is since the Unix epoch instead).
+func filetimeDuration(ft syscall.Filetime) time.Duration {
+	return time.Duration(int64(ft.HighDateTime)<<32|int64(ft.LowDateTime)) * 100
+}
+
+// getProcessUsage returns the resource usage of the process.
+func getProcessUsage(pid int64) (processUsage, error) {
+	h, err := syscall.OpenProcess(processQueryInfo|processVMRead, false, uint32(pid))
+	if err != nil {
+		return processUsage{}, err
+	}
+	defer syscall.CloseHandle(h)
+
+	var u processUsage
+	var creation, exit, kernel, user syscall.Filetime
+	if err = syscall.GetProcessTimes(h, &creation, &exit, &kernel, &user); err != nil {
+		return processUsage{}, err
+	}
+	u.cpu = filetimeDuration(kernel) + filetimeDuration(user)
+
+	var mem processMemoryCounters
+	mem.CB = uint32(unsafe.Sizeof(mem))
+	if r, _, err := procGetProcessMemoryInfo.Call(uintptr(h), uintptr(unsafe.Pointer(&mem)), uintptr(mem.CB)); r == 0 {
+		return processUsage{}, fmt.Errorf("GetProcessMemoryInfo failed (%v)", err)
+	}
+	u.workingSetBytes = uint64(mem.WorkingSetSize)
+
+	var io ioCounters
+	if r, _, err := procGetProcessIoCounters.Call(uintptr(h), uintptr(unsafe.Pointer(&io))); r == 0 {
+		return processUsage{}, fmt.Errorf("GetProcessIoCounters failed (%v)", err)
+	}
+	u.readBytes, u.writeBytes = io.ReadTransferCount, io.WriteTransferCount
+	return u, nil
+}
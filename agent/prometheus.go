@@ -0,0 +1,140 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// promHistogram is a cumulative Prometheus histogram.
+type promHistogram struct {
+	// bounds are the sorted upper bounds of buckets, including +Inf
+	bounds []float64
+	// counts are the cumulative observation counts of buckets
+	counts []float64
+	count  int64
+}
+
+// sub returns the observations since 'prev' of the same histogram.
+func (h promHistogram) sub(prev promHistogram) promHistogram {
+	d := promHistogram{bounds: h.bounds, counts: make([]float64, len(h.counts)), count: h.count - prev.count}
+	for i := range h.counts {
+		d.counts[i] = h.counts[i]
+		if i < len(prev.counts) {
+			d.counts[i] -= prev.counts[i]
+		}
+	}
+	return d
+}
+
+// quantile estimates the quantile with linear interpolation within
+// the bucket, as 'histogram_quantile' of Prometheus. It returns the
+// highest finite bound if the quantile falls in the +Inf bucket.
+func (h promHistogram) quantile(q float64) float64 {
+	if len(h.counts) == 0 || h.counts[len(h.counts)-1] == 0 {
+		return 0
+	}
+	rank := q * h.counts[len(h.counts)-1]
+	i := sort.SearchFloat64s(h.counts, rank)
+	if i == len(h.bounds)-1 && math.IsInf(h.bounds[i], 1) {
+		if i == 0 {
+			return 0
+		}
+		return h.bounds[i-1]
+	}
+	lower, prevCount := 0.0, 0.0
+	if i > 0 {
+		lower, prevCount = h.bounds[i-1], h.counts[i-1]
+	}
+	inBucket := h.counts[i] - prevCount
+	if inBucket == 0 {
+		return h.bounds[i]
+	}
+	return lower + (h.bounds[i]-lower)*(rank-prevCount)/inBucket
+}
+
+// scrapeHistograms scrapes the named histograms from the
+// Prometheus text format metrics endpoint.
+func scrapeHistograms(ep string, names ...string) (map[string]promHistogram, error) {
+	hc := &http.Client{Timeout: time.Second}
+	resp, err := hc.Get("http://" + ep + "/metrics")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return parseHistograms(bufio.NewScanner(resp.Body), names...)
+}
+
+func parseHistograms(sc *bufio.Scanner, names ...string) (map[string]promHistogram, error) {
+	hists := make(map[string]promHistogram, len(names))
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, name := range names {
+			if !strings.HasPrefix(line, name+"_") {
+				continue
+			}
+			fields := strings.Fields(line)
+			if len(fields) < 2 {
+				continue
+			}
+			v, err := strconv.ParseFloat(fields[1], 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse %q (%v)", line, err)
+			}
+			h := hists[name]
+			switch {
+			case strings.HasPrefix(fields[0], name+"_bucket{"):
+				i := strings.Index(fields[0], `le="`)
+				if i < 0 {
+					continue
+				}
+				le := fields[0][i+len(`le="`):]
+				j := strings.Index(le, `"`)
+				if j < 0 {
+					continue
+				}
+				le = le[:j]
+				bound, err := strconv.ParseFloat(le, 64) // parses '+Inf'
+				if err != nil {
+					return nil, fmt.Errorf("cannot parse bucket %q (%v)", line, err)
+				}
+				h.bounds = append(h.bounds, bound)
+				h.counts = append(h.counts, v)
+			case fields[0] == name+"_count":
+				h.count = int64(v)
+			}
+			hists[name] = h
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if _, ok := hists[name]; !ok {
+			return nil, fmt.Errorf("histogram %q is not found", name)
+		}
+	}
+	return hists, nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gyuho/linux-inspect/proc"
+	"go.uber.org/zap"
+)
+
+// readStatusHeader is the header of the read status CSV.
+var readStatusHeader = []string{
+	"UNIX-SECOND",
+	"DISK-READ-BYTES", "LOGICAL-READ-BYTES", "CACHE-HIT-RATIO",
+	"MAJOR-FAULTS", "PAGE-CACHE-BYTES",
+	"ERROR",
+}
+
+// readStatus polls the I/O statistics of the database process and the
+// page cache of the host every second, to tell reads served from memory
+// from reads that hit the disk. Logical reads are all bytes the process
+// read via system calls, and disk reads are the bytes fetched from the
+// storage layer, so one minus their ratio estimates the cache hit ratio.
+// Logical reads include socket reads, so the estimate is a lower bound.
+type readStatus struct {
+	lg    *zap.Logger
+	pid   int64
+	f     *os.File
+	w     *csv.Writer
+	pidc  chan int64
+	stopc chan struct{}
+	donec chan struct{}
+
+	// totals since start, across process restarts
+	diskReadBytes    uint64
+	logicalReadBytes uint64
+}
+
+// startReadStatus starts polling the database process into 'fs.readStatusCSV'.
+func startReadStatus(fs *flags, t *transporterServer) (*readStatus, error) {
+	f, err := os.Create(fs.readStatusCSV)
+	if err != nil {
+		return nil, err
+	}
+	s := &readStatus{
+		lg:    t.lg,
+		pid:   t.pid,
+		f:     f,
+		w:     csv.NewWriter(f),
+		pidc:  make(chan int64, 1),
+		stopc: make(chan struct{}),
+		donec: make(chan struct{}),
+	}
+	if err = s.write(readStatusHeader); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	t.lg.Info("starting polling read status", zap.Int64("pid", s.pid), zap.String("path", fs.readStatusCSV))
+	go s.run()
+	return s, nil
+}
+
+func (s *readStatus) run() {
+	defer close(s.donec)
+
+	prev, perr := proc.GetIOByPID(s.pid)
+	prevFaults, _ := procValue("/proc/vmstat", "pgmajfault")
+	for {
+		select {
+		case <-s.stopc:
+			return
+		case pid := <-s.pidc:
+			// restarted database, with new I/O statistics
+			s.pid = pid
+			prev, perr = proc.GetIOByPID(s.pid)
+			continue
+		case <-time.After(time.Second):
+		}
+
+		now := time.Now()
+		row := []string{fmt.Sprintf("%d", now.Unix()), "", "", "", "", "", ""}
+		cur, err := proc.GetIOByPID(s.pid)
+		if err == nil && perr == nil {
+			disk, logical := cur.ReadBytes-prev.ReadBytes, cur.Rchar-prev.Rchar
+			s.diskReadBytes += disk
+			s.logicalReadBytes += logical
+			row[1], row[2] = fmt.Sprintf("%d", disk), fmt.Sprintf("%d", logical)
+			if logical > 0 {
+				row[3] = fmt.Sprintf("%.4f", cacheHitRatio(disk, logical))
+			}
+		}
+		prev, perr = cur, err
+		if faults, ferr := procValue("/proc/vmstat", "pgmajfault"); ferr == nil {
+			row[4] = fmt.Sprintf("%d", faults-prevFaults)
+			prevFaults = faults
+		}
+		if cached, cerr := procValue("/proc/meminfo", "Cached"); cerr == nil {
+			row[5] = fmt.Sprintf("%d", cached)
+		}
+		if err != nil {
+			row[6] = err.Error()
+		}
+		if werr := s.write(row); werr != nil {
+			s.lg.Warn("failed to write read status", zap.Error(werr))
+		}
+	}
+}
+
+// restarted polls the restarted database process of the PID.
+func (s *readStatus) restarted(pid int64) {
+	select {
+	case s.pidc <- pid:
+	default:
+	}
+}
+
+func (s *readStatus) write(row []string) error {
+	if err := s.w.Write(row); err != nil {
+		return err
+	}
+	s.w.Flush()
+	return s.w.Error()
+}
+
+// stop stops polling, closes the CSV, and returns
+// the total disk and logical read bytes.
+func (s *readStatus) stop() (disk, logical uint64) {
+	close(s.stopc)
+	<-s.donec
+	s.f.Close()
+	return s.diskReadBytes, s.logicalReadBytes
+}
+
+// cacheHitRatio estimates the ratio of reads served from page cache.
+func cacheHitRatio(disk, logical uint64) float64 {
+	if logical == 0 || disk >= logical {
+		return 0
+	}
+	return 1 - float64(disk)/float64(logical)
+}
+
+// procValue returns the value of the key in /proc files of
+// "key value [kB]" lines (e.g. /proc/vmstat, /proc/meminfo), in bytes
+// if the unit is kB.
+func procValue(fpath, key string) (uint64, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 2 || strings.TrimSuffix(fields[0], ":") != key {
+			continue
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		if len(fields) > 2 && fields[2] == "kB" {
+			v *= 1024
+		}
+		return v, nil
+	}
+	if err = sc.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("%q is not found in %q", key, fpath)
+}
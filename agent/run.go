@@ -0,0 +1,109 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// run is the state of a database run, from Start to Stop. Each run has
+// its own directory tree under '--run-dir', keyed by test name and run
+// timestamp, so that repeated or restarted runs do not share data
+// directories, logs, or system metrics.
+type run struct {
+	// dir is the root directory of the run.
+	dir string
+	// fs is the flags of the run, with per-run paths under 'dir'.
+	// Changes (e.g. data directories on a delayed disk) do not
+	// affect other runs.
+	fs flags
+}
+
+// defaultTestName is the directory of runs without test name.
+const defaultTestName = "default"
+
+// runDir returns the root directory of the run. Restarts of the same
+// run (e.g. scaling benchmark) get a numbered suffix.
+func runDir(root string, req dbtesterpb.Request, now time.Time) string {
+	name, ts := defaultTestName, now.Format("20060102-150405")
+	if req.ConfigClientMachineInitial != nil {
+		if req.ConfigClientMachineInitial.TestName != "" {
+			name = req.ConfigClientMachineInitial.TestName
+		}
+		if req.ConfigClientMachineInitial.RunTimestamp != "" {
+			ts = req.ConfigClientMachineInitial.RunTimestamp
+		}
+	}
+	dir := filepath.Join(root, name, ts)
+	for i := 2; exist(dir); i++ {
+		dir = filepath.Join(root, name, fmt.Sprintf("%s-%d", ts, i))
+	}
+	return dir
+}
+
+// newRun creates the directory tree of the run. Per-run paths are named
+// after the base names of their flags.
+func newRun(base flags, req dbtesterpb.Request) (*run, error) {
+	r := &run{dir: runDir(base.runDir, req, time.Now()), fs: base}
+	in := func(p string) string {
+		return filepath.Join(r.dir, filepath.Base(p))
+	}
+	r.fs.databaseLog = in(base.databaseLog)
+	r.fs.systemMetricsCSV = in(base.systemMetricsCSV)
+	r.fs.systemMetricsCSVInterpolated = in(base.systemMetricsCSVInterpolated)
+	r.fs.etcdStatusCSV = in(base.etcdStatusCSV)
+	r.fs.zkStatusCSV = in(base.zkStatusCSV)
+	r.fs.consulTelemetryCSV = in(base.consulTelemetryCSV)
+	r.fs.diskStatusCSV = in(base.diskStatusCSV)
+	r.fs.readStatusCSV = in(base.readStatusCSV)
+	r.fs.databaseEventsCSV = in(base.databaseEventsCSV)
+	r.fs.oomKernelLog = in(base.oomKernelLog)
+	r.fs.uploadManifest = in(base.uploadManifest)
+	r.fs.clientNumPath = in(base.clientNumPath)
+	r.fs.zkDataDir = in(base.zkDataDir)
+	r.fs.zkConfig = in(base.zkConfig)
+	r.fs.etcdDataDir = in(base.etcdDataDir)
+	r.fs.consulDataDir = in(base.consulDataDir)
+	r.fs.backupDir = in(base.backupDir)
+	r.fs.diskDelayDir = in(base.diskDelayDir)
+	r.fs.antagonistDir = in(base.antagonistDir)
+
+	if err := os.MkdirAll(r.dir, 0777); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// removeData removes database data and backups of the run, once the
+// database is stopped. Logs and system metrics are kept for uploads.
+func (r *run) removeData() error {
+	for _, p := range []string{
+		r.fs.etcdDataDir,
+		r.fs.zkDataDir,
+		r.fs.consulDataDir,
+		r.fs.backupDir,
+		r.fs.antagonistDir,
+	} {
+		if err := os.RemoveAll(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
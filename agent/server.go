@@ -19,25 +19,63 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/antagonist"
+	"github.com/etcd-io/dbtester/pkg/cgroup"
+	"github.com/etcd-io/dbtester/pkg/diskdelay"
 	"github.com/etcd-io/dbtester/pkg/fileinspect"
+	"github.com/etcd-io/dbtester/pkg/logrotate"
+	"github.com/etcd-io/dbtester/pkg/netem"
+	"github.com/etcd-io/dbtester/pkg/ntp"
+	"github.com/etcd-io/dbtester/pkg/remotestorage"
 
-	"github.com/gyuho/linux-inspect/inspect"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
 )
 
 // implements dbtesterpb.TransporterServer
 type transporterServer struct {
-	lg  *zap.Logger
+	lg *zap.Logger
+	// testID is the test ID of the requests
+	testID string
+	// base is the flags of the test, from which runs are created
+	base flags
+
+	// refs is the number of requests being served, protected by server.mu
+	refs int
+
+	// opMu serializes operations of 'Transfer'
+	opMu sync.Mutex
+
+	// mu protects state transitions, and fields read by other RPCs
+	mu    sync.Mutex
+	state state
+	// lastResp is the response of the last successful operation, to
+	// acknowledge retries
+	lastResp map[dbtesterpb.Operation]*dbtesterpb.Response
+	// run is the current or last run, nil before the first start
+	run *run
+	// collected is true when the results of the last run are no longer
+	// needed: its uploads are reported by 'WaitUpload', or it has been
+	// stopped for 'stoppedRetention'
+	collected bool
+	// database, pid, and cmdWait after the last operation,
+	// for 'Status' while operations are running
+	statusDatabaseID dbtesterpb.DatabaseID
+	statusPID        int64
+	statusCmdWait    chan struct{}
+
 	req dbtesterpb.Request
 
-	databaseLogFile      *os.File
-	proxyDatabaseLogfile *os.File
-	clientNumPath        string
+	databaseLogFile      *logrotate.Writer
+	proxyDatabaseLogfile *logrotate.Writer
 
 	// cmd is the main process that's running the database
 	cmd *exec.Cmd
@@ -46,12 +84,44 @@ type transporterServer struct {
 	cmdWait chan struct{}
 
 	pid int64
+	// diskWriteBytes is the bytes written to storage by database
+	// processes exited since start, to sum across restarts
+	diskWriteBytes int64
 
 	proxyCmd     *exec.Cmd
 	proxyCmdWait chan struct{}
 	proxyPid     int64
 
-	metricsCSV *inspect.CSV
+	// clockSkewed is true when the system clock is skewed,
+	// until reset when the database is stopped
+	clockSkewed bool
+
+	// antagonist consumes host resources while the database is running
+	antagonist *antagonist.Antagonist
+
+	metricsCSV *systemMetricsCSV
+	// metricsCgroup samples the cgroup v2 accounting of the database,
+	// nil when '/proc' is the only monitor backend
+	metricsCgroup *cgroup.Reader
+	// peakMemoryBytes is the peak memory in system metrics since start
+	peakMemoryBytes uint64
+	// oomKilledAt is the time the OOM killer killed the database,
+	// zero unless killed before stop
+	oomKilledAt time.Time
+	// metricsPIDc sends the new process ID to collect metrics of,
+	// when the database is restarted (e.g. rolling upgrade)
+	metricsPIDc chan int64
+
+	// etcdStatus polls the local etcd member, nil for other databases
+	etcdStatus *etcdStatus
+	// zkStatus polls the local Zookeeper server, nil for other databases
+	zkStatus *zkStatus
+	// consulTelemetry polls the local Consul server, nil for other databases
+	consulTelemetry *consulTelemetry
+	// diskGuard polls free disk space, and stops the database before disk is full
+	diskGuard *diskGuard
+	// readStatus polls disk and logical reads of the database process
+	readStatus *readStatus
 
 	// trigger log uploads to cloud storage
 	// this should be triggered before we shut down
@@ -59,45 +129,196 @@ type transporterServer struct {
 	uploadSig chan struct{}
 	csvReady  chan struct{}
 
+	// uploadDone is closed after log uploads complete,
+	// with uploadQueue and uploadErr set (protected by mu)
+	uploadDone  chan struct{}
+	uploadQueue *remotestorage.Queue
+	uploadErr   error
+
 	// notified after all tests finish
 	notifier chan os.Signal
 }
 
 // NewServer returns a new server that implements gRPC interface.
+// Requests are served by the state of their test ID.
 func NewServer(lg *zap.Logger) dbtesterpb.TransporterServer {
+	return newServer(lg, globalFlags)
+}
+
+func newServer(lg *zap.Logger, base flags) *server {
+	return &server{lg: lg, base: base, tests: make(map[string]*transporterServer)}
+}
+
+// stoppedRetention is how long a stopped test is kept after its uploads
+// complete, for duplicate 'Stop' and 'WaitUpload' requests.
+const stoppedRetention = 30 * time.Minute
+
+// server dispatches requests to the test of request test ID,
+// so that concurrent tests do not share agent state.
+type server struct {
+	lg   *zap.Logger
+	base flags
+
+	mu    sync.Mutex
+	tests map[string]*transporterServer
+}
+
+// acquire returns the state of the test ID, creating it if not exists.
+// The test is kept until released.
+func (s *server) acquire(testID string) *transporterServer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tests[testID]
+	if !ok {
+		lg := s.lg
+		if testID != "" {
+			lg = lg.With(zap.String("test-id", testID))
+		}
+		t = newTransporterServer(lg, testID, s.base.scoped(testID))
+		s.tests[testID] = t
+	}
+	t.refs++
+	return t
+}
+
+// release releases the test acquired, and removes it if finished.
+func (s *server) release(t *transporterServer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t.refs--
+	s.remove(t)
+}
+
+// remove removes the test if no request is being served, and the test
+// is finished. It stops signal notifications of the test, so that
+// signals are not queued for tests that are gone. 's.mu' must be held.
+func (s *server) remove(t *transporterServer) {
+	if t.refs > 0 || s.tests[t.testID] != t || !t.finished() {
+		return
+	}
+	delete(s.tests, t.testID)
+	signal.Stop(t.notifier)
+	t.lg.Info("removed finished test")
+}
+
+// lookup returns the state of the test ID, nil if not exists.
+func (s *server) lookup(testID string) *transporterServer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tests[testID]
+}
+
+func (s *server) Transfer(ctx context.Context, req *dbtesterpb.Request) (*dbtesterpb.Response, error) {
+	t := s.acquire(req.TestID)
+	defer s.release(t)
+	resp, err := t.Transfer(ctx, req)
+	switch {
+	case req.Operation == dbtesterpb.Operation_Stop,
+		req.Operation == dbtesterpb.Operation_Start && err != nil:
+		go s.collect(t)
+	}
+	return resp, err
+}
+
+// collect marks the stopped run collected after its uploads complete and
+// 'stoppedRetention' passes, and removes the test unless started again.
+func (s *server) collect(t *transporterServer) {
+	t.mu.Lock()
+	r, done := t.run, t.uploadDone
+	t.mu.Unlock()
+	if done != nil {
+		<-done
+	}
+	time.Sleep(stoppedRetention)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t.mu.Lock()
+	if t.run == r {
+		t.collected = true
+	}
+	t.mu.Unlock()
+	s.remove(t)
+}
+
+func (s *server) WaitUpload(ctx context.Context, req *dbtesterpb.UploadRequest) (*dbtesterpb.UploadResponse, error) {
+	t := s.acquire(req.TestID)
+	defer s.release(t)
+	return t.WaitUpload(ctx, req)
+}
+
+func (s *server) StreamLog(req *dbtesterpb.LogRequest, stream dbtesterpb.Transporter_StreamLogServer) error {
+	t := s.acquire(req.TestID)
+	defer s.release(t)
+	return t.StreamLog(req, stream)
+}
+
+func newTransporterServer(lg *zap.Logger, testID string, fs flags) *transporterServer {
 	notifier := make(chan os.Signal, 1)
 	signal.Notify(notifier, syscall.SIGINT, syscall.SIGTERM)
 
 	return &transporterServer{
-		lg:            lg,
-		clientNumPath: globalFlags.clientNumPath,
-		uploadSig:     make(chan struct{}, 1),
-		csvReady:      make(chan struct{}),
-		notifier:      notifier,
+		lg:          lg,
+		testID:      testID,
+		base:        fs,
+		lastResp:    make(map[dbtesterpb.Operation]*dbtesterpb.Response),
+		uploadSig:   make(chan struct{}, 1),
+		csvReady:    make(chan struct{}),
+		metricsPIDc: make(chan int64, 1),
+		notifier:    notifier,
 	}
 }
 
+// Transfer runs operations one at a time, following the state transitions
+// of the database (Idle -> Running -> Stopping -> Idle).
 func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Request) (*dbtesterpb.Response, error) {
-	if req != nil {
-		t.lg.Info(
-			"received gRPC request",
-			zap.String("operation", req.Operation.String()),
-			zap.String("database-id", req.DatabaseID.String()),
-			zap.Int64("client-number", req.CurrentClientNumber),
-		)
+	if req == nil {
+		return nil, fmt.Errorf("nil request")
+	}
+	t.lg.Info(
+		"received gRPC request",
+		zap.String("operation", req.Operation.String()),
+		zap.String("database-id", req.DatabaseID.String()),
+		zap.Int64("client-number", req.CurrentClientNumber),
+	)
+	t.opMu.Lock()
+	defer t.opMu.Unlock()
+
+	resp, err := t.begin(req)
+	if err != nil {
+		t.lg.Warn("rejected gRPC request", zap.String("operation", req.Operation.String()), zap.Error(err))
+		return nil, err
+	}
+	if resp != nil {
+		t.lg.Info("acknowledged duplicate gRPC request", zap.String("operation", req.Operation.String()))
+		return resp, nil
 	}
+	resp, err = t.transfer(ctx, req)
+	t.end(req, resp, err)
+	return resp, err
+}
 
+func (t *transporterServer) transfer(ctx context.Context, req *dbtesterpb.Request) (*dbtesterpb.Response, error) {
 	if req.Operation == dbtesterpb.Operation_Start {
-		f, err := openToAppend(globalFlags.databaseLog)
+		r, err := newRun(t.base, *req)
+		if err != nil {
+			return nil, err
+		}
+		t.mu.Lock()
+		t.run, t.collected = r, false
+		t.mu.Unlock()
+		t.lg.Info("created run directory", zap.String("path", r.dir))
+
+		f, err := logrotate.NewWriter(r.fs.databaseLog, r.fs.logRotate)
 		if err != nil {
 			return nil, err
 		}
 		t.databaseLogFile = f
-		t.lg.Info("created database log file", zap.String("path", globalFlags.databaseLog))
+		t.lg.Info("created database log file", zap.String("path", r.fs.databaseLog))
 
 		if req.DatabaseID == dbtesterpb.DatabaseID_zetcd__beta || req.DatabaseID == dbtesterpb.DatabaseID_cetcd__beta {
-			proxyLog := globalFlags.databaseLog + "-" + t.req.DatabaseID.String()
-			pf, err := openToAppend(proxyLog)
+			proxyLog := r.fs.databaseLog + "-" + req.DatabaseID.String()
+			pf, err := logrotate.NewWriter(proxyLog, r.fs.logRotate)
 			if err != nil {
 				return nil, err
 			}
@@ -112,37 +333,37 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 			dbtesterpb.DatabaseID_etcd__v3_3:
 			t.lg.Info(
 				"requested on etcd",
-				zap.String("executable-binary-path", globalFlags.etcdExec),
-				zap.String("data-directory", globalFlags.etcdDataDir),
+				zap.String("executable-binary-path", t.run.fs.etcdExec),
+				zap.String("data-directory", t.run.fs.etcdDataDir),
 			)
 
 		case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
 			t.lg.Info(
 				"requested on Zookeeper",
-				zap.String("working-directory", globalFlags.zkWorkDir),
-				zap.String("data-directory", globalFlags.zkDataDir),
-				zap.String("configuration-file", globalFlags.zkConfig),
+				zap.String("working-directory", t.run.fs.zkWorkDir),
+				zap.String("data-directory", t.run.fs.zkDataDir),
+				zap.String("configuration-file", t.run.fs.zkConfig),
 			)
 
 		case dbtesterpb.DatabaseID_consul__v1_0_2:
 			t.lg.Info(
 				"requested on Consul",
-				zap.String("executable-binary-path", globalFlags.consulExec),
-				zap.String("data-directory", globalFlags.consulDataDir),
+				zap.String("executable-binary-path", t.run.fs.consulExec),
+				zap.String("data-directory", t.run.fs.consulDataDir),
 			)
 
 		case dbtesterpb.DatabaseID_zetcd__beta:
 			t.lg.Info(
 				"requested on zetcd",
-				zap.String("executable-binary-path", globalFlags.zetcdExec),
-				zap.String("data-directory", globalFlags.etcdDataDir),
+				zap.String("executable-binary-path", t.run.fs.zetcdExec),
+				zap.String("data-directory", t.run.fs.etcdDataDir),
 			)
 
 		case dbtesterpb.DatabaseID_cetcd__beta:
 			t.lg.Info(
 				"requested on cetcd",
-				zap.String("executable-binary-path", globalFlags.cetcdExec),
-				zap.String("data-directory", globalFlags.etcdDataDir),
+				zap.String("executable-binary-path", t.run.fs.cetcdExec),
+				zap.String("data-directory", t.run.fs.etcdDataDir),
 			)
 		}
 
@@ -153,9 +374,40 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 		t.req.CurrentClientNumber = req.CurrentClientNumber
 	}
 
-	var diskSpaceUsageBytes int64
+	var diskSpaceUsageBytes, backupSizeBytes int64
+	var took time.Duration
+	var etcdGitSHA string
+	var receivedBytes, diskWriteBytes int64
+	var diskReadBytes, logicalReadBytes int64
+	var peakMemoryBytes int64
+	var oomKilled bool
+	var clockSkew time.Duration
+	var profile *dbtesterpb.HardwareProfile
+	var unixNano int64
+	var pid int64
+	var dataDir, version, message, execOutput, cpuArch string
+	var ports map[string]int64
 	switch req.Operation {
 	case dbtesterpb.Operation_Start:
+		if t.req.LatencyProfile != "" {
+			if err := applyLatencyProfile(t); err != nil {
+				return nil, err
+			}
+		}
+		if t.req.DiskWriteDelayMs > 0 || t.req.DiskReadDelayMs > 0 {
+			if err := applyDiskDelay(t); err != nil {
+				return nil, err
+			}
+		}
+		if err := checkFreeDisk(&t.run.fs, diskGuardDirs(&t.run.fs, t.run.dir, t.req.DatabaseID)); err != nil {
+			return nil, err
+		}
+		if err := checkDatabaseLimits(t.lg, &t.req); err != nil {
+			return nil, err
+		}
+		t.diskWriteBytes = 0
+		setLabel(&t.req, "cpu-architecture", runtime.GOARCH)
+
 		switch t.req.DatabaseID {
 		case dbtesterpb.DatabaseID_etcd__other,
 			dbtesterpb.DatabaseID_etcd__tip,
@@ -163,12 +415,21 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 			dbtesterpb.DatabaseID_etcd__v3_3,
 			dbtesterpb.DatabaseID_zetcd__beta,
 			dbtesterpb.DatabaseID_cetcd__beta:
-			if err := startEtcd(&globalFlags, t); err != nil {
+			if t.req.EtcdGitRef != "" {
+				bin, sha, err := buildEtcd(&t.run.fs, t, t.req.EtcdGitRef)
+				if err != nil {
+					return nil, err
+				}
+				t.run.fs.etcdExec = bin
+				etcdGitSHA = sha
+				setLabel(&t.req, "etcd-git-sha", sha)
+			}
+			if err := startEtcd(&t.run.fs, t); err != nil {
 				return nil, err
 			}
 			switch t.req.DatabaseID {
 			case dbtesterpb.DatabaseID_zetcd__beta:
-				if err := startZetcd(&globalFlags, t); err != nil {
+				if err := startZetcd(&t.run.fs, t); err != nil {
 					return nil, err
 				}
 				go func() {
@@ -181,7 +442,7 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 				}()
 
 			case dbtesterpb.DatabaseID_cetcd__beta:
-				if err := startCetcd(&globalFlags, t); err != nil {
+				if err := startCetcd(&t.run.fs, t); err != nil {
 					return nil, err
 				}
 				go func() {
@@ -195,12 +456,12 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 			}
 
 		case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
-			if err := startZookeeper(&globalFlags, t); err != nil {
+			if err := startZookeeper(&t.run.fs, t); err != nil {
 				return nil, err
 			}
 
 		case dbtesterpb.DatabaseID_consul__v1_0_2:
-			if err := startConsul(&globalFlags, t); err != nil {
+			if err := startConsul(&t.run.fs, t); err != nil {
 				return nil, err
 			}
 
@@ -208,17 +469,55 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 			return nil, fmt.Errorf("unknown database %q", t.req.DatabaseID)
 		}
 
-		go func() {
-			defer close(t.cmdWait)
-			if err := t.cmd.Wait(); err != nil {
-				t.lg.Warn("t.cmd.Wait() returned error", zap.Error(err))
-				return
+		go t.waitCmd(t.cmd, t.cmdWait)
+		// member may be restarted (e.g. scaling benchmark)
+		t.csvReady = make(chan struct{})
+		if err := startMetrics(&t.run.fs, t); err != nil {
+			return nil, err
+		}
+		if etcdBased(t.req.DatabaseID) && !grpcProxyEtcd(t.req) {
+			s, err := startEtcdStatus(&t.run.fs, t)
+			if err != nil {
+				return nil, err
 			}
-			t.lg.Info("exiting", zap.String("executable-path", t.cmd.Path))
-		}()
-		if err := startMetrics(&globalFlags, t); err != nil {
+			t.etcdStatus = s
+		}
+		if t.req.DatabaseID == dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta {
+			s, err := startZkStatus(&t.run.fs, t)
+			if err != nil {
+				return nil, err
+			}
+			t.zkStatus = s
+		}
+		if consulServer(t.req) {
+			c, err := startConsulTelemetry(&t.run.fs, t)
+			if err != nil {
+				return nil, err
+			}
+			t.consulTelemetry = c
+		}
+		g, err := startDiskGuard(&t.run.fs, t, diskGuardDirs(&t.run.fs, t.run.dir, t.req.DatabaseID))
+		if err != nil {
+			return nil, err
+		}
+		t.diskGuard = g
+		rs, err := startReadStatus(&t.run.fs, t)
+		if err != nil {
 			return nil, err
 		}
+		t.readStatus = rs
+		if t.req.AntagonistCPUCores > 0 || t.req.AntagonistMemoryBytes > 0 || t.req.AntagonistDiskWriteBytesPerSecond > 0 {
+			if err := startAntagonist(t); err != nil {
+				return nil, err
+			}
+		}
+
+		pid = t.pid
+		dataDir = databaseDataDir(&t.run.fs, t.req.DatabaseID)
+		version = databaseVersion(&t.run.fs, t.req.DatabaseID)
+		ports = databasePorts(t)
+		cpuArch = runtime.GOARCH
+		message = fmt.Sprintf("started %v (pid %d, version %q) with data directory %q", t.req.DatabaseID, pid, version, dataDir)
 
 	case dbtesterpb.Operation_Stop:
 		if t.cmd == nil {
@@ -229,16 +528,58 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 		t.lg.Info("waiting a few more seconds before stopping", zap.String("executable-path", t.cmd.Path))
 		time.Sleep(3 * time.Second)
 
+		if t.etcdStatus != nil {
+			t.lg.Info("stopping polling etcd status")
+			t.etcdStatus.stop()
+			t.etcdStatus = nil
+		}
+		if t.zkStatus != nil {
+			t.lg.Info("stopping polling Zookeeper status")
+			t.zkStatus.stop()
+			t.zkStatus = nil
+		}
+		if t.consulTelemetry != nil {
+			t.lg.Info("stopping polling Consul telemetry")
+			t.consulTelemetry.stop()
+			t.consulTelemetry = nil
+		}
+		if t.diskGuard != nil {
+			t.lg.Info("stopping polling free disk space")
+			t.diskGuard.stop()
+			t.diskGuard = nil
+		}
+		if t.readStatus != nil {
+			t.lg.Info("stopping polling read status")
+			disk, logical := t.readStatus.stop()
+			diskReadBytes, logicalReadBytes = int64(disk), int64(logical)
+			t.readStatus = nil
+		}
+		if len(req.Phases) > 0 {
+			for _, fpath := range []string{t.run.fs.etcdStatusCSV, t.run.fs.zkStatusCSV, t.run.fs.consulTelemetryCSV, t.run.fs.diskStatusCSV, t.run.fs.readStatusCSV} {
+				if !exist(fpath) {
+					continue
+				}
+				if err := annotatePhases(fpath, req.Phases); err != nil {
+					t.lg.Warn("failed to annotate phases", zap.String("path", fpath), zap.Error(err))
+				}
+			}
+		}
+
+		exited := processExited(t)
+		if !exited {
+			addDiskWriteBytes(t)
+		}
+		diskWriteBytes = t.diskWriteBytes
+
 		// TODO: https://github.com/etcd-io/dbtester/issues/330
-		t.lg.Info("sending", zap.String("syscall", syscall.SIGINT.String()), zap.Int64("pid", t.pid), zap.String("executable-path", t.cmd.Path))
-		if err := t.cmd.Process.Signal(syscall.SIGINT); err != nil {
-			t.lg.Warn("syscall.SIGINT failed", zap.Error(err))
-
-			time.Sleep(3 * time.Second)
-			t.lg.Info("sending", zap.String("syscall", syscall.SIGTERM.String()), zap.Int64("pid", t.pid), zap.String("executable-path", t.cmd.Path))
-			if err := syscall.Kill(int(t.pid), syscall.SIGTERM); err != nil {
-				t.lg.Warn("syscall.Kill failed", zap.Error(err))
+		interruptProcess(t.lg, t.cmd, t.pid)
+
+		if t.antagonist != nil {
+			t.lg.Info("stopping antagonist")
+			if err := t.antagonist.Stop(); err != nil {
+				t.lg.Warn("antagonist failed", zap.Error(err))
 			}
+			t.antagonist = nil
 		}
 
 		time.Sleep(time.Second)
@@ -251,16 +592,7 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 		t.lg.Info("stopped", zap.String("database", t.req.DatabaseID.String()), zap.Int64("pid", t.pid))
 
 		if t.proxyCmd != nil {
-			t.lg.Info("sending", zap.String("syscall", syscall.SIGINT.String()), zap.Int64("pid", t.proxyPid), zap.String("executable-path", t.proxyCmd.Path))
-			if err := t.proxyCmd.Process.Signal(syscall.SIGINT); err != nil {
-				t.lg.Warn("syscall.SIGINT failed", zap.Error(err))
-
-				time.Sleep(3 * time.Second)
-				t.lg.Info("sending", zap.String("syscall", syscall.SIGTERM.String()), zap.Int64("pid", t.proxyPid), zap.String("executable-path", t.proxyCmd.Path))
-				if err := syscall.Kill(int(t.proxyPid), syscall.SIGTERM); err != nil {
-					t.lg.Warn("syscall.Kill failed", zap.Error(err))
-				}
-			}
+			interruptProcess(t.lg, t.proxyCmd, t.proxyPid)
 
 			<-t.proxyCmdWait
 			t.lg.Info("stopped", zap.String("database", t.req.DatabaseID.String()), zap.Int64("pid", t.proxyPid))
@@ -271,33 +603,222 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 			}
 		}
 
+		if t.req.LatencyProfile != "" {
+			t.lg.Info("resetting latency profile", zap.String("network-interface", t.run.fs.networkInterface))
+			if o, err := netem.Reset(t.run.fs.networkInterface); err != nil {
+				t.lg.Warn("failed to reset latency profile", zap.String("output", o), zap.Error(err))
+			}
+		}
+
+		if t.clockSkewed {
+			t.lg.Info("resetting clock skew")
+			if o, err := ntp.Unskew(); err != nil {
+				t.lg.Warn("failed to reset clock skew", zap.String("output", o), zap.Error(err))
+			}
+			t.clockSkewed = false
+		}
+
 		t.uploadSig <- struct{}{}
 		<-t.csvReady
+		peakMemoryBytes = int64(t.peakMemoryBytes)
 
-		if t.req.TriggerLogUpload {
-			if err := uploadLog(&globalFlags, t); err != nil {
-				return nil, err
+		// metrics collection is done, to read its cgroup
+		t.oomKilledAt = time.Time{}
+		if exited {
+			oomKilled = detectOOM(&t.run.fs, t)
+		}
+
+		if !grpcProxyEtcd(t.req) {
+			if err := writeDatabaseEvents(&t.run.fs, t); err != nil {
+				t.lg.Warn("failed to parse database events", zap.String("path", t.run.fs.databaseEventsCSV), zap.Error(err))
+			} else if len(req.Phases) > 0 {
+				if err = annotatePhases(t.run.fs.databaseEventsCSV, req.Phases); err != nil {
+					t.lg.Warn("failed to annotate phases", zap.String("path", t.run.fs.databaseEventsCSV), zap.Error(err))
+				}
 			}
 		}
 
-		dbs, err := measureDatabasSize(globalFlags, req.DatabaseID)
+		r := t.run
+		if t.req.TriggerLogUpload && !t.req.ConfigClientMachineInitial.Offline() {
+			done := make(chan struct{})
+			t.mu.Lock()
+			t.uploadDone = done
+			t.mu.Unlock()
+			go func() {
+				q, err := uploadLog(&r.fs, t)
+				t.mu.Lock()
+				t.uploadQueue, t.uploadErr = q, err
+				t.mu.Unlock()
+				close(done)
+			}()
+		}
+
+		dbs, err := measureDatabasSize(r.fs, req.DatabaseID)
 		if err != nil {
 			return nil, err
 		}
 		diskSpaceUsageBytes = dbs
+		message = fmt.Sprintf("stopped %v (%d bytes on disk)", req.DatabaseID, dbs)
+		if oomKilled {
+			message = fmt.Sprintf("%v was killed by OOM killer before stop (%d bytes on disk)", req.DatabaseID, dbs)
+		}
+
+		if !r.fs.keepRunData {
+			t.lg.Info("removing run data", zap.String("run-directory", r.dir))
+			if err := r.removeData(); err != nil {
+				t.lg.Warn("failed to remove run data", zap.String("run-directory", r.dir), zap.Error(err))
+			}
+		}
+
+		if t.req.DiskWriteDelayMs > 0 || t.req.DiskReadDelayMs > 0 {
+			t.lg.Info("removing delayed disk", zap.String("mount-directory", t.run.fs.diskDelayDir))
+			if o, err := diskdelay.Teardown(diskDelayConfig(t)); err != nil {
+				t.lg.Warn("failed to remove delayed disk", zap.String("output", o), zap.Error(err))
+			}
+		}
 
 	case dbtesterpb.Operation_Heartbeat:
-		t.lg.Info("overwriting clients number", zap.Int64("number", t.req.CurrentClientNumber), zap.String("number-path", t.clientNumPath))
-		if err := toFile(fmt.Sprintf("%d", t.req.CurrentClientNumber), t.clientNumPath); err != nil {
+		t.lg.Info("overwriting clients number", zap.Int64("number", t.req.CurrentClientNumber), zap.String("number-path", t.run.fs.clientNumPath))
+		if err := toFile(fmt.Sprintf("%d", t.req.CurrentClientNumber), t.run.fs.clientNumPath); err != nil {
+			return nil, err
+		}
+
+	case dbtesterpb.Operation_Backup:
+		var err error
+		backupSizeBytes, took, err = backupDatabase(&t.run.fs, t)
+		if err != nil {
+			return nil, err
+		}
+
+	case dbtesterpb.Operation_Restore:
+		var err error
+		took, err = restoreDatabase(&t.run.fs, t)
+		if err != nil {
+			return nil, err
+		}
+
+	case dbtesterpb.Operation_Upgrade:
+		var err error
+		etcdGitSHA, took, err = upgradeEtcd(&t.run.fs, t, req.EtcdGitRef)
+		if err != nil {
+			return nil, err
+		}
+
+	case dbtesterpb.Operation_StopMember:
+		if err := stopMember(t); err != nil {
 			return nil, err
 		}
 
+	case dbtesterpb.Operation_RestartMember:
+		var err error
+		took, receivedBytes, err = restartMember(&t.run.fs, t)
+		if err != nil {
+			return nil, err
+		}
+
+	case dbtesterpb.Operation_SkewClock:
+		offset := time.Duration(req.ClockSkewMs) * time.Millisecond
+		t.lg.Info("skewing clock", zap.Duration("offset", offset))
+		before := time.Now()
+		o, err := ntp.Skew(offset)
+		if err != nil {
+			t.lg.Warn("failed to skew clock", zap.String("output", o), zap.Error(err))
+			return nil, err
+		}
+		t.clockSkewed = true
+		after := time.Now()
+		// Round(0) strips monotonic clock reading
+		clockSkew = after.Round(0).Sub(before.Round(0)) - after.Sub(before)
+		t.lg.Info("skewed clock", zap.Duration("offset", offset), zap.Duration("measured-offset", clockSkew))
+
+	case dbtesterpb.Operation_Clock:
+		unixNano = time.Now().UnixNano()
+
+	case dbtesterpb.Operation_Profile:
+		profile = hardwareProfile(&t.base)
+		t.lg.Info("profiled hardware",
+			zap.String("cpu-model", profile.CPUModel),
+			zap.Int64("cpu-count", profile.CPUCount),
+			zap.Int64("memory-bytes", profile.MemoryBytes),
+			zap.String("disk-type", profile.DiskType),
+			zap.String("kernel-version", profile.KernelVersion),
+		)
+
+	case dbtesterpb.Operation_DropPageCache:
+		if err := dropPageCache(&t.run.fs, t, req.RestartDatabase); err != nil {
+			return nil, err
+		}
+		message = "dropped page cache"
+		if req.RestartDatabase {
+			message = fmt.Sprintf("dropped page cache and restarted database (PID %d)", t.pid)
+		}
+
+	case dbtesterpb.Operation_Exec:
+		var err error
+		execOutput, err = runExec(&t.base, t, req.ExecCommand, time.Duration(req.ExecTimeoutSeconds)*time.Second)
+		if err != nil {
+			return nil, err
+		}
+		message = fmt.Sprintf("ran %q", req.ExecCommand)
+
 	default:
 		return nil, fmt.Errorf("Not implemented %v", req.Operation)
 	}
 
-	t.lg.Info("Transfer success!")
-	return &dbtesterpb.Response{Success: true, DiskSpaceUsageBytes: diskSpaceUsageBytes}, nil
+	if message == "" {
+		message = fmt.Sprintf("%v succeeded", req.Operation)
+	}
+	t.lg.Info("Transfer success!", zap.String("message", message))
+	return &dbtesterpb.Response{
+		Success:              true,
+		DiskSpaceUsageBytes:  diskSpaceUsageBytes,
+		BackupSizeBytes:      backupSizeBytes,
+		TookNanoseconds:      int64(took),
+		EtcdGitSHA:           etcdGitSHA,
+		ReceivedBytes:        receivedBytes,
+		DiskWriteBytes:       diskWriteBytes,
+		DiskReadBytes:        diskReadBytes,
+		LogicalReadBytes:     logicalReadBytes,
+		PeakMemoryBytes:      peakMemoryBytes,
+		OOMKilled:            oomKilled,
+		ClockSkewNanoseconds: int64(clockSkew),
+		HardwareProfile:      profile,
+		UnixNano:             unixNano,
+		PID:                  pid,
+		DataDir:              dataDir,
+		DatabaseVersion:      version,
+		CPUArchitecture:      cpuArch,
+		Ports:                ports,
+		Message:              message,
+		ExecOutput:           execOutput,
+	}, nil
+}
+
+// restarted notifies pollers of the restarted database process.
+func (t *transporterServer) restarted() {
+	select {
+	case t.metricsPIDc <- t.pid:
+	default:
+	}
+	if t.readStatus != nil {
+		t.readStatus.restarted(t.pid)
+	}
+}
+
+// waitCmd waits for the database process to exit, and closes waitc.
+func (t *transporterServer) waitCmd(cmd *exec.Cmd, waitc chan struct{}) {
+	defer close(waitc)
+	trackProcess(t.lg, cmd)
+	if err := cmd.Wait(); err != nil {
+		t.lg.Warn("t.cmd.Wait() returned error", zap.Error(err))
+		return
+	}
+	t.lg.Info("exiting", zap.String("executable-path", cmd.Path))
+}
+
+// port returns the database port shifted by the port offset of the test.
+func (t *transporterServer) port(p int64) int64 {
+	return p + t.req.PortOffset
 }
 
 func measureDatabasSize(flg flags, rdb dbtesterpb.DatabaseID) (int64, error) {
@@ -320,3 +841,99 @@ func measureDatabasSize(flg flags, rdb dbtesterpb.DatabaseID) (int64, error) {
 		return 0, fmt.Errorf("uknown %q", rdb)
 	}
 }
+
+// applyLatencyProfile delays packets from this agent's peer to other peers.
+func applyLatencyProfile(t *transporterServer) error {
+	peerIPs := strings.Split(t.req.PeerIPsString, "___")
+	m, err := netem.Matrix(t.req.LatencyProfile, len(peerIPs))
+	if err != nil {
+		return err
+	}
+	if int(t.req.IPIndex) >= len(peerIPs) {
+		// not a database peer (e.g. Consul client agent)
+		t.lg.Info("skipping latency profile", zap.Uint32("ip-index", t.req.IPIndex))
+		return nil
+	}
+	delays := m[t.req.IPIndex]
+
+	t.lg.Info(
+		"applying latency profile",
+		zap.String("profile", t.req.LatencyProfile),
+		zap.String("network-interface", t.run.fs.networkInterface),
+		zap.Strings("peer-ips", peerIPs),
+		zap.String("delays", fmt.Sprintf("%v", delays)),
+	)
+	o, err := netem.Apply(t.run.fs.networkInterface, peerIPs, delays)
+	if err != nil {
+		t.lg.Warn("failed to apply latency profile", zap.String("output", o), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func diskDelayConfig(t *transporterServer) diskdelay.Config {
+	name := "dbtester-delay"
+	if t.req.TestID != "" {
+		name += "-" + t.req.TestID
+	}
+	return diskdelay.Config{
+		Name:        name,
+		BackingFile: t.run.fs.diskDelayDir + ".img",
+		SizeBytes:   t.run.fs.diskDelaySizeBytes,
+		MountDir:    t.run.fs.diskDelayDir,
+		ReadDelay:   time.Duration(t.req.DiskReadDelayMs) * time.Millisecond,
+		WriteDelay:  time.Duration(t.req.DiskWriteDelayMs) * time.Millisecond,
+	}
+}
+
+// applyDiskDelay creates the delayed disk, and moves the database
+// data directory onto it.
+func applyDiskDelay(t *transporterServer) error {
+	cfg := diskDelayConfig(t)
+	t.lg.Info(
+		"creating delayed disk",
+		zap.String("name", cfg.Name),
+		zap.String("mount-directory", cfg.MountDir),
+		zap.Int64("size-bytes", cfg.SizeBytes),
+		zap.Duration("read-delay", cfg.ReadDelay),
+		zap.Duration("write-delay", cfg.WriteDelay),
+	)
+	o, err := diskdelay.Setup(cfg)
+	if err != nil {
+		t.lg.Warn("failed to create delayed disk", zap.String("output", o), zap.Error(err))
+		return err
+	}
+
+	switch t.req.DatabaseID {
+	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		t.run.fs.zkDataDir = filepath.Join(cfg.MountDir, filepath.Base(t.run.fs.zkDataDir))
+	case dbtesterpb.DatabaseID_consul__v1_0_2:
+		t.run.fs.consulDataDir = filepath.Join(cfg.MountDir, filepath.Base(t.run.fs.consulDataDir))
+	default:
+		t.run.fs.etcdDataDir = filepath.Join(cfg.MountDir, filepath.Base(t.run.fs.etcdDataDir))
+	}
+	return nil
+}
+
+// startAntagonist starts consuming host resources, as noisy neighbors.
+func startAntagonist(t *transporterServer) error {
+	cfg := antagonist.Config{
+		CPUCores:                int(t.req.AntagonistCPUCores),
+		MemoryBytes:             t.req.AntagonistMemoryBytes,
+		DiskWriteBytesPerSecond: t.req.AntagonistDiskWriteBytesPerSecond,
+		DiskDir:                 t.run.fs.antagonistDir,
+	}
+	t.lg.Info(
+		"starting antagonist",
+		zap.Int("cpu-cores", cfg.CPUCores),
+		zap.Int64("memory-bytes", cfg.MemoryBytes),
+		zap.Int64("disk-write-bytes-per-second", cfg.DiskWriteBytesPerSecond),
+		zap.String("disk-directory", cfg.DiskDir),
+	)
+	a, err := antagonist.Start(cfg)
+	if err != nil {
+		return err
+	}
+	t.antagonist = a
+	return nil
+}
@@ -12,6 +12,9 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//go:build !windows
+// +build !windows
+
 package agent
 
 import (
@@ -19,11 +22,19 @@ import (
 	"os"
 	"time"
 
+	"github.com/etcd-io/dbtester/pkg/cgroup"
+	"github.com/etcd-io/dbtester/pkg/logrotate"
+
+	humanize "github.com/dustin/go-humanize"
 	"github.com/gyuho/linux-inspect/inspect"
 	"github.com/gyuho/linux-inspect/top"
 	"go.uber.org/zap"
 )
 
+// systemMetricsCSV is the system metrics of the database process, and
+// the disk and network of the host, from '/proc' and 'top'.
+type systemMetricsCSV = inspect.CSV
+
 // startMetrics starts collecting metrics.
 func startMetrics(fs *flags, t *transporterServer) (err error) {
 	if fs == nil || t == nil || t.cmd == nil {
@@ -35,12 +46,16 @@ func startMetrics(fs *flags, t *transporterServer) (err error) {
 		zap.String("database", t.req.DatabaseID.String()),
 		zap.String("disk-device", fs.diskDevice),
 		zap.String("network-device", fs.networkInterface),
+		zap.String("monitor-backend", fs.monitorBackend),
 		zap.Int64("pid", t.pid),
 	)
 	if err = os.RemoveAll(fs.systemMetricsCSV); err != nil {
 		return err
 	}
-	if err = toFile(fmt.Sprintf("%d", t.req.CurrentClientNumber), t.clientNumPath); err != nil {
+	if err = logrotate.RemoveBackups(fs.systemMetricsCSV); err != nil {
+		return err
+	}
+	if err = toFile(fmt.Sprintf("%d", t.req.CurrentClientNumber), fs.clientNumPath); err != nil {
 		return err
 	}
 
@@ -54,21 +69,54 @@ func startMetrics(fs *flags, t *transporterServer) (err error) {
 		t.pid,
 		fs.diskDevice,
 		fs.networkInterface,
-		t.clientNumPath,
+		fs.clientNumPath,
 		tcfg,
 	)
-	if err := t.metricsCSV.Add(); err != nil {
+	t.peakMemoryBytes = 0
+	if err = setMetricsCgroup(fs, t, t.pid); err != nil {
+		return err
+	}
+	if err := addMetrics(t); err != nil {
 		return err
 	}
 
 	go func() {
+		rotatedAt := time.Now()
 		for {
 			select {
 			case <-time.After(time.Second):
-				if err := t.metricsCSV.Add(); err != nil {
+				if err := addMetrics(t); err != nil {
 					t.lg.Warn("inspect.CSV.Add error", zap.Error(err))
 					continue
 				}
+				if (fs.systemMetricsRotateRows > 0 && len(t.metricsCSV.Rows) >= fs.systemMetricsRotateRows) ||
+					(fs.logRotate.MaxAge > 0 && time.Since(rotatedAt) > fs.logRotate.MaxAge) {
+					if err := rotateMetrics(fs, t); err != nil {
+						t.lg.Warn("failed to rotate CSV", zap.Error(err))
+					}
+					rotatedAt = time.Now()
+				}
+
+			case pid := <-t.metricsPIDc:
+				// restarted database, with new 'top' stream of the process
+				t.lg.Info("collecting system metrics of restarted database", zap.Int64("pid", pid))
+				tcfg := &top.Config{
+					Exec:           top.DefaultExecPath,
+					IntervalSecond: 1,
+					PID:            pid,
+				}
+				stream, err := tcfg.StartStream()
+				if err != nil {
+					t.lg.Warn("failed to start top stream", zap.Error(err))
+					continue
+				}
+				if t.metricsCSV.TopStream != nil {
+					t.metricsCSV.TopStream.Stop()
+				}
+				t.metricsCSV.PID, t.metricsCSV.TopStream = pid, stream
+				if err := setMetricsCgroup(fs, t, pid); err != nil {
+					t.lg.Warn("failed to find cgroup of restarted database", zap.Error(err))
+				}
 
 			case <-t.uploadSig:
 				t.lg.Info("upload requested, saving CSV", zap.String("path", t.metricsCSV.FilePath))
@@ -101,3 +149,98 @@ func startMetrics(fs *flags, t *transporterServer) (err error) {
 	}()
 	return nil
 }
+
+// rotateMetrics saves and rotates the system metrics CSV, keeping only the
+// latest row in memory. The interpolated CSV only covers the rows since
+// the last rotation.
+func rotateMetrics(fs *flags, t *transporterServer) error {
+	if err := t.metricsCSV.Save(); err != nil {
+		return err
+	}
+	rpath, err := logrotate.Rotate(t.metricsCSV.FilePath, fs.logRotate)
+	if err != nil {
+		return err
+	}
+	t.metricsCSV.Rows = t.metricsCSV.Rows[len(t.metricsCSV.Rows)-1:]
+	t.lg.Info("rotated CSV", zap.String("path", rpath))
+	return nil
+}
+
+// setMetricsCgroup selects the monitor backend of the database process.
+// With 'auto', the cgroup v2 backend is used only when the database runs in
+// its own cgroup (e.g. started by 'systemd-run'), since the cgroup shared with
+// the agent would account the agent too.
+func setMetricsCgroup(fs *flags, t *transporterServer, pid int64) error {
+	t.metricsCgroup = nil
+	switch fs.monitorBackend {
+	case "proc":
+		return nil
+	case "cgroup2", "auto":
+	default:
+		return fmt.Errorf("unknown monitor backend %q", fs.monitorBackend)
+	}
+	auto := fs.monitorBackend == "auto"
+
+	if !cgroup.Unified(cgroup.DefaultRoot) {
+		if auto {
+			return nil
+		}
+		return fmt.Errorf("%q is not cgroup v2 unified hierarchy", cgroup.DefaultRoot)
+	}
+	dir, err := cgroup.Find(cgroup.DefaultRoot, pid)
+	if err != nil {
+		if auto {
+			return nil
+		}
+		return err
+	}
+	if own, err := cgroup.Find(cgroup.DefaultRoot, int64(os.Getpid())); err == nil && own == dir {
+		if auto {
+			return nil
+		}
+		t.lg.Warn("database shares cgroup with agent, accounting both", zap.String("cgroup", dir))
+	}
+	r := &cgroup.Reader{Dir: dir}
+	if _, err = r.Sample(); err != nil {
+		// cpu, memory, or io controller not enabled for the cgroup
+		if auto {
+			t.lg.Info("cannot read cgroup v2 accounting, using '/proc'", zap.String("cgroup", dir), zap.Error(err))
+			return nil
+		}
+		return err
+	}
+	t.metricsCgroup = r
+	t.lg.Info("collecting cgroup v2 accounting", zap.String("cgroup", dir), zap.Int64("pid", pid))
+	return nil
+}
+
+// addMetrics adds the row of the current second, replacing the CPU, memory,
+// and I/O of the process with those of its cgroup when using cgroup v2,
+// and tracks the peak memory.
+func addMetrics(t *transporterServer) error {
+	if err := t.metricsCSV.Add(); err != nil {
+		return err
+	}
+	row := &t.metricsCSV.Rows[len(t.metricsCSV.Rows)-1]
+	if t.metricsCgroup == nil {
+		if row.PSEntry.VMRSSNum > t.peakMemoryBytes {
+			t.peakMemoryBytes = row.PSEntry.VMRSSNum
+		}
+		return nil
+	}
+	u, err := t.metricsCgroup.Sample()
+	if err != nil {
+		return err
+	}
+	if u.MemoryBytes > t.peakMemoryBytes {
+		t.peakMemoryBytes = u.MemoryBytes
+	}
+	if u.PeakMemoryBytes > t.peakMemoryBytes {
+		t.peakMemoryBytes = u.PeakMemoryBytes
+	}
+	row.PSEntry.CPUNum, row.PSEntry.CPU = u.CPUPercent, fmt.Sprintf("%3.2f %%", u.CPUPercent)
+	row.PSEntry.VMRSSNum, row.PSEntry.VMRSS = u.MemoryBytes, humanize.Bytes(u.MemoryBytes)
+	row.ReadBytesDelta, row.ReadMegabytesDelta = u.ReadBytesDelta, u.ReadBytesDelta/1000000
+	row.WriteBytesDelta, row.WriteMegabytesDelta = u.WriteBytesDelta, u.WriteBytesDelta/1000000
+	return nil
+}
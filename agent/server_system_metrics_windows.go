@@ -0,0 +1,222 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/pkg/logrotate"
+
+	"go.uber.org/zap"
+)
+
+// systemMetricsColumns is the columns of system metrics CSV that
+// 'analyze' reads. Windows has no equivalent of context switches,
+// load average, or disk device counters, which are left zero.
+var systemMetricsColumns = []string{
+	"UNIX-SECOND",
+	"VOLUNTARY-CTXT-SWITCHES",
+	"NON-VOLUNTARY-CTXT-SWITCHES",
+	"CPU-NUM",
+	"LOAD-AVERAGE-1-MINUTE",
+	"VMRSS-NUM",
+	"READS-COMPLETED",
+	"READS-COMPLETED-DELTA",
+	"SECTORS-READ",
+	"SECTORS-READ-DELTA",
+	"WRITES-COMPLETED",
+	"WRITES-COMPLETED-DELTA",
+	"SECTORS-WRITTEN",
+	"SECTORS-WRITTEN-DELTA",
+	"READ-BYTES-DELTA",
+	"WRITE-BYTES-DELTA",
+	"RECEIVE-BYTES-NUM",
+	"RECEIVE-BYTES-NUM-DELTA",
+	"TRANSMIT-BYTES-NUM",
+	"TRANSMIT-BYTES-NUM-DELTA",
+	"EXTRA",
+}
+
+// systemMetricsCSV is the system metrics of the database process, from
+// the process accounting of Windows. Rows are taken every second, so
+// they need no interpolation.
+type systemMetricsCSV struct {
+	FilePath      string
+	PID           int64
+	ClientNumPath string
+	Rows          [][]string
+
+	prev   processUsage
+	prevAt time.Time
+}
+
+// Add appends the row of the current second.
+func (c *systemMetricsCSV) Add() error {
+	u, err := getProcessUsage(c.PID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	var cpu float64
+	var readDelta, writeDelta uint64
+	if !c.prevAt.IsZero() {
+		// percent of one CPU, as 'top' reports
+		cpu = 100 * float64(u.cpu-c.prev.cpu) / float64(now.Sub(c.prevAt))
+		readDelta, writeDelta = u.readBytes-c.prev.readBytes, u.writeBytes-c.prev.writeBytes
+	}
+	c.prev, c.prevAt = u, now
+
+	var clientNum string
+	if bts, err := ioutil.ReadFile(c.ClientNumPath); err == nil {
+		clientNum = strings.TrimSpace(string(bts))
+	}
+
+	row := make([]string, len(systemMetricsColumns))
+	for i := range row {
+		row[i] = "0"
+	}
+	row[0] = fmt.Sprintf("%d", now.Unix())
+	row[3] = fmt.Sprintf("%3.2f", cpu)
+	row[5] = fmt.Sprintf("%d", u.workingSetBytes)
+	row[14] = fmt.Sprintf("%d", readDelta)
+	row[15] = fmt.Sprintf("%d", writeDelta)
+	row[20] = clientNum
+	c.Rows = append(c.Rows, row)
+	return nil
+}
+
+// Save writes the rows to the file path.
+func (c *systemMetricsCSV) Save() error {
+	return c.saveTo(c.FilePath)
+}
+
+func (c *systemMetricsCSV) saveTo(fpath string) error {
+	f, err := os.OpenFile(fpath, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0777)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wr := csv.NewWriter(f)
+	if err := wr.Write(systemMetricsColumns); err != nil {
+		return err
+	}
+	if err := wr.WriteAll(c.Rows); err != nil {
+		return err
+	}
+	wr.Flush()
+	return wr.Error()
+}
+
+// startMetrics starts collecting metrics.
+func startMetrics(fs *flags, t *transporterServer) (err error) {
+	if fs == nil || t == nil || t.cmd == nil {
+		return fmt.Errorf("cannot find process to track (%+v, %+v)", fs, t)
+	}
+
+	t.lg.Info(
+		"starting collecting system metrics",
+		zap.String("database", t.req.DatabaseID.String()),
+		zap.Int64("pid", t.pid),
+	)
+	if err = os.RemoveAll(fs.systemMetricsCSV); err != nil {
+		return err
+	}
+	if err = logrotate.RemoveBackups(fs.systemMetricsCSV); err != nil {
+		return err
+	}
+	if err = toFile(fmt.Sprintf("%d", t.req.CurrentClientNumber), fs.clientNumPath); err != nil {
+		return err
+	}
+
+	t.metricsCSV = &systemMetricsCSV{FilePath: fs.systemMetricsCSV, PID: t.pid, ClientNumPath: fs.clientNumPath}
+	t.peakMemoryBytes = 0
+	if err = addMetrics(t); err != nil {
+		return err
+	}
+
+	go func() {
+		rotatedAt := time.Now()
+		for {
+			select {
+			case <-time.After(time.Second):
+				if err := addMetrics(t); err != nil {
+					t.lg.Warn("systemMetricsCSV.Add error", zap.Error(err))
+					continue
+				}
+				if (fs.systemMetricsRotateRows > 0 && len(t.metricsCSV.Rows) >= fs.systemMetricsRotateRows) ||
+					(fs.logRotate.MaxAge > 0 && time.Since(rotatedAt) > fs.logRotate.MaxAge) {
+					if err := rotateMetrics(fs, t); err != nil {
+						t.lg.Warn("failed to rotate CSV", zap.Error(err))
+					}
+					rotatedAt = time.Now()
+				}
+
+			case pid := <-t.metricsPIDc:
+				t.lg.Info("collecting system metrics of restarted database", zap.Int64("pid", pid))
+				t.metricsCSV.PID, t.metricsCSV.prevAt = pid, time.Time{}
+
+			case <-t.uploadSig:
+				t.lg.Info("upload requested, saving CSV", zap.String("path", t.metricsCSV.FilePath))
+				for _, fpath := range []string{fs.systemMetricsCSV, fs.systemMetricsCSVInterpolated} {
+					if err := t.metricsCSV.saveTo(fpath); err != nil {
+						t.lg.Warn("failed to save CSV", zap.Error(err))
+					} else {
+						t.lg.Info("saved CSV", zap.String("path", fpath))
+					}
+				}
+				close(t.csvReady)
+				return
+
+			case sig := <-t.notifier:
+				t.lg.Info("received a signal", zap.String("signal", sig.String()))
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// rotateMetrics saves and rotates the system metrics CSV, keeping only the
+// latest row in memory.
+func rotateMetrics(fs *flags, t *transporterServer) error {
+	if err := t.metricsCSV.Save(); err != nil {
+		return err
+	}
+	rpath, err := logrotate.Rotate(t.metricsCSV.FilePath, fs.logRotate)
+	if err != nil {
+		return err
+	}
+	t.metricsCSV.Rows = t.metricsCSV.Rows[len(t.metricsCSV.Rows)-1:]
+	t.lg.Info("rotated CSV", zap.String("path", rpath))
+	return nil
+}
+
+// addMetrics adds the row of the current second, and tracks the peak memory.
+func addMetrics(t *transporterServer) error {
+	if err := t.metricsCSV.Add(); err != nil {
+		return err
+	}
+	if t.metricsCSV.prev.workingSetBytes > t.peakMemoryBytes {
+		t.peakMemoryBytes = t.metricsCSV.prev.workingSetBytes
+	}
+	return nil
+}
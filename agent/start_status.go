@@ -0,0 +1,103 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// databaseDataDir returns the data directory of the database.
+func databaseDataDir(fs *flags, id dbtesterpb.DatabaseID) string {
+	switch id {
+	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		return fs.zkDataDir
+	case dbtesterpb.DatabaseID_consul__v1_0_2:
+		return fs.consulDataDir
+	}
+	return fs.etcdDataDir
+}
+
+var (
+	etcdVersionRegex      = regexp.MustCompile(`etcd Version: (\S+)`)
+	consulVersionRegex    = regexp.MustCompile(`Consul v(\S+)`)
+	zookeeperVersionRegex = regexp.MustCompile(`zookeeper-(\S+)\.jar`)
+)
+
+// databaseVersion returns the version reported by the database binary,
+// or empty if unknown. zetcd and cetcd report the version of the etcd
+// they run on.
+func databaseVersion(fs *flags, id dbtesterpb.DatabaseID) string {
+	var (
+		out []byte
+		re  *regexp.Regexp
+	)
+	switch id {
+	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		out, re = []byte(JavaClassPathZookeeperr353beta), zookeeperVersionRegex
+	case dbtesterpb.DatabaseID_consul__v1_0_2:
+		out, _ = exec.Command(fs.consulExec, "version").Output()
+		re = consulVersionRegex
+	default:
+		out, _ = exec.Command(fs.etcdExec, "--version").Output()
+		re = etcdVersionRegex
+	}
+	if m := re.FindSubmatch(out); len(m) == 2 {
+		return strings.TrimSpace(string(m[1]))
+	}
+	return ""
+}
+
+// databasePorts returns the ports the database listens on by name.
+func databasePorts(t *transporterServer) map[string]int64 {
+	switch t.req.DatabaseID {
+	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
+		ports := map[string]int64{
+			"quorum":   t.port(2888),
+			"election": t.port(3888),
+		}
+		if t.req.Flag_Zookeeper_R3_5_3Beta != nil {
+			ports["client"] = t.port(t.req.Flag_Zookeeper_R3_5_3Beta.ClientPort)
+		}
+		if t.req.PortOffset > 0 {
+			ports["admin"] = t.port(8080)
+		}
+		return ports
+
+	case dbtesterpb.DatabaseID_consul__v1_0_2:
+		return map[string]int64{
+			"http":     t.port(8500),
+			"dns":      t.port(8600),
+			"server":   t.port(8300),
+			"serf-lan": t.port(8301),
+			"serf-wan": t.port(8302),
+		}
+	}
+
+	ports := map[string]int64{
+		"client": t.port(2379),
+		"peer":   t.port(2380),
+	}
+	switch t.req.DatabaseID {
+	case dbtesterpb.DatabaseID_zetcd__beta:
+		ports["zookeeper"] = t.port(2181)
+	case dbtesterpb.DatabaseID_cetcd__beta:
+		ports["consul-http"] = t.port(8500)
+	}
+	return ports
+}
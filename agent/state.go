@@ -0,0 +1,125 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// state is the state of the database on the agent.
+type state int
+
+const (
+	// stateIdle is before the database starts, or after it stops.
+	stateIdle state = iota
+	// stateRunning is after the database starts.
+	stateRunning
+	// stateStopping is while the database is being stopped.
+	stateStopping
+)
+
+func (s state) String() string {
+	switch s {
+	case stateIdle:
+		return "Idle"
+	case stateRunning:
+		return "Running"
+	case stateStopping:
+		return "Stopping"
+	default:
+		return fmt.Sprintf("state(%d)", int(s))
+	}
+}
+
+// begin validates the operation in the current state, and starts the
+// transition. It returns the response of the last same operation if
+// the request is a retry (e.g. duplicate 'Stop'), which is acknowledged
+// without running again.
+func (t *transporterServer) begin(req *dbtesterpb.Request) (*dbtesterpb.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch req.Operation {
+	case dbtesterpb.Operation_Start:
+		switch t.state {
+		case stateRunning:
+			if req.DatabaseID != t.req.DatabaseID {
+				return nil, fmt.Errorf("cannot start %q while %q is running", req.DatabaseID, t.req.DatabaseID)
+			}
+			return t.lastResp[req.Operation], nil
+		case stateStopping:
+			return nil, fmt.Errorf("cannot start %q while stopping", req.DatabaseID)
+		}
+
+	case dbtesterpb.Operation_Stop:
+		switch t.state {
+		case stateIdle:
+			if resp, ok := t.lastResp[req.Operation]; ok {
+				return resp, nil
+			}
+			return nil, fmt.Errorf("cannot stop before database start")
+		case stateStopping:
+			return nil, fmt.Errorf("already stopping")
+		}
+		t.state = stateStopping
+
+	case dbtesterpb.Operation_Heartbeat,
+		dbtesterpb.Operation_Backup,
+		dbtesterpb.Operation_Restore,
+		dbtesterpb.Operation_Upgrade,
+		dbtesterpb.Operation_StopMember,
+		dbtesterpb.Operation_RestartMember,
+		dbtesterpb.Operation_DropPageCache:
+		if t.state != stateRunning {
+			return nil, fmt.Errorf("cannot %v in state %v", req.Operation, t.state)
+		}
+
+	case dbtesterpb.Operation_SkewClock:
+		if t.state == stateStopping {
+			return nil, fmt.Errorf("cannot %v in state %v", req.Operation, t.state)
+		}
+	}
+	return nil, nil
+}
+
+// end completes the transition of the operation. A failed 'Stop' still
+// moves to idle, since the database process has been signaled.
+func (t *transporterServer) end(req *dbtesterpb.Request, resp *dbtesterpb.Response, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch req.Operation {
+	case dbtesterpb.Operation_Start:
+		if err == nil {
+			t.state = stateRunning
+		}
+	case dbtesterpb.Operation_Stop:
+		t.state, t.collected = stateIdle, false
+	}
+	if err == nil {
+		t.lastResp[req.Operation] = resp
+	}
+	t.statusDatabaseID, t.statusPID, t.statusCmdWait = t.req.DatabaseID, t.pid, t.cmdWait
+}
+
+// finished returns true if the test is idle, and nothing is left to
+// reset or report: it never started, or its last run is collected.
+func (t *transporterServer) finished() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.state == stateIdle && !t.clockSkewed && (t.run == nil || t.collected)
+}
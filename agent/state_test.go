@@ -0,0 +1,92 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+func TestTransferState(t *testing.T) {
+	stopResp := &dbtesterpb.Response{Success: true}
+	tests := []struct {
+		state    state
+		lastResp map[dbtesterpb.Operation]*dbtesterpb.Response
+		op       dbtesterpb.Operation
+
+		resp *dbtesterpb.Response
+		err  bool
+	}{
+		// duplicate 'Stop' is acknowledged with the cached response
+		{state: stateIdle, lastResp: map[dbtesterpb.Operation]*dbtesterpb.Response{dbtesterpb.Operation_Stop: stopResp}, op: dbtesterpb.Operation_Stop, resp: stopResp},
+		{state: stateIdle, op: dbtesterpb.Operation_Stop, err: true},
+		{state: stateStopping, op: dbtesterpb.Operation_Stop, err: true},
+		{state: stateStopping, op: dbtesterpb.Operation_Start, err: true},
+		{state: stateIdle, op: dbtesterpb.Operation_Heartbeat, err: true},
+		{state: stateStopping, op: dbtesterpb.Operation_Heartbeat, err: true},
+	}
+	for i, tt := range tests {
+		ts := &transporterServer{
+			lg:       zap.NewNop(),
+			state:    tt.state,
+			lastResp: make(map[dbtesterpb.Operation]*dbtesterpb.Response),
+		}
+		for op, resp := range tt.lastResp {
+			ts.lastResp[op] = resp
+		}
+
+		resp, err := ts.Transfer(context.Background(), &dbtesterpb.Request{Operation: tt.op, DatabaseID: dbtesterpb.DatabaseID_etcd__tip})
+		if (err != nil) != tt.err {
+			t.Fatalf("#%d: %v in %v, error expected %v, got %v", i, tt.op, tt.state, tt.err, err)
+		}
+		if resp != tt.resp {
+			t.Fatalf("#%d: %v in %v, expected response %v, got %v", i, tt.op, tt.state, tt.resp, resp)
+		}
+		if ts.state != tt.state {
+			t.Fatalf("#%d: %v in %v, state changed to %v", i, tt.op, tt.state, ts.state)
+		}
+	}
+}
+
+func TestServerRemoveFinished(t *testing.T) {
+	s := newServer(zap.NewNop(), flags{})
+
+	// never started
+	if _, err := s.Transfer(context.Background(), &dbtesterpb.Request{Operation: dbtesterpb.Operation_Heartbeat, TestID: "a"}); err == nil {
+		t.Fatal("expected error on heartbeat before start")
+	}
+	if s.lookup("a") != nil {
+		t.Fatal("expected test never started to be removed")
+	}
+
+	// stopped, with uploads not yet reported
+	ts := s.acquire("b")
+	done := make(chan struct{})
+	close(done)
+	ts.run, ts.uploadDone = &run{}, done
+	s.release(ts)
+	if s.lookup("b") != ts {
+		t.Fatal("expected stopped test to be kept until uploads are reported")
+	}
+	if _, err := s.WaitUpload(context.Background(), &dbtesterpb.UploadRequest{TestID: "b"}); err != nil {
+		t.Fatal(err)
+	}
+	if s.lookup("b") != nil {
+		t.Fatal("expected test to be removed after uploads are reported")
+	}
+}
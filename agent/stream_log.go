@@ -0,0 +1,143 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+// StreamLog streams the database log, following rotations when requested.
+func (t *transporterServer) StreamLog(req *dbtesterpb.LogRequest, stream dbtesterpb.Transporter_StreamLogServer) error {
+	t.mu.Lock()
+	r := t.run
+	t.mu.Unlock()
+	if r == nil {
+		return fmt.Errorf("database has not started")
+	}
+	logPath := r.fs.databaseLog
+	t.lg.Info("streaming database log", zap.String("path", logPath), zap.Int64("tail-lines", req.TailLines), zap.Bool("follow", req.Follow))
+
+	f, err := os.Open(logPath)
+	if err != nil {
+		return err
+	}
+	defer func() { f.Close() }()
+
+	off, err := tailOffset(f, req.TailLines)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Seek(off, io.SeekStart); err != nil {
+		return err
+	}
+
+	rd := bufio.NewReader(f)
+	var partial string
+	for {
+		line, err := rd.ReadString('\n')
+		if err == nil {
+			off += int64(len(line))
+			if err = stream.Send(&dbtesterpb.LogLine{Line: partial + line[:len(line)-1]}); err != nil {
+				return err
+			}
+			partial = ""
+			continue
+		}
+		if err != io.EOF {
+			return err
+		}
+		off += int64(len(line))
+		partial += line
+		if !req.Follow {
+			if partial != "" {
+				return stream.Send(&dbtesterpb.LogLine{Line: partial})
+			}
+			return nil
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-time.After(500 * time.Millisecond):
+		}
+
+		// reopen from the beginning if the log was rotated or truncated
+		fi, err := os.Stat(logPath)
+		if err != nil {
+			continue
+		}
+		cur, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		if os.SameFile(fi, cur) && fi.Size() >= off {
+			continue
+		}
+		nf, err := os.Open(logPath)
+		if err != nil {
+			continue
+		}
+		f.Close()
+		f, off, partial = nf, 0, ""
+		rd.Reset(f)
+	}
+}
+
+// tailOffset returns the offset of the last 'n' lines in the file.
+// It returns 0 if 'n' is not positive or the file has fewer lines.
+func tailOffset(f *os.File, n int64) (int64, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if n <= 0 {
+		return 0, nil
+	}
+
+	const chunkSize = 64 * 1024
+	buf := make([]byte, chunkSize)
+	end := fi.Size()
+	var cnt int64
+	for end > 0 {
+		start := end - chunkSize
+		if start < 0 {
+			start = 0
+		}
+		b := buf[:end-start]
+		if _, err = f.ReadAt(b, start); err != nil && err != io.EOF {
+			return 0, err
+		}
+		for i := len(b) - 1; i >= 0; i-- {
+			// skip the newline of the last line
+			if b[i] != '\n' || start+int64(i) == fi.Size()-1 {
+				continue
+			}
+			cnt++
+			if cnt == n {
+				return start + int64(i) + 1, nil
+			}
+		}
+		end = start
+	}
+	return 0, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+// upgradeEtcd restarts the running etcd member with the binary built
+// at the git ref, keeping its data directory. It returns the commit SHA
+// of new binary and how long the member was down.
+func upgradeEtcd(fs *flags, t *transporterServer, ref string) (string, time.Duration, error) {
+	switch t.req.DatabaseID {
+	case dbtesterpb.DatabaseID_etcd__other, dbtesterpb.DatabaseID_etcd__tip:
+	default:
+		return "", 0, fmt.Errorf("upgrade is not supported for %q", t.req.DatabaseID)
+	}
+	if ref == "" {
+		return "", 0, fmt.Errorf("upgrade requires etcd git ref")
+	}
+	if grpcProxyEtcd(t.req) {
+		return "", 0, fmt.Errorf("upgrade is not supported for gRPC proxy")
+	}
+
+	// build first, so that the member is down only for restart
+	bin, sha, err := buildEtcd(fs, t, ref)
+	if err != nil {
+		return "", 0, err
+	}
+
+	now := time.Now()
+	stopProcess(t)
+
+	fs.etcdExec = bin
+	if err := execEtcd(fs, t); err != nil {
+		return "", 0, err
+	}
+	go t.waitCmd(t.cmd, t.cmdWait)
+	t.restarted()
+	took := time.Since(now)
+
+	setLabel(&t.req, "etcd-upgrade-git-sha", sha)
+	t.lg.Info("upgraded etcd", zap.String("ref", ref), zap.String("sha", sha), zap.Duration("took", took))
+	return sha, took, nil
+}
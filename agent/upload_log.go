@@ -21,129 +21,127 @@ import (
 	"time"
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/logrotate"
 	"github.com/etcd-io/dbtester/pkg/remotestorage"
 
 	"go.uber.org/zap"
+	"golang.org/x/net/context"
 )
 
-// uploadLog starts cetcd. This assumes that etcd is already started.
-func uploadLog(fs *flags, t *transporterServer) error {
+// uploadLog uploads logs and system metrics to all remote storage destinations,
+// and then the manifest of uploaded files.
+func uploadLog(fs *flags, t *transporterServer) (*remotestorage.Queue, error) {
 	t.lg.Info(
 		"stopped collecting metrics, now uploading logs to storage",
 		zap.String("gcp-project-name", t.req.ConfigClientMachineInitial.GoogleCloudProjectName),
 	)
-	u, err := remotestorage.NewGoogleCloudStorage(t.lg, []byte(t.req.ConfigClientMachineInitial.GoogleCloudStorageKey), t.req.ConfigClientMachineInitial.GoogleCloudProjectName)
+	u, err := remotestorage.NewMulti(t.lg, t.req.ConfigClientMachineInitial)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var uerr error
+	srcs := []string{fs.databaseLog}
+	if t.req.DatabaseID == dbtesterpb.DatabaseID_zetcd__beta ||
+		t.req.DatabaseID == dbtesterpb.DatabaseID_cetcd__beta {
+		srcs = append(srcs, fs.databaseLog+"-"+t.req.DatabaseID.String())
+	}
+	srcs = append(srcs, fs.systemMetricsCSV)
+	rotated := append([]string{}, srcs...)
+	srcs = append(srcs, fs.systemMetricsCSVInterpolated, fs.agentLog, fs.diskStatusCSV, fs.readStatusCSV)
+	if etcdBased(t.req.DatabaseID) && !grpcProxyEtcd(t.req) {
+		srcs = append(srcs, fs.etcdStatusCSV)
+	}
+	if t.req.DatabaseID == dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta {
+		srcs = append(srcs, fs.zkStatusCSV)
+	}
+	if consulServer(t.req) {
+		srcs = append(srcs, fs.consulTelemetryCSV)
+	}
+	if exist(fs.databaseEventsCSV) {
+		srcs = append(srcs, fs.databaseEventsCSV)
+	}
+	if exist(fs.oomKernelLog) {
+		srcs = append(srcs, fs.oomKernelLog)
+	}
 
-	{
-		srcDatabaseLogPath := fs.databaseLog
-		dstDatabaseLogPath := filepath.Base(fs.databaseLog)
-		if !strings.HasPrefix(filepath.Base(fs.databaseLog), t.req.DatabaseTag) {
-			dstDatabaseLogPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.databaseLog))
-		}
-		dstDatabaseLogPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstDatabaseLogPath)
-		t.lg.Info("uploading database log", zap.String("source", srcDatabaseLogPath), zap.String("destination", dstDatabaseLogPath))
-		for k := 0; k < 30; k++ {
-			if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcDatabaseLogPath, dstDatabaseLogPath); uerr != nil {
-				t.lg.Warn("upload error; retrying...", zap.Error(uerr))
-				time.Sleep(2 * time.Second)
-				continue
-			}
-			break
-		}
-		if uerr != nil {
-			return uerr
+	// include rotated files
+	for _, src := range rotated {
+		bs, err := logrotate.Backups(src)
+		if err != nil {
+			return nil, err
 		}
+		srcs = append(srcs, bs...)
 	}
 
-	{
-		if t.req.DatabaseID == dbtesterpb.DatabaseID_zetcd__beta ||
-			t.req.DatabaseID == dbtesterpb.DatabaseID_cetcd__beta {
-			dpath := fs.databaseLog + "-" + t.req.DatabaseID.String()
-			srcDatabaseLogPath2 := dpath
-			dstDatabaseLogPath2 := filepath.Base(dpath)
-			if !strings.HasPrefix(filepath.Base(dpath), t.req.DatabaseTag) {
-				dstDatabaseLogPath2 = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(dpath))
-			}
-			dstDatabaseLogPath2 = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstDatabaseLogPath2)
-			t.lg.Info("uploading proxy database log", zap.String("source", srcDatabaseLogPath2), zap.String("destination", dstDatabaseLogPath2))
-			for k := 0; k < 30; k++ {
-				if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcDatabaseLogPath2, dstDatabaseLogPath2); uerr != nil {
-					t.lg.Warn("upload error; retrying...", zap.Error(uerr))
-					time.Sleep(2 * time.Second)
-					continue
-				}
-				break
-			}
-			if uerr != nil {
-				return uerr
-			}
-		}
+	q := remotestorage.NewQueue(t.lg, u, 30)
+	for _, src := range srcs {
+		q.Add(src, t.uploadPath(src))
 	}
+	err = q.Run()
 
-	{
-		srcSysMetricsDataPath := fs.systemMetricsCSV
-		dstSysMetricsDataPath := filepath.Base(fs.systemMetricsCSV)
-		if !strings.HasPrefix(filepath.Base(fs.systemMetricsCSV), t.req.DatabaseTag) {
-			dstSysMetricsDataPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.systemMetricsCSV))
-		}
-		dstSysMetricsDataPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstSysMetricsDataPath)
-		t.lg.Info("uploading system metrics", zap.String("source", srcSysMetricsDataPath), zap.String("destination", dstSysMetricsDataPath))
-		for k := 0; k < 30; k++ {
-			if uerr := u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcSysMetricsDataPath, dstSysMetricsDataPath); uerr != nil {
-				t.lg.Warn("upload error; retrying...", zap.Error(uerr))
-				time.Sleep(2 * time.Second)
-				continue
-			}
-			break
-		}
-		if uerr != nil {
-			return uerr
-		}
+	if merr := q.WriteManifest(fs.uploadManifest, t.req.ConfigClientMachineInitial.Labels); merr != nil {
+		t.lg.Warn("failed to write upload manifest", zap.String("path", fs.uploadManifest), zap.Error(merr))
+	} else if merr = u.UploadFile(fs.uploadManifest, t.uploadPath(fs.uploadManifest), 30); merr != nil {
+		t.lg.Warn("failed to upload manifest", zap.String("path", fs.uploadManifest), zap.Error(merr))
 	}
 
-	{
-		srcSysMetricsInterpolatedDataPath := fs.systemMetricsCSVInterpolated
-		dstSysMetricsInterpolatedDataPath := filepath.Base(fs.systemMetricsCSVInterpolated)
-		if !strings.HasPrefix(filepath.Base(fs.systemMetricsCSVInterpolated), t.req.DatabaseTag) {
-			dstSysMetricsInterpolatedDataPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.systemMetricsCSVInterpolated))
-		}
-		dstSysMetricsInterpolatedDataPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstSysMetricsInterpolatedDataPath)
-		t.lg.Info("uploading interpolated system metrics", zap.String("source", srcSysMetricsInterpolatedDataPath), zap.String("destination", dstSysMetricsInterpolatedDataPath))
-		for k := 0; k < 30; k++ {
-			if uerr := u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcSysMetricsInterpolatedDataPath, dstSysMetricsInterpolatedDataPath); uerr != nil {
-				t.lg.Warn("upload error; retrying...", zap.Error(uerr))
-				time.Sleep(2 * time.Second)
-				continue
-			}
-			break
-		}
-		if uerr != nil {
-			return uerr
-		}
+	for name, st := range u.Status() {
+		t.lg.Info("upload status", zap.String("destination", name), zap.Int("uploaded", st.Uploaded), zap.Int("failed", st.Failed))
 	}
+	return q, err
+}
 
-	{
-		srcAgentLogPath := fs.agentLog
-		dstAgentLogPath := filepath.Base(fs.agentLog)
-		if !strings.HasPrefix(filepath.Base(fs.agentLog), t.req.DatabaseTag) {
-			dstAgentLogPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.agentLog))
-		}
-		dstAgentLogPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstAgentLogPath)
-		t.lg.Info("uploading agent log", zap.String("source", srcAgentLogPath), zap.String("destination", dstAgentLogPath))
-		for k := 0; k < 30; k++ {
-			if uerr := u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcAgentLogPath, dstAgentLogPath); uerr != nil {
-				t.lg.Warn("upload error; retrying...", zap.Error(uerr))
-				time.Sleep(2 * time.Second)
-				continue
-			}
-			break
-		}
+// uploadPath returns the destination path of 'src', prefixed with the database tag,
+// under 'member-N' directory of the run.
+func (t *transporterServer) uploadPath(src string) string {
+	dst := filepath.Base(src)
+	if !strings.HasPrefix(dst, t.req.DatabaseTag) {
+		dst = fmt.Sprintf("%s-%s", t.req.DatabaseTag, dst)
 	}
+	return filepath.Join(t.req.ConfigClientMachineInitial.RunPath(), fmt.Sprintf("member-%d", t.req.IPIndex+1), dst)
+}
 
-	return uerr
+// WaitUpload blocks until log uploads triggered by stop request complete.
+func (t *transporterServer) WaitUpload(ctx context.Context, req *dbtesterpb.UploadRequest) (*dbtesterpb.UploadResponse, error) {
+	t.mu.Lock()
+	done := t.uploadDone
+	t.mu.Unlock()
+	if done == nil {
+		return nil, fmt.Errorf("no upload was triggered")
+	}
+	if req.TimeoutSeconds > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(req.TimeoutSeconds)*time.Second)
+		defer cancel()
+	}
+
+	t.lg.Info("waiting for uploads")
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	t.mu.Lock()
+	q, uerr := t.uploadQueue, t.uploadErr
+	if t.uploadDone == done && t.state == stateIdle {
+		// reported, no need to keep the test
+		t.collected = true
+	}
+	t.mu.Unlock()
+	if q == nil {
+		return nil, uerr
+	}
+
+	resp := &dbtesterpb.UploadResponse{Success: uerr == nil}
+	for _, f := range q.Files() {
+		resp.Files = append(resp.Files, &dbtesterpb.UploadFileStatus{
+			Source:      f.Source,
+			Destination: f.Destination,
+			State:       f.State,
+			Error:       f.Error,
+			Objects:     f.Objects,
+		})
+	}
+	t.lg.Info("uploads done", zap.Bool("success", resp.Success))
+	return resp, nil
 }
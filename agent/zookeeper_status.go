@@ -0,0 +1,218 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// zkStatusHeader is the header of the Zookeeper status CSV.
+var zkStatusHeader = []string{
+	"UNIX-SECOND", "SERVER-STATE",
+	"AVG-LATENCY-MS", "MAX-LATENCY-MS", "SECOND-AVG-LATENCY-MS",
+	"PACKETS-RECEIVED", "PACKETS-SENT",
+	"OUTSTANDING-REQUESTS", "ZNODE-COUNT", "ALIVE-CONNECTIONS",
+	"ERROR",
+}
+
+// zkStatus polls the 'mntr' command of the local Zookeeper server
+// through its admin server every second, so that server-side request
+// latencies are recorded with system metrics. It does not read JMX;
+// 'mntr' and the JMX server bean are both backed by the same server
+// statistics, so a JMX bridge has no other counters.
+//
+// Packet counts are written as per-second deltas. Average and maximum
+// latencies are cumulative since the server start, unless 'reset' is set,
+// in which case statistics are reset after each poll. Without reset, the
+// average latency of each second is estimated from the deltas of the
+// cumulative average weighted by received packets, which counts each
+// request once. The estimate is coarse, since Zookeeper 3.5 reports the
+// average in whole milliseconds.
+type zkStatus struct {
+	lg    *zap.Logger
+	addr  string
+	reset bool
+	f     *os.File
+	w     *csv.Writer
+	stopc chan struct{}
+	donec chan struct{}
+}
+
+// zkMntr is the subset of 'mntr' command output.
+type zkMntr struct {
+	ServerState         string  `json:"server_state"`
+	AvgLatency          float64 `json:"avg_latency"`
+	MaxLatency          float64 `json:"max_latency"`
+	PacketsReceived     int64   `json:"packets_received"`
+	PacketsSent         int64   `json:"packets_sent"`
+	OutstandingRequests int64   `json:"outstanding_requests"`
+	ZnodeCount          int64   `json:"znode_count"`
+	NumAliveConnections int64   `json:"num_alive_connections"`
+	Error               string  `json:"error"`
+}
+
+// startZkStatus starts polling the local Zookeeper server
+// into 'fs.zkStatusCSV'.
+func startZkStatus(fs *flags, t *transporterServer) (*zkStatus, error) {
+	peerIPs := strings.Split(t.req.PeerIPsString, "___")
+	f, err := os.Create(fs.zkStatusCSV)
+	if err != nil {
+		return nil, err
+	}
+	s := &zkStatus{
+		lg:    t.lg,
+		addr:  fmt.Sprintf("http://%s:%d", peerIPs[t.req.IPIndex], t.port(8080)),
+		reset: fs.zkStatusReset,
+		f:     f,
+		w:     csv.NewWriter(f),
+		stopc: make(chan struct{}),
+		donec: make(chan struct{}),
+	}
+	if err = s.write(zkStatusHeader); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	t.lg.Info("starting polling Zookeeper status", zap.String("admin-server", s.addr), zap.String("path", fs.zkStatusCSV))
+	go s.run()
+	return s, nil
+}
+
+func (s *zkStatus) run() {
+	defer close(s.donec)
+
+	var (
+		prevState string
+		prev      *zkMntr
+	)
+	for {
+		select {
+		case <-s.stopc:
+			return
+		case <-time.After(time.Second):
+		}
+
+		now := time.Now()
+		m, err := s.poll()
+		if err != nil {
+			prev = nil
+		}
+		row := zkStatusRow(now.Unix(), m, prev, s.reset, err)
+		if err == nil {
+			prev = &m
+			if m.ServerState != prevState {
+				s.lg.Info("Zookeeper server state changed", zap.String("admin-server", s.addr), zap.String("state", m.ServerState))
+				prevState = m.ServerState
+			}
+		}
+		if err := s.write(row); err != nil {
+			s.lg.Warn("failed to write Zookeeper status", zap.Error(err))
+		}
+	}
+}
+
+// zkStatusRow returns the CSV row of the statistics, with deltas from
+// the previous poll, nil if there is none.
+func zkStatusRow(unixSecond int64, m zkMntr, prev *zkMntr, reset bool, err error) []string {
+	row := []string{fmt.Sprintf("%d", unixSecond), "", "", "", "", "", "", "", "", "", ""}
+	if err != nil {
+		row[10] = err.Error()
+		return row
+	}
+	row[1] = m.ServerState
+	row[2] = fmt.Sprintf("%f", m.AvgLatency)
+	row[3] = fmt.Sprintf("%f", m.MaxLatency)
+	switch {
+	case reset:
+		row[4] = fmt.Sprintf("%f", m.AvgLatency)
+		row[5] = fmt.Sprintf("%d", m.PacketsReceived)
+		row[6] = fmt.Sprintf("%d", m.PacketsSent)
+	case prev != nil:
+		rcv, snt := m.PacketsReceived-prev.PacketsReceived, m.PacketsSent-prev.PacketsSent
+		if rcv < 0 || snt < 0 {
+			// counters go back on server restarts
+			break
+		}
+		if rcv > 0 {
+			lat := (m.AvgLatency*float64(m.PacketsReceived) - prev.AvgLatency*float64(prev.PacketsReceived)) / float64(rcv)
+			row[4] = fmt.Sprintf("%f", math.Max(lat, 0))
+		}
+		row[5] = fmt.Sprintf("%d", rcv)
+		row[6] = fmt.Sprintf("%d", snt)
+	}
+	row[7] = fmt.Sprintf("%d", m.OutstandingRequests)
+	row[8] = fmt.Sprintf("%d", m.ZnodeCount)
+	row[9] = fmt.Sprintf("%d", m.NumAliveConnections)
+	return row
+}
+
+// poll returns the server statistics, and resets them if 's.reset'.
+func (s *zkStatus) poll() (m zkMntr, err error) {
+	hc := &http.Client{Timeout: time.Second}
+	resp, err := hc.Get(s.addr + "/commands/mntr")
+	if err != nil {
+		return m, err
+	}
+	m, err = parseZkMntr(resp.Body)
+	resp.Body.Close()
+	if err != nil || !s.reset {
+		return m, err
+	}
+
+	// reset latencies and packet counts for the next second
+	resp, err = hc.Get(s.addr + "/commands/stat_reset")
+	if err != nil {
+		return m, err
+	}
+	resp.Body.Close()
+	return m, nil
+}
+
+// parseZkMntr parses the JSON response of the 'mntr' admin command.
+func parseZkMntr(r io.Reader) (m zkMntr, err error) {
+	if err = json.NewDecoder(r).Decode(&m); err != nil {
+		return m, err
+	}
+	if m.Error != "" {
+		return m, fmt.Errorf("mntr failed (%s)", m.Error)
+	}
+	return m, nil
+}
+
+func (s *zkStatus) write(row []string) error {
+	if err := s.w.Write(row); err != nil {
+		return err
+	}
+	// flush every row, to keep the rows before a crash
+	s.w.Flush()
+	return s.w.Error()
+}
+
+// stop stops polling, and closes the CSV.
+func (s *zkStatus) stop() {
+	close(s.stopc)
+	<-s.donec
+	s.f.Close()
+}
@@ -0,0 +1,113 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseZkMntr(t *testing.T) {
+	tests := []struct {
+		body string
+		m    zkMntr
+		err  bool
+	}{
+		{
+			body: `{"version":"3.5.3-beta","avg_latency":2,"max_latency":31,"min_latency":0,"packets_received":1043,"packets_sent":1042,"num_alive_connections":3,"outstanding_requests":7,"server_state":"leader","znode_count":1005,"command":"mntr","error":null}`,
+			m: zkMntr{
+				ServerState:         "leader",
+				AvgLatency:          2,
+				MaxLatency:          31,
+				PacketsReceived:     1043,
+				PacketsSent:         1042,
+				OutstandingRequests: 7,
+				ZnodeCount:          1005,
+				NumAliveConnections: 3,
+			},
+		},
+		{
+			body: `{"command":"mntr","error":"This ZooKeeper instance is not currently serving requests"}`,
+			m:    zkMntr{Error: "This ZooKeeper instance is not currently serving requests"},
+			err:  true,
+		},
+		{
+			body: `Unknown command`,
+			err:  true,
+		},
+	}
+	for i, tt := range tests {
+		m, err := parseZkMntr(strings.NewReader(tt.body))
+		if (err != nil) != tt.err {
+			t.Fatalf("#%d: error expected %v, got %v", i, tt.err, err)
+		}
+		if tt.err && tt.m.Error == "" {
+			continue
+		}
+		if !reflect.DeepEqual(m, tt.m) {
+			t.Fatalf("#%d: expected %+v, got %+v", i, tt.m, m)
+		}
+	}
+}
+
+func TestZkStatusRow(t *testing.T) {
+	prev := &zkMntr{ServerState: "leader", AvgLatency: 2, MaxLatency: 31, PacketsReceived: 1000, PacketsSent: 1000}
+	tests := []struct {
+		m     zkMntr
+		prev  *zkMntr
+		reset bool
+		err   error
+		row   []string
+	}{
+		{ // first poll has no deltas
+			m:   zkMntr{ServerState: "leader", AvgLatency: 2, MaxLatency: 31, PacketsReceived: 1000, PacketsSent: 1000, ZnodeCount: 5},
+			row: []string{"1", "leader", "2.000000", "31.000000", "", "", "", "0", "5", "0", ""},
+		},
+		{ // 1000 requests at 2 ms, then 1000 requests at 4 ms
+			m:    zkMntr{ServerState: "leader", AvgLatency: 3, MaxLatency: 31, PacketsReceived: 2000, PacketsSent: 1990, OutstandingRequests: 2},
+			prev: prev,
+			row:  []string{"1", "leader", "3.000000", "31.000000", "4.000000", "1000", "990", "2", "0", "0", ""},
+		},
+		{ // no requests within the second
+			m:    *prev,
+			prev: prev,
+			row:  []string{"1", "leader", "2.000000", "31.000000", "", "0", "0", "0", "0", "0", ""},
+		},
+		{ // server restarted
+			m:    zkMntr{ServerState: "follower", AvgLatency: 1, PacketsReceived: 10, PacketsSent: 10},
+			prev: prev,
+			row:  []string{"1", "follower", "1.000000", "0.000000", "", "", "", "0", "0", "0", ""},
+		},
+		{
+			m:     zkMntr{ServerState: "leader", AvgLatency: 5, MaxLatency: 9, PacketsReceived: 100, PacketsSent: 99},
+			prev:  prev,
+			reset: true,
+			row:   []string{"1", "leader", "5.000000", "9.000000", "5.000000", "100", "99", "0", "0", "0", ""},
+		},
+		{
+			prev: prev,
+			err:  errors.New("connection refused"),
+			row:  []string{"1", "", "", "", "", "", "", "", "", "", "connection refused"},
+		},
+	}
+	for i, tt := range tests {
+		row := zkStatusRow(1, tt.m, tt.prev, tt.reset, tt.err)
+		if !reflect.DeepEqual(row, tt.row) {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.row, row)
+		}
+	}
+}
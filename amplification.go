@@ -0,0 +1,73 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// byteCounter counts the bytes of keys and values
+// written or read by successful client requests.
+type byteCounter struct {
+	n int64
+}
+
+func (c *byteCounter) add(n int64) {
+	if c == nil {
+		return
+	}
+	atomic.AddInt64(&c.n, n)
+}
+
+func (c *byteCounter) bytes() int64 {
+	if c == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&c.n)
+}
+
+// writeBytes returns the size of key and value of the write request,
+// 0 for reads and deletes.
+func (r *Request) writeBytes() int64 {
+	op, key, value := r.traceOp()
+	if op != "put" {
+		return 0
+	}
+	return int64(len(key) + len(value))
+}
+
+// cacheHitRatio estimates the ratio of reads served from page cache,
+// from bytes fetched from storage and bytes read via system calls.
+func cacheHitRatio(disk, logical int64) float64 {
+	if logical <= 0 || disk >= logical {
+		return 0
+	}
+	return 1 - float64(disk)/float64(logical)
+}
+
+// readBytes returns the size of value read by the request.
+func (r *Request) readBytes() int64 {
+	return int64(len(r.readValue))
+}
+
+// amplification returns the ratio of bytes written (or read) by the
+// database to bytes written (or read) by clients, "0" if none by clients.
+func amplification(databaseBytes, clientBytes int64) string {
+	if clientBytes == 0 {
+		return "0"
+	}
+	return fmt.Sprintf("%.2f", float64(databaseBytes)/float64(clientBytes))
+}
@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	"github.com/etcd-io/dbtester"
+	"github.com/etcd-io/dbtester/dbtesterpb"
 
 	humanize "github.com/dustin/go-humanize"
 	"github.com/gyuho/dataframe"
@@ -570,6 +571,30 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 	return nil
 }
 
+// annotatePhases adds a PHASE column with the workload phase of each second.
+func (data *analyzeData) annotatePhases(phasesPath string) error {
+	phases, err := dbtester.ReadPhases(phasesPath)
+	if err != nil {
+		return err
+	}
+	ps := dbtester.PhasesToProto(phases)
+
+	uc, err := data.aggregated.Column("UNIX-SECOND")
+	if err != nil {
+		return err
+	}
+	pc := dataframe.NewColumn("PHASE")
+	for i := 0; i < uc.Count(); i++ {
+		v, err := uc.Value(i)
+		if err != nil {
+			return err
+		}
+		sec, _ := v.Int64()
+		pc.PushBack(dataframe.NewStringValue(dbtesterpb.PhaseAt(ps, sec)))
+	}
+	return data.aggregated.AddColumn(pc)
+}
+
 func (data *analyzeData) save() error {
 	return data.aggregated.CSV(data.allAggregatedOutputPath)
 }
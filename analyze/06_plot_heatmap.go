@@ -0,0 +1,101 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/gyuho/dataframe"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/plotter"
+)
+
+// latencyHeatmap implements plotter.GridXYZ with
+// elapsed seconds in columns and latency buckets in rows.
+type latencyHeatmap struct {
+	buckets []string
+	// counts[second][bucket] is the number of requests
+	counts [][]float64
+}
+
+func (h *latencyHeatmap) Dims() (c, r int) { return len(h.counts), len(h.buckets) }
+
+// Z returns the request count in log scale, since
+// a few slow requests must be visible next to fast ones.
+func (h *latencyHeatmap) Z(c, r int) float64 { return math.Log10(1 + h.counts[c][r]) }
+
+func (h *latencyHeatmap) X(c int) float64 { return float64(c) }
+
+func (h *latencyHeatmap) Y(r int) float64 { return float64(r) }
+
+// readLatencyHeatmap reads per-second latency histograms
+// saved by 'dbtester control'.
+func readLatencyHeatmap(fpath string) (*latencyHeatmap, error) {
+	fr, err := dataframe.NewFromCSV(nil, fpath)
+	if err != nil {
+		return nil, err
+	}
+	cols := fr.Columns()
+	if len(cols) < 2 {
+		return nil, fmt.Errorf("%q has no latency bucket column", fpath)
+	}
+
+	h := &latencyHeatmap{counts: make([][]float64, cols[0].Count())}
+	for i := range h.counts {
+		h.counts[i] = make([]float64, len(cols)-1)
+	}
+	for j, col := range cols[1:] {
+		h.buckets = append(h.buckets, col.Header())
+		vs, ok := col.Float64s()
+		if !ok {
+			return nil, fmt.Errorf("cannot Float64s from %q in %q", col.Header(), fpath)
+		}
+		for i := range vs {
+			h.counts[i][j] = vs[i]
+		}
+	}
+	return h, nil
+}
+
+// drawLatencyHeatmap plots latency histograms over time, which reveals
+// periodic stalls (e.g. snapshot, GC) that averages smear out.
+func drawLatencyHeatmap(title, fpath, outputPath string) error {
+	h, err := readLatencyHeatmap(fpath)
+	if err != nil {
+		return err
+	}
+	if len(h.counts) == 0 {
+		return fmt.Errorf("%q has no data", fpath)
+	}
+
+	plt, err := plot.New()
+	if err != nil {
+		return err
+	}
+	plt.Title.Text = fmt.Sprintf("%s, latency heatmap (log10 of requests)", title)
+	plt.X.Label.Text = "second"
+	plt.Y.Label.Text = "latency"
+
+	var ticks plot.ConstantTicks
+	for i, b := range h.buckets {
+		ticks = append(ticks, plot.Tick{Value: float64(i), Label: b})
+	}
+	plt.Y.Tick.Marker = ticks
+
+	plt.Add(plotter.NewHeatMap(h, palette.Heat(64, 1)))
+	return plt.Save(plotWidth, plotHeight, outputPath)
+}
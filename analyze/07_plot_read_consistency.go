@@ -0,0 +1,82 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+
+	"github.com/gyuho/dataframe"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/plotutil"
+)
+
+// drawReadConsistency plots serializable and linearizable read latencies
+// by percentile, which were measured at the same rate in the same run,
+// so that the gap is the cost of linearizable reads.
+func drawReadConsistency(title, fpath, outputPath string) error {
+	fr, err := dataframe.NewFromCSV(nil, fpath)
+	if err != nil {
+		return err
+	}
+	pctCol, err := fr.Column("LATENCY-PERCENTILE")
+	if err != nil {
+		return err
+	}
+
+	plt, err := plot.New()
+	if err != nil {
+		return err
+	}
+	plt.Title.Text = fmt.Sprintf("%s, read latency by consistency", title)
+	plt.X.Label.Text = "percentile"
+	plt.Y.Label.Text = "latency (ms)"
+	plt.Legend.Top = true
+
+	var ticks plot.ConstantTicks
+	for i := 0; i < pctCol.Count(); i++ {
+		v, err := pctCol.Value(i)
+		if err != nil {
+			return err
+		}
+		label, _ := v.String()
+		ticks = append(ticks, plot.Tick{Value: float64(i), Label: label})
+	}
+	plt.X.Tick.Marker = ticks
+
+	for i, hd := range []string{"SERIALIZABLE-LATENCY-MS", "LINEARIZABLE-LATENCY-MS"} {
+		col, err := fr.Column(hd)
+		if err != nil {
+			return err
+		}
+		vs, ok := col.Float64s()
+		if !ok {
+			return fmt.Errorf("cannot Float64s from %q in %q", hd, fpath)
+		}
+		pt := make(plotter.XYs, len(vs))
+		for j := range vs {
+			pt[j].X, pt[j].Y = float64(j), vs[j]
+		}
+		l, err := plotter.NewLine(pt)
+		if err != nil {
+			return err
+		}
+		l.Color = plotutil.Color(i)
+		l.Dashes = plotutil.Dashes(i)
+		plt.Add(l)
+		plt.Legend.Add(hd, l)
+	}
+	return plt.Save(plotWidth, plotHeight, outputPath)
+}
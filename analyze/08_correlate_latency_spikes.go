@@ -0,0 +1,263 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/etcd-io/dbtester"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+)
+
+// spikeFactor is the ratio to the median that makes a spike,
+// of client p99 latency and of server-side subsystem latencies.
+const spikeFactor = 2.0
+
+// etcdSubsystems are the server-side subsystems to explain
+// client latency spikes, by their etcd status CSV columns.
+var etcdSubsystems = []struct {
+	name   string
+	column string
+}{
+	{"wal-fsync", "WAL-FSYNC-P99-MS"},
+	{"backend-commit", "BACKEND-COMMIT-P99-MS"},
+}
+
+// readClientP99BySecond estimates client p99 latency of each second
+// from the latency heatmap, as the upper bound of the p99 bucket.
+func readClientP99BySecond(fpath string) (map[int64]float64, error) {
+	fr, err := dataframe.NewFromCSV(nil, fpath)
+	if err != nil {
+		return nil, err
+	}
+	cols := fr.Columns()
+	bounds := dbtester.LatencyHeatmapBucketsMs
+	if len(cols) != len(bounds)+2 {
+		return nil, fmt.Errorf("%q has %d columns, expected %d", fpath, len(cols), len(bounds)+2)
+	}
+	secs, ok := cols[0].Float64s()
+	if !ok {
+		return nil, fmt.Errorf("cannot Float64s from %q in %q", cols[0].Header(), fpath)
+	}
+	counts := make([][]float64, len(cols)-1)
+	for j, col := range cols[1:] {
+		if counts[j], ok = col.Float64s(); !ok {
+			return nil, fmt.Errorf("cannot Float64s from %q in %q", col.Header(), fpath)
+		}
+	}
+
+	p99s := make(map[int64]float64, len(secs))
+	for i, sec := range secs {
+		var total float64
+		for j := range counts {
+			total += counts[j][i]
+		}
+		if total == 0 {
+			continue
+		}
+		var cum float64
+		for j := range counts {
+			cum += counts[j][i]
+			if cum >= 0.99*total {
+				if j < len(bounds) {
+					p99s[int64(sec)] = bounds[j]
+				} else {
+					// overflow bucket has no upper bound
+					p99s[int64(sec)] = 2 * bounds[len(bounds)-1]
+				}
+				break
+			}
+		}
+	}
+	return p99s, nil
+}
+
+// readEtcdSubsystemsBySecond reads the p99 latency of each subsystem
+// by second, the maximum of all members.
+func readEtcdSubsystemsBySecond(fpaths ...string) ([]map[int64]float64, error) {
+	subs := make([]map[int64]float64, len(etcdSubsystems))
+	for i := range subs {
+		subs[i] = make(map[int64]float64)
+	}
+	for _, fpath := range fpaths {
+		f, err := openToRead(fpath)
+		if err != nil {
+			return nil, err
+		}
+		rd := csv.NewReader(f)
+		rd.FieldsPerRecord = -1
+		rows, err := rd.ReadAll()
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			return nil, fmt.Errorf("%q has no header", fpath)
+		}
+		idx := make(map[string]int, len(rows[0]))
+		for i, h := range rows[0] {
+			idx[h] = i
+		}
+		for _, s := range etcdSubsystems {
+			if _, ok := idx[s.column]; !ok {
+				return nil, fmt.Errorf("%q has no %q column (agent does not scrape disk latencies)", fpath, s.column)
+			}
+		}
+		for _, row := range rows[1:] {
+			sec, err := strconv.ParseInt(row[idx["UNIX-SECOND"]], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%q: %v", fpath, err)
+			}
+			for i, s := range etcdSubsystems {
+				if idx[s.column] >= len(row) || row[idx[s.column]] == "" {
+					continue // not scraped in this second
+				}
+				v, err := strconv.ParseFloat(row[idx[s.column]], 64)
+				if err != nil {
+					return nil, fmt.Errorf("%q: %v", fpath, err)
+				}
+				subs[i][sec] = math.Max(subs[i][sec], v)
+			}
+		}
+	}
+	return subs, nil
+}
+
+func median(m map[int64]float64) float64 {
+	if len(m) == 0 {
+		return 0
+	}
+	vs := make([]float64, 0, len(m))
+	for _, v := range m {
+		vs = append(vs, v)
+	}
+	sort.Float64s(vs)
+	return vs[len(vs)/2]
+}
+
+// pearson returns the correlation coefficient of the values
+// of the seconds in both, NaN if undefined.
+func pearson(a, b map[int64]float64) float64 {
+	var xs, ys []float64
+	for sec, x := range a {
+		if y, ok := b[sec]; ok {
+			xs, ys = append(xs, x), append(ys, y)
+		}
+	}
+	n := float64(len(xs))
+	var sx, sy, sxx, syy, sxy float64
+	for i := range xs {
+		sx, sy = sx+xs[i], sy+ys[i]
+		sxx, syy, sxy = sxx+xs[i]*xs[i], syy+ys[i]*ys[i], sxy+xs[i]*ys[i]
+	}
+	d := math.Sqrt(n*sxx-sx*sx) * math.Sqrt(n*syy-sy*sy)
+	if n < 2 || d == 0 {
+		return math.NaN()
+	}
+	return (n*sxy - sx*sy) / d
+}
+
+// spikeWindow is consecutive seconds of client p99 spikes.
+type spikeWindow struct {
+	start, end int64
+	clientP99  float64
+	// subP99s is the maximum p99 of each subsystem within the window
+	subP99s []float64
+}
+
+// correlateLatencySpikes joins client p99 spikes with etcd WAL fsync
+// and backend commit latencies per second, and writes each spike window
+// with the subsystem that spiked the most relative to its median
+// ('none' if no subsystem spiked, e.g. network or client-side stalls).
+func correlateLatencySpikes(heatmapPath string, statusPaths []string, outputPath string) error {
+	client, err := readClientP99BySecond(heatmapPath)
+	if err != nil {
+		return err
+	}
+	subs, err := readEtcdSubsystemsBySecond(statusPaths...)
+	if err != nil {
+		return err
+	}
+	for i, s := range etcdSubsystems {
+		lg.Info("client p99 correlation", zap.String("subsystem", s.name), zap.Float64("pearson", pearson(client, subs[i])))
+	}
+
+	secs := make([]int64, 0, len(client))
+	for sec := range client {
+		secs = append(secs, sec)
+	}
+	sort.Slice(secs, func(i, j int) bool { return secs[i] < secs[j] })
+
+	threshold := spikeFactor * median(client)
+	var windows []spikeWindow
+	for _, sec := range secs {
+		if client[sec] < threshold || client[sec] == 0 {
+			continue
+		}
+		if n := len(windows); n == 0 || windows[n-1].end+1 != sec {
+			windows = append(windows, spikeWindow{start: sec, subP99s: make([]float64, len(subs))})
+		}
+		w := &windows[len(windows)-1]
+		w.end = sec
+		w.clientP99 = math.Max(w.clientP99, client[sec])
+		for i := range subs {
+			w.subP99s[i] = math.Max(w.subP99s[i], subs[i][sec])
+		}
+	}
+
+	baselines := make([]float64, len(subs))
+	for i := range subs {
+		baselines[i] = median(subs[i])
+	}
+
+	f, err := openToOverwrite(outputPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	wr := csv.NewWriter(f)
+	hdr := []string{"START-UNIX-SECOND", "END-UNIX-SECOND", "CLIENT-P99-MS"}
+	for _, s := range etcdSubsystems {
+		hdr = append(hdr, s.column)
+	}
+	if err = wr.Write(append(hdr, "EXPLAINED-BY")); err != nil {
+		return err
+	}
+	for _, w := range windows {
+		row := []string{fmt.Sprintf("%d", w.start), fmt.Sprintf("%d", w.end), fmt.Sprintf("%f", w.clientP99)}
+		explained, maxRatio := "none", spikeFactor
+		for i, s := range etcdSubsystems {
+			row = append(row, fmt.Sprintf("%f", w.subP99s[i]))
+			ratio := math.Inf(1)
+			if baselines[i] > 0 {
+				ratio = w.subP99s[i] / baselines[i]
+			}
+			if w.subP99s[i] > 0 && ratio >= maxRatio {
+				explained, maxRatio = s.name, ratio
+			}
+		}
+		if err = wr.Write(append(row, explained)); err != nil {
+			return err
+		}
+	}
+	wr.Flush()
+	return wr.Error()
+}
@@ -0,0 +1,289 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/etcd-io/dbtester"
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// Kinds of timeline events.
+const (
+	timelinePhase    = "phase"
+	timelineFault    = "fault"
+	timelineDatabase = "database"
+	timelineAnomaly  = "anomaly"
+)
+
+// timelineHeader is the header of 'client_timeline_path'.
+var timelineHeader = []string{"UNIX-NANO", "TIMESTAMP", "SOURCE", "KIND", "EVENT", "DETAIL"}
+
+// timelineEvent is an event of the run, on the clock of control.
+type timelineEvent struct {
+	at time.Time
+	// source is 'control', 'analyze', or 'member-N'
+	source string
+	kind   string
+	event  string
+	detail string
+}
+
+// writeTimeline merges workload phases, injected faults, database events,
+// and anomalies of the run into one timeline, so that post-mortems do not
+// have to correlate each file by hand. Sources that are not configured
+// are skipped.
+func writeTimeline(testdata dbtesterpb.ConfigAnalyzeMachineInitial) error {
+	offsets := make(map[int]time.Duration)
+	if testdata.ClientClockOffsetsPath != "" {
+		var err error
+		if offsets, err = readClockOffsets(testdata.ClientClockOffsetsPath); err != nil {
+			return err
+		}
+	}
+
+	var evs []timelineEvent
+	if testdata.ClientPhasesPath != "" {
+		phases, err := dbtester.ReadPhases(testdata.ClientPhasesPath)
+		if err != nil {
+			return err
+		}
+		for _, p := range phases {
+			evs = append(evs, timelineEvent{at: p.Start, source: "control", kind: timelinePhase, event: p.Name, detail: "begin"})
+			if !p.End.IsZero() {
+				evs = append(evs, timelineEvent{at: p.End, source: "control", kind: timelinePhase, event: p.Name, detail: "end"})
+			}
+		}
+	}
+	if testdata.ClientUpgradesPath != "" {
+		err := readTimelineRows(testdata.ClientUpgradesPath, func(get func(string) string) error {
+			at, err := unixSecond(get("UNIX-SECOND"))
+			if err != nil {
+				return err
+			}
+			evs = append(evs, timelineEvent{
+				at:     at,
+				source: "control",
+				kind:   timelineFault,
+				event:  "member-restart",
+				detail: fmt.Sprintf("member %s to %s, down for %ss", get("MEMBER-INDEX"), get("ETCD-GIT-SHA"), get("DOWNTIME-SECONDS")),
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if testdata.ClientMembershipChangesPath != "" {
+		err := readTimelineRows(testdata.ClientMembershipChangesPath, func(get func(string) string) error {
+			at, err := unixSecond(get("UNIX-SECOND"))
+			if err != nil {
+				return err
+			}
+			evs = append(evs, timelineEvent{
+				at:     at,
+				source: "control",
+				kind:   timelineFault,
+				event:  "membership-change",
+				detail: fmt.Sprintf("%s to %s members, took %ss", get("FROM-MEMBER-NUMBER"), get("TO-MEMBER-NUMBER"), get("TOOK-SECONDS")),
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for i, fpath := range testdata.ServerDatabaseEventsPathList {
+		err := readTimelineRows(fpath, func(get func(string) string) error {
+			at, err := time.Parse(time.RFC3339Nano, get("TIMESTAMP"))
+			if err != nil {
+				return err
+			}
+			evs = append(evs, timelineEvent{
+				at:     at.Add(-offsets[i]),
+				source: fmt.Sprintf("member-%d", i+1),
+				kind:   timelineDatabase,
+				event:  get("EVENT"),
+				detail: get("MESSAGE"),
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for i, fpath := range testdata.ServerEtcdStatusPathList {
+		es, err := readEtcdStatusAnomalies(fpath, fmt.Sprintf("member-%d", i+1), offsets[i])
+		if err != nil {
+			return err
+		}
+		evs = append(evs, es...)
+	}
+	if testdata.ClientLatencySpikeCorrelationPath != "" {
+		err := readTimelineRows(testdata.ClientLatencySpikeCorrelationPath, func(get func(string) string) error {
+			start, err := unixSecond(get("START-UNIX-SECOND"))
+			if err != nil {
+				return err
+			}
+			end, err := unixSecond(get("END-UNIX-SECOND"))
+			if err != nil {
+				return err
+			}
+			evs = append(evs, timelineEvent{
+				at:     start,
+				source: "analyze",
+				kind:   timelineAnomaly,
+				event:  "latency-spike",
+				detail: fmt.Sprintf("client p99 %s ms for %v, explained by %s", get("CLIENT-P99-MS"), end.Sub(start)+time.Second, get("EXPLAINED-BY")),
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	sort.SliceStable(evs, func(i, j int) bool { return evs[i].at.Before(evs[j].at) })
+
+	f, err := openToOverwrite(testdata.ClientTimelinePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	wr := csv.NewWriter(f)
+	if err = wr.Write(timelineHeader); err != nil {
+		return err
+	}
+	for _, ev := range evs {
+		row := []string{fmt.Sprintf("%d", ev.at.UnixNano()), ev.at.UTC().Format(time.RFC3339Nano), ev.source, ev.kind, ev.event, ev.detail}
+		if err = wr.Write(row); err != nil {
+			return err
+		}
+	}
+	wr.Flush()
+	return wr.Error()
+}
+
+// readClockOffsets returns the clock offset of each agent from control,
+// by member index.
+func readClockOffsets(fpath string) (map[int]time.Duration, error) {
+	offsets := make(map[int]time.Duration)
+	err := readTimelineRows(fpath, func(get func(string) string) error {
+		idx, err := strconv.Atoi(get(dbtester.ClockOffsetsColumns[0]))
+		if err != nil {
+			return err
+		}
+		ms, err := strconv.ParseFloat(get(dbtester.ClockOffsetsColumns[2]), 64)
+		if err != nil {
+			return err
+		}
+		offsets[idx] = time.Duration(ms * float64(time.Millisecond))
+		return nil
+	})
+	return offsets, err
+}
+
+// readEtcdStatusAnomalies returns the changes of health, alarms,
+// and role of the member, on the clock of control.
+func readEtcdStatusAnomalies(fpath, source string, offset time.Duration) ([]timelineEvent, error) {
+	var (
+		evs                  []timelineEvent
+		health, alarms, role string
+		first                = true
+	)
+	err := readTimelineRows(fpath, func(get func(string) string) error {
+		at, err := unixSecond(get("UNIX-SECOND"))
+		if err != nil {
+			return err
+		}
+		at = at.Add(-offset)
+		add := func(event, detail string) {
+			evs = append(evs, timelineEvent{at: at, source: source, kind: timelineAnomaly, event: event, detail: detail})
+		}
+		if h := get("HEALTH"); h != health {
+			if h == "false" {
+				add("unhealthy", get("ERROR"))
+			} else if !first {
+				add("healthy", "")
+			}
+			health = h
+		}
+		if a := get("ALARMS"); a != alarms {
+			if a != "" {
+				add("alarm", a)
+			} else if !first {
+				add("alarms-cleared", "")
+			}
+			alarms = a
+		}
+		// role is empty when the status request fails
+		if r := get("ROLE"); r != "" && r != role {
+			if !first && role != "" {
+				add("role-change", fmt.Sprintf("%s to %s", role, r))
+			}
+			role = r
+		}
+		first = false
+		return nil
+	})
+	return evs, err
+}
+
+// readTimelineRows calls 'f' with each row of the CSV, to get
+// values by column name (empty if the column does not exist).
+func readTimelineRows(fpath string, f func(get func(string) string) error) error {
+	rf, err := openToRead(fpath)
+	if err != nil {
+		return err
+	}
+	rd := csv.NewReader(rf)
+	rd.FieldsPerRecord = -1
+	rows, err := rd.ReadAll()
+	rf.Close()
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("%q has no header", fpath)
+	}
+	idx := make(map[string]int, len(rows[0]))
+	for i, h := range rows[0] {
+		idx[h] = i
+	}
+	for _, row := range rows[1:] {
+		get := func(name string) string {
+			if i, ok := idx[name]; ok && i < len(row) {
+				return row[i]
+			}
+			return ""
+		}
+		if err = f(get); err != nil {
+			return fmt.Errorf("%q: %v", fpath, err)
+		}
+	}
+	return nil
+}
+
+func unixSecond(s string) (time.Time, error) {
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(sec, 0), nil
+}
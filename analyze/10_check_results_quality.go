@@ -0,0 +1,194 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// expectedRequestsTolerance is the fraction of missing or extra
+// requests tolerated before a run is flagged.
+const expectedRequestsTolerance = 0.01
+
+// checkResultsQuality returns the suspicious findings of a run, so that
+// broken results are flagged before being published. It returns nil if
+// the results look sane.
+func checkResultsQuality(testgroup dbtesterpb.ConfigClientMachineAgentControl, testdata dbtesterpb.ConfigAnalyzeMachineInitial) (issues []string) {
+	artifacts := []string{
+		testdata.ClientSystemMetricsInterpolatedPath,
+		testdata.ClientLatencyThroughputTimeseriesPath,
+		testdata.ClientLatencyDistributionAllPath,
+		testdata.ClientLatencyDistributionPercentilePath,
+		testdata.ClientLatencyDistributionSummaryPath,
+		testdata.ClientLatencyByKeyNumberPath,
+		testdata.ServerDiskSpaceUsageSummaryPath,
+	}
+	artifacts = append(artifacts, testdata.ServerSystemMetricsInterpolatedPathList...)
+	for _, fpath := range artifacts {
+		if fpath == "" {
+			continue
+		}
+		fi, err := os.Stat(fpath)
+		switch {
+		case err != nil:
+			issues = append(issues, fmt.Sprintf("missing artifact %q (%v)", fpath, err))
+		case fi.Size() == 0:
+			issues = append(issues, fmt.Sprintf("empty artifact %q", fpath))
+		}
+	}
+
+	// client timestamps are the benchmark duration
+	var clientSecs []int64
+	if testdata.ClientLatencyThroughputTimeseriesPath != "" {
+		secs, err := readUnixSeconds(testdata.ClientLatencyThroughputTimeseriesPath)
+		switch {
+		case err != nil:
+			issues = append(issues, fmt.Sprintf("cannot read benchmark timeseries (%v)", err))
+		case len(secs) == 0:
+			issues = append(issues, fmt.Sprintf("no rows in %q", testdata.ClientLatencyThroughputTimeseriesPath))
+		default:
+			issues = append(issues, checkMonotonic(testdata.ClientLatencyThroughputTimeseriesPath, secs)...)
+			clientSecs = secs
+		}
+	}
+
+	monitors := append([]string{testdata.ClientSystemMetricsInterpolatedPath}, testdata.ServerSystemMetricsInterpolatedPathList...)
+	for _, fpath := range monitors {
+		if fpath == "" {
+			continue
+		}
+		secs, err := readUnixSeconds(fpath)
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("cannot read monitor %q (%v)", fpath, err))
+			continue
+		}
+		issues = append(issues, checkMonotonic(fpath, secs)...)
+		if len(clientSecs) == 0 {
+			continue
+		}
+		if len(secs) == 0 {
+			issues = append(issues, fmt.Sprintf("no rows in monitor %q", fpath))
+			continue
+		}
+		from, to := clientSecs[0], clientSecs[len(clientSecs)-1]
+		mfrom, mto := minMaxInt64(secs)
+		var uncovered int64
+		if mfrom > from {
+			uncovered += mfrom - from
+		}
+		if mto < to {
+			uncovered += to - mto
+		}
+		if uncovered > 0 {
+			issues = append(issues, fmt.Sprintf("monitor %q covers [%d, %d], benchmark ran [%d, %d] (%d seconds not monitored)", fpath, mfrom, mto, from, to, uncovered))
+		}
+	}
+
+	if expected, unit, ok := expectedRequests(testgroup); ok && testdata.ClientLatencyThroughputTimeseriesPath != "" {
+		var got float64
+		err := readTimelineRows(testdata.ClientLatencyThroughputTimeseriesPath, func(get func(string) string) error {
+			v, err := strconv.ParseFloat(get("AVG-THROUGHPUT"), 64)
+			if err != nil {
+				return err
+			}
+			got += v
+			return nil
+		})
+		if err == nil {
+			if diff := got - float64(expected); diff > float64(expected)*expectedRequestsTolerance || -diff > float64(expected)*expectedRequestsTolerance {
+				issues = append(issues, fmt.Sprintf("expected %d %s, got %.0f", expected, unit, got))
+			}
+		}
+	}
+	return issues
+}
+
+// expectedRequests returns the number of requests that the benchmark
+// should complete, if known from the configuration.
+func expectedRequests(testgroup dbtesterpb.ConfigClientMachineAgentControl) (int64, string, bool) {
+	opts := testgroup.ConfigClientMachineBenchmarkOptions
+	if opts == nil || opts.RequestNumber <= 0 || opts.Repeat > 1 || len(opts.ConnectionClientNumbers) > 0 {
+		return 0, "", false
+	}
+	switch opts.Type {
+	case "write":
+		if opts.SameKey {
+			return opts.RequestNumber, "requests", true
+		}
+		return opts.RequestNumber, "keys", true
+	case "read":
+		return opts.RequestNumber, "requests", true
+	}
+	return 0, "", false
+}
+
+// readUnixSeconds returns the 'UNIX-SECOND' column of the CSV, in file order.
+func readUnixSeconds(fpath string) ([]int64, error) {
+	var secs []int64
+	err := readTimelineRows(fpath, func(get func(string) string) error {
+		sec, err := strconv.ParseInt(get("UNIX-SECOND"), 10, 64)
+		if err != nil {
+			return err
+		}
+		secs = append(secs, sec)
+		return nil
+	})
+	return secs, err
+}
+
+// checkMonotonic flags timestamps that do not increase, from merged
+// files or clocks that stepped backwards.
+func checkMonotonic(fpath string, secs []int64) []string {
+	for i := 1; i < len(secs); i++ {
+		if secs[i] <= secs[i-1] {
+			return []string{fmt.Sprintf("timestamps in %q do not increase at row %d (%d after %d)", fpath, i+1, secs[i], secs[i-1])}
+		}
+	}
+	return nil
+}
+
+func minMaxInt64(vs []int64) (min, max int64) {
+	min, max = vs[0], vs[0]
+	for _, v := range vs[1:] {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	return min, max
+}
+
+// writeResultsQuality writes the results quality of each database,
+// flagging suspicious runs.
+func writeResultsQuality(w io.Writer, databaseIDs []string, issues map[string][]string) {
+	fmt.Fprintln(w, "RESULTS QUALITY:")
+	for _, databaseID := range databaseIDs {
+		if len(issues[databaseID]) == 0 {
+			fmt.Fprintf(w, "%s: OK\n", databaseID)
+			continue
+		}
+		fmt.Fprintf(w, "%s: SUSPICIOUS (%d issues)\n", databaseID, len(issues[databaseID]))
+		for _, issue := range issues[databaseID] {
+			fmt.Fprintf(w, "  - %s\n", issue)
+		}
+	}
+}
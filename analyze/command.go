@@ -71,10 +71,17 @@ func do(configPath string) error {
 		headerToDatabaseDescription: make(map[string]string),
 		allDatabaseIDList:           cfg.AllDatabaseIDList,
 	}
+	quality := make(map[string][]string)
 	for _, databaseID := range cfg.AllDatabaseIDList {
 		testgroup := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 		testdata := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
 
+		lg.Sugar().Infof("checking results quality for %s", databaseID)
+		quality[databaseID] = checkResultsQuality(testgroup, testdata)
+		for _, issue := range quality[databaseID] {
+			lg.Sugar().Warnf("suspicious results for %s: %s", databaseID, issue)
+		}
+
 		lg.Sugar().Info("reading system metrics data for %s", databaseID)
 		ad, err := readSystemMetricsAll(testdata.ServerSystemMetricsInterpolatedPathList...)
 		if err != nil {
@@ -93,9 +100,40 @@ func do(configPath string) error {
 		if err = ad.aggregateAll(testdata.ServerMemoryByKeyNumberPath, testdata.ServerReadBytesDeltaByKeyNumberPath, testdata.ServerWriteBytesDeltaByKeyNumberPath, testgroup.ConfigClientMachineBenchmarkOptions.RequestNumber); err != nil {
 			return err
 		}
+		if testdata.ClientPhasesPath != "" {
+			if err = ad.annotatePhases(testdata.ClientPhasesPath); err != nil {
+				return err
+			}
+		}
 		if err = ad.save(); err != nil {
 			return err
 		}
+		if testdata.ClientLatencyHeatmapPath != "" && testdata.ClientLatencyHeatmapPlotPath != "" {
+			lg.Sugar().Infof("plotting latency heatmap for %s", databaseID)
+			if err = drawLatencyHeatmap(testgroup.DatabaseDescription, testdata.ClientLatencyHeatmapPath, testdata.ClientLatencyHeatmapPlotPath); err != nil {
+				return err
+			}
+		}
+		if testdata.ClientReadConsistencyPath != "" && testdata.ClientReadConsistencyPlotPath != "" {
+			lg.Sugar().Infof("plotting read consistency cost for %s", databaseID)
+			if err = drawReadConsistency(testgroup.DatabaseDescription, testdata.ClientReadConsistencyPath, testdata.ClientReadConsistencyPlotPath); err != nil {
+				return err
+			}
+		}
+
+		if testdata.ClientLatencySpikeCorrelationPath != "" {
+			lg.Sugar().Infof("correlating latency spikes for %s", databaseID)
+			if err = correlateLatencySpikes(testdata.ClientLatencyHeatmapPath, testdata.ServerEtcdStatusPathList, testdata.ClientLatencySpikeCorrelationPath); err != nil {
+				return err
+			}
+		}
+
+		if testdata.ClientTimelinePath != "" {
+			lg.Sugar().Infof("merging event timeline for %s", databaseID)
+			if err = writeTimeline(testdata); err != nil {
+				return err
+			}
+		}
 
 		all.data = append(all.data, ad)
 		for _, hd := range ad.aggregated.Headers() {
@@ -271,6 +309,10 @@ func do(configPath string) error {
 	row24ClientMaxMemory := []string{"CLIENT-MAX-MEMORY-USAGE"}                         // VMRSS-NUM
 	row25ClientErrorCount := []string{"CLIENT-ERROR-COUNT"}                             // ERROR:
 	row30AvgDiskSpaceUsage := []string{"SERVER-AVG-DISK-SPACE-USAGE"}                   // DISK-SPACE-USAGE
+	row31AvgWriteAmplification := []string{"SERVER-AVG-WRITE-AMPLIFICATION"}            // WRITE-AMPLIFICATION
+	row32AvgSpaceAmplification := []string{"SERVER-AVG-SPACE-AMPLIFICATION"}            // SPACE-AMPLIFICATION
+	row33AvgReadAmplification := []string{"SERVER-AVG-READ-AMPLIFICATION"}              // READ-AMPLIFICATION
+	row34AvgCacheHitRatio := []string{"SERVER-AVG-CACHE-HIT-RATIO"}                     // CACHE-HIT-RATIO
 
 	databaseIDToErrs := make(map[string][]string)
 	for i, databaseID := range cfg.AllDatabaseIDList {
@@ -468,6 +510,34 @@ func do(configPath string) error {
 			}
 			avg := uint64(sum / float64(col.Count()))
 			row30AvgDiskSpaceUsage = append(row30AvgDiskSpaceUsage, humanize.Bytes(avg))
+
+			// summaries before amplification was measured
+			// do not have the columns
+			for _, v := range []struct {
+				row    *[]string
+				column string
+			}{
+				{&row31AvgWriteAmplification, dbtester.DiskSpaceUsageSummaryColumns[7]},
+				{&row32AvgSpaceAmplification, dbtester.DiskSpaceUsageSummaryColumns[8]},
+				{&row33AvgReadAmplification, dbtester.DiskSpaceUsageSummaryColumns[11]},
+				{&row34AvgCacheHitRatio, dbtester.DiskSpaceUsageSummaryColumns[12]},
+			} {
+				col, err := fr.Column(v.column)
+				if err != nil {
+					*v.row = append(*v.row, "N/A")
+					continue
+				}
+				var sum float64
+				for i := 0; i < col.Count(); i++ {
+					val, err := col.Value(i)
+					if err != nil {
+						return err
+					}
+					fv, _ := val.Float64()
+					sum += fv
+				}
+				*v.row = append(*v.row, fmt.Sprintf("%.2f", sum/float64(col.Count())))
+			}
 		}
 		{
 			f, err := openToRead(testdata.ClientLatencyDistributionPercentilePath)
@@ -560,6 +630,10 @@ func do(configPath string) error {
 		row28WritesCompletedDeltaSum,
 		row29SectorsWrittenDeltaSum,
 		row30AvgDiskSpaceUsage,
+		row31AvgWriteAmplification,
+		row32AvgSpaceAmplification,
+		row33AvgReadAmplification,
+		row34AvgCacheHitRatio,
 	}
 	file, err := openToOverwrite(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV)
 	if err != nil {
@@ -614,6 +688,10 @@ func do(configPath string) error {
 		row28WritesCompletedDeltaSum,
 		row29SectorsWrittenDeltaSum,
 		row30AvgDiskSpaceUsage,
+		row31AvgWriteAmplification,
+		row32AvgSpaceAmplification,
+		row33AvgReadAmplification,
+		row34AvgCacheHitRatio,
 	}
 	buf := new(bytes.Buffer)
 	tw := tablewriter.NewWriter(buf)
@@ -632,6 +710,8 @@ func do(configPath string) error {
 		}
 		errs = databaseID + " " + "errors:\n" + strings.Join(es, "\n") + "\n"
 	}
+	buf.WriteString("\n")
+	writeResultsQuality(buf, cfg.AllDatabaseIDList, quality)
 	stxt := buf.String()
 	if errs != "" {
 		stxt += "\n" + "\n" + errs
@@ -1140,7 +1220,10 @@ func do(configPath string) error {
 		}
 	}
 
-	return cfg.WriteREADME(stxt)
+	if err = cfg.WriteREADME(stxt); err != nil {
+		return err
+	}
+	return cfg.WriteHTML(stxt)
 }
 
 func changeExtToTxt(fpath string) string {
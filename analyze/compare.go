@@ -0,0 +1,138 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/etcd-io/dbtester"
+	"github.com/etcd-io/dbtester/pkg/stats"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// CompareCommand implements 'compare' command.
+var CompareCommand = &cobra.Command{
+	Use:   "compare",
+	Short: "Compares repeated benchmark results of two configurations.",
+	RunE:  compareCommandFunc,
+}
+
+var (
+	compareBaselinePath     string
+	compareTargetPath       string
+	compareAlpha            float64
+	compareFailOnRegression bool
+)
+
+func init() {
+	CompareCommand.Flags().StringVar(&compareBaselinePath, "baseline", "", "'client_repeat_summary_path' of the baseline configuration.")
+	CompareCommand.Flags().StringVar(&compareTargetPath, "target", "", "'client_repeat_summary_path' of the configuration to compare.")
+	CompareCommand.Flags().Float64Var(&compareAlpha, "alpha", 0.05, "Significance level of Welch's t-test.")
+	CompareCommand.Flags().BoolVar(&compareFailOnRegression, "fail-on-regression", false, "'true' to return error on statistically significant regressions.")
+}
+
+// comparison is the result of comparing a metric of two configurations.
+type comparison struct {
+	metric string
+
+	baselineMean, baselineLow, baselineHigh float64
+	targetMean, targetLow, targetHigh       float64
+
+	pValue      float64
+	significant bool
+	regression  bool
+}
+
+func (c comparison) result() string {
+	switch {
+	case !c.significant:
+		return "not significant"
+	case c.regression:
+		return "regression (significant)"
+	default:
+		return "improvement (significant)"
+	}
+}
+
+// compareMetric runs Welch's t-test on the samples of the metric.
+func compareMetric(m string, baseline, target []float64, alpha float64) (comparison, error) {
+	c := comparison{metric: m}
+	c.baselineMean, c.baselineLow, c.baselineHigh = stats.ConfidenceInterval(baseline, dbtester.RepeatConfidenceLevel)
+	c.targetMean, c.targetLow, c.targetHigh = stats.ConfidenceInterval(target, dbtester.RepeatConfidenceLevel)
+
+	_, _, p, err := stats.WelchTTest(baseline, target)
+	if err != nil {
+		return c, fmt.Errorf("%q: %v", m, err)
+	}
+	c.pValue = p
+	c.significant = p < alpha
+	if dbtester.IsHigherBetter(m) {
+		c.regression = c.targetMean < c.baselineMean
+	} else {
+		c.regression = c.targetMean > c.baselineMean
+	}
+	return c, nil
+}
+
+func compareCommandFunc(cmd *cobra.Command, args []string) error {
+	if compareBaselinePath == "" || compareTargetPath == "" {
+		return fmt.Errorf("both '--baseline' and '--target' are required")
+	}
+	if compareAlpha <= 0 || compareAlpha >= 1 {
+		return fmt.Errorf("invalid '--alpha' %f", compareAlpha)
+	}
+	baseline, err := dbtester.ReadRepeatSummary(compareBaselinePath)
+	if err != nil {
+		return err
+	}
+	target, err := dbtester.ReadRepeatSummary(compareTargetPath)
+	if err != nil {
+		return err
+	}
+
+	tw := tablewriter.NewWriter(os.Stdout)
+	tw.SetHeader([]string{"METRIC", "BASELINE (95% CI)", "TARGET (95% CI)", "CHANGE", "P-VALUE", "RESULT"})
+	var regressions []string
+	for _, m := range dbtester.BenchmarkMetrics {
+		c, err := compareMetric(m, baseline[m], target[m], compareAlpha)
+		if err != nil {
+			return err
+		}
+		change := "N/A"
+		if c.baselineMean != 0 {
+			change = fmt.Sprintf("%+.2f%%", 100*(c.targetMean-c.baselineMean)/c.baselineMean)
+		}
+		tw.Append([]string{
+			m,
+			fmt.Sprintf("%.4f [%.4f, %.4f]", c.baselineMean, c.baselineLow, c.baselineHigh),
+			fmt.Sprintf("%.4f [%.4f, %.4f]", c.targetMean, c.targetLow, c.targetHigh),
+			change,
+			fmt.Sprintf("%.4f", c.pValue),
+			c.result(),
+		})
+		if c.significant && c.regression {
+			regressions = append(regressions, m)
+		}
+	}
+	tw.Render()
+
+	if compareFailOnRegression && len(regressions) > 0 {
+		return fmt.Errorf("statistically significant regressions in %q (alpha %g)", regressions, compareAlpha)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/etcd-io/dbtester/pkg/flamediff"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// FlameDiffCommand implements 'flame-diff' command.
+var FlameDiffCommand = &cobra.Command{
+	Use:   "flame-diff",
+	Short: "Compares server CPU profiles of two workloads or versions.",
+	Long: `Compares two CPU profiles in folded stack format (e.g. 'perf script | stackcollapse-perf.pl'),
+and prints the functions whose share of CPU time shifted the most. With '--output',
+it writes the differential profile for 'flamegraph.pl' to render a differential flame graph.`,
+	RunE: flameDiffCommandFunc,
+}
+
+var (
+	flameDiffBaselinePath string
+	flameDiffTargetPath   string
+	flameDiffOutputPath   string
+	flameDiffNormalize    bool
+	flameDiffTop          int
+)
+
+func init() {
+	FlameDiffCommand.Flags().StringVar(&flameDiffBaselinePath, "baseline", "", "Folded stack profile of the baseline.")
+	FlameDiffCommand.Flags().StringVar(&flameDiffTargetPath, "target", "", "Folded stack profile to compare.")
+	FlameDiffCommand.Flags().StringVar(&flameDiffOutputPath, "output", "", "Path to write differential folded stacks ('stack baseline target' lines), empty to skip.")
+	FlameDiffCommand.Flags().BoolVar(&flameDiffNormalize, "normalize", true, "'true' to scale target samples to the baseline total in '--output'.")
+	FlameDiffCommand.Flags().IntVar(&flameDiffTop, "top", 20, "Number of functions to print, 0 for all.")
+}
+
+func readFolded(fpath string) (flamediff.Profile, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	p, err := flamediff.ReadFolded(f)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %v", fpath, err)
+	}
+	if p.Total() == 0 {
+		return nil, fmt.Errorf("%q has no samples", fpath)
+	}
+	return p, nil
+}
+
+func flameDiffCommandFunc(cmd *cobra.Command, args []string) error {
+	if flameDiffBaselinePath == "" || flameDiffTargetPath == "" {
+		return fmt.Errorf("both '--baseline' and '--target' are required")
+	}
+	if flameDiffTop < 0 {
+		return fmt.Errorf("invalid '--top' %d", flameDiffTop)
+	}
+	baseline, err := readFolded(flameDiffBaselinePath)
+	if err != nil {
+		return err
+	}
+	target, err := readFolded(flameDiffTargetPath)
+	if err != nil {
+		return err
+	}
+
+	if flameDiffOutputPath != "" {
+		f, err := openToOverwrite(flameDiffOutputPath)
+		if err != nil {
+			return err
+		}
+		if err = flamediff.WriteDiff(f, baseline, target, flameDiffNormalize); err != nil {
+			f.Close()
+			return err
+		}
+		if err = f.Close(); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "wrote differential profile to %q (render with 'flamegraph.pl %s > diff.svg')\n", flameDiffOutputPath, flameDiffOutputPath)
+	}
+
+	ss := flamediff.Shifts(baseline, target)
+	if flameDiffTop > 0 && len(ss) > flameDiffTop {
+		ss = ss[:flameDiffTop]
+	}
+	fmt.Printf("baseline %d samples, target %d samples\n", baseline.Total(), target.Total())
+	tw := tablewriter.NewWriter(os.Stdout)
+	tw.SetHeader([]string{"FUNCTION", "BASELINE-SELF", "TARGET-SELF", "BASELINE-TOTAL", "TARGET-TOTAL", "CHANGE"})
+	for _, s := range ss {
+		tw.Append([]string{
+			s.Function,
+			fmt.Sprintf("%.2f%%", s.BaselineSelf),
+			fmt.Sprintf("%.2f%%", s.TargetSelf),
+			fmt.Sprintf("%.2f%%", s.BaselineTotal),
+			fmt.Sprintf("%.2f%%", s.TargetTotal),
+			fmt.Sprintf("%+.2f", s.Delta()),
+		})
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.Render()
+	return nil
+}
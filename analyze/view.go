@@ -0,0 +1,276 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// ViewCommand implements 'view' command.
+var ViewCommand = &cobra.Command{
+	Use:   "view [results directory]",
+	Short: "Interactively browses local benchmark results.",
+	Long: `Finds every 'client_latency_throughput_timeseries_path' CSV under the results directory,
+and lists the runs with their throughput and latency. Entering a run number drills down
+into its per-second series (including interpolated system metrics, if present) as sparklines.`,
+	Args: cobra.ExactArgs(1),
+	RunE: viewCommandFunc,
+}
+
+var viewWidth int
+
+func init() {
+	ViewCommand.Flags().IntVar(&viewWidth, "width", 60, "Number of characters in each sparkline.")
+}
+
+const (
+	viewTimeseriesSuffix    = "client-latency-throughput-timeseries.csv"
+	viewSystemMetricsSuffix = "client-system-metrics-interpolated.csv"
+)
+
+// viewRun is a benchmark run found in the results directory.
+type viewRun struct {
+	name   string
+	series []viewSeries
+}
+
+// viewSeries is a numeric per-second column of a run.
+type viewSeries struct {
+	column string
+	values []float64
+}
+
+func (r viewRun) find(column string) []float64 {
+	for _, s := range r.series {
+		if s.column == column {
+			return s.values
+		}
+	}
+	return nil
+}
+
+func viewCommandFunc(cmd *cobra.Command, args []string) error {
+	if viewWidth < 1 {
+		return fmt.Errorf("invalid '--width' %d", viewWidth)
+	}
+	runs, err := findViewRuns(args[0])
+	if err != nil {
+		return err
+	}
+	if len(runs) == 0 {
+		return fmt.Errorf("no %q found under %q", viewTimeseriesSuffix, args[0])
+	}
+
+	in := bufio.NewScanner(os.Stdin)
+	for {
+		printViewRuns(os.Stdout, runs)
+		fmt.Printf("run number to drill down ('q' to quit): ")
+		if !in.Scan() {
+			fmt.Println()
+			return in.Err()
+		}
+		line := strings.TrimSpace(in.Text())
+		switch line {
+		case "":
+			continue
+		case "q", "quit", "exit":
+			return nil
+		}
+		n, err := strconv.Atoi(line)
+		if err != nil || n < 1 || n > len(runs) {
+			fmt.Printf("invalid run number %q\n\n", line)
+			continue
+		}
+		printViewSeries(os.Stdout, runs[n-1], viewWidth)
+		fmt.Printf("press enter to go back ('q' to quit): ")
+		if !in.Scan() {
+			fmt.Println()
+			return in.Err()
+		}
+		if strings.TrimSpace(in.Text()) == "q" {
+			return nil
+		}
+	}
+}
+
+// findViewRuns walks the directory for benchmark timeseries, sorted by path.
+func findViewRuns(dir string) ([]viewRun, error) {
+	var runs []viewRun
+	err := filepath.Walk(dir, func(fpath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(info.Name(), viewTimeseriesSuffix) {
+			return nil
+		}
+		prefix := strings.TrimSuffix(fpath, viewTimeseriesSuffix)
+		name, err := filepath.Rel(dir, prefix)
+		if err != nil {
+			return err
+		}
+		name = strings.TrimSuffix(name, "-")
+		if name == "" || name == "." {
+			name = filepath.Base(filepath.Clean(dir))
+		}
+		series, err := readViewSeries(fpath)
+		if err != nil {
+			return err
+		}
+		if _, err = os.Stat(prefix + viewSystemMetricsSuffix); err == nil {
+			sys, err := readViewSeries(prefix + viewSystemMetricsSuffix)
+			if err != nil {
+				return err
+			}
+			series = append(series, sys...)
+		}
+		runs = append(runs, viewRun{name: name, series: series})
+		return nil
+	})
+	sort.Slice(runs, func(i, j int) bool { return runs[i].name < runs[j].name })
+	return runs, err
+}
+
+// readViewSeries reads the numeric columns of a per-second CSV,
+// skipping the time column and columns with non-numeric values.
+func readViewSeries(fpath string) ([]viewSeries, error) {
+	f, err := openToRead(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	rd := csv.NewReader(f)
+	rd.FieldsPerRecord = -1
+	rows, err := rd.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%q: %v", fpath, err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%q has no header", fpath)
+	}
+
+	var series []viewSeries
+	for i, h := range rows[0] {
+		if h == "UNIX-SECOND" {
+			continue
+		}
+		s := viewSeries{column: h, values: make([]float64, 0, len(rows)-1)}
+		numeric := true
+		for _, row := range rows[1:] {
+			if i >= len(row) || row[i] == "" {
+				continue
+			}
+			v, err := strconv.ParseFloat(row[i], 64)
+			if err != nil {
+				numeric = false
+				break
+			}
+			s.values = append(s.values, v)
+		}
+		if numeric && len(s.values) > 0 {
+			series = append(series, s)
+		}
+	}
+	return series, nil
+}
+
+func printViewRuns(w io.Writer, runs []viewRun) {
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{"#", "RUN", "SECONDS", "AVG-THROUGHPUT", "AVG-LATENCY-MS", "MAX-LATENCY-MS"})
+	for i, r := range runs {
+		_, avgTp, _ := summarizeSeries(r.find("AVG-THROUGHPUT"))
+		_, avgLat, _ := summarizeSeries(r.find("AVG-LATENCY-MS"))
+		_, _, maxLat := summarizeSeries(r.find("MAX-LATENCY-MS"))
+		tw.Append([]string{
+			strconv.Itoa(i + 1),
+			r.name,
+			strconv.Itoa(len(r.find("AVG-THROUGHPUT"))),
+			fmt.Sprintf("%.2f", avgTp),
+			fmt.Sprintf("%.2f", avgLat),
+			fmt.Sprintf("%.2f", maxLat),
+		})
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.Render()
+}
+
+func printViewSeries(w io.Writer, r viewRun, width int) {
+	fmt.Fprintf(w, "\n%s\n", r.name)
+	tw := tablewriter.NewWriter(w)
+	tw.SetHeader([]string{"SERIES", "MIN", "AVG", "MAX", "PER-SECOND"})
+	for _, s := range r.series {
+		min, avg, max := summarizeSeries(s.values)
+		tw.Append([]string{
+			s.column,
+			fmt.Sprintf("%.2f", min),
+			fmt.Sprintf("%.2f", avg),
+			fmt.Sprintf("%.2f", max),
+			sparkline(s.values, width),
+		})
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.Render()
+}
+
+func summarizeSeries(vs []float64) (min, avg, max float64) {
+	if len(vs) == 0 {
+		return 0, 0, 0
+	}
+	min, max = math.Inf(1), math.Inf(-1)
+	for _, v := range vs {
+		min, max = minFloat64(min, v), maxFloat64(max, v)
+		avg += v
+	}
+	return min, avg / float64(len(vs)), max
+}
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline renders the values in at most 'width' characters,
+// averaging consecutive values when there are more values than characters.
+func sparkline(vs []float64, width int) string {
+	if len(vs) > width {
+		buckets := make([]float64, width)
+		for i := range buckets {
+			lo, hi := i*len(vs)/width, (i+1)*len(vs)/width
+			for _, v := range vs[lo:hi] {
+				buckets[i] += v
+			}
+			buckets[i] /= float64(hi - lo)
+		}
+		vs = buckets
+	}
+	min, _, max := summarizeSeries(vs)
+	rs := make([]rune, len(vs))
+	for i, v := range vs {
+		idx := 0
+		if max > min {
+			idx = int((v - min) / (max - min) * float64(len(sparkBlocks)-1))
+		}
+		rs[i] = sparkBlocks[idx]
+	}
+	return string(rs)
+}
@@ -0,0 +1,181 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeViewFiles(t *testing.T, dir string, files map[string]string) {
+	for name, data := range files {
+		fpath := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(fpath, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestReadViewSeries(t *testing.T) {
+	tests := []struct {
+		data   string
+		series []viewSeries
+		err    bool
+	}{
+		{
+			// non-numeric column is skipped, empty values are skipped
+			data: "UNIX-SECOND,AVG-LATENCY-MS,THROUGHPUT,STATE\n1,1.5,100,leader\n2,,200,follower\n3,2.5,300,leader\n",
+			series: []viewSeries{
+				{column: "AVG-LATENCY-MS", values: []float64{1.5, 2.5}},
+				{column: "THROUGHPUT", values: []float64{100, 200, 300}},
+			},
+		},
+		{
+			// column with a non-numeric value after numeric values
+			data: "UNIX-SECOND,A,B\n1,1,2\n2,error,3\n",
+			series: []viewSeries{
+				{column: "B", values: []float64{2, 3}},
+			},
+		},
+		{
+			// short rows
+			data: "UNIX-SECOND,A,B\n1,1\n2,2,3\n",
+			series: []viewSeries{
+				{column: "A", values: []float64{1, 2}},
+				{column: "B", values: []float64{3}},
+			},
+		},
+		{
+			// header only
+			data: "UNIX-SECOND,A\n",
+		},
+		{
+			data: "",
+			err:  true,
+		},
+	}
+
+	dir, err := ioutil.TempDir(os.TempDir(), "analyze-view")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for i, tt := range tests {
+		fpath := filepath.Join(dir, "series.csv")
+		if err = ioutil.WriteFile(fpath, []byte(tt.data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		series, err := readViewSeries(fpath)
+		if (err != nil) != tt.err {
+			t.Fatalf("#%d: error expected %v, got %v", i, tt.err, err)
+		}
+		if !reflect.DeepEqual(series, tt.series) {
+			t.Fatalf("#%d: expected %+v, got %+v", i, tt.series, series)
+		}
+	}
+}
+
+func TestFindViewRuns(t *testing.T) {
+	tests := []struct {
+		files map[string]string
+		runs  []viewRun
+	}{
+		{
+			files: map[string]string{"a.csv": "UNIX-SECOND,A\n1,1\n"},
+		},
+		{
+			// runs are named by path, with system metrics of the same prefix
+			files: map[string]string{
+				"b-client-latency-throughput-timeseries.csv":     "UNIX-SECOND,THROUGHPUT\n1,10\n",
+				"a/client-latency-throughput-timeseries.csv":     "UNIX-SECOND,THROUGHPUT\n1,20\n",
+				"a/client-system-metrics-interpolated.csv":       "UNIX-SECOND,CPU\n1,50\n",
+				"a/other-client-system-metrics-interpolated.csv": "UNIX-SECOND,CPU\n1,90\n",
+			},
+			runs: []viewRun{
+				{name: "a", series: []viewSeries{
+					{column: "THROUGHPUT", values: []float64{20}},
+					{column: "CPU", values: []float64{50}},
+				}},
+				{name: "b", series: []viewSeries{
+					{column: "THROUGHPUT", values: []float64{10}},
+				}},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		dir, err := ioutil.TempDir(os.TempDir(), "analyze-view")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		writeViewFiles(t, dir, tt.files)
+
+		runs, err := findViewRuns(dir)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if !reflect.DeepEqual(runs, tt.runs) {
+			t.Fatalf("#%d: expected %+v, got %+v", i, tt.runs, runs)
+		}
+	}
+}
+
+func TestFindViewRunsRoot(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "analyze-view")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeViewFiles(t, dir, map[string]string{"client-latency-throughput-timeseries.csv": "UNIX-SECOND,THROUGHPUT\n1,10\n"})
+
+	runs, err := findViewRuns(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 1 || runs[0].name != filepath.Base(dir) {
+		t.Fatalf("expected one run named %q, got %+v", filepath.Base(dir), runs)
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		vs    []float64
+		width int
+		line  string
+	}{
+		{vs: nil, width: 5, line: ""},
+		{vs: []float64{3, 3, 3}, width: 5, line: "▁▁▁"},
+		{vs: []float64{0, 7}, width: 5, line: "▁█"},
+		{vs: []float64{0, 1, 2, 3, 4, 5, 6, 7}, width: 8, line: "▁▂▃▄▅▆▇█"},
+		// more values than width are averaged into buckets
+		{vs: []float64{0, 2, 4, 6, 8, 10}, width: 3, line: "▁▄█"},
+		{vs: []float64{0, 0, 0, 7, 7}, width: 2, line: "▁█"},
+		{vs: []float64{1, 2, 3}, width: 1, line: "▁"},
+	}
+	for i, tt := range tests {
+		line := sparkline(tt.vs, tt.width)
+		if line != tt.line {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.line, line)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bench benchmarks existing database clusters, without agents.
+// Only the stress step runs; databases are neither started nor stopped.
+package bench
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/etcd-io/dbtester"
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// Command implements 'bench' command.
+var Command = &cobra.Command{
+	Use:   "bench",
+	Short: "Benchmarks existing database clusters.",
+	RunE:  commandFunc,
+}
+
+var databaseID string
+var configPath string
+var adminAddr string
+var recordPath string
+var endpoints []string
+var sessionPath string
+
+func init() {
+	ids := dbtesterpb.GetAllDatabaseIDs()
+	Command.PersistentFlags().StringVar(&databaseID, "database-id", ids[0], strings.Join(ids, ", "))
+	Command.PersistentFlags().StringVarP(&configPath, "config", "c", "", "YAML configuration file path.")
+	Command.PersistentFlags().StringVar(&adminAddr, "admin-addr", "", "Address of the admin HTTP server to pause ('POST /pause') and resume ('POST /resume') request generation, either 'host:port' or 'unix://<socket-path>' (empty to disable).")
+	Command.PersistentFlags().StringVar(&recordPath, "record", "", "File path to record generated requests to, for replays with 'trace' type and 'trace_file' (empty to disable).")
+	Command.PersistentFlags().StringSliceVar(&endpoints, "endpoints", nil, "Database endpoints to benchmark (overwrites 'database_endpoints' in the configuration).")
+	Command.PersistentFlags().StringVar(&sessionPath, "session", "", "Session file written by 'control --session' after step 1, to benchmark its cluster (ignored with '--endpoints').")
+}
+
+func commandFunc(cmd *cobra.Command, args []string) error {
+	if !dbtesterpb.IsValidDatabaseID(databaseID) {
+		return fmt.Errorf("database id %q is unknown", databaseID)
+	}
+
+	cfg, err := dbtester.ReadConfig(configPath, false)
+	if err != nil {
+		return err
+	}
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q is not found", databaseID)
+	}
+
+	switch gcfg.ConfigClientMachineBenchmarkOptions.Type {
+	case "write":
+	case "read":
+	case "read-oneshot":
+	case "custom":
+	case "trace":
+	case "read-your-writes":
+	case "read-consistency":
+	case "auth":
+	case "watch":
+	case "list":
+	case "kubernetes-apiserver":
+	default:
+		return fmt.Errorf("%q is not supported", gcfg.ConfigClientMachineBenchmarkOptions.Type)
+	}
+
+	if sessionPath != "" && len(endpoints) == 0 {
+		s, err := dbtester.ReadSession(sessionPath)
+		if err != nil {
+			return err
+		}
+		if err = cfg.UseSession(s, databaseID); err != nil {
+			return err
+		}
+		gcfg = cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	}
+	if len(endpoints) > 0 {
+		gcfg.DatabaseEndpoints = endpoints
+	}
+	if len(gcfg.DatabaseEndpoints) == 0 {
+		return fmt.Errorf("no database endpoint is given for %q", databaseID)
+	}
+
+	// no agent to signal, the cluster is not managed by dbtester
+	gcfg.AgentEndpoints = nil
+	cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = gcfg
+	if err = cfg.CheckClientLimits(databaseID); err != nil {
+		return err
+	}
+
+	lg.Info(
+		"starting benchmark on existing cluster",
+		zap.String("database-id", databaseID),
+		zap.Strings("endpoints", gcfg.DatabaseEndpoints),
+		zap.String("type", gcfg.ConfigClientMachineBenchmarkOptions.Type),
+	)
+	if adminAddr != "" {
+		stop, err := cfg.ServeAdmin(adminAddr)
+		if err != nil {
+			return err
+		}
+		defer stop()
+	}
+	if recordPath != "" {
+		stop, err := cfg.Record(recordPath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := stop(); err != nil {
+				lg.Warn("failed to record requests", zap.String("path", recordPath), zap.Error(err))
+			}
+		}()
+	}
+	if err = cfg.StressRepeat(context.Background(), databaseID); err != nil {
+		return err
+	}
+	if err = cfg.CheckSLOs(); err != nil {
+		return err
+	}
+
+	lg.Info("all done!")
+	return nil
+}
@@ -0,0 +1,196 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bisect finds the first etcd commit that regresses a benchmark metric,
+// building and benchmarking commits chosen by 'git bisect'.
+package bisect
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester"
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// Command implements 'bisect' command.
+var Command = &cobra.Command{
+	Use:   "bisect",
+	Short: "Finds the first etcd commit that regresses benchmark results.",
+	RunE:  commandFunc,
+}
+
+var (
+	databaseID string
+	configPath string
+	repoDir    string
+	goodRef    string
+	badRef     string
+	metric     string
+	threshold  string
+)
+
+func init() {
+	ids := dbtesterpb.GetAllDatabaseIDs()
+	Command.PersistentFlags().StringVar(&databaseID, "database-id", "etcd__tip", strings.Join(ids, ", "))
+	Command.PersistentFlags().StringVarP(&configPath, "config", "c", "", "YAML configuration file path.")
+	Command.PersistentFlags().StringVar(&repoDir, "repo-dir", "etcd", "Local etcd git repository to run 'git bisect' in.")
+	Command.PersistentFlags().StringVar(&goodRef, "good", "", "Commit without regression.")
+	Command.PersistentFlags().StringVar(&badRef, "bad", "", "Commit with regression.")
+	Command.PersistentFlags().StringVar(&metric, "metric", "p99", strings.Join(dbtester.BenchmarkMetrics, ", "))
+	Command.PersistentFlags().StringVar(&threshold, "threshold", "10%", "Regression threshold relative to the good commit.")
+}
+
+func commandFunc(cmd *cobra.Command, args []string) error {
+	if goodRef == "" || badRef == "" {
+		return fmt.Errorf("both '--good' and '--bad' are required")
+	}
+	if !dbtester.IsValidBenchmarkMetric(metric) {
+		return fmt.Errorf("metric %q is unknown (available %q)", metric, dbtester.BenchmarkMetrics)
+	}
+	th, err := parseThreshold(threshold)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := dbtester.ReadConfig(configPath, false)
+	if err != nil {
+		return err
+	}
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q is not found", databaseID)
+	}
+	switch databaseID {
+	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+	default:
+		return fmt.Errorf("bisect is not supported for %q", databaseID)
+	}
+
+	// logs are not uploaded for each commit
+	gcfg.ConfigClientMachineBenchmarkSteps.Step4UploadLogs = false
+	cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = gcfg
+
+	good, err := git("rev-parse", goodRef+"^{commit}")
+	if err != nil {
+		return err
+	}
+	bad, err := git("rev-parse", badRef+"^{commit}")
+	if err != nil {
+		return err
+	}
+
+	baseline, err := benchmark(cfg, good)
+	if err != nil {
+		return err
+	}
+	lg.Info("measured good commit", zap.String("sha", good), zap.String("metric", metric), zap.Float64("value", baseline))
+
+	v, err := benchmark(cfg, bad)
+	if err != nil {
+		return err
+	}
+	if !isRegression(metric, baseline, v, th) {
+		return fmt.Errorf("bad commit %q is not regressed (%s %.4f, good %.4f, threshold %s)", bad, metric, v, baseline, threshold)
+	}
+	lg.Info("measured bad commit", zap.String("sha", bad), zap.String("metric", metric), zap.Float64("value", v))
+
+	if _, err = git("bisect", "start", bad, good); err != nil {
+		return err
+	}
+	defer git("bisect", "reset")
+
+	for {
+		sha, err := git("rev-parse", "HEAD")
+		if err != nil {
+			return err
+		}
+		v, err = benchmark(cfg, sha)
+		if err != nil {
+			return err
+		}
+		mark := "good"
+		if isRegression(metric, baseline, v, th) {
+			mark = "bad"
+		}
+		lg.Info("measured commit", zap.String("sha", sha), zap.String("metric", metric), zap.Float64("value", v), zap.String("mark", mark))
+
+		o, err := git("bisect", mark)
+		if err != nil {
+			return err
+		}
+		if strings.Contains(o, "is the first bad commit") {
+			first := strings.Fields(o)[0]
+			lg.Info("found first bad commit", zap.String("sha", first), zap.String("metric", metric), zap.Float64("good-value", baseline))
+			fmt.Println(o)
+			return nil
+		}
+	}
+}
+
+// benchmark starts databases at the commit, runs the benchmark,
+// stops databases, and returns the measured metric.
+func benchmark(cfg *dbtester.Config, sha string) (float64, error) {
+	gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	gcfg.EtcdGitRef = sha
+	cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = gcfg
+
+	lg.Info("benchmarking commit", zap.String("sha", sha))
+	if _, err := cfg.BroadcaseRequest(context.Background(), databaseID, dbtesterpb.Operation_Start); err != nil {
+		return 0, err
+	}
+	time.Sleep(5 * time.Second)
+	serr := cfg.Stress(context.Background(), databaseID)
+	time.Sleep(5 * time.Second)
+	if _, err := cfg.BroadcaseRequest(context.Background(), databaseID, dbtesterpb.Operation_Stop); err != nil {
+		return 0, err
+	}
+	if serr != nil {
+		return 0, serr
+	}
+	return cfg.ReadMetric(metric)
+}
+
+func git(args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = repoDir
+	o, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("git %s failed (%v, %q)", strings.Join(args, " "), err, string(o))
+	}
+	return strings.TrimSpace(string(o)), nil
+}
+
+// parseThreshold parses relative threshold (e.g. "10%" or "0.1").
+func parseThreshold(s string) (float64, error) {
+	pct := strings.HasSuffix(s, "%")
+	v, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid threshold %q (%v)", s, err)
+	}
+	if pct {
+		v /= 100
+	}
+	if v <= 0 {
+		return 0, fmt.Errorf("threshold %q must be positive", s)
+	}
+	return v, nil
+}
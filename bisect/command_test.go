@@ -0,0 +1,46 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bisect
+
+import "testing"
+
+func TestParseThreshold(t *testing.T) {
+	tests := []struct {
+		s   string
+		v   float64
+		err bool
+	}{
+		{s: "10%", v: 0.1},
+		{s: "0.1", v: 0.1},
+		{s: "2.5%", v: 0.025},
+		{s: "1", v: 1},
+		{s: "0", err: true},
+		{s: "0%", err: true},
+		{s: "-5%", err: true},
+		{s: "-0.1", err: true},
+		{s: "", err: true},
+		{s: "%", err: true},
+		{s: "ten", err: true},
+	}
+	for i, tt := range tests {
+		v, err := parseThreshold(tt.s)
+		if (err != nil) != tt.err {
+			t.Fatalf("#%d: %q error expected %v, got %v", i, tt.s, tt.err, err)
+		}
+		if v != tt.v {
+			t.Fatalf("#%d: %q expected %v, got %v", i, tt.s, tt.v, v)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bisect
+
+import "github.com/etcd-io/dbtester"
+
+// isRegression returns true if the value is worse than the baseline
+// by more than the threshold. Higher throughput or lower latency is better.
+func isRegression(m string, baseline, v, threshold float64) bool {
+	if dbtester.IsHigherBetter(m) {
+		return v < baseline*(1-threshold)
+	}
+	return v > baseline*(1+threshold)
+}
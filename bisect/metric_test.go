@@ -0,0 +1,48 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bisect
+
+import "testing"
+
+func TestIsRegression(t *testing.T) {
+	tests := []struct {
+		metric    string
+		baseline  float64
+		v         float64
+		threshold float64
+		expected  bool
+	}{
+		// higher throughput is better
+		{"throughput", 1000, 850, 0.1, true},
+		{"throughput", 1000, 950, 0.1, false},
+		{"throughput", 1000, 1500, 0.1, false},
+
+		// lower latency is better
+		{"p99", 10, 12, 0.1, true},
+		{"p99", 10, 10.5, 0.1, false},
+		{"p99", 10, 5, 0.1, false},
+		{"avg", 10, 12, 0.1, true},
+		{"avg", 10, 8, 0.1, false},
+
+		// within the threshold on the boundary
+		{"throughput", 1000, 900, 0.1, false},
+		{"avg", 10, 11, 0.5, false},
+	}
+	for i, tt := range tests {
+		if r := isRegression(tt.metric, tt.baseline, tt.v, tt.threshold); r != tt.expected {
+			t.Fatalf("#%d: %s %v -> %v (threshold %v), expected regression %v, got %v", i, tt.metric, tt.baseline, tt.v, tt.threshold, tt.expected, r)
+		}
+	}
+}
@@ -17,83 +17,357 @@ package dbtester
 import (
 	"context"
 	"fmt"
+	"runtime"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/agentauth"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
 
+// DialAgent connects to the agent endpoint, signing all requests
+// with 'agent_auth_secret_path' if set.
+func (cfg *Config) DialAgent(ep string) (*grpc.ClientConn, error) {
+	return grpc.Dial(ep, append([]grpc.DialOption{grpc.WithInsecure()}, agentauth.DialOptions(cfg.agentAuthSecret)...)...)
+}
+
 // BroadcaseRequest sends request to all endpoints.
-func (cfg *Config) BroadcaseRequest(databaseID string, op dbtesterpb.Operation) (map[int]dbtesterpb.Response, error) {
+// With 'scaling_member_numbers', only current members are started or stopped.
+func (cfg *Config) BroadcaseRequest(ctx context.Context, databaseID string, op dbtesterpb.Operation) (map[int]dbtesterpb.Response, error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return nil, fmt.Errorf("database id %q does not exist", databaseID)
+	}
+
+	idxs := make([]int, len(gcfg.AgentEndpoints))
+	for i := range idxs {
+		idxs[i] = i
+	}
+	var clusterSize int64
+	if nums := gcfg.ConfigClientMachineBenchmarkOptions.ScalingMemberNumbers; len(nums) > 0 {
+		switch op {
+		case dbtesterpb.Operation_Start:
+			clusterSize = nums[0]
+			idxs = idxs[:clusterSize]
+		case dbtesterpb.Operation_Stop:
+			clusterSize = cfg.memberNumber(databaseID)
+			idxs = idxs[:clusterSize]
+		}
+	}
+	return cfg.sendRequests(ctx, databaseID, op, idxs, clusterSize, false)
+}
+
+// CheckStarted logs what each agent started, and returns an error if an
+// agent reports no database process. Different database versions across
+// members are logged as warnings.
+func (cfg *Config) CheckStarted(databaseID string, idxToResp map[int]dbtesterpb.Response) error {
+	idxs := make([]int, 0, len(idxToResp))
+	for idx := range idxToResp {
+		idxs = append(idxs, idx)
+	}
+	sort.Ints(idxs)
+
+	versions, archs := make(map[string][]int), make(map[string][]int)
+	for _, idx := range idxs {
+		resp := idxToResp[idx]
+		cfg.lg.Info("started member",
+			zap.Int("index", idx),
+			zap.String("database", databaseID),
+			zap.Int64("pid", resp.PID),
+			zap.String("version", resp.DatabaseVersion),
+			zap.String("cpu-architecture", resp.CPUArchitecture),
+			zap.String("data-dir", resp.DataDir),
+			zap.Any("ports", resp.Ports),
+			zap.String("message", resp.Message),
+		)
+		if resp.PID == 0 {
+			return fmt.Errorf("agent %d reported no database process (%q)", idx, resp.Message)
+		}
+		if resp.DatabaseVersion != "" {
+			versions[resp.DatabaseVersion] = append(versions[resp.DatabaseVersion], idx)
+		}
+		if resp.CPUArchitecture != "" {
+			archs[resp.CPUArchitecture] = append(archs[resp.CPUArchitecture], idx)
+		}
+	}
+	if len(versions) > 1 {
+		cfg.lg.Warn("members run different database versions", zap.Any("version-to-indexes", versions))
+	}
+	if len(archs) > 1 {
+		cfg.lg.Warn("members run on different CPU architectures", zap.Any("architecture-to-indexes", archs))
+	}
+	cfg.labelCPUArchitectures(archs)
+	return nil
+}
+
+// labelCPUArchitectures records the CPU architectures of members and of
+// control in the labels of results, to compare results across
+// architectures (e.g. arm64 and amd64 servers). Mixed members are labeled
+// with all their architectures in order.
+func (cfg *Config) labelCPUArchitectures(archs map[string][]int) {
+	cfg.SetLabel("client-cpu-architecture", runtime.GOARCH)
+	if len(archs) == 0 {
+		return
+	}
+	names := make([]string, 0, len(archs))
+	for a := range archs {
+		names = append(names, a)
+	}
+	sort.Strings(names)
+	cfg.SetLabel("cpu-architecture", strings.Join(names, ","))
+}
+
+// SkewClocks offsets the clocks of members in 'clock_skew_member_indexes',
+// and logs the clock offsets measured by agents.
+func (cfg *Config) SkewClocks(ctx context.Context, databaseID string) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("database id %q does not exist", databaseID)
+	}
+
+	idxs := []int{0}
+	if len(gcfg.ClockSkewMemberIndexes) > 0 {
+		idxs = make([]int, len(gcfg.ClockSkewMemberIndexes))
+		for i, idx := range gcfg.ClockSkewMemberIndexes {
+			idxs[i] = int(idx)
+		}
+	}
+	idxToResp, err := cfg.sendRequests(ctx, databaseID, dbtesterpb.Operation_SkewClock, idxs, 0, false)
+	if err != nil {
+		return err
+	}
+	for _, idx := range idxs {
+		cfg.lg.Info("skewed member clock",
+			zap.Int("index", idx),
+			zap.Int64("clock-skew-ms", gcfg.ClockSkewMs),
+			zap.Duration("measured-clock-skew", time.Duration(idxToResp[idx].ClockSkewNanoseconds)),
+		)
+	}
+	return nil
+}
+
+// sendRequests sends request to the agents of given indexes, concurrently
+// within each wave of 'broadcast_order'. It returns all errors of the
+// first failed wave, without sending the following waves.
+func (cfg *Config) sendRequests(ctx context.Context, databaseID string, op dbtesterpb.Operation, idxs []int, clusterSize int64, joinExisting bool) (map[int]dbtesterpb.Response, error) {
 	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 	if !ok {
 		return nil, fmt.Errorf("database id %q does not exist", databaseID)
 	}
 
+	reqs := make(map[int]*dbtesterpb.Request, len(idxs))
+	for _, i := range idxs {
+		req, err := cfg.ToRequest(databaseID, op, i)
+		if err != nil {
+			return nil, err
+		}
+		req.ClusterSize = clusterSize
+		req.JoinExisting = joinExisting
+		reqs[i] = req
+	}
+
 	type result struct {
 		idx int
 		r   dbtesterpb.Response
+		err error
 	}
-	donec, errc := make(chan result), make(chan error)
-	for i := range gcfg.AgentEndpoints {
-		req, err := cfg.ToRequest(databaseID, op, i)
-		if err != nil {
+	im := make(map[int]dbtesterpb.Response)
+	for _, wave := range broadcastWaves(gcfg, op, idxs) {
+		resc := make(chan result, len(wave))
+		for _, i := range wave {
+			go func(i int, ep string, req *dbtesterpb.Request) {
+				resp, err := cfg.sendRequest(ctx, ep, i, req)
+				resc <- result{idx: i, r: resp, err: err}
+			}(i, gcfg.AgentEndpoints[i], reqs[i])
+		}
+
+		var errs []error
+		for range wave {
+			rs := <-resc
+			if rs.err != nil {
+				errs = append(errs, rs.err)
+				continue
+			}
+			im[rs.idx] = rs.r
+		}
+		if err := joinErrors(errs); err != nil {
 			return nil, err
 		}
-		ep := gcfg.AgentEndpoints[i]
+	}
+	return im, nil
+}
+
+// sendRequest sends the request to the agent at the endpoint.
+func (cfg *Config) sendRequest(ctx context.Context, ep string, idx int, req *dbtesterpb.Request) (dbtesterpb.Response, error) {
+	cfg.lg.Info("sending message",
+		zap.Int("index", idx),
+		zap.String("endpoint", ep),
+		zap.String("operation", req.Operation.String()),
+		zap.String("database", req.DatabaseID.String()),
+	)
+	conn, err := cfg.DialAgent(ep)
+	if err != nil {
+		return dbtesterpb.Response{}, fmt.Errorf("%v (%q)", err, ep)
+	}
+	defer conn.Close()
+
+	// give enough timeout
+	// e.g. uploading logs takes longer
+	cli := dbtesterpb.NewTransporterClient(conn)
+	timeout := 2 * time.Minute
+	if req.Operation == dbtesterpb.Operation_Start && req.EtcdGitRef != "" {
+		// building etcd from source takes longer
+		timeout = 30 * time.Minute
+	}
+	if req.Operation == dbtesterpb.Operation_Exec && req.ExecTimeoutSeconds > 0 {
+		// the agent kills the command on its timeout,
+		// leave time for the response
+		timeout = time.Duration(req.ExecTimeoutSeconds)*time.Second + 30*time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	resp, err := cli.Transfer(ctx, req)
+	cancel()
+	if err != nil {
+		return dbtesterpb.Response{}, fmt.Errorf("%v (%q)", err, ep)
+	}
+	cfg.lg.Info("received response",
+		zap.Int("index", idx),
+		zap.String("endpoint", ep),
+		zap.String("operation", req.Operation.String()),
+		zap.String("database", req.DatabaseID.String()),
+		zap.String("response", fmt.Sprintf("%+v", resp)),
+	)
+	return *resp, nil
+}
+
+// broadcastWaves groups the agent indexes into waves of concurrent
+// requests, per 'broadcast_order'. Consul starts with bootstrap servers
+// first by default, since the other servers join them on start.
+func broadcastWaves(gcfg dbtesterpb.ConfigClientMachineAgentControl, op dbtesterpb.Operation, idxs []int) [][]int {
+	order := gcfg.BroadcastOrder
+	if order == "" && op == dbtesterpb.Operation_Start && gcfg.DatabaseID == "consul__v1_0_2" {
+		order = dbtesterpb.BroadcastOrderBootstrapFirst
+	}
+
+	switch order {
+	case dbtesterpb.BroadcastOrderSequential:
+		waves := make([][]int, len(idxs))
+		for i, idx := range idxs {
+			waves[i] = []int{idx}
+		}
+		return waves
+
+	case dbtesterpb.BroadcastOrderBootstrapFirst:
+		if op != dbtesterpb.Operation_Start {
+			break
+		}
+		var bootstrap, rest []int
+		for _, idx := range idxs {
+			if isBootstrapMember(gcfg, idx) {
+				bootstrap = append(bootstrap, idx)
+			} else {
+				rest = append(rest, idx)
+			}
+		}
+		if len(bootstrap) > 0 && len(rest) > 0 {
+			return [][]int{bootstrap, rest}
+		}
+	}
+	return [][]int{idxs}
+}
+
+// isBootstrapMember returns true if other members join the member on
+// start: the first server of each Consul datacenter, or the first member.
+func isBootstrapMember(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int) bool {
+	if gcfg.DatabaseID == "consul__v1_0_2" {
+		if idx >= len(gcfg.PeerIPs) { // client agent on loader machine
+			return false
+		}
+		_, first, _ := gcfg.Flag_Consul_V1_0_2.Datacenter(idx)
+		return idx == first
+	}
+	return idx == 0
+}
+
+// joinErrors returns nil without errors, the error if only one,
+// or one error with all messages.
+func joinErrors(errs []error) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return fmt.Errorf("%d requests failed (%s)", len(errs), strings.Join(msgs, "; "))
+}
+
+// WaitUploads blocks until all stopped agents report that
+// their logs are uploaded, returning error if any upload fails.
+func (cfg *Config) WaitUploads(databaseID string, timeout time.Duration) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("database id %q does not exist", databaseID)
+	}
+
+	// with 'scaling_member_numbers', only agents that have ever started upload logs
+	n := int64(len(gcfg.AgentEndpoints))
+	if nums := gcfg.ConfigClientMachineBenchmarkOptions.ScalingMemberNumbers; len(nums) > 0 {
+		n = 0
+		for _, v := range nums {
+			if n < v {
+				n = v
+			}
+		}
+	}
 
-		go func(i int, ep string, req *dbtesterpb.Request) {
-			cfg.lg.Info("sending message",
-				zap.Int("index", i),
-				zap.String("endpoint", ep),
-				zap.String("operation", op.String()),
-				zap.String("database", req.DatabaseID.String()),
-			)
-			conn, err := grpc.Dial(ep, grpc.WithInsecure())
+	errc := make(chan error)
+	for _, ep := range gcfg.AgentEndpoints[:n] {
+		go func(ep string) {
+			conn, err := cfg.DialAgent(ep)
 			if err != nil {
 				errc <- fmt.Errorf("%v (%q)", err, ep)
 				return
 			}
 			defer conn.Close()
 
-			// give enough timeout
-			// e.g. uploading logs takes longer
 			cli := dbtesterpb.NewTransporterClient(conn)
-			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
-			resp, err := cli.Transfer(ctx, req)
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			resp, err := cli.WaitUpload(ctx, &dbtesterpb.UploadRequest{TimeoutSeconds: int64(timeout.Seconds()), TestID: cfg.ConfigClientMachineInitial.TestID})
 			cancel()
 			if err != nil {
 				errc <- fmt.Errorf("%v (%q)", err, ep)
 				return
 			}
-			cfg.lg.Info("received response",
-				zap.Int("index", i),
-				zap.String("endpoint", ep),
-				zap.String("operation", op.String()),
-				zap.String("database", req.DatabaseID.String()),
-				zap.String("response", fmt.Sprintf("%+v", resp)),
-			)
-
-			donec <- result{idx: i, r: *resp}
-		}(i, ep, req)
-
-		time.Sleep(time.Second)
+			for _, f := range resp.Files {
+				cfg.lg.Info("agent upload status",
+					zap.String("endpoint", ep),
+					zap.String("source", f.Source),
+					zap.String("state", f.State),
+					zap.Strings("objects", f.Objects),
+					zap.String("error", f.Error),
+				)
+			}
+			if !resp.Success {
+				errc <- fmt.Errorf("agent %q failed to upload logs", ep)
+				return
+			}
+			errc <- nil
+		}(ep)
 	}
 
-	im := make(map[int]dbtesterpb.Response)
 	var errs []error
-	for cnt := 0; cnt != len(gcfg.AgentEndpoints); cnt++ {
-		select {
-		case rs := <-donec:
-			im[rs.idx] = rs.r
-		case err := <-errc:
+	for i := int64(0); i < n; i++ {
+		if err := <-errc; err != nil {
 			errs = append(errs, err)
 		}
 	}
-	if len(errs) > 0 {
-		return nil, errs[0]
-	}
-	return im, nil
+	return joinErrors(errs)
 }
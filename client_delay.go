@@ -0,0 +1,65 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// clientDelay is the artificial delay added before each request of a
+// client, to simulate clients far from the database cluster.
+type clientDelay struct {
+	base   time.Duration
+	jitter time.Duration
+}
+
+// clientDelays returns the delays of clients, assigning 'client_delays_ms'
+// in round-robin order. It returns nil if no delay is configured.
+func clientDelays(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions, clientsN int) []clientDelay {
+	if len(opts.ClientDelaysMs) == 0 && opts.ClientDelayJitterMs <= 0 {
+		return nil
+	}
+	ds := make([]clientDelay, clientsN)
+	for i := range ds {
+		if len(opts.ClientDelaysMs) > 0 {
+			ds[i].base = time.Duration(opts.ClientDelaysMs[i%len(opts.ClientDelaysMs)]) * time.Millisecond
+		}
+		ds[i].jitter = time.Duration(opts.ClientDelayJitterMs) * time.Millisecond
+	}
+	return ds
+}
+
+// duration returns the delay of the next request.
+func (d clientDelay) duration() time.Duration {
+	if d.jitter <= 0 {
+		return d.base
+	}
+	return d.base + time.Duration(rand.Int63n(int64(d.jitter)+1))
+}
+
+// wait sleeps for the delay of the next request, or until abortc is closed.
+func (d clientDelay) wait(abortc <-chan struct{}) {
+	dur := d.duration()
+	if dur <= 0 {
+		return
+	}
+	select {
+	case <-time.After(dur):
+	case <-abortc:
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func TestClientDelays(t *testing.T) {
+	if ds := clientDelays(&dbtesterpb.ConfigClientMachineBenchmarkOptions{}, 3); ds != nil {
+		t.Fatalf("unexpected delays %+v", ds)
+	}
+
+	ds := clientDelays(&dbtesterpb.ConfigClientMachineBenchmarkOptions{ClientDelaysMs: []int64{0, 80}, ClientDelayJitterMs: 5}, 3)
+	if len(ds) != 3 {
+		t.Fatalf("expected 3 delays, got %d", len(ds))
+	}
+	for i, base := range []time.Duration{0, 80 * time.Millisecond, 0} {
+		if ds[i].base != base {
+			t.Fatalf("#%d: expected %v, got %v", i, base, ds[i].base)
+		}
+		for j := 0; j < 100; j++ {
+			if d := ds[i].duration(); d < base || d > base+5*time.Millisecond {
+				t.Fatalf("#%d: delay %v out of [%v, %v]", i, d, base, base+5*time.Millisecond)
+			}
+		}
+	}
+}
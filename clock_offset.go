@@ -0,0 +1,177 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// ClockOffsetsColumns defines clock offsets columns.
+var ClockOffsetsColumns = []string{
+	"MEMBER-INDEX",
+	"AGENT-ENDPOINT",
+	"OFFSET-MS",
+	"EXPECTED-OFFSET-MS",
+	"ROUND-TRIP-MS",
+}
+
+// clockOffsetSamples is the number of clock requests to each agent,
+// of which the one with the shortest round trip is the most accurate.
+const clockOffsetSamples = 5
+
+// defaultClockOffsetWarnMs is the default of 'clock_offset_warn_ms'.
+const defaultClockOffsetWarnMs = 100
+
+type clockOffset struct {
+	idx      int
+	ep       string
+	offset   time.Duration
+	expected time.Duration
+	rtt      time.Duration
+}
+
+// CheckClockOffsets measures the clock offset of each agent from control,
+// warns when it exceeds 'clock_offset_warn_ms' (except the offsets applied
+// by 'clock_skew_ms'), and records the offsets to 'client_clock_offsets_path'.
+func (cfg *Config) CheckClockOffsets(databaseID string) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("database id %q does not exist", databaseID)
+	}
+	warn := time.Duration(gcfg.ClockOffsetWarnMs) * time.Millisecond
+	if warn == 0 {
+		warn = defaultClockOffsetWarnMs * time.Millisecond
+	}
+
+	skewed := map[int]bool{0: true}
+	if len(gcfg.ClockSkewMemberIndexes) > 0 {
+		skewed = make(map[int]bool, len(gcfg.ClockSkewMemberIndexes))
+		for _, idx := range gcfg.ClockSkewMemberIndexes {
+			skewed[int(idx)] = true
+		}
+	}
+
+	offsets := make([]clockOffset, len(gcfg.AgentEndpoints))
+	var maxResidual time.Duration
+	for idx, ep := range gcfg.AgentEndpoints {
+		o, err := cfg.measureClockOffset(databaseID, idx)
+		if err != nil {
+			return fmt.Errorf("%v (%q)", err, ep)
+		}
+		o.idx, o.ep = idx, ep
+		if skewed[idx] {
+			o.expected = time.Duration(gcfg.ClockSkewMs) * time.Millisecond
+		}
+		offsets[idx] = o
+
+		residual := o.offset - o.expected
+		if residual < 0 {
+			residual = -residual
+		}
+		if residual > maxResidual {
+			maxResidual = residual
+		}
+		if residual > warn {
+			cfg.lg.Warn("agent clock offset exceeds threshold; timestamps across hosts are unreliable",
+				zap.Int("index", idx),
+				zap.String("endpoint", ep),
+				zap.Duration("offset", o.offset),
+				zap.Duration("expected-offset", o.expected),
+				zap.Duration("round-trip", o.rtt),
+				zap.Duration("threshold", warn),
+			)
+		} else {
+			cfg.lg.Info("measured agent clock offset",
+				zap.Int("index", idx),
+				zap.String("endpoint", ep),
+				zap.Duration("offset", o.offset),
+				zap.Duration("round-trip", o.rtt),
+			)
+		}
+	}
+
+	cfg.SetLabel("max-clock-offset-ms", fmt.Sprintf("%.3f", float64(maxResidual)/float64(time.Millisecond)))
+	return cfg.saveClockOffsets(offsets)
+}
+
+// measureClockOffset returns the offset of the agent wall clock from
+// control, assuming the agent reads its clock in the middle of the
+// round trip of the request.
+func (cfg *Config) measureClockOffset(databaseID string, idx int) (clockOffset, error) {
+	gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	req, err := cfg.ToRequest(databaseID, dbtesterpb.Operation_Clock, idx)
+	if err != nil {
+		return clockOffset{}, err
+	}
+	conn, err := cfg.DialAgent(gcfg.AgentEndpoints[idx])
+	if err != nil {
+		return clockOffset{}, err
+	}
+	defer conn.Close()
+
+	cli := dbtesterpb.NewTransporterClient(conn)
+	best := clockOffset{rtt: time.Duration(math.MaxInt64)}
+	for i := 0; i < clockOffsetSamples; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		sent := time.Now()
+		resp, err := cli.Transfer(ctx, req)
+		rtt := time.Since(sent)
+		cancel()
+		if err != nil {
+			return clockOffset{}, err
+		}
+		if rtt < best.rtt {
+			mid := sent.Round(0).Add(rtt / 2)
+			best.rtt = rtt
+			best.offset = time.Unix(0, resp.UnixNano).Sub(mid)
+		}
+	}
+	return best, nil
+}
+
+func (cfg *Config) saveClockOffsets(offsets []clockOffset) error {
+	if cfg.ConfigClientMachineInitial.ClientClockOffsetsPath == "" {
+		return nil
+	}
+
+	c1 := dataframe.NewColumn(ClockOffsetsColumns[0])
+	c2 := dataframe.NewColumn(ClockOffsetsColumns[1])
+	c3 := dataframe.NewColumn(ClockOffsetsColumns[2])
+	c4 := dataframe.NewColumn(ClockOffsetsColumns[3])
+	c5 := dataframe.NewColumn(ClockOffsetsColumns[4])
+	for _, o := range offsets {
+		c1.PushBack(dataframe.NewStringValue(o.idx))
+		c2.PushBack(dataframe.NewStringValue(o.ep))
+		c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.3f", float64(o.offset)/float64(time.Millisecond))))
+		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.3f", float64(o.expected)/float64(time.Millisecond))))
+		c5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.3f", float64(o.rtt)/float64(time.Millisecond))))
+	}
+
+	fr := dataframe.New()
+	for _, col := range []dataframe.Column{c1, c2, c3, c4, c5} {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientClockOffsetsPath)
+}
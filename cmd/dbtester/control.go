@@ -0,0 +1,31 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+// +build !windows
+
+package main
+
+import "github.com/etcd-io/dbtester/control"
+
+// control collects client system metrics with 'top', which is not
+// available on Windows, where only agents run.
+func init() {
+	rootCommand.AddCommand(control.Command)
+	rootCommand.AddCommand(control.ExportCommand)
+	rootCommand.AddCommand(control.HistoryCommand)
+	rootCommand.AddCommand(control.LogsCommand)
+	rootCommand.AddCommand(control.LsCommand)
+	rootCommand.AddCommand(control.ServeCommand)
+}
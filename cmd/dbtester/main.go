@@ -20,7 +20,16 @@
 //	Available Commands:
 //	agent       Database 'agent' in remote servers.
 //	analyze     Analyzes test dbtester test results.
+//	bench       Benchmarks existing database clusters.
+//	bisect      Finds the first etcd commit that regresses benchmark results.
+//	compare     Compares repeated benchmark results of two configurations.
 //	control     Controls tests.
+//	export      Exports anonymized results and logs for external sharing.
+//	history     Queries past results in the local results history.
+//	logs        Live-tails the database log of an agent.
+//	ls          Lists past experiment runs in remote storage.
+//	serve       Runs submitted benchmarks one by one as a shared service.
+//	view        Interactively browses local benchmark results.
 //
 package main
 
@@ -30,7 +39,8 @@ import (
 
 	"github.com/etcd-io/dbtester/agent"
 	"github.com/etcd-io/dbtester/analyze"
-	"github.com/etcd-io/dbtester/control"
+	"github.com/etcd-io/dbtester/bench"
+	"github.com/etcd-io/dbtester/bisect"
 	"github.com/spf13/cobra"
 )
 
@@ -49,7 +59,11 @@ func init() {
 func init() {
 	rootCommand.AddCommand(agent.Command)
 	rootCommand.AddCommand(analyze.Command)
-	rootCommand.AddCommand(control.Command)
+	rootCommand.AddCommand(bench.Command)
+	rootCommand.AddCommand(bisect.Command)
+	rootCommand.AddCommand(analyze.CompareCommand)
+	rootCommand.AddCommand(analyze.FlameDiffCommand)
+	rootCommand.AddCommand(analyze.ViewCommand)
 }
 
 func main() {
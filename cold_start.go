@@ -0,0 +1,63 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+// coldRestartTimeout is the maximum time for restarted databases
+// to elect a leader before cold reads.
+const coldRestartTimeout = 2 * time.Minute
+
+// dropPageCaches drops page caches of all agents per 'drop_page_cache',
+// and waits for a leader if databases are restarted with 'cold_restart'.
+func (cfg *Config) dropPageCaches(gcfg dbtesterpb.ConfigClientMachineAgentControl) error {
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	if !opts.DropPageCache {
+		return nil
+	}
+	idxs := make([]int, len(gcfg.AgentEndpoints))
+	for i := range idxs {
+		idxs[i] = i
+	}
+	cfg.lg.Info("dropping page caches before reads", zap.Bool("cold-restart", opts.ColdRestart))
+	if _, err := cfg.sendRequests(cfg.stressContext(), gcfg.DatabaseID, dbtesterpb.Operation_DropPageCache, idxs, 0, false); err != nil {
+		return err
+	}
+	if !opts.ColdRestart {
+		return nil
+	}
+
+	now := time.Now()
+	for {
+		for _, role := range memberRoles(gcfg) {
+			// single-node Zookeeper runs in 'standalone' mode
+			if role == roleLeader || role == "standalone" {
+				cfg.lg.Info("restarted databases have a leader", zap.Duration("took", time.Since(now)))
+				return nil
+			}
+		}
+		if time.Since(now) > coldRestartTimeout {
+			return fmt.Errorf("no leader in %v after cold restart", coldRestartTimeout)
+		}
+		time.Sleep(time.Second)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// ComparisonRun is the summary of one database in a comparison run,
+// where the same workload is run against multiple databases.
+type ComparisonRun struct {
+	DatabaseID          string
+	DatabaseTag         string
+	DatabaseDescription string
+	Success             bool
+	// Metrics maps 'BenchmarkMetrics' to their values,
+	// without the metrics that were not measured.
+	Metrics map[string]float64
+}
+
+// CheckComparable returns an error if the databases do not run the
+// same workload on the same number of agents, so that their results
+// can be compared in one report.
+func (cfg *Config) CheckComparable(databaseIDs []string) error {
+	if len(databaseIDs) < 2 {
+		return fmt.Errorf("comparison needs at least 2 databases, got %q", databaseIDs)
+	}
+	seen := make(map[string]bool, len(databaseIDs))
+	var first dbtesterpb.ConfigClientMachineAgentControl
+	for i, id := range databaseIDs {
+		if seen[id] {
+			return fmt.Errorf("database id %q is duplicate", id)
+		}
+		seen[id] = true
+		gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[id]
+		if !ok {
+			return fmt.Errorf("%q is not found", id)
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions == nil {
+			return fmt.Errorf("%q has no benchmark options", id)
+		}
+		if i == 0 {
+			first = gcfg
+			continue
+		}
+		if len(gcfg.AgentEndpoints) != len(first.AgentEndpoints) {
+			return fmt.Errorf("%q has %d agents, %q has %d", id, len(gcfg.AgentEndpoints), first.DatabaseID, len(first.AgentEndpoints))
+		}
+		o, fo := gcfg.ConfigClientMachineBenchmarkOptions, first.ConfigClientMachineBenchmarkOptions
+		for _, v := range []struct {
+			name      string
+			got, want interface{}
+		}{
+			{"type", o.Type, fo.Type},
+			{"request_number", o.RequestNumber, fo.RequestNumber},
+			{"connection_number", o.ConnectionNumber, fo.ConnectionNumber},
+			{"client_number", o.ClientNumber, fo.ClientNumber},
+			{"key_size_bytes", o.KeySizeBytes, fo.KeySizeBytes},
+			{"value_size_bytes", o.ValueSizeBytes, fo.ValueSizeBytes},
+		} {
+			if v.got != v.want {
+				return fmt.Errorf("%q has %s %v, %q has %v", id, v.name, v.got, first.DatabaseID, v.want)
+			}
+		}
+	}
+	return nil
+}
+
+// ComparisonRun returns the summary of the last benchmark of the database.
+func (cfg *Config) ComparisonRun(databaseID string, success bool) (ComparisonRun, error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return ComparisonRun{}, fmt.Errorf("%q does not exist", databaseID)
+	}
+	run := ComparisonRun{
+		DatabaseID:          databaseID,
+		DatabaseTag:         gcfg.DatabaseTag,
+		DatabaseDescription: gcfg.DatabaseDescription,
+		Success:             success,
+		Metrics:             make(map[string]float64),
+	}
+	for _, m := range BenchmarkMetrics {
+		// aborted runs may not have written all results
+		if v, err := cfg.ReadMetric(m); err == nil {
+			run.Metrics[m] = v
+		}
+	}
+	return run, nil
+}
+
+// comparisonTablePath returns the path of the text table,
+// next to the CSV of 'client_comparison_report_path'.
+func comparisonTablePath(fpath string) string {
+	return strings.TrimSuffix(fpath, filepath.Ext(fpath)) + ".txt"
+}
+
+// SaveComparisonReport writes the runs to 'client_comparison_report_path'
+// as CSV, and as a text table with the best database of each metric.
+// It returns the paths written.
+func (cfg *Config) SaveComparisonReport(runs []ComparisonRun) ([]string, error) {
+	fpath := cfg.ConfigClientMachineInitial.ClientComparisonReportPath
+	if fpath == "" {
+		return nil, fmt.Errorf("'client_comparison_report_path' is not configured")
+	}
+
+	header := append([]string{"DATABASE-ID", "DATABASE-TAG", "DATABASE-DESCRIPTION", "SUCCESS"}, BenchmarkMetrics...)
+	rows := [][]string{header}
+	best := make([]string, len(BenchmarkMetrics))
+	bestValues := make([]float64, len(BenchmarkMetrics))
+	for _, run := range runs {
+		row := []string{run.DatabaseID, run.DatabaseTag, run.DatabaseDescription, fmt.Sprint(run.Success)}
+		for i, m := range BenchmarkMetrics {
+			v, ok := run.Metrics[m]
+			if !ok {
+				row = append(row, "")
+				continue
+			}
+			row = append(row, fmt.Sprintf("%4.4f", v))
+			if best[i] == "" || (IsHigherBetter(m) && v > bestValues[i]) || (!IsHigherBetter(m) && v < bestValues[i]) {
+				best[i], bestValues[i] = run.DatabaseID, v
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	var buf bytes.Buffer
+	wr := csv.NewWriter(&buf)
+	if err := wr.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(fpath, buf.Bytes(), 0644); err != nil {
+		return nil, err
+	}
+
+	buf.Reset()
+	tw := tablewriter.NewWriter(&buf)
+	tw.SetHeader(header)
+	tw.SetAutoFormatHeaders(false)
+	for _, row := range rows[1:] {
+		tw.Append(row)
+	}
+	tw.SetFooter(append([]string{"BEST", "", "", ""}, best...))
+	tw.Render()
+	tpath := comparisonTablePath(fpath)
+	if err := ioutil.WriteFile(tpath, buf.Bytes(), 0644); err != nil {
+		return nil, err
+	}
+	return []string{fpath, tpath}, nil
+}
+
+// UploadComparisonReport uploads the comparison report of the run,
+// which is not specific to one database.
+func (cfg *Config) UploadComparisonReport(paths ...string) error {
+	if err := cfg.initUploader(); err != nil {
+		return err
+	}
+	for _, p := range paths {
+		dst := path.Join(cfg.ConfigClientMachineInitial.RunPath(), "control", filepath.Base(p))
+		if err := cfg.uploader.UploadFile(p, dst, 30); err != nil {
+			return err
+		}
+	}
+	return nil
+}
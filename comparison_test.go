@@ -0,0 +1,101 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func TestCheckComparable(t *testing.T) {
+	newGcfg := func(id string, agents int, reqN int64) dbtesterpb.ConfigClientMachineAgentControl {
+		return dbtesterpb.ConfigClientMachineAgentControl{
+			DatabaseID:     id,
+			AgentEndpoints: make([]string, agents),
+			ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
+				Type:          "write",
+				RequestNumber: reqN,
+			},
+		}
+	}
+	cfg := &Config{DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+		"etcd__tip":              newGcfg("etcd__tip", 3, 100),
+		"zookeeper__r3_5_3_beta": newGcfg("zookeeper__r3_5_3_beta", 3, 100),
+		"consul__v1_0_2":         newGcfg("consul__v1_0_2", 1, 100),
+		"etcd__v3_3":             newGcfg("etcd__v3_3", 3, 200),
+	}}
+	tests := []struct {
+		ids   []string
+		valid bool
+	}{
+		{[]string{"etcd__tip", "zookeeper__r3_5_3_beta"}, true},
+		{[]string{"etcd__tip"}, false},
+		{[]string{"etcd__tip", "etcd__tip"}, false},
+		{[]string{"etcd__tip", "consul__v1_0_2"}, false},
+		{[]string{"etcd__tip", "etcd__v3_3"}, false},
+		{[]string{"etcd__tip", "etcd__v3_2"}, false},
+	}
+	for i, tt := range tests {
+		if err := cfg.CheckComparable(tt.ids); (err == nil) != tt.valid {
+			t.Fatalf("#%d: expected valid %v, got %v", i, tt.valid, err)
+		}
+	}
+}
+
+func TestSaveComparisonReport(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "comparison")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := &Config{}
+	cfg.ConfigClientMachineInitial.ClientComparisonReportPath = filepath.Join(dir, "comparison.csv")
+	paths, err := cfg.SaveComparisonReport([]ComparisonRun{
+		{DatabaseID: "etcd__tip", Success: true, Metrics: map[string]float64{"p99": 10, "throughput": 1000}},
+		{DatabaseID: "zookeeper__r3_5_3_beta", Success: true, Metrics: map[string]float64{"p99": 5, "throughput": 500}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 2 || paths[1] != filepath.Join(dir, "comparison.txt") {
+		t.Fatalf("unexpected paths %q", paths)
+	}
+
+	bts, err := ioutil.ReadFile(paths[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(bts)), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[1], "etcd__tip,") {
+		t.Fatalf("unexpected CSV %q", bts)
+	}
+
+	bts, err = ioutil.ReadFile(paths[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// lower p99 and higher throughput are better
+	for _, s := range []string{"BEST", "zookeeper__r3_5_3_beta", "etcd__tip"} {
+		if !strings.Contains(string(bts), s) {
+			t.Fatalf("expected %q in table %q", s, bts)
+		}
+	}
+}
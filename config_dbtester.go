@@ -21,8 +21,13 @@ import (
 	"strings"
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/agentauth"
+	"github.com/etcd-io/dbtester/pkg/netem"
+	"github.com/etcd-io/dbtester/pkg/remotestorage"
 
+	"github.com/coreos/etcd/pkg/report"
 	"go.uber.org/zap"
+	"golang.org/x/net/context"
 	"gopkg.in/yaml.v2"
 )
 
@@ -40,6 +45,57 @@ func MakeTag(desc string) string {
 type Config struct {
 	lg *zap.Logger
 
+	// memberNumbers maps database ID to the number of current members,
+	// when cluster is reconfigured with 'scaling_member_numbers'
+	memberNumbers map[string]int64
+
+	// uploader is created on first upload
+	uploader *remotestorage.Multi
+	// uploadQueue tracks all files uploaded by control
+	uploadQueue *remotestorage.Queue
+
+	// compactor is set while a write benchmark runs with 'compaction_policy'
+	compactor *compactor
+
+	// pauser pauses request generation from the admin server, nil if disabled
+	pauser *Pauser
+
+	// valueCodec compresses values of the benchmark, nil if disabled
+	valueCodec *valueCodec
+
+	// recorder records generated requests to a trace file, nil if disabled
+	recorder *traceRecorder
+
+	// phases records workload phases, created on benchmark
+	// or admin server start
+	phases *phaseLog
+
+	// stressCtx aborts the benchmarks of the running 'Stress',
+	// nil if not running
+	stressCtx context.Context
+
+	// sloResults is the evaluation of 'slos' on the last benchmark
+	sloResults []SLOResult
+
+	// clientWrites and clientReads count bytes written and read by
+	// clients on the last benchmark, to compute amplification
+	clientWrites *byteCounter
+	clientReads  *byteCounter
+
+	// opStats is the latency per operation type of the last benchmark,
+	// nil if not reported per type
+	opStats map[string]report.Stats
+
+	// agentAuthSecret signs requests to agents, empty if disabled
+	agentAuthSecret []byte
+
+	// memberRoles is the last known role of each database endpoint
+	// on the last benchmark, empty if unknown
+	memberRoles []string
+
+	// rawConfig is the configuration file as read, to snapshot in reports
+	rawConfig []byte
+
 	TestTitle       string `yaml:"test_title"`
 	TestDescription string `yaml:"test_description"`
 
@@ -71,6 +127,7 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		return nil, lerr
 	}
 	cfg.lg = lg
+	cfg.rawConfig = bts
 
 	for _, id := range cfg.AllDatabaseIDList {
 		if !dbtesterpb.IsValidDatabaseID(id) {
@@ -88,6 +145,112 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)
 		cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath)
 		cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath)
+		if cfg.ConfigClientMachineInitial.ClientBackupRestoreSummaryPath != "" {
+			cfg.ConfigClientMachineInitial.ClientBackupRestoreSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientBackupRestoreSummaryPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientMembershipChangesPath != "" {
+			cfg.ConfigClientMachineInitial.ClientMembershipChangesPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientMembershipChangesPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientLatencyDistributionCorrectedPath != "" {
+			cfg.ConfigClientMachineInitial.ClientLatencyDistributionCorrectedPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientLatencyDistributionCorrectedPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientLatencyHeatmapPath != "" {
+			cfg.ConfigClientMachineInitial.ClientLatencyHeatmapPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientLatencyHeatmapPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientReadYourWritesPath != "" {
+			cfg.ConfigClientMachineInitial.ClientReadYourWritesPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientReadYourWritesPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientReadConsistencyPath != "" {
+			cfg.ConfigClientMachineInitial.ClientReadConsistencyPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientReadConsistencyPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientWatchSummaryPath != "" {
+			cfg.ConfigClientMachineInitial.ClientWatchSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientWatchSummaryPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientWatchCompactionSummaryPath != "" {
+			cfg.ConfigClientMachineInitial.ClientWatchCompactionSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientWatchCompactionSummaryPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientDeleteRangeSummaryPath != "" {
+			cfg.ConfigClientMachineInitial.ClientDeleteRangeSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientDeleteRangeSummaryPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientTenancySummaryPath != "" {
+			cfg.ConfigClientMachineInitial.ClientTenancySummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientTenancySummaryPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientPhasesPath != "" {
+			cfg.ConfigClientMachineInitial.ClientPhasesPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientPhasesPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientSnapshotTransferSummaryPath != "" {
+			cfg.ConfigClientMachineInitial.ClientSnapshotTransferSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientSnapshotTransferSummaryPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientOpTypeSummaryPath != "" {
+			cfg.ConfigClientMachineInitial.ClientOpTypeSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientOpTypeSummaryPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientClockOffsetsPath != "" {
+			cfg.ConfigClientMachineInitial.ClientClockOffsetsPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientClockOffsetsPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientUpgradesPath != "" {
+			cfg.ConfigClientMachineInitial.ClientUpgradesPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientUpgradesPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientAuthPenaltyPath != "" {
+			cfg.ConfigClientMachineInitial.ClientAuthPenaltyPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientAuthPenaltyPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientLeaderTimeseriesPath != "" {
+			cfg.ConfigClientMachineInitial.ClientLeaderTimeseriesPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientLeaderTimeseriesPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientRepeatSummaryPath != "" {
+			cfg.ConfigClientMachineInitial.ClientRepeatSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientRepeatSummaryPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientOpenMetricsPath != "" {
+			cfg.ConfigClientMachineInitial.ClientOpenMetricsPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientOpenMetricsPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientReportPath != "" {
+			cfg.ConfigClientMachineInitial.ClientReportPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientReportPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientReportDataPath != "" {
+			cfg.ConfigClientMachineInitial.ClientReportDataPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientReportDataPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientUploadManifestPath != "" {
+			cfg.ConfigClientMachineInitial.ClientUploadManifestPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientUploadManifestPath)
+		}
+		if cfg.ConfigClientMachineInitial.ClientComparisonReportPath != "" {
+			cfg.ConfigClientMachineInitial.ClientComparisonReportPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientComparisonReportPath)
+		}
+	}
+
+	if strings.ContainsAny(cfg.ConfigClientMachineInitial.TestID, "/ ") {
+		return nil, fmt.Errorf("test id %q must not contain '/' or space", cfg.ConfigClientMachineInitial.TestID)
+	}
+	if cfg.ConfigClientMachineInitial.TestName == "" {
+		cfg.ConfigClientMachineInitial.TestName = cfg.ConfigClientMachineInitial.TestID
+	}
+	if cfg.ConfigClientMachineInitial.TestName == "" {
+		cfg.ConfigClientMachineInitial.TestName = testNameFromTitle(cfg.TestTitle)
+	}
+	if strings.ContainsAny(cfg.ConfigClientMachineInitial.TestName, "/ ") {
+		return nil, fmt.Errorf("test name %q must not contain '/' or space", cfg.ConfigClientMachineInitial.TestName)
+	}
+	if strings.ContainsAny(cfg.ConfigClientMachineInitial.RunTimestamp, "/ ") {
+		return nil, fmt.Errorf("run timestamp %q must not contain '/' or space", cfg.ConfigClientMachineInitial.RunTimestamp)
+	}
+	if cfg.ConfigClientMachineInitial.PortOffset < 0 {
+		return nil, fmt.Errorf("port offset must not be negative, got %d", cfg.ConfigClientMachineInitial.PortOffset)
+	}
+
+	if len(cfg.ConfigClientMachineInitial.ClientReportFormats) > 0 && cfg.ConfigClientMachineInitial.ClientReportDataPath == "" {
+		return nil, fmt.Errorf("'client_report_formats' requires 'client_report_data_path'")
+	}
+	for _, format := range cfg.ConfigClientMachineInitial.ClientReportFormats {
+		if _, ok := reportEncoder(format); !ok {
+			return nil, fmt.Errorf("unknown report format %q (registered %q)", format, RegisteredReportFormats())
+		}
+	}
+
+	for k, v := range cfg.ConfigClientMachineInitial.Labels {
+		if k == "" || v == "" {
+			return nil, fmt.Errorf("label %q=%q has empty key or value", k, v)
+		}
+		if strings.ContainsAny(k, "/=") || strings.Contains(v, "/") {
+			return nil, fmt.Errorf("label %q=%q must not contain '/' (or '=' in key)", k, v)
+		}
 	}
 
 	for databaseID, group := range cfg.DatabaseIDToConfigClientMachineAgentControl {
@@ -98,11 +261,452 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		group.DatabaseID = databaseID
 		group.DatabaseTag = MakeTag(group.DatabaseDescription)
 		group.PeerIPsString = strings.Join(group.PeerIPs, "___")
-		group.DatabaseEndpoints = make([]string, len(group.PeerIPs))
-		group.AgentEndpoints = make([]string, len(group.PeerIPs))
-		for j := range group.PeerIPs {
-			group.DatabaseEndpoints[j] = fmt.Sprintf("%s:%d", group.PeerIPs[j], group.DatabasePortToConnect)
-			group.AgentEndpoints[j] = fmt.Sprintf("%s:%d", group.PeerIPs[j], group.AgentPortToConnect)
+		if group.LatencyProfile != "" && !netem.IsValidProfile(group.LatencyProfile) {
+			return nil, fmt.Errorf("latency profile %q is unknown (available %q)", group.LatencyProfile, netem.Profiles())
+		}
+		if s := group.ConfigClientMachineBenchmarkSteps; s != nil && (s.Step1TimeoutSeconds < 0 || s.Step2TimeoutSeconds < 0) {
+			return nil, fmt.Errorf("'step1_timeout_seconds' and 'step2_timeout_seconds' must not be negative")
+		}
+		if group.DiskWriteDelayMs < 0 || group.DiskReadDelayMs < 0 {
+			return nil, fmt.Errorf("'disk_write_delay_ms' and 'disk_read_delay_ms' must not be negative")
+		}
+		for _, idx := range group.ClockSkewMemberIndexes {
+			if idx < 0 || int(idx) >= len(group.AgentEndpoints) {
+				return nil, fmt.Errorf("invalid 'clock_skew_member_indexes' %d (%d agents)", idx, len(group.AgentEndpoints))
+			}
+		}
+		if group.AntagonistCPUCores < 0 || group.AntagonistMemoryBytes < 0 || group.AntagonistDiskWriteBytesPerSecond < 0 {
+			return nil, fmt.Errorf("antagonist options must not be negative")
+		}
+		switch group.Durability {
+		case "", dbtesterpb.DurabilityFsync:
+		case dbtesterpb.DurabilityNoFsync:
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "zookeeper__r3_5_3_beta":
+			default:
+				// e.g. Consul has no option to skip fsync of its Raft log
+				return nil, fmt.Errorf("'durability' %q is not supported for %q", group.Durability, databaseID)
+			}
+		default:
+			return nil, fmt.Errorf("unknown 'durability' %q (must be %q or %q)", group.Durability, dbtesterpb.DurabilityFsync, dbtesterpb.DurabilityNoFsync)
+		}
+		switch group.DatabaseLogLevel {
+		case "":
+		case dbtesterpb.LogLevelDebug, dbtesterpb.LogLevelInfo, dbtesterpb.LogLevelWarn, dbtesterpb.LogLevelError:
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "zookeeper__r3_5_3_beta", "consul__v1_0_2":
+			case "etcd__v3_2", "etcd__v3_3":
+				// only '--debug' before structured logging of v3.4
+				if group.DatabaseLogLevel != dbtesterpb.LogLevelDebug {
+					return nil, fmt.Errorf("'database_log_level' %q is not supported for %q (must be %q)", group.DatabaseLogLevel, databaseID, dbtesterpb.LogLevelDebug)
+				}
+			default:
+				return nil, fmt.Errorf("'database_log_level' is not supported for %q", databaseID)
+			}
+		default:
+			return nil, fmt.Errorf("unknown 'database_log_level' %q (must be %q, %q, %q or %q)", group.DatabaseLogLevel, dbtesterpb.LogLevelDebug, dbtesterpb.LogLevelInfo, dbtesterpb.LogLevelWarn, dbtesterpb.LogLevelError)
+		}
+		switch group.HardwareCheck {
+		case "", dbtesterpb.HardwareCheckWarn, dbtesterpb.HardwareCheckAbort:
+		default:
+			return nil, fmt.Errorf("unknown 'hardware_check' %q (must be %q or %q)", group.HardwareCheck, dbtesterpb.HardwareCheckWarn, dbtesterpb.HardwareCheckAbort)
+		}
+		if group.HardwareTolerancePercent < 0 {
+			return nil, fmt.Errorf("'hardware_tolerance_percent' must not be negative")
+		}
+		switch group.BroadcastOrder {
+		case "", dbtesterpb.BroadcastOrderParallel, dbtesterpb.BroadcastOrderBootstrapFirst, dbtesterpb.BroadcastOrderSequential:
+		default:
+			return nil, fmt.Errorf("unknown 'broadcast_order' %q (must be %q, %q, or %q)", group.BroadcastOrder, dbtesterpb.BroadcastOrderParallel, dbtesterpb.BroadcastOrderBootstrapFirst, dbtesterpb.BroadcastOrderSequential)
+		}
+		if group.ClockOffsetWarnMs < 0 {
+			return nil, fmt.Errorf("'clock_offset_warn_ms' must not be negative")
+		}
+		for i, h := range group.Hooks {
+			valid := false
+			for _, p := range dbtesterpb.HookPoints {
+				valid = valid || h.Point == p
+			}
+			if !valid {
+				return nil, fmt.Errorf("unknown 'point' %q of hook %d (must be one of %q)", h.Point, i, dbtesterpb.HookPoints)
+			}
+			switch h.Target {
+			case "", dbtesterpb.HookTargetLocal, dbtesterpb.HookTargetAgents:
+			default:
+				return nil, fmt.Errorf("unknown 'target' %q of hook %d (must be %q or %q)", h.Target, i, dbtesterpb.HookTargetLocal, dbtesterpb.HookTargetAgents)
+			}
+			if len(h.Command) == 0 {
+				return nil, fmt.Errorf("empty 'command' of hook %d", i)
+			}
+			if h.TimeoutSeconds < 0 {
+				return nil, fmt.Errorf("'timeout_seconds' of hook %d must not be negative", i)
+			}
+		}
+		if group.EtcdGitRef != "" {
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			default:
+				return nil, fmt.Errorf("'etcd_git_ref' is not supported for %q", databaseID)
+			}
+		}
+		if r := group.ConfigClientMachineBenchmarkOptions.MaxErrorRate; r < 0 || r > 1 {
+			return nil, fmt.Errorf("invalid 'max_error_rate' %f (must be between 0 and 1)", r)
+		}
+		if group.ConfigClientMachineBenchmarkOptions.MaxConsecutiveErrors < 0 {
+			return nil, fmt.Errorf("invalid 'max_consecutive_errors' %d", group.ConfigClientMachineBenchmarkOptions.MaxConsecutiveErrors)
+		}
+		if group.ConfigClientMachineBenchmarkOptions.ListPageSize < 0 || group.ConfigClientMachineBenchmarkOptions.ListIterations < 0 {
+			return nil, fmt.Errorf("'list_page_size' and 'list_iterations' must not be negative")
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "list" && group.ConfigClientMachineBenchmarkOptions.SameKey {
+			return nil, fmt.Errorf("'list' cannot be used with 'same_key'")
+		}
+		switch group.ConfigClientMachineBenchmarkOptions.KeyEncoding {
+		case "", dbtesterpb.KeyEncodingDecimal:
+		case dbtesterpb.KeyEncodingBinary:
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			default:
+				// Zookeeper paths and Consul keys must be valid strings
+				return nil, fmt.Errorf("'key_encoding' %q is not supported for %q", dbtesterpb.KeyEncodingBinary, databaseID)
+			}
+			if group.ConfigClientMachineBenchmarkOptions.KeySizeBytes < 8 {
+				return nil, fmt.Errorf("'key_encoding' %q requires 'key_size_bytes' of at least 8, got %d", dbtesterpb.KeyEncodingBinary, group.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
+			}
+		default:
+			return nil, fmt.Errorf("unknown 'key_encoding' %q (must be %q or %q)", group.ConfigClientMachineBenchmarkOptions.KeyEncoding, dbtesterpb.KeyEncodingDecimal, dbtesterpb.KeyEncodingBinary)
+		}
+		if prefix := group.ConfigClientMachineBenchmarkOptions.KeyPrefix; prefix != "" {
+			if strings.HasPrefix(prefix, "/") || strings.HasSuffix(prefix, "/") || strings.Contains(prefix, "//") {
+				return nil, fmt.Errorf("invalid 'key_prefix' %q (must not start or end with '/', or have empty elements)", prefix)
+			}
+			if group.ConfigClientMachineBenchmarkOptions.SameKey {
+				return nil, fmt.Errorf("'key_prefix' cannot be used with 'same_key'")
+			}
+		}
+		if depth, fanout := group.ConfigClientMachineBenchmarkOptions.KeyPathDepth, group.ConfigClientMachineBenchmarkOptions.KeyPathFanout; depth != 0 {
+			if depth < 0 || fanout < 1 {
+				return nil, fmt.Errorf("invalid 'key_path_depth' %d and 'key_path_fanout' %d", depth, fanout)
+			}
+			if numKeyDirs(depth, fanout) < 0 {
+				return nil, fmt.Errorf("'key_path_depth' %d and 'key_path_fanout' %d exceed %d directories", depth, fanout, maxKeyPathDirs)
+			}
+		}
+		if opts := group.ConfigClientMachineBenchmarkOptions; opts.DropPageCache || opts.ColdRestart {
+			switch opts.Type {
+			case "read", "read-oneshot":
+			default:
+				return nil, fmt.Errorf("'drop_page_cache' is not supported for %q", opts.Type)
+			}
+			if opts.ColdRestart && !opts.DropPageCache {
+				return nil, fmt.Errorf("'cold_restart' requires 'drop_page_cache'")
+			}
+			if opts.ColdRestart {
+				switch databaseID {
+				case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3", "zookeeper__r3_5_3_beta", "consul__v1_0_2":
+				default:
+					return nil, fmt.Errorf("'cold_restart' is not supported for %q", databaseID)
+				}
+			}
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Repeat < 0 {
+			return nil, fmt.Errorf("invalid 'repeat' %d", group.ConfigClientMachineBenchmarkOptions.Repeat)
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Repeat > 1 {
+			if t := group.ConfigClientMachineBenchmarkOptions.Type; t == "backup-restore" || t == "snapshot-transfer" {
+				return nil, fmt.Errorf("'repeat' is not supported for %q", t)
+			}
+			if cfg.ConfigClientMachineInitial.ClientRepeatSummaryPath == "" {
+				return nil, fmt.Errorf("'repeat' requires 'client_repeat_summary_path'")
+			}
+		}
+		if p := group.ConfigClientMachineBenchmarkOptions.CompactionPolicy; p != nil && p.Mode != "" {
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			default:
+				return nil, fmt.Errorf("'compaction_policy' is not supported for %q", databaseID)
+			}
+			if t := group.ConfigClientMachineBenchmarkOptions.Type; t != "write" && t != "watch-compaction" {
+				return nil, fmt.Errorf("'compaction_policy' is only supported for 'write' and 'watch-compaction', got %q", t)
+			}
+			switch p.Mode {
+			case "revision":
+				if p.IntervalRevisions <= 0 || p.RetentionRevisions < 0 {
+					return nil, fmt.Errorf("'revision' compaction requires positive 'interval_revisions' and non-negative 'retention_revisions'")
+				}
+			case "time":
+				if p.IntervalSeconds <= 0 || p.RetentionSeconds < 0 {
+					return nil, fmt.Errorf("'time' compaction requires positive 'interval_seconds' and non-negative 'retention_seconds'")
+				}
+			default:
+				return nil, fmt.Errorf("unknown compaction mode %q (must be 'revision' or 'time')", p.Mode)
+			}
+		}
+		for _, slo := range group.ConfigClientMachineBenchmarkOptions.SLOs {
+			if err := validateSLO(slo); err != nil {
+				return nil, err
+			}
+			if t := group.ConfigClientMachineBenchmarkOptions.Type; t == "backup-restore" || t == "snapshot-transfer" {
+				return nil, fmt.Errorf("'slos' is not supported for %q", t)
+			}
+		}
+		if r := group.ConfigClientMachineBenchmarkOptions.TraceSampleRatio; r < 0 || r > 1 {
+			return nil, fmt.Errorf("invalid 'trace_sample_ratio' %f (must be between 0 and 1)", r)
+		}
+		if group.ConfigClientMachineBenchmarkOptions.TraceSampleRatio > 0 && group.ConfigClientMachineBenchmarkOptions.TraceOTLPEndpoint == "" {
+			return nil, fmt.Errorf("'trace_sample_ratio' requires 'trace_otlp_endpoint'")
+		}
+		for _, ms := range group.ConfigClientMachineBenchmarkOptions.ClientDelaysMs {
+			if ms < 0 {
+				return nil, fmt.Errorf("invalid 'client_delays_ms' %v", group.ConfigClientMachineBenchmarkOptions.ClientDelaysMs)
+			}
+		}
+		if group.ConfigClientMachineBenchmarkOptions.ClientDelayJitterMs < 0 {
+			return nil, fmt.Errorf("invalid 'client_delay_jitter_ms' %d", group.ConfigClientMachineBenchmarkOptions.ClientDelayJitterMs)
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "kubernetes-apiserver" {
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			default:
+				return nil, fmt.Errorf("'kubernetes-apiserver' is not supported for %q", databaseID)
+			}
+			if r := group.ConfigClientMachineBenchmarkOptions.KubernetesListRatio; r < 0 || r > 1 {
+				return nil, fmt.Errorf("invalid 'kubernetes_list_ratio' %f (must be between 0 and 1)", r)
+			}
+			if group.ConfigClientMachineBenchmarkOptions.KubernetesObjects < 0 ||
+				group.ConfigClientMachineBenchmarkOptions.KubernetesHotObjects < 0 ||
+				group.ConfigClientMachineBenchmarkOptions.KubernetesWatchers < 0 {
+				return nil, fmt.Errorf("'kubernetes_objects', 'kubernetes_hot_objects' and 'kubernetes_watchers' must not be negative")
+			}
+		}
+		if opts := group.ConfigClientMachineBenchmarkOptions; opts.Type == "consul-catalog" {
+			if databaseID != "consul__v1_0_2" {
+				return nil, fmt.Errorf("'consul-catalog' is not supported for %q", databaseID)
+			}
+			if opts.ConsulCatalogServices < 0 || opts.ConsulCatalogInstances < 0 {
+				return nil, fmt.Errorf("'consul_catalog_services' and 'consul_catalog_instances' must not be negative")
+			}
+			if opts.ConsulCatalogCheckRatio < 0 || opts.ConsulCatalogQueryRatio < 0 || opts.ConsulCatalogCheckRatio+opts.ConsulCatalogQueryRatio > 1 {
+				return nil, fmt.Errorf("invalid 'consul_catalog_check_ratio' %f and 'consul_catalog_query_ratio' %f (must not be negative, and sum up to at most 1)", opts.ConsulCatalogCheckRatio, opts.ConsulCatalogQueryRatio)
+			}
+		}
+		if enc := group.ConfigClientMachineBenchmarkOptions.ValueEncoding; !isValidValueEncoding(enc) {
+			return nil, fmt.Errorf("unknown 'value_encoding' %q (must be 'random', 'json' or 'protobuf')", enc)
+		}
+		if group.ConfigClientMachineBenchmarkOptions.ValueSchemaFields < 0 || group.ConfigClientMachineBenchmarkOptions.ValueSchemaDepth < 0 {
+			return nil, fmt.Errorf("'value_schema_fields' and 'value_schema_depth' must not be negative")
+		}
+		if group.ConfigClientMachineBenchmarkOptions.DialConcurrency < 0 || group.ConfigClientMachineBenchmarkOptions.DialJitterMs < 0 {
+			return nil, fmt.Errorf("'dial_concurrency' and 'dial_jitter_ms' must not be negative")
+		}
+		switch group.ConfigClientMachineBenchmarkOptions.EtcdCompression {
+		case "", "none":
+		case "gzip":
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			default:
+				return nil, fmt.Errorf("'etcd_compression' is not supported for %q", databaseID)
+			}
+		default:
+			return nil, fmt.Errorf("unknown 'etcd_compression' %q (must be 'none' or 'gzip')", group.ConfigClientMachineBenchmarkOptions.EtcdCompression)
+		}
+		switch group.ConfigClientMachineBenchmarkOptions.ValueCompression {
+		case "", "none":
+		case "gzip":
+			switch group.ConfigClientMachineBenchmarkOptions.Type {
+			case "write", "read":
+			default:
+				return nil, fmt.Errorf("'value_compression' is not supported for %q", group.ConfigClientMachineBenchmarkOptions.Type)
+			}
+		default:
+			// zstd is not vendored
+			return nil, fmt.Errorf("unknown 'value_compression' %q (must be 'none' or 'gzip')", group.ConfigClientMachineBenchmarkOptions.ValueCompression)
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "read-your-writes" {
+			if group.ConfigClientMachineBenchmarkOptions.SameKey {
+				return nil, fmt.Errorf("'read-your-writes' cannot be used with 'same_key'")
+			}
+			if cfg.ConfigClientMachineInitial.ClientReadYourWritesPath == "" {
+				return nil, fmt.Errorf("'read-your-writes' requires 'client_read_your_writes_path'")
+			}
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "read-consistency" {
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			default:
+				return nil, fmt.Errorf("'read-consistency' is not supported for %q", databaseID)
+			}
+			if cfg.ConfigClientMachineInitial.ClientReadConsistencyPath == "" {
+				return nil, fmt.Errorf("'read-consistency' requires 'client_read_consistency_path'")
+			}
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "auth" {
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			case "consul__v1_0_2":
+				if group.Flag_Consul_V1_0_2 == nil || group.Flag_Consul_V1_0_2.ACLMasterToken == "" {
+					return nil, fmt.Errorf("'auth' requires 'acl_master_token' for %q", databaseID)
+				}
+			default:
+				return nil, fmt.Errorf("'auth' is not supported for %q", databaseID)
+			}
+			if cfg.ConfigClientMachineInitial.ClientAuthPenaltyPath == "" {
+				return nil, fmt.Errorf("'auth' requires 'client_auth_penalty_path'")
+			}
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "watch" {
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			default:
+				return nil, fmt.Errorf("'watch' is not supported for %q", databaseID)
+			}
+			if group.ConfigClientMachineBenchmarkOptions.SameKey {
+				return nil, fmt.Errorf("'watch' cannot be used with 'same_key'")
+			}
+			if group.ConfigClientMachineBenchmarkOptions.RequestNumber <= 0 {
+				return nil, fmt.Errorf("'watch' requires positive 'request_number'")
+			}
+			if group.ConfigClientMachineBenchmarkOptions.WatcherNumber < 0 || group.ConfigClientMachineBenchmarkOptions.WatchStreamsPerConnection < 0 {
+				return nil, fmt.Errorf("'watcher_number' and 'watch_streams_per_connection' must not be negative")
+			}
+			if cfg.ConfigClientMachineInitial.ClientWatchSummaryPath == "" {
+				return nil, fmt.Errorf("'watch' requires 'client_watch_summary_path'")
+			}
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "watch-compaction" {
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			default:
+				return nil, fmt.Errorf("'watch-compaction' is not supported for %q", databaseID)
+			}
+			if group.ConfigClientMachineBenchmarkOptions.WatcherNumber < 0 || group.ConfigClientMachineBenchmarkOptions.WatchDisconnectSeconds < 0 {
+				return nil, fmt.Errorf("'watcher_number' and 'watch_disconnect_seconds' must not be negative")
+			}
+			if cfg.ConfigClientMachineInitial.ClientWatchCompactionSummaryPath == "" {
+				return nil, fmt.Errorf("'watch-compaction' requires 'client_watch_compaction_summary_path'")
+			}
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "delete-range" {
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			default:
+				return nil, fmt.Errorf("'delete-range' is not supported for %q", databaseID)
+			}
+			if group.ConfigClientMachineBenchmarkOptions.DeleteRangeKeys < 0 || group.ConfigClientMachineBenchmarkOptions.DeleteRangeProbeSeconds < 0 {
+				return nil, fmt.Errorf("'delete_range_keys' and 'delete_range_probe_seconds' must not be negative")
+			}
+			if cfg.ConfigClientMachineInitial.ClientDeleteRangeSummaryPath == "" {
+				return nil, fmt.Errorf("'delete-range' requires 'client_delete_range_summary_path'")
+			}
+		}
+		if len(group.ConfigClientMachineBenchmarkOptions.PriorityClasses) > 0 {
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			default:
+				return nil, fmt.Errorf("'priority_classes' is not supported for %q", databaseID)
+			}
+			for _, class := range group.ConfigClientMachineBenchmarkOptions.PriorityClasses {
+				if class == "" || strings.Contains(class, "/") {
+					return nil, fmt.Errorf("invalid 'priority_classes' %q (must be non-empty without '/')", class)
+				}
+			}
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "tenancy" {
+			if group.ConfigClientMachineBenchmarkOptions.TenancyReaderKeys < 0 || group.ConfigClientMachineBenchmarkOptions.TenancyWriterClients < 0 {
+				return nil, fmt.Errorf("'tenancy_reader_keys' and 'tenancy_writer_clients' must not be negative")
+			}
+			if group.ConfigClientMachineBenchmarkOptions.RequestNumber < 2 {
+				return nil, fmt.Errorf("'tenancy' requires at least 2 'request_number', got %d", group.ConfigClientMachineBenchmarkOptions.RequestNumber)
+			}
+			if cfg.ConfigClientMachineInitial.ClientTenancySummaryPath == "" {
+				return nil, fmt.Errorf("'tenancy' requires 'client_tenancy_summary_path'")
+			}
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "trace" && group.ConfigClientMachineBenchmarkOptions.TraceFile == "" {
+			return nil, fmt.Errorf("'trace' requires 'trace_file'")
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "backup-restore" {
+			if len(group.ConfigClientMachineBenchmarkOptions.BackupRestoreKeyNumbers) == 0 {
+				return nil, fmt.Errorf("'backup-restore' requires 'backup_restore_key_numbers'")
+			}
+			if cfg.ConfigClientMachineInitial.ClientBackupRestoreSummaryPath == "" {
+				return nil, fmt.Errorf("'backup-restore' requires 'client_backup_restore_summary_path'")
+			}
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "snapshot-transfer" {
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3", "zookeeper__r3_5_3_beta", "consul__v1_0_2":
+			default:
+				return nil, fmt.Errorf("'snapshot-transfer' is not supported for %q", databaseID)
+			}
+			if len(group.PeerIPs) < 3 {
+				return nil, fmt.Errorf("'snapshot-transfer' requires at least 3 members, got %d", len(group.PeerIPs))
+			}
+			if cfg.ConfigClientMachineInitial.ClientSnapshotTransferSummaryPath == "" {
+				return nil, fmt.Errorf("'snapshot-transfer' requires 'client_snapshot_transfer_summary_path'")
+			}
+			if n := etcdSnapshotCount(group); n > 0 && group.ConfigClientMachineBenchmarkOptions.RequestNumber <= n+etcdSnapshotCatchUpEntries {
+				return nil, fmt.Errorf("'snapshot-transfer' requires 'request_number' > %d to force snapshot (snapshot count %d), got %d", n+etcdSnapshotCatchUpEntries, n, group.ConfigClientMachineBenchmarkOptions.RequestNumber)
+			}
+		}
+		if nums := group.ConfigClientMachineBenchmarkOptions.ScalingMemberNumbers; len(nums) > 0 {
+			switch databaseID {
+			case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			default:
+				// members are added and removed with etcd membership APIs,
+				// Zookeeper dynamic reconfig and Consul join/leave are not implemented
+				return nil, fmt.Errorf("'scaling_member_numbers' is only supported for etcd, not %q", databaseID)
+			}
+			if learner, _ := etcdLearnerOptions(group); learner {
+				return nil, fmt.Errorf("'scaling_member_numbers' cannot be used with etcd learner")
+			}
+			for _, n := range nums {
+				if n < 1 || n > int64(len(group.PeerIPs)) {
+					return nil, fmt.Errorf("invalid scaling member number %d (peers %d)", n, len(group.PeerIPs))
+				}
+			}
+		}
+		if group.ConfigClientMachineBenchmarkOptions.UpgradeEtcdGitRef != "" {
+			switch databaseID {
+			case "etcd__other", "etcd__tip":
+			default:
+				return nil, fmt.Errorf("'upgrade_etcd_git_ref' is not supported for %q", databaseID)
+			}
+			if len(group.ConfigClientMachineBenchmarkOptions.ScalingMemberNumbers) > 0 {
+				return nil, fmt.Errorf("'upgrade_etcd_git_ref' cannot be used with 'scaling_member_numbers'")
+			}
+			if learner, _ := etcdLearnerOptions(group); learner {
+				return nil, fmt.Errorf("'upgrade_etcd_git_ref' cannot be used with etcd learner")
+			}
+			if group.ConfigClientMachineBenchmarkOptions.UpgradeIntervalSeconds < 0 {
+				return nil, fmt.Errorf("invalid 'upgrade_interval_seconds' %d", group.ConfigClientMachineBenchmarkOptions.UpgradeIntervalSeconds)
+			}
+		}
+
+		// without peer IPs, 'database_endpoints' are given as-is
+		// (e.g. 'bench' against existing clusters with no agent)
+		if len(group.PeerIPs) > 0 {
+			group.DatabaseEndpoints = make([]string, len(group.PeerIPs))
+			group.AgentEndpoints = make([]string, len(group.PeerIPs))
+			for j := range group.PeerIPs {
+				group.DatabaseEndpoints[j] = fmt.Sprintf("%s:%d", group.PeerIPs[j], group.DatabasePortToConnect+cfg.ConfigClientMachineInitial.PortOffset)
+				group.AgentEndpoints[j] = fmt.Sprintf("%s:%d", group.PeerIPs[j], group.AgentPortToConnect)
+			}
+		}
+		if len(group.EndpointZones) > 0 && len(group.EndpointZones) != len(group.DatabaseEndpoints) {
+			return nil, fmt.Errorf("'endpoint_zones' %d must match the number of endpoints %d", len(group.EndpointZones), len(group.DatabaseEndpoints))
+		}
+		if group.ClientZone != "" {
+			found := false
+			for _, z := range group.EndpointZones {
+				found = found || z == group.ClientZone
+			}
+			if !found {
+				return nil, fmt.Errorf("'client_zone' %q has no endpoint in 'endpoint_zones' %q", group.ClientZone, group.EndpointZones)
+			}
+		}
+		if group.ZoneSpilloverPercent < 0 || group.ZoneSpilloverPercent > 100 {
+			return nil, fmt.Errorf("invalid 'zone_spillover_percent' %v (must be between 0 and 100)", group.ZoneSpilloverPercent)
 		}
 		cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = group
 	}
@@ -128,8 +732,40 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 				amc.ServerSystemMetricsInterpolatedPathList[i] = amc.PathPrefix + "-" + amc.ServerSystemMetricsInterpolatedPathList[i]
 			}
 			amc.AllAggregatedOutputPath = amc.PathPrefix + "-" + amc.AllAggregatedOutputPath
+			if amc.ClientLatencyHeatmapPath != "" {
+				amc.ClientLatencyHeatmapPath = amc.PathPrefix + "-" + amc.ClientLatencyHeatmapPath
+			}
+			if amc.ClientLatencyHeatmapPlotPath != "" {
+				amc.ClientLatencyHeatmapPlotPath = amc.PathPrefix + "-" + amc.ClientLatencyHeatmapPlotPath
+			}
+			if amc.ClientReadConsistencyPath != "" {
+				amc.ClientReadConsistencyPath = amc.PathPrefix + "-" + amc.ClientReadConsistencyPath
+			}
+			if amc.ClientReadConsistencyPlotPath != "" {
+				amc.ClientReadConsistencyPlotPath = amc.PathPrefix + "-" + amc.ClientReadConsistencyPlotPath
+			}
+			for i := range amc.ServerEtcdStatusPathList {
+				amc.ServerEtcdStatusPathList[i] = amc.PathPrefix + "-" + amc.ServerEtcdStatusPathList[i]
+			}
+			if amc.ClientLatencySpikeCorrelationPath != "" {
+				amc.ClientLatencySpikeCorrelationPath = amc.PathPrefix + "-" + amc.ClientLatencySpikeCorrelationPath
+			}
+			if amc.ClientPhasesPath != "" {
+				amc.ClientPhasesPath = amc.PathPrefix + "-" + amc.ClientPhasesPath
+			}
+			for _, p := range []*string{&amc.ClientTimelinePath, &amc.ClientClockOffsetsPath, &amc.ClientUpgradesPath, &amc.ClientMembershipChangesPath} {
+				if *p != "" {
+					*p = amc.PathPrefix + "-" + *p
+				}
+			}
+			for i := range amc.ServerDatabaseEventsPathList {
+				amc.ServerDatabaseEventsPathList[i] = amc.PathPrefix + "-" + amc.ServerDatabaseEventsPathList[i]
+			}
 		}
 
+		if amc.ClientLatencySpikeCorrelationPath != "" && (amc.ClientLatencyHeatmapPath == "" || len(amc.ServerEtcdStatusPathList) == 0) {
+			return nil, fmt.Errorf("'client_latency_spike_correlation_path' requires 'client_latency_heatmap_path' and 'server_etcd_status_path_list'")
+		}
 		cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID] = amc
 	}
 
@@ -171,6 +807,16 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		if v.Flag_Etcd_Other.QuotaSizeBytes == 0 {
 			v.Flag_Etcd_Other.QuotaSizeBytes = defaultEtcdQuotaSizeBytes
 		}
+		if v.Flag_Etcd_Other.Learner && len(v.PeerIPs) < 2 {
+			return nil, fmt.Errorf("etcd learner requires at least 2 peers, got %d", len(v.PeerIPs))
+		}
+		// gRPC proxies are signaled after all members,
+		// so that 'IPIndex' beyond peer IPs indicates a proxy
+		if len(v.PeerIPs) > 0 {
+			for _, ip := range v.Flag_Etcd_Other.GRPCProxyIPs {
+				v.AgentEndpoints = append(v.AgentEndpoints, fmt.Sprintf("%s:%d", ip, v.AgentPortToConnect))
+			}
+		}
 		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__other.String()] = v
 	}
 	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__tip.String()]; ok {
@@ -186,6 +832,16 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		if v.Flag_Etcd_Tip.QuotaSizeBytes == 0 {
 			v.Flag_Etcd_Tip.QuotaSizeBytes = defaultEtcdQuotaSizeBytes
 		}
+		if v.Flag_Etcd_Tip.Learner && len(v.PeerIPs) < 2 {
+			return nil, fmt.Errorf("etcd learner requires at least 2 peers, got %d", len(v.PeerIPs))
+		}
+		// gRPC proxies are signaled after all members,
+		// so that 'IPIndex' beyond peer IPs indicates a proxy
+		if len(v.PeerIPs) > 0 {
+			for _, ip := range v.Flag_Etcd_Tip.GRPCProxyIPs {
+				v.AgentEndpoints = append(v.AgentEndpoints, fmt.Sprintf("%s:%d", ip, v.AgentPortToConnect))
+			}
+		}
 		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__tip.String()] = v
 	}
 	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_2.String()]; ok {
@@ -245,6 +901,12 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		if v.Flag_Zookeeper_R3_5_3Beta.MaxClientConnections == 0 {
 			v.Flag_Zookeeper_R3_5_3Beta.MaxClientConnections = defaultZookeeperMaxClientConnections
 		}
+		if v.Flag_Zookeeper_R3_5_3Beta.ObserverNumber < 0 || (len(v.PeerIPs) > 0 && v.Flag_Zookeeper_R3_5_3Beta.ObserverNumber >= int64(len(v.PeerIPs))) {
+			return nil, fmt.Errorf("invalid Zookeeper observer number %d (peers %d)", v.Flag_Zookeeper_R3_5_3Beta.ObserverNumber, len(v.PeerIPs))
+		}
+		if v.Flag_Zookeeper_R3_5_3Beta.ReadFromObservers && v.Flag_Zookeeper_R3_5_3Beta.ObserverNumber == 0 {
+			return nil, fmt.Errorf("'read_from_observers' requires 'observer_number' > 0")
+		}
 		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta.String()] = v
 	}
 
@@ -255,6 +917,36 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		if v.DatabasePortToConnect == 0 {
 			v.DatabasePortToConnect = defaultConsulClientPort
 		}
+		// Consul client agents are signaled after all servers,
+		// so that 'IPIndex' beyond peer IPs indicates a client agent
+		if v.Flag_Consul_V1_0_2 != nil && len(v.PeerIPs) > 0 {
+			for _, ip := range v.Flag_Consul_V1_0_2.ClientAgentIPs {
+				v.AgentEndpoints = append(v.AgentEndpoints, fmt.Sprintf("%s:%d", ip, v.AgentPortToConnect))
+			}
+		}
+		if v.Flag_Consul_V1_0_2 != nil && len(v.Flag_Consul_V1_0_2.DatacenterSizes) > 0 {
+			total := 0
+			for _, n := range v.Flag_Consul_V1_0_2.DatacenterSizes {
+				if n <= 0 {
+					return nil, fmt.Errorf("invalid Consul datacenter size %d", n)
+				}
+				total += int(n)
+			}
+			if total != len(v.PeerIPs) {
+				return nil, fmt.Errorf("Consul datacenter sizes add up to %d, but got %d peers", total, len(v.PeerIPs))
+			}
+		}
+		if v.Flag_Consul_V1_0_2 != nil && v.Flag_Consul_V1_0_2.TargetDatacenter != "" {
+			ok := false
+			for i := range v.Flag_Consul_V1_0_2.DatacenterSizes {
+				if v.Flag_Consul_V1_0_2.TargetDatacenter == fmt.Sprintf("dc%d", i+1) {
+					ok = true
+				}
+			}
+			if !ok {
+				return nil, fmt.Errorf("unknown Consul target datacenter %q (datacenter sizes %v)", v.Flag_Consul_V1_0_2.TargetDatacenter, v.Flag_Consul_V1_0_2.DatacenterSizes)
+			}
+		}
 		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_consul__v1_0_2.String()] = v
 	}
 
@@ -278,6 +970,25 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		}
 	}
 
+	for databaseID, gcfg := range cfg.DatabaseIDToConfigClientMachineAgentControl {
+		if gcfg.ConfigClientMachineBenchmarkOptions == nil {
+			continue
+		}
+		// fail before starting databases, rather than deep inside clients
+		n := gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes + gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes
+		if max := maxRequestBytes(databaseID, gcfg); n+requestOverheadBytes > max {
+			return nil, fmt.Errorf("%q key and value size %d bytes exceed the server request limit %d bytes (including %d bytes of request overhead); reduce 'value_size_bytes' or raise the limit (e.g. etcd 'max_request_bytes', Zookeeper 'java_d_jute_max_buffer')", databaseID, n, max, requestOverheadBytes)
+		}
+	}
+
+	for _, d := range cfg.ConfigClientMachineInitial.Destinations() {
+		switch d.Type {
+		case "google-cloud-storage", "":
+			if cfg.ConfigClientMachineInitial.GoogleCloudStorageKeyPath == "" && !analyze {
+				return nil, fmt.Errorf("remote storage %q is Google Cloud Storage, but 'google_cloud_storage_key_path' is empty", d.Name)
+			}
+		}
+	}
 	if cfg.ConfigClientMachineInitial.GoogleCloudStorageKeyPath != "" && !analyze {
 		bts, err = ioutil.ReadFile(cfg.ConfigClientMachineInitial.GoogleCloudStorageKeyPath)
 		if err != nil {
@@ -285,6 +996,12 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		}
 		cfg.ConfigClientMachineInitial.GoogleCloudStorageKey = string(bts)
 	}
+	if cfg.ConfigClientMachineInitial.AgentAuthSecretPath != "" && !analyze {
+		cfg.agentAuthSecret, err = agentauth.ReadSecret(cfg.ConfigClientMachineInitial.AgentAuthSecretPath)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	for i := range cfg.AnalyzePlotList {
 		cfg.AnalyzePlotList[i].OutputPathCSV = filepath.Join(cfg.AnalyzePlotPathPrefix, cfg.AnalyzePlotList[i].Column+".csv")
@@ -298,6 +1015,59 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 
 const maxEtcdQuotaSize = 8000000000
 
+const (
+	// defaultEtcdMaxRequestBytes is etcd '--max-request-bytes' default.
+	defaultEtcdMaxRequestBytes = 1.5 * 1024 * 1024
+	// defaultZookeeperJuteMaxBuffer is Zookeeper '-Djute.maxbuffer' default.
+	defaultZookeeperJuteMaxBuffer = 0xfffff
+	// maxConsulValueBytes is the maximum size of Consul KV value.
+	maxConsulValueBytes = 512 * 1024
+
+	// requestOverheadBytes is reserved for request encoding
+	// other than the key and value.
+	requestOverheadBytes = 512
+)
+
+// maxRequestBytes returns the maximum size of a write request
+// that the database server accepts.
+func maxRequestBytes(databaseID string, gcfg dbtesterpb.ConfigClientMachineAgentControl) int64 {
+	var n int64
+	switch databaseID {
+	case "etcd__other":
+		n = gcfg.Flag_Etcd_Other.MaxRequestBytes
+	case "etcd__tip":
+		n = gcfg.Flag_Etcd_Tip.MaxRequestBytes
+	case "etcd__v3_2":
+		n = gcfg.Flag_Etcd_V3_2.MaxRequestBytes
+	case "etcd__v3_3":
+		n = gcfg.Flag_Etcd_V3_3.MaxRequestBytes
+	case "zookeeper__r3_5_3_beta":
+		if gcfg.Flag_Zookeeper_R3_5_3Beta != nil {
+			n = int64(gcfg.Flag_Zookeeper_R3_5_3Beta.JavaDJuteMaxBuffer)
+		}
+		if n == 0 {
+			n = defaultZookeeperJuteMaxBuffer
+		}
+		return n
+	case "consul__v1_0_2":
+		// only the value size is limited
+		return maxConsulValueBytes + gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes + requestOverheadBytes
+	}
+	if n == 0 {
+		n = defaultEtcdMaxRequestBytes
+	}
+	return n
+}
+
+// SetLabel sets the label of results, to compare runs
+// of different settings in one matrix.
+func (cfg *Config) SetLabel(k, v string) {
+	if cfg.ConfigClientMachineInitial.Labels == nil {
+		cfg.ConfigClientMachineInitial.Labels = make(map[string]string)
+	}
+	cfg.ConfigClientMachineInitial.Labels[k] = v
+}
+
 // ToRequest converts configuration to 'dbtesterpb.Request'.
 func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int) (req *dbtesterpb.Request, err error) {
 	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
@@ -315,12 +1085,39 @@ func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int
 		PeerIPsString:       gcfg.PeerIPsString,
 		IPIndex:             uint32(idx),
 		CurrentClientNumber: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+		LatencyProfile:      gcfg.LatencyProfile,
+		EtcdGitRef:          gcfg.EtcdGitRef,
+		DiskWriteDelayMs:    gcfg.DiskWriteDelayMs,
+		DiskReadDelayMs:     gcfg.DiskReadDelayMs,
+		ClockSkewMs:         gcfg.ClockSkewMs,
+		TestID:              cfg.ConfigClientMachineInitial.TestID,
+		PortOffset:          cfg.ConfigClientMachineInitial.PortOffset,
 		ConfigClientMachineInitial: &dbtesterpb.ConfigClientMachineInitial{
 			GoogleCloudProjectName:         cfg.ConfigClientMachineInitial.GoogleCloudProjectName,
 			GoogleCloudStorageKey:          cfg.ConfigClientMachineInitial.GoogleCloudStorageKey,
 			GoogleCloudStorageBucketName:   cfg.ConfigClientMachineInitial.GoogleCloudStorageBucketName,
 			GoogleCloudStorageSubDirectory: cfg.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory,
+			RemoteStorageDestinations:      cfg.ConfigClientMachineInitial.RemoteStorageDestinations,
+			Labels:                         cfg.ConfigClientMachineInitial.Labels,
+			TestName:                       cfg.ConfigClientMachineInitial.TestName,
+			RunTimestamp:                   cfg.ConfigClientMachineInitial.RunTimestamp,
 		},
+
+		AntagonistCPUCores:                gcfg.AntagonistCPUCores,
+		AntagonistMemoryBytes:             gcfg.AntagonistMemoryBytes,
+		AntagonistDiskWriteBytesPerSecond: gcfg.AntagonistDiskWriteBytesPerSecond,
+		Durability:                        gcfg.Durability,
+		DatabaseLogLevel:                  gcfg.DatabaseLogLevel,
+		ConnectionNumber:                  maxConnectionNumber(gcfg.ConfigClientMachineBenchmarkOptions),
+	}
+	if op == dbtesterpb.Operation_Stop {
+		req.Phases = cfg.phasesForAgents()
+	}
+	if op == dbtesterpb.Operation_Upgrade {
+		req.EtcdGitRef = gcfg.ConfigClientMachineBenchmarkOptions.UpgradeEtcdGitRef
+	}
+	if op == dbtesterpb.Operation_DropPageCache {
+		req.RestartDatabase = gcfg.ConfigClientMachineBenchmarkOptions.ColdRestart
 	}
 
 	switch req.DatabaseID {
@@ -330,8 +1127,13 @@ func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int
 			return
 		}
 		req.Flag_Etcd_Other = &dbtesterpb.Flag_Etcd_Other{
-			SnapshotCount:  gcfg.Flag_Etcd_Other.SnapshotCount,
-			QuotaSizeBytes: gcfg.Flag_Etcd_Other.QuotaSizeBytes,
+			SnapshotCount:         gcfg.Flag_Etcd_Other.SnapshotCount,
+			QuotaSizeBytes:        gcfg.Flag_Etcd_Other.QuotaSizeBytes,
+			Learner:               gcfg.Flag_Etcd_Other.Learner,
+			LearnerPromoteSeconds: gcfg.Flag_Etcd_Other.LearnerPromoteSeconds,
+			MaxRequestBytes:       gcfg.Flag_Etcd_Other.MaxRequestBytes,
+			ExtraFlags:            gcfg.Flag_Etcd_Other.ExtraFlags,
+			GRPCProxyIPs:          gcfg.Flag_Etcd_Other.GRPCProxyIPs,
 		}
 	case dbtesterpb.DatabaseID_etcd__tip:
 		if gcfg.Flag_Etcd_Tip.QuotaSizeBytes > maxEtcdQuotaSize {
@@ -339,8 +1141,13 @@ func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int
 			return
 		}
 		req.Flag_Etcd_Tip = &dbtesterpb.Flag_Etcd_Tip{
-			SnapshotCount:  gcfg.Flag_Etcd_Tip.SnapshotCount,
-			QuotaSizeBytes: gcfg.Flag_Etcd_Tip.QuotaSizeBytes,
+			SnapshotCount:         gcfg.Flag_Etcd_Tip.SnapshotCount,
+			QuotaSizeBytes:        gcfg.Flag_Etcd_Tip.QuotaSizeBytes,
+			Learner:               gcfg.Flag_Etcd_Tip.Learner,
+			LearnerPromoteSeconds: gcfg.Flag_Etcd_Tip.LearnerPromoteSeconds,
+			MaxRequestBytes:       gcfg.Flag_Etcd_Tip.MaxRequestBytes,
+			ExtraFlags:            gcfg.Flag_Etcd_Tip.ExtraFlags,
+			GRPCProxyIPs:          gcfg.Flag_Etcd_Tip.GRPCProxyIPs,
 		}
 	case dbtesterpb.DatabaseID_etcd__v3_2:
 		if gcfg.Flag_Etcd_V3_2.QuotaSizeBytes > maxEtcdQuotaSize {
@@ -348,8 +1155,9 @@ func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int
 			return
 		}
 		req.Flag_Etcd_V3_2 = &dbtesterpb.Flag_Etcd_V3_2{
-			SnapshotCount:  gcfg.Flag_Etcd_V3_2.SnapshotCount,
-			QuotaSizeBytes: gcfg.Flag_Etcd_V3_2.QuotaSizeBytes,
+			SnapshotCount:   gcfg.Flag_Etcd_V3_2.SnapshotCount,
+			QuotaSizeBytes:  gcfg.Flag_Etcd_V3_2.QuotaSizeBytes,
+			MaxRequestBytes: gcfg.Flag_Etcd_V3_2.MaxRequestBytes,
 		}
 	case dbtesterpb.DatabaseID_etcd__v3_3:
 		if gcfg.Flag_Etcd_V3_3.QuotaSizeBytes > maxEtcdQuotaSize {
@@ -357,8 +1165,9 @@ func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int
 			return
 		}
 		req.Flag_Etcd_V3_3 = &dbtesterpb.Flag_Etcd_V3_3{
-			SnapshotCount:  gcfg.Flag_Etcd_V3_3.SnapshotCount,
-			QuotaSizeBytes: gcfg.Flag_Etcd_V3_3.QuotaSizeBytes,
+			SnapshotCount:   gcfg.Flag_Etcd_V3_3.SnapshotCount,
+			QuotaSizeBytes:  gcfg.Flag_Etcd_V3_3.QuotaSizeBytes,
+			MaxRequestBytes: gcfg.Flag_Etcd_V3_3.MaxRequestBytes,
 		}
 
 	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
@@ -373,9 +1182,19 @@ func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int
 			SyncLimit:            gcfg.Flag_Zookeeper_R3_5_3Beta.SyncLimit,
 			SnapCount:            gcfg.Flag_Zookeeper_R3_5_3Beta.SnapCount,
 			MaxClientConnections: gcfg.Flag_Zookeeper_R3_5_3Beta.MaxClientConnections,
+			ObserverNumber:       gcfg.Flag_Zookeeper_R3_5_3Beta.ObserverNumber,
+			ReadFromObservers:    gcfg.Flag_Zookeeper_R3_5_3Beta.ReadFromObservers,
 		}
 
 	case dbtesterpb.DatabaseID_consul__v1_0_2:
+		if gcfg.Flag_Consul_V1_0_2 != nil {
+			req.Flag_Consul_V1_0_2 = &dbtesterpb.Flag_Consul_V1_0_2{
+				ClientAgentIPs:   gcfg.Flag_Consul_V1_0_2.ClientAgentIPs,
+				DatacenterSizes:  gcfg.Flag_Consul_V1_0_2.DatacenterSizes,
+				TargetDatacenter: gcfg.Flag_Consul_V1_0_2.TargetDatacenter,
+				ACLMasterToken:   gcfg.Flag_Consul_V1_0_2.ACLMasterToken,
+			}
+		}
 
 	case dbtesterpb.DatabaseID_zetcd__beta:
 	case dbtesterpb.DatabaseID_cetcd__beta:
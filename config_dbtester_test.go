@@ -15,7 +15,10 @@
 package dbtester
 
 import (
+	"io/ioutil"
+	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
@@ -56,6 +59,8 @@ func TestConfig(t *testing.T) {
 			GoogleCloudStorageKey:                   "test-key",
 			GoogleCloudStorageBucketName:            "dbtester-results",
 			GoogleCloudStorageSubDirectory:          "2017Q1-01-etcd-zookeeper-consul/01-write-1M-keys-client-variable",
+			Labels:                                  map[string]string{"machine-type": "n1-standard-16", "disk-type": "pd-ssd"},
+			TestName:                                "write-1m-keys-256-byte-key-1kb-value-value-clients-1-to-1-000",
 		},
 		AllDatabaseIDList: []string{"etcd__tip", "zookeeper__r3_5_3_beta", "consul__v1_0_2"},
 		DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
@@ -492,9 +497,15 @@ func TestConfig(t *testing.T) {
 			},
 		},
 	}
+	// logger is created and file contents are kept on read
+	expected.lg = cfg.lg
+	expected.rawConfig = cfg.rawConfig
 	if !reflect.DeepEqual(cfg, expected) {
 		t.Fatalf("configuration expected\n%+v\n, got\n%+v\n", expected, cfg)
 	}
+	if lp := cfg.ConfigClientMachineInitial.LabelPath(); lp != "disk-type=pd-ssd/machine-type=n1-standard-16" {
+		t.Fatalf("unexpected label path %q", lp)
+	}
 
 	req1, err := cfg.ToRequest("etcd__tip", dbtesterpb.Operation_Start, 0)
 	if err != nil {
@@ -508,11 +519,14 @@ func TestConfig(t *testing.T) {
 		PeerIPsString:       "10.240.0.7___10.240.0.8___10.240.0.12",
 		IPIndex:             0,
 		CurrentClientNumber: 0,
+		ConnectionNumber:    1000,
 		ConfigClientMachineInitial: &dbtesterpb.ConfigClientMachineInitial{
 			GoogleCloudProjectName:         "etcd-development",
 			GoogleCloudStorageKey:          "test-key",
 			GoogleCloudStorageBucketName:   "dbtester-results",
 			GoogleCloudStorageSubDirectory: "2017Q1-01-etcd-zookeeper-consul/01-write-1M-keys-client-variable",
+			Labels:                         map[string]string{"machine-type": "n1-standard-16", "disk-type": "pd-ssd"},
+			TestName:                       "write-1m-keys-256-byte-key-1kb-value-value-clients-1-to-1-000",
 		},
 		Flag_Etcd_Tip: &dbtesterpb.Flag_Etcd_Tip{
 			SnapshotCount:  100000,
@@ -535,11 +549,14 @@ func TestConfig(t *testing.T) {
 		PeerIPsString:       "10.240.0.21___10.240.0.22___10.240.0.23",
 		IPIndex:             2,
 		CurrentClientNumber: 0,
+		ConnectionNumber:    1000,
 		ConfigClientMachineInitial: &dbtesterpb.ConfigClientMachineInitial{
 			GoogleCloudProjectName:         "etcd-development",
 			GoogleCloudStorageKey:          "test-key",
 			GoogleCloudStorageBucketName:   "dbtester-results",
 			GoogleCloudStorageSubDirectory: "2017Q1-01-etcd-zookeeper-consul/01-write-1M-keys-client-variable",
+			Labels:                         map[string]string{"machine-type": "n1-standard-16", "disk-type": "pd-ssd"},
+			TestName:                       "write-1m-keys-256-byte-key-1kb-value-value-clients-1-to-1-000",
 		},
 		Flag_Zookeeper_R3_5_3Beta: &dbtesterpb.Flag_Zookeeper_R3_5_3Beta{
 			JavaDJuteMaxBuffer:   33554432,
@@ -558,3 +575,82 @@ func TestConfig(t *testing.T) {
 		t.Fatalf("configuration expected\n%+v\n, got\n%+v\n", expected2, req2)
 	}
 }
+
+func Test_maxRequestBytes(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		Flag_Etcd_Tip:                       &dbtesterpb.Flag_Etcd_Tip{},
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{KeySizeBytes: 256},
+	}
+	if n := maxRequestBytes("etcd__tip", gcfg); n != defaultEtcdMaxRequestBytes {
+		t.Fatalf("expected etcd default %d, got %d", int64(defaultEtcdMaxRequestBytes), n)
+	}
+	gcfg.Flag_Etcd_Tip.MaxRequestBytes = 10 * 1024 * 1024
+	if n := maxRequestBytes("etcd__tip", gcfg); n != 10*1024*1024 {
+		t.Fatalf("expected %d, got %d", 10*1024*1024, n)
+	}
+	if n := maxRequestBytes("zookeeper__r3_5_3_beta", gcfg); n != defaultZookeeperJuteMaxBuffer {
+		t.Fatalf("expected Zookeeper default %d, got %d", defaultZookeeperJuteMaxBuffer, n)
+	}
+}
+
+func TestOffline(t *testing.T) {
+	var ci *dbtesterpb.ConfigClientMachineInitial
+	if !ci.Offline() {
+		t.Fatal("expected nil configuration to be offline")
+	}
+	ci = &dbtesterpb.ConfigClientMachineInitial{}
+	if !ci.Offline() {
+		t.Fatal("expected no remote storage to be offline")
+	}
+	ci.GoogleCloudStorageBucketName = "dbtester-results"
+	if ci.Offline() {
+		t.Fatal("expected Google Cloud Storage bucket to be online")
+	}
+	ci = &dbtesterpb.ConfigClientMachineInitial{
+		RemoteStorageDestinations: []*dbtesterpb.RemoteStorageDestination{{Name: "nfs", Type: "local", LocalDirectory: "/mnt/results"}},
+	}
+	if ci.Offline() {
+		t.Fatal("expected local destination to be online")
+	}
+}
+
+func TestConfigScalingMemberNumbers(t *testing.T) {
+	bts, err := ioutil.ReadFile("config_dbtester_test.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// benchmark options of etcd, Zookeeper, and Consul in order
+	parts := strings.Split(string(bts), "    benchmark_options:\n")
+	if len(parts) != 4 {
+		t.Fatalf("expected 3 benchmark options, got %d", len(parts)-1)
+	}
+	tests := []struct {
+		databaseID string
+		err        bool
+	}{
+		{"etcd__tip", false},
+		{"zookeeper__r3_5_3_beta", true},
+		{"consul__v1_0_2", true},
+	}
+	for i, tt := range tests {
+		ps := append([]string(nil), parts...)
+		ps[i+1] = "      scaling_member_numbers: [1, 3]\n" + ps[i+1]
+		f, err := ioutil.TempFile("", "dbtester-config")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = f.WriteString(strings.Join(ps, "    benchmark_options:\n")); err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+
+		_, err = ReadConfig(f.Name(), false)
+		os.Remove(f.Name())
+		if (err != nil) != tt.err {
+			t.Fatalf("%q: error expected %v, got %v", tt.databaseID, tt.err, err)
+		}
+		if tt.err && !strings.Contains(err.Error(), tt.databaseID) {
+			t.Fatalf("%q: unexpected error %v", tt.databaseID, err)
+		}
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/gyuho/linux-inspect/top"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
+	"golang.org/x/net/context"
 )
 
 // Command implements 'control' command.
@@ -41,9 +42,16 @@ var Command = &cobra.Command{
 }
 
 var databaseID string
+var databaseIDs string
 var configPath string
+var adminAddr string
+var recordPath string
+var historyPath string
+var steps string
+var sessionPath string
 var diskDevice string
 var networkInterface string
+var etcdGitRef string
 
 func init() {
 	dn, err := df.GetDevice("/")
@@ -62,12 +70,22 @@ func init() {
 
 	ids := dbtesterpb.GetAllDatabaseIDs()
 	Command.PersistentFlags().StringVar(&databaseID, "database-id", ids[0], strings.Join(ids, ", "))
+	Command.PersistentFlags().StringVar(&databaseIDs, "database-ids", "", "Comma-separated database ids to run the same workload against in turn, with one run timestamp and a combined 'client_comparison_report_path', overriding '--database-id' (empty to disable).")
 	Command.PersistentFlags().StringVarP(&configPath, "config", "c", "", "YAML configuration file path.")
+	Command.PersistentFlags().StringVar(&adminAddr, "admin-addr", "", "Address of the admin HTTP server to pause ('POST /pause') and resume ('POST /resume') request generation, either 'host:port' or 'unix://<socket-path>' (empty to disable).")
+	Command.PersistentFlags().StringVar(&recordPath, "record", "", "File path to record generated requests to, for replays with 'trace' type and 'trace_file' (empty to disable).")
+	Command.PersistentFlags().StringVar(&historyPath, "history", defaultHistoryPath(), "Local results history SQLite file to append the summary of each run to, for 'dbtester history' (empty to disable).")
+	Command.PersistentFlags().StringVar(&steps, "steps", "", "Comma-separated benchmark steps to run (e.g. '2' to only stress a running cluster, '3,4' to only stop and upload), overriding 'benchmark_steps' (empty to use the configuration).")
+	Command.PersistentFlags().StringVar(&sessionPath, "session", "", "Session file of the running cluster, written after step 1 and removed after step 3. Without step 1, the cluster of the session is reused (e.g. '--steps 2' with different workloads) (empty to disable).")
+	Command.PersistentFlags().StringVar(&etcdGitRef, "etcd-git-ref", "", "etcd git ref to build and run, overriding 'etcd_git_ref' (empty to use the configuration).")
 	Command.PersistentFlags().StringVar(&diskDevice, "disk-device", dn, "Disk device to collect disk statistics metrics from.")
 	Command.PersistentFlags().StringVar(&networkInterface, "network-interface", nt, "Network interface to record in/outgoing packets.")
 }
 
 func commandFunc(cmd *cobra.Command, args []string) error {
+	if databaseIDs != "" {
+		return compareDatabases(strings.Split(databaseIDs, ","))
+	}
 	if !dbtesterpb.IsValidDatabaseID(databaseID) {
 		return fmt.Errorf("database id %q is unknown", databaseID)
 	}
@@ -76,16 +94,197 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	return runDatabase(cfg, databaseID)
+}
+
+// compareDatabases runs the same workload against each database in turn,
+// with one run timestamp so that all results are uploaded as one result
+// set, and writes the comparison report of all databases.
+func compareDatabases(ids []string) error {
+	switch {
+	case sessionPath != "":
+		return fmt.Errorf("'--session' is not supported with '--database-ids'")
+	case recordPath != "":
+		return fmt.Errorf("'--record' is not supported with '--database-ids'")
+	case etcdGitRef != "":
+		return fmt.Errorf("'--etcd-git-ref' is not supported with '--database-ids'")
+	}
+	for i := range ids {
+		ids[i] = strings.TrimSpace(ids[i])
+		if !dbtesterpb.IsValidDatabaseID(ids[i]) {
+			return fmt.Errorf("database id %q is unknown", ids[i])
+		}
+	}
+
+	cfg, err := dbtester.ReadConfig(configPath, false)
+	if err != nil {
+		return err
+	}
+	if cfg.ConfigClientMachineInitial.ClientComparisonReportPath == "" {
+		return fmt.Errorf("'--database-ids' requires 'client_comparison_report_path'")
+	}
+	if err = cfg.CheckComparable(ids); err != nil {
+		return err
+	}
+	cfg.SetRunTimestamp()
+	runTimestamp := cfg.ConfigClientMachineInitial.RunTimestamp
+
+	var (
+		runs   []dbtester.ComparisonRun
+		failed []string
+		upload bool
+	)
+	for i, id := range ids {
+		if i > 0 {
+			// no state of the last database is reused,
+			// and its local results are overwritten
+			if cfg, err = dbtester.ReadConfig(configPath, false); err != nil {
+				return err
+			}
+			cfg.ConfigClientMachineInitial.RunTimestamp = runTimestamp
+		}
+		println()
+		lg.Info("comparison: running database", zap.String("database-id", id), zap.Int("index", i+1), zap.Int("total", len(ids)))
+		rerr := runDatabase(cfg, id)
+		if rerr != nil {
+			// databases are independent, so the others are still compared
+			lg.Warn("comparison: database failed", zap.String("database-id", id), zap.Error(rerr))
+			failed = append(failed, id)
+		}
+		run, err := cfg.ComparisonRun(id, rerr == nil)
+		if err != nil {
+			return err
+		}
+		runs = append(runs, run)
+		upload = upload || cfg.DatabaseIDToConfigClientMachineAgentControl[id].ConfigClientMachineBenchmarkSteps.Step4UploadLogs
+	}
+
+	println()
+	paths, err := cfg.SaveComparisonReport(runs)
+	if err != nil {
+		return err
+	}
+	lg.Info("comparison: saved report", zap.Strings("paths", paths))
+	if upload {
+		lg.Info("comparison: uploading report...", zap.String("run-path", cfg.ConfigClientMachineInitial.RunPath()))
+		if err = cfg.UploadComparisonReport(paths...); err != nil {
+			return err
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%d of %d databases failed: %s", len(failed), len(ids), strings.Join(failed, ", "))
+	}
+	lg.Info("comparison: all done!")
+	return nil
+}
+
+// runDatabase runs the benchmark steps against the database.
+func runDatabase(cfg *dbtester.Config, databaseID string) (err error) {
 	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 	if !ok {
 		return fmt.Errorf("%q is not found", databaseID)
 	}
+	if steps != "" {
+		ss, err := parseSteps(steps)
+		if err != nil {
+			return err
+		}
+		lg.Info("overriding benchmark steps", zap.String("steps", steps))
+		if gcfg.ConfigClientMachineBenchmarkSteps != nil {
+			ss.Step1TimeoutSeconds = gcfg.ConfigClientMachineBenchmarkSteps.Step1TimeoutSeconds
+			ss.Step2TimeoutSeconds = gcfg.ConfigClientMachineBenchmarkSteps.Step2TimeoutSeconds
+		}
+		gcfg.ConfigClientMachineBenchmarkSteps = ss
+		cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = gcfg
+	}
+	if gcfg.ConfigClientMachineBenchmarkSteps.Step4UploadLogs && cfg.ConfigClientMachineInitial.Offline() {
+		// agents are not asked to upload either
+		lg.Info("no remote storage configured; skipping uploads, results and logs are kept on control and agents")
+		gcfg.ConfigClientMachineBenchmarkSteps.Step4UploadLogs = false
+	}
+	if etcdGitRef != "" {
+		switch databaseID {
+		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		default:
+			return fmt.Errorf("'--etcd-git-ref' is not supported for %q", databaseID)
+		}
+		lg.Info("overriding etcd git ref", zap.String("ref", etcdGitRef))
+		gcfg.EtcdGitRef = etcdGitRef
+		cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = gcfg
+	}
+	if sessionPath != "" && !gcfg.ConfigClientMachineBenchmarkSteps.Step1StartDatabase {
+		s, err := dbtester.ReadSession(sessionPath)
+		if err != nil {
+			return err
+		}
+		if err = cfg.UseSession(s, databaseID); err != nil {
+			return err
+		}
+		gcfg = cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+		lg.Info("reusing cluster of session",
+			zap.String("path", sessionPath),
+			zap.Strings("endpoints", gcfg.DatabaseEndpoints),
+			zap.Time("started-at", s.StartedAt),
+		)
+	}
+	if gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase {
+		if err = cfg.CheckClientLimits(databaseID); err != nil {
+			return err
+		}
+	}
+	cfg.SetRunTimestamp()
+	if adminAddr != "" {
+		stop, err := cfg.ServeAdmin(adminAddr)
+		if err != nil {
+			return err
+		}
+		defer stop()
+	}
+	if recordPath != "" {
+		stop, err := cfg.Record(recordPath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := stop(); err != nil {
+				lg.Warn("failed to record requests", zap.String("path", recordPath), zap.Error(err))
+			}
+		}()
+	}
+	if gcfg.Durability != "" {
+		cfg.SetLabel("durability", gcfg.Durability)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && gcfg.ConfigClientMachineBenchmarkOptions.EtcdCompression != "" {
+		cfg.SetLabel("etcd-compression", gcfg.ConfigClientMachineBenchmarkOptions.EtcdCompression)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && gcfg.ConfigClientMachineBenchmarkOptions.DropPageCache {
+		cache := "cold"
+		if gcfg.ConfigClientMachineBenchmarkOptions.ColdRestart {
+			cache = "cold-restart"
+		}
+		cfg.SetLabel("cache", cache)
+	}
 
 	if gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase {
 		switch gcfg.ConfigClientMachineBenchmarkOptions.Type {
 		case "write":
 		case "read":
 		case "read-oneshot":
+		case "custom":
+		case "trace":
+		case "read-your-writes":
+		case "read-consistency":
+		case "auth":
+		case "watch":
+		case "watch-compaction":
+		case "delete-range":
+		case "tenancy":
+		case "list":
+		case "kubernetes-apiserver":
+		case "consul-catalog":
+		case "backup-restore":
+		case "snapshot-transfer":
 		default:
 			return fmt.Errorf("%q is not supported", gcfg.ConfigClientMachineBenchmarkOptions.Type)
 		}
@@ -164,32 +363,130 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		lg.Warn("ntp update failed", zap.Error(nerr))
 	}
 
+	// on step timeout, stop databases and upload existing
+	// results, before returning the timeout error
+	var timeoutErr error
+
 	println()
 	if gcfg.ConfigClientMachineBenchmarkSteps.Step1StartDatabase {
 		lg.Info("step 1: starting databases...")
-		if _, err = cfg.BroadcaseRequest(databaseID, dbtesterpb.Operation_Start); err != nil {
+		err = runStep(lg, "step 1", gcfg.ConfigClientMachineBenchmarkSteps.Step1TimeoutSeconds, func(ctx context.Context) error {
+			if err := cfg.RunHooks(databaseID, "pre-step1"); err != nil {
+				return err
+			}
+			if gcfg.HardwareCheck != "" {
+				lg.Info("step 1: verifying agent hardware...", zap.String("hardware-check", gcfg.HardwareCheck))
+				if err := cfg.VerifyHardware(ctx, databaseID); err != nil {
+					return err
+				}
+			}
+			idxToResp, err := cfg.BroadcaseRequest(ctx, databaseID, dbtesterpb.Operation_Start)
+			if err != nil {
+				return err
+			}
+			if err = cfg.CheckStarted(databaseID, idxToResp); err != nil {
+				return err
+			}
+			if gcfg.EtcdGitRef != "" {
+				sha := idxToResp[0].EtcdGitSHA
+				for idx, resp := range idxToResp {
+					if resp.EtcdGitSHA != sha {
+						return fmt.Errorf("agent %d built etcd %q, expected %q (ref %q)", idx, resp.EtcdGitSHA, sha, gcfg.EtcdGitRef)
+					}
+				}
+				lg.Info("built etcd", zap.String("ref", gcfg.EtcdGitRef), zap.String("sha", sha))
+				cfg.SetLabel("etcd-git-sha", sha)
+			}
+			if gcfg.ClockSkewMs != 0 {
+				lg.Info("step 1: skewing member clocks...", zap.Int64("clock-skew-ms", gcfg.ClockSkewMs))
+				if err = cfg.SkewClocks(ctx, databaseID); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err == nil {
+			if herr := cfg.RunHooks(databaseID, "post-step1"); herr != nil {
+				lg.Warn("step 1: hooks failed", zap.Error(herr))
+			}
+		}
+		switch {
+		case isStepTimeout(err):
+			timeoutErr = err
+		case err != nil:
 			return err
+		case sessionPath != "":
+			if err = cfg.SaveSession(sessionPath, databaseID); err != nil {
+				return err
+			}
+			lg.Info("step 1: saved session", zap.String("path", sessionPath))
 		}
 	}
 
-	if gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase {
+	// on stress failure (e.g. error budget exceeded), still stop
+	// databases and upload partial results before returning the error;
+	// SLO failures are returned after all steps, as the run is complete
+	var stressErr, sloErr error
+	if gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase && timeoutErr == nil {
 		println()
 		time.Sleep(5 * time.Second)
 		println()
+		lg.Info("step 2: checking clock offsets...")
+		if err = cfg.CheckClockOffsets(databaseID); err != nil {
+			lg.Warn("step 2: failed to check clock offsets", zap.Error(err))
+		}
 		lg.Info("step 2: starting tests...")
-		if err = cfg.Stress(databaseID); err != nil {
-			return err
+		stressErr = runStep(lg, "step 2", gcfg.ConfigClientMachineBenchmarkSteps.Step2TimeoutSeconds, func(ctx context.Context) error {
+			if err := cfg.RunHooks(databaseID, "pre-step2"); err != nil {
+				return err
+			}
+			if err := cfg.StressRepeat(ctx, databaseID); err != nil {
+				return err
+			}
+			if err := cfg.CheckSLOs(); err != nil {
+				sloErr = err
+				lg.Warn("step 2: SLOs failed", zap.Error(err))
+			}
+			return nil
+		})
+		if stressErr != nil {
+			lg.Warn("step 2: tests failed", zap.Error(stressErr))
+		}
+		if err = cfg.RunHooks(databaseID, "post-step2"); err != nil {
+			lg.Warn("step 2: hooks failed", zap.Error(err))
+		}
+		if isStepTimeout(stressErr) {
+			timeoutErr = stressErr
+		}
+
+		if historyPath != "" {
+			if err = cfg.AppendHistory(historyPath, databaseID, stressErr == nil && sloErr == nil); err != nil {
+				lg.Warn("step 2: failed to append results history", zap.String("path", historyPath), zap.Error(err))
+			}
+		}
+
+		// upload results as soon as they are available, so that they
+		// are not lost if the following steps fail; retried in step 4
+		if gcfg.ConfigClientMachineBenchmarkSteps.Step4UploadLogs {
+			lg.Info("step 2: uploading results...")
+			if err = cfg.UploadFiles(databaseID, existingPaths(cfg.ClientResultPaths(databaseID))...); err != nil {
+				lg.Warn("step 2: failed to upload results", zap.Error(err))
+			}
 		}
 	}
 
-	if gcfg.ConfigClientMachineBenchmarkSteps.Step3StopDatabase {
+	if gcfg.ConfigClientMachineBenchmarkSteps.Step3StopDatabase || timeoutErr != nil {
 		println()
 		time.Sleep(5 * time.Second)
 		println()
 		lg.Info("step 3: stopping tests...")
+		// databases are stopped even if hooks fail
+		if err = cfg.RunHooks(databaseID, "pre-step3"); err != nil {
+			lg.Warn("step 3: hooks failed", zap.Error(err))
+		}
 		var idxToResp map[int]dbtesterpb.Response
 		for i := 0; i < 5; i++ {
-			idxToResp, err = cfg.BroadcaseRequest(databaseID, dbtesterpb.Operation_Stop)
+			idxToResp, err = cfg.BroadcaseRequest(context.Background(), databaseID, dbtesterpb.Operation_Stop)
 			if err != nil {
 				lg.Warn("STOP failed", zap.Int("i", i), zap.Error(err))
 				time.Sleep(300 * time.Millisecond)
@@ -199,6 +496,9 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		}
 		for idx := range gcfg.AgentEndpoints {
 			lg.Info("stop response", zap.String("response", fmt.Sprintf("%+v", idxToResp[idx])))
+			if idxToResp[idx].OOMKilled {
+				lg.Warn("database was killed by OOM killer", zap.Int("index", idx), zap.String("endpoint", gcfg.AgentEndpoints[idx]), zap.Int64("peak-memory-bytes", idxToResp[idx].PeakMemoryBytes))
+			}
 		}
 
 		println()
@@ -208,6 +508,30 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		if err = cfg.SaveDiskSpaceUsageSummary(databaseID, idxToResp); err != nil {
 			return err
 		}
+		if sessionPath != "" {
+			if err = os.Remove(sessionPath); err != nil && !os.IsNotExist(err) {
+				lg.Warn("step 3: failed to remove session", zap.String("path", sessionPath), zap.Error(err))
+			}
+		}
+		if err = cfg.RunHooks(databaseID, "post-step3"); err != nil {
+			lg.Warn("step 3: hooks failed", zap.Error(err))
+		}
+
+		if gcfg.ConfigClientMachineBenchmarkSteps.Step4UploadLogs {
+			println()
+			lg.Info("step 3: waiting for agents to upload logs...")
+			uploadTimeout := 30 * time.Minute
+			if timeoutErr != nil {
+				// agents that never started have nothing to upload
+				uploadTimeout = 5 * time.Minute
+			}
+			if err = cfg.WaitUploads(databaseID, uploadTimeout); err != nil {
+				if timeoutErr == nil {
+					return err
+				}
+				lg.Warn("step 3: failed to wait for uploads", zap.Error(err))
+			}
+		}
 	}
 
 	close(donec)
@@ -218,35 +542,79 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		time.Sleep(3 * time.Second)
 		println()
 		lg.Info("step 4: uploading logs...")
-		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.LogPath); err != nil {
-			return err
-		}
-		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientSystemMetricsPath); err != nil {
-			return err
-		}
-		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientSystemMetricsInterpolatedPath); err != nil {
-			return err
-		}
-		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath); err != nil {
-			return err
-		}
-		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientLatencyDistributionAllPath); err != nil {
-			return err
+		paths := []string{
+			cfg.ConfigClientMachineInitial.LogPath,
+			cfg.ConfigClientMachineInitial.ClientSystemMetricsPath,
+			cfg.ConfigClientMachineInitial.ClientSystemMetricsInterpolatedPath,
+			cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath,
 		}
-		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath); err != nil {
-			return err
+		paths = append(paths, cfg.ClientResultPaths(databaseID)...)
+		if stressErr != nil || timeoutErr != nil {
+			// aborted tests may not have written all results
+			paths = existingPaths(paths)
 		}
-		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath); err != nil {
+		if err = cfg.UploadFiles(databaseID, paths...); err != nil {
 			return err
 		}
-		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath); err != nil {
-			return err
+
+		lg.Info("step 4: updating experiment index...", zap.String("run-path", cfg.ConfigClientMachineInitial.RunPath()))
+		if err = cfg.UpdateExperimentIndex(databaseID, stressErr == nil && timeoutErr == nil); err != nil {
+			lg.Warn("failed to update experiment index", zap.Error(err))
 		}
-		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath); err != nil {
-			return err
+	}
+
+	if gcfg.ConfigClientMachineBenchmarkSteps.Step4UploadLogs {
+		for name, st := range cfg.UploadStatus() {
+			lg.Info("upload status",
+				zap.String("destination", name),
+				zap.Int("uploaded", st.Uploaded),
+				zap.Int("failed", st.Failed),
+				zap.String("last-error", st.LastError),
+			)
 		}
 	}
 
+	if timeoutErr != nil {
+		return timeoutErr
+	}
+	if stressErr != nil {
+		return stressErr
+	}
+	if sloErr != nil {
+		return sloErr
+	}
 	lg.Info("all done!")
 	return nil
 }
+
+// parseSteps returns the benchmark steps of comma-separated step
+// numbers, where steps not listed are skipped.
+func parseSteps(s string) (*dbtesterpb.ConfigClientMachineBenchmarkSteps, error) {
+	ss := &dbtesterpb.ConfigClientMachineBenchmarkSteps{}
+	for _, step := range strings.Split(s, ",") {
+		switch strings.TrimSpace(step) {
+		case "1":
+			ss.Step1StartDatabase = true
+		case "2":
+			ss.Step2StressDatabase = true
+		case "3":
+			ss.Step3StopDatabase = true
+		case "4":
+			ss.Step4UploadLogs = true
+		default:
+			return nil, fmt.Errorf("unknown step %q in %q (must be 1, 2, 3, or 4)", step, s)
+		}
+	}
+	return ss, nil
+}
+
+// existingPaths returns the paths that exist.
+func existingPaths(paths []string) []string {
+	var ps []string
+	for _, p := range paths {
+		if _, err := os.Stat(p); err == nil {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
@@ -0,0 +1,91 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/etcd-io/dbtester"
+
+	"github.com/spf13/cobra"
+)
+
+// ExportCommand implements 'export' command.
+var ExportCommand = &cobra.Command{
+	Use:   "export",
+	Short: "Exports anonymized results and logs for external sharing.",
+	RunE:  exportCommandFunc,
+}
+
+var (
+	exportDatabaseIDs []string
+	exportIncludes    []string
+	exportOutput      string
+)
+
+func init() {
+	ExportCommand.Flags().StringVarP(&configPath, "config", "c", "", "YAML configuration file path.")
+	ExportCommand.Flags().StringSliceVar(&exportDatabaseIDs, "database-id", nil, "Only export results of these databases (default all).")
+	ExportCommand.Flags().StringSliceVar(&exportIncludes, "include", nil, "Additional text files to export (e.g. downloaded agent logs).")
+	ExportCommand.Flags().StringVar(&exportOutput, "output", "dbtester-export.tar.gz", "Path of the anonymized gzipped tarball.")
+}
+
+func exportCommandFunc(cmd *cobra.Command, args []string) error {
+	cfg, err := dbtester.ReadConfig(configPath, false)
+	if err != nil {
+		return err
+	}
+
+	ids := exportDatabaseIDs
+	if len(ids) == 0 {
+		for id := range cfg.DatabaseIDToConfigClientMachineAgentControl {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+	}
+
+	paths := []string{
+		configPath,
+		cfg.ConfigClientMachineInitial.LogPath,
+		cfg.ConfigClientMachineInitial.ClientSystemMetricsPath,
+		cfg.ConfigClientMachineInitial.ClientSystemMetricsInterpolatedPath,
+		cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath,
+		cfg.ConfigClientMachineInitial.ClientUploadManifestPath,
+	}
+	for _, id := range ids {
+		if _, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[id]; !ok {
+			return fmt.Errorf("%q does not exist", id)
+		}
+		paths = append(paths, cfg.ClientResultPaths(id)...)
+	}
+	paths = append(paths, exportIncludes...)
+
+	// results paths are shared by databases
+	seen := make(map[string]bool)
+	var uniq []string
+	for _, p := range existingPaths(paths) {
+		if !seen[p] {
+			seen[p] = true
+			uniq = append(uniq, p)
+		}
+	}
+
+	if err = cfg.ExportResults(cfg.NewAnonymizer(), uniq, exportOutput); err != nil {
+		return err
+	}
+	fmt.Printf("exported %d file(s) to %q\n", len(uniq), exportOutput)
+	return nil
+}
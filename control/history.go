@@ -0,0 +1,100 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/etcd-io/dbtester"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// HistoryCommand implements 'history' command.
+var HistoryCommand = &cobra.Command{
+	Use:   "history",
+	Short: "Queries past results in the local results history.",
+	RunE:  historyCommandFunc,
+}
+
+var (
+	historyTestName   string
+	historyDatabaseID string
+	historyLabels     []string
+	historyMetrics    []string
+	historyLast       int
+)
+
+func init() {
+	HistoryCommand.Flags().StringVar(&historyPath, "history", defaultHistoryPath(), "Local results history SQLite file, appended by 'control'.")
+	HistoryCommand.Flags().StringVar(&historyTestName, "test-name", "", "Only show runs of this test name.")
+	HistoryCommand.Flags().StringVar(&historyDatabaseID, "database-id", "", "Only show runs of this database.")
+	HistoryCommand.Flags().StringSliceVar(&historyLabels, "label", nil, "Only show runs with these 'key=value' labels.")
+	HistoryCommand.Flags().StringSliceVar(&historyMetrics, "metric", dbtester.BenchmarkMetrics, "Metrics to show, "+strings.Join(dbtester.BenchmarkMetrics, ", ")+".")
+	HistoryCommand.Flags().IntVar(&historyLast, "last", 0, "Only show the last N runs (0 for all).")
+}
+
+// defaultHistoryPath returns the history file under the home directory.
+func defaultHistoryPath() string {
+	return filepath.Join(os.Getenv("HOME"), ".dbtester", "history.db")
+}
+
+func historyCommandFunc(cmd *cobra.Command, args []string) error {
+	labels := make(map[string]string, len(historyLabels))
+	for _, l := range historyLabels {
+		kv := strings.SplitN(l, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("label %q is not 'key=value'", l)
+		}
+		labels[kv[0]] = kv[1]
+	}
+	for _, m := range historyMetrics {
+		if !dbtester.IsValidBenchmarkMetric(m) {
+			return fmt.Errorf("unknown metric %q (must be one of %q)", m, dbtester.BenchmarkMetrics)
+		}
+	}
+
+	matched, err := dbtester.QueryHistory(historyPath, dbtester.HistoryQuery{
+		TestName:   historyTestName,
+		DatabaseID: historyDatabaseID,
+		Labels:     labels,
+		Last:       historyLast,
+	})
+	if err != nil {
+		return err
+	}
+
+	tw := tablewriter.NewWriter(os.Stdout)
+	tw.SetHeader(append([]string{"RUN-ID", "DATABASE", "SUCCESS", "LABELS"}, historyMetrics...))
+	for _, run := range matched {
+		row := []string{run.RunID, run.DatabaseID, fmt.Sprint(run.Success), formatLabels(run.Labels)}
+		for _, m := range historyMetrics {
+			v, ok := run.Metrics[m]
+			if !ok {
+				row = append(row, "-")
+				continue
+			}
+			row = append(row, fmt.Sprintf("%.4f", v))
+		}
+		tw.Append(row)
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.Render()
+	return nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/etcd-io/dbtester"
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/spf13/cobra"
+)
+
+// LogsCommand implements 'logs' command.
+var LogsCommand = &cobra.Command{
+	Use:   "logs",
+	Short: "Live-tails the database log of an agent.",
+	RunE:  logsCommandFunc,
+}
+
+var (
+	logsPeer      int
+	logsTailLines int64
+	logsNoFollow  bool
+	logsLevel     string
+	logsRegex     string
+)
+
+func init() {
+	ids := dbtesterpb.GetAllDatabaseIDs()
+	LogsCommand.Flags().StringVar(&databaseID, "database-id", ids[0], strings.Join(ids, ", "))
+	LogsCommand.Flags().StringVarP(&configPath, "config", "c", "", "YAML configuration file path.")
+	LogsCommand.Flags().IntVar(&logsPeer, "peer", 1, "Index of the agent to tail, starting from 1.")
+	LogsCommand.Flags().Int64Var(&logsTailLines, "tail", 100, "Number of last lines to show first.")
+	LogsCommand.Flags().BoolVar(&logsNoFollow, "no-follow", false, "'true' to exit after showing the last lines.")
+	LogsCommand.Flags().StringVar(&logsLevel, "level", "", "Minimum log level to show (debug, info, warn, error).")
+	LogsCommand.Flags().StringVar(&logsRegex, "regex", "", "Regular expression that lines must match.")
+}
+
+func logsCommandFunc(cmd *cobra.Command, args []string) error {
+	if !dbtesterpb.IsValidDatabaseID(databaseID) {
+		return fmt.Errorf("database id %q is unknown", databaseID)
+	}
+	cfg, err := dbtester.ReadConfig(configPath, false)
+	if err != nil {
+		return err
+	}
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("database id %q does not exist", databaseID)
+	}
+	if logsPeer < 1 || logsPeer > len(gcfg.AgentEndpoints) {
+		return fmt.Errorf("peer %d is out of range [1, %d]", logsPeer, len(gcfg.AgentEndpoints))
+	}
+
+	minLevel := -1
+	if logsLevel != "" {
+		if minLevel = levelIndex(logsLevel); minLevel < 0 {
+			return fmt.Errorf("log level %q is unknown (available %q)", logsLevel, logLevels)
+		}
+	}
+	var re *regexp.Regexp
+	if logsRegex != "" {
+		if re, err = regexp.Compile(logsRegex); err != nil {
+			return err
+		}
+	}
+
+	ep := gcfg.AgentEndpoints[logsPeer-1]
+	conn, err := cfg.DialAgent(ep)
+	if err != nil {
+		return fmt.Errorf("%v (%q)", err, ep)
+	}
+	defer conn.Close()
+
+	cli := dbtesterpb.NewTransporterClient(conn)
+	stream, err := cli.StreamLog(context.Background(), &dbtesterpb.LogRequest{TailLines: logsTailLines, Follow: !logsNoFollow, TestID: cfg.ConfigClientMachineInitial.TestID})
+	if err != nil {
+		return fmt.Errorf("%v (%q)", err, ep)
+	}
+	for {
+		l, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%v (%q)", err, ep)
+		}
+		if minLevel >= 0 && levelIndex(lineLevel(l.Line)) < minLevel {
+			continue
+		}
+		if re != nil && !re.MatchString(l.Line) {
+			continue
+		}
+		fmt.Fprintln(os.Stdout, l.Line)
+	}
+}
+
+var logLevels = []string{"debug", "info", "warn", "error"}
+
+func levelIndex(level string) int {
+	for i, v := range logLevels {
+		if v == level {
+			return i
+		}
+	}
+	return -1
+}
+
+// lineLevel returns the log level of a line in etcd, Zookeeper,
+// Consul, or zap format. It returns "info" if unknown.
+func lineLevel(line string) string {
+	switch {
+	case strings.Contains(line, " E | "),
+		strings.Contains(line, " C | "),
+		strings.Contains(line, " ERROR "),
+		strings.Contains(line, "[ERR]"),
+		strings.Contains(line, `"level":"error"`),
+		strings.Contains(line, `"level":"fatal"`):
+		return "error"
+	case strings.Contains(line, " W | "),
+		strings.Contains(line, " WARN "),
+		strings.Contains(line, "[WARN]"),
+		strings.Contains(line, `"level":"warn"`):
+		return "warn"
+	case strings.Contains(line, " D | "),
+		strings.Contains(line, " DEBUG "),
+		strings.Contains(line, "[DEBUG]"),
+		strings.Contains(line, `"level":"debug"`):
+		return "debug"
+	}
+	return "info"
+}
@@ -0,0 +1,107 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/etcd-io/dbtester"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+// LsCommand implements 'ls' command.
+var LsCommand = &cobra.Command{
+	Use:   "ls",
+	Short: "Lists past experiment runs in remote storage.",
+	RunE:  lsCommandFunc,
+}
+
+var (
+	lsTestName string
+	lsLabels   []string
+)
+
+func init() {
+	LsCommand.Flags().StringVarP(&configPath, "config", "c", "", "YAML configuration file path (for remote storage destinations).")
+	LsCommand.Flags().StringVar(&lsTestName, "test-name", "", "Only list runs of this test name.")
+	LsCommand.Flags().StringSliceVar(&lsLabels, "label", nil, "Only list runs with these 'key=value' labels.")
+}
+
+func lsCommandFunc(cmd *cobra.Command, args []string) error {
+	labels := make(map[string]string, len(lsLabels))
+	for _, l := range lsLabels {
+		kv := strings.SplitN(l, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("label %q is not 'key=value'", l)
+		}
+		labels[kv[0]] = kv[1]
+	}
+
+	cfg, err := dbtester.ReadConfig(configPath, false)
+	if err != nil {
+		return err
+	}
+	idx, err := cfg.ReadExperimentIndex()
+	if err != nil {
+		return err
+	}
+
+	tw := tablewriter.NewWriter(os.Stdout)
+	tw.SetHeader([]string{"TEST-NAME", "RUN-TIMESTAMP", "DATABASE", "MEMBERS", "SUCCESS", "LABELS", "PATH"})
+	for _, run := range idx.Runs {
+		if lsTestName != "" && run.TestName != lsTestName {
+			continue
+		}
+		if !matchLabels(run.Labels, labels) {
+			continue
+		}
+		tw.Append([]string{
+			run.TestName,
+			run.RunTimestamp,
+			run.DatabaseID,
+			fmt.Sprint(run.Members),
+			fmt.Sprint(run.Success),
+			formatLabels(run.Labels),
+			run.Path,
+		})
+	}
+	tw.SetAutoFormatHeaders(false)
+	tw.Render()
+	return nil
+}
+
+// matchLabels returns true if 'labels' has all of 'want'.
+func matchLabels(labels, want map[string]string) bool {
+	for k, v := range want {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func formatLabels(labels map[string]string) string {
+	ss := make([]string, 0, len(labels))
+	for k, v := range labels {
+		ss = append(ss, k+"="+v)
+	}
+	sort.Strings(ss)
+	return strings.Join(ss, ",")
+}
@@ -0,0 +1,455 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/etcd-io/dbtester"
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// ServeCommand implements 'serve' command.
+var ServeCommand = &cobra.Command{
+	Use:   "serve",
+	Short: "Runs submitted benchmarks one by one as a shared service.",
+	RunE:  serveCommandFunc,
+}
+
+var (
+	serveListenAddr string
+	serveJobsDir    string
+)
+
+func init() {
+	ServeCommand.Flags().StringVar(&serveListenAddr, "listen-addr", "localhost:3700", "Address of the job HTTP server.")
+	ServeCommand.Flags().StringVar(&serveJobsDir, "jobs-dir", filepath.Join(os.Getenv("HOME"), ".dbtester", "jobs"), "Directory to store configurations, logs, and status of jobs.")
+	ServeCommand.Flags().StringVar(&historyPath, "history", defaultHistoryPath(), "Local results history SQLite file to append the summary of each job to (empty to disable).")
+}
+
+// job states
+const (
+	jobQueued    = "queued"
+	jobRunning   = "running"
+	jobSucceeded = "succeeded"
+	jobFailed    = "failed"
+	jobCanceled  = "canceled"
+)
+
+// job is a benchmark submitted to 'serve'.
+type job struct {
+	ID         string    `json:"id"`
+	DatabaseID string    `json:"database_id"`
+	TestTitle  string    `json:"test_title"`
+	State      string    `json:"state"`
+	Error      string    `json:"error,omitempty"`
+	SubmitTime time.Time `json:"submit_time"`
+	StartTime  time.Time `json:"start_time,omitempty"`
+	EndTime    time.Time `json:"end_time,omitempty"`
+
+	// EtcdGitRef overrides 'etcd_git_ref' of the configuration.
+	EtcdGitRef string `json:"etcd_git_ref,omitempty"`
+	// PullRequest is 'owner/repo#number' of jobs triggered by GitHub.
+	PullRequest string `json:"pull_request,omitempty"`
+	// BaseJobID is the job of the base branch to compare results with.
+	BaseJobID string `json:"base_job_id,omitempty"`
+	// CommentsURL is the GitHub API URL to post results to.
+	CommentsURL string `json:"comments_url,omitempty"`
+}
+
+// jobQueue runs jobs serially in submission order. Each job is
+// stored under its own directory, so the queue survives restarts.
+type jobQueue struct {
+	dir string
+
+	mu     sync.Mutex
+	jobs   []*job
+	lastID int
+	notify chan struct{}
+
+	// onFinish is called after each job finishes, if not nil
+	onFinish func(job)
+}
+
+func openJobQueue(dir string) (*jobQueue, error) {
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return nil, err
+	}
+	q := &jobQueue{dir: dir, notify: make(chan struct{}, 1)}
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, fi := range fis {
+		bts, err := ioutil.ReadFile(filepath.Join(dir, fi.Name(), "job.json"))
+		if err != nil {
+			continue
+		}
+		var j job
+		if err = json.Unmarshal(bts, &j); err != nil {
+			return nil, fmt.Errorf("cannot read job %q (%v)", fi.Name(), err)
+		}
+		if j.State == jobRunning {
+			// the server stopped while running
+			j.State, j.Error, j.EndTime = jobFailed, "interrupted", time.Now()
+			if err = q.save(&j); err != nil {
+				return nil, err
+			}
+		}
+		var n int
+		if _, err = fmt.Sscanf(j.ID, "%d", &n); err == nil && n > q.lastID {
+			q.lastID = n
+		}
+		q.jobs = append(q.jobs, &j)
+	}
+	sort.Slice(q.jobs, func(i, k int) bool { return q.jobs[i].ID < q.jobs[k].ID })
+	q.wake()
+	return q, nil
+}
+
+func (q *jobQueue) jobDir(id string) string { return filepath.Join(q.dir, id) }
+
+// save writes the job status, with the lock held or before the
+// job is shared.
+func (q *jobQueue) save(j *job) error {
+	bts, err := json.MarshalIndent(j, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(q.jobDir(j.ID), "job.json"), bts, 0666)
+}
+
+func (q *jobQueue) wake() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// submit validates and enqueues the configuration, to run as the job.
+func (q *jobQueue) submit(j job, config []byte) (job, error) {
+	if !dbtesterpb.IsValidDatabaseID(j.DatabaseID) {
+		return job{}, fmt.Errorf("database id %q is unknown", j.DatabaseID)
+	}
+	databaseID := j.DatabaseID
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	j.ID, j.State, j.SubmitTime = fmt.Sprintf("%06d", q.lastID+1), jobQueued, time.Now()
+	dir := q.jobDir(j.ID)
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return job{}, err
+	}
+	fpath := filepath.Join(dir, "config.yaml")
+	err := ioutil.WriteFile(fpath, config, 0666)
+	if err == nil {
+		var cfg *dbtester.Config
+		if cfg, err = dbtester.ReadConfig(fpath, false); err == nil {
+			if _, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]; !ok {
+				err = fmt.Errorf("%q is not found", databaseID)
+			}
+			j.TestTitle = cfg.TestTitle
+		}
+	}
+	if err == nil {
+		err = q.save(&j)
+	}
+	if err != nil {
+		os.RemoveAll(dir)
+		return job{}, err
+	}
+
+	q.lastID++
+	q.jobs = append(q.jobs, &j)
+	q.wake()
+	return j, nil
+}
+
+// cancel cancels the queued job.
+func (q *jobQueue) cancel(id string) (job, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, j := range q.jobs {
+		if j.ID != id {
+			continue
+		}
+		if j.State != jobQueued {
+			return *j, fmt.Errorf("job %q is %s", id, j.State)
+		}
+		j.State, j.EndTime = jobCanceled, time.Now()
+		return *j, q.save(j)
+	}
+	return job{}, os.ErrNotExist
+}
+
+func (q *jobQueue) get(id string) (job, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, j := range q.jobs {
+		if j.ID == id {
+			return *j, true
+		}
+	}
+	return job{}, false
+}
+
+func (q *jobQueue) list() []job {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	js := make([]job, len(q.jobs))
+	for i, j := range q.jobs {
+		js[i] = *j
+	}
+	return js
+}
+
+// next marks the first queued job running, or returns nil.
+func (q *jobQueue) next() *job {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, j := range q.jobs {
+		if j.State == jobQueued {
+			j.State, j.StartTime = jobRunning, time.Now()
+			if err := q.save(j); err != nil {
+				lg.Warn("failed to save job", zap.String("id", j.ID), zap.Error(err))
+			}
+			return j
+		}
+	}
+	return nil
+}
+
+func (q *jobQueue) finish(j *job, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	j.State, j.EndTime = jobSucceeded, time.Now()
+	if err != nil {
+		j.State, j.Error = jobFailed, err.Error()
+	}
+	if serr := q.save(j); serr != nil {
+		lg.Warn("failed to save job", zap.String("id", j.ID), zap.Error(serr))
+	}
+}
+
+// run runs queued jobs until stopc is closed. Each job runs 'control'
+// in a child process against the agents of its configuration, so that
+// failures that exit the tester do not stop the service.
+func (q *jobQueue) run(stopc <-chan struct{}) {
+	for {
+		j := q.next()
+		if j == nil {
+			select {
+			case <-q.notify:
+				continue
+			case <-stopc:
+				return
+			}
+		}
+		lg.Info("running job", zap.String("id", j.ID), zap.String("database-id", j.DatabaseID), zap.String("test-title", j.TestTitle))
+		err := q.exec(j)
+		q.finish(j, err)
+		lg.Info("finished job", zap.String("id", j.ID), zap.String("state", j.State), zap.Error(err))
+		if historyPath != "" {
+			if err = mergeHistory(historyPath, q.historyPath(j.ID)); err != nil {
+				lg.Warn("failed to append results history", zap.String("path", historyPath), zap.Error(err))
+			}
+		}
+		if q.onFinish != nil {
+			jc, _ := q.get(j.ID)
+			q.onFinish(jc)
+		}
+
+		select {
+		case <-stopc:
+			return
+		default:
+		}
+	}
+}
+
+func (q *jobQueue) exec(j *job) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	dir := q.jobDir(j.ID)
+	f, err := os.Create(filepath.Join(dir, "control.log"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// results of each job are kept separately for comparisons,
+	// and appended to the shared history after the run
+	args := []string{"control",
+		"--config", filepath.Join(dir, "config.yaml"),
+		"--database-id", j.DatabaseID,
+		"--history", q.historyPath(j.ID),
+	}
+	if j.EtcdGitRef != "" {
+		args = append(args, "--etcd-git-ref", j.EtcdGitRef)
+	}
+	cmd := exec.Command(exe, args...)
+	cmd.Stdout, cmd.Stderr = f, f
+	return cmd.Run()
+}
+
+// historyPath returns the results history file of the job.
+func (q *jobQueue) historyPath(id string) string {
+	return filepath.Join(q.jobDir(id), "history.db")
+}
+
+// mergeHistory appends the runs of the src history file to dst,
+// if src has any.
+func mergeHistory(dst, src string) error {
+	runs, err := dbtester.ReadHistory(src)
+	if err != nil || len(runs) == 0 {
+		return err
+	}
+	return dbtester.AppendHistoryRuns(dst, runs...)
+}
+
+// newJobHandler returns the HTTP handler of the job queue:
+//
+//	POST   /jobs?database-id=...  submits the YAML configuration in body,
+//	                              optionally with 'etcd-git-ref=...'
+//	GET    /jobs                  lists all jobs
+//	GET    /jobs/<id>             returns the job status
+//	GET    /jobs/<id>/log         returns the output of 'control'
+//	DELETE /jobs/<id>             cancels the queued job
+func newJobHandler(q *jobQueue) *http.ServeMux {
+	writeJSON := func(w http.ResponseWriter, code int, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(v)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/jobs", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, q.list())
+		case http.MethodPost:
+			bts, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			j, err := q.submit(job{DatabaseID: r.URL.Query().Get("database-id"), EtcdGitRef: r.URL.Query().Get("etcd-git-ref")}, bts)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			lg.Info("submitted job", zap.String("id", j.ID), zap.String("database-id", j.DatabaseID))
+			writeJSON(w, http.StatusCreated, j)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/jobs/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/jobs/")
+		id, sub := strings.TrimSuffix(id, "/log"), strings.HasSuffix(id, "/log")
+		j, ok := q.get(id)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && sub:
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			http.ServeFile(w, r, filepath.Join(q.jobDir(id), "control.log"))
+		case r.Method == http.MethodGet:
+			writeJSON(w, http.StatusOK, j)
+		case r.Method == http.MethodDelete && !sub:
+			j, err := q.cancel(id)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			}
+			writeJSON(w, http.StatusOK, j)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+	return mux
+}
+
+func serveCommandFunc(cmd *cobra.Command, args []string) error {
+	q, err := openJobQueue(serveJobsDir)
+	if err != nil {
+		return err
+	}
+
+	srvs := []*http.Server{{Addr: serveListenAddr, Handler: newJobHandler(q), ReadTimeout: time.Minute}}
+	if githubConfigPath != "" {
+		if githubListenAddr == "" {
+			return fmt.Errorf("'--github-config' requires '--github-listen-addr'")
+		}
+		if githubListenAddr == serveListenAddr {
+			return fmt.Errorf("'--github-listen-addr' must differ from '--listen-addr' %q", serveListenAddr)
+		}
+		h, err := newGitHubHook(q)
+		if err != nil {
+			return err
+		}
+		q.onFinish = h.onFinish
+		mux := http.NewServeMux()
+		mux.Handle("/github", h)
+		srvs = append(srvs, &http.Server{Addr: githubListenAddr, Handler: mux, ReadTimeout: time.Minute})
+		lg.Info("started GitHub webhook server", zap.String("address", githubListenAddr))
+	}
+
+	errc := make(chan error, len(srvs))
+	for _, srv := range srvs {
+		go func(srv *http.Server) {
+			errc <- srv.ListenAndServe()
+		}(srv)
+	}
+	stopc, donec := make(chan struct{}), make(chan struct{})
+	go func() {
+		q.run(stopc)
+		close(donec)
+	}()
+	lg.Info("started job server", zap.String("address", serveListenAddr), zap.String("jobs-dir", serveJobsDir))
+
+	sc := make(chan os.Signal, 1)
+	signal.Notify(sc, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case s := <-sc:
+		lg.Info("received signal; waiting for the running job", zap.String("signal", s.String()))
+	case err = <-errc:
+	}
+	for _, srv := range srvs {
+		srv.Close()
+	}
+	close(stopc)
+	<-donec
+	return err
+}
@@ -0,0 +1,352 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester"
+	"github.com/etcd-io/dbtester/pkg/agentauth"
+
+	"go.uber.org/zap"
+)
+
+var (
+	githubListenAddr string
+	githubSecretPath string
+	githubTokenPath  string
+	githubConfigPath string
+	githubDatabaseID string
+)
+
+func init() {
+	ServeCommand.Flags().StringVar(&githubConfigPath, "github-config", "", "YAML configuration file path of the benchmark to run on GitHub pull requests of etcd, with the webhook at 'POST /github' (empty to disable). Only pull requests of repository members, from branches of the repository, or labeled 'ok-to-test' are run.")
+	ServeCommand.Flags().StringVar(&githubListenAddr, "github-listen-addr", "", "Address of the GitHub webhook HTTP server, required with '--github-config'. The webhook is served apart from the job API, which runs submitted configurations unauthenticated, so that only the webhook is exposed to GitHub.")
+	ServeCommand.Flags().StringVar(&githubDatabaseID, "github-database-id", "etcd__tip", "Database ID of the benchmark to run on GitHub pull requests.")
+	ServeCommand.Flags().StringVar(&githubSecretPath, "github-webhook-secret-path", "", "File path of the GitHub webhook secret to verify deliveries with.")
+	ServeCommand.Flags().StringVar(&githubTokenPath, "github-token-path", "", "File path of the GitHub token to comment on pull requests with.")
+}
+
+// githubOKToTestLabel is the label maintainers apply to benchmark
+// pull requests of untrusted authors.
+const githubOKToTestLabel = "ok-to-test"
+
+// githubLabel is a label of GitHub issues and pull requests.
+type githubLabel struct {
+	Name string `json:"name"`
+}
+
+// githubPullRequestEvent is the subset of GitHub 'pull_request'
+// webhook payload to benchmark the pull request.
+type githubPullRequestEvent struct {
+	Action string `json:"action"`
+	Number int    `json:"number"`
+	// Label is the label added, with 'labeled' action
+	Label       githubLabel `json:"label"`
+	PullRequest struct {
+		CommentsURL       string        `json:"comments_url"`
+		AuthorAssociation string        `json:"author_association"`
+		Labels            []githubLabel `json:"labels"`
+		Head              struct {
+			SHA  string `json:"sha"`
+			Repo struct {
+				FullName string `json:"full_name"`
+			} `json:"repo"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+			SHA string `json:"sha"`
+		} `json:"base"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// trusted returns true if the head of the pull request may be built on
+// agents: the author is a member of the repository, the head branch is
+// in the repository, or a maintainer applied 'ok-to-test'. Agents run the
+// build script of the head commit, so pull requests from forks of others
+// must not run unreviewed.
+func (ev githubPullRequestEvent) trusted() bool {
+	switch ev.PullRequest.AuthorAssociation {
+	case "OWNER", "MEMBER", "COLLABORATOR":
+		return true
+	}
+	if ev.PullRequest.Head.Repo.FullName != "" && ev.PullRequest.Head.Repo.FullName == ev.Repository.FullName {
+		return true
+	}
+	for _, l := range ev.PullRequest.Labels {
+		if l.Name == githubOKToTestLabel {
+			return true
+		}
+	}
+	return false
+}
+
+// githubHook benchmarks the base and head of pull requests,
+// and comments results on the pull request.
+type githubHook struct {
+	q      *jobQueue
+	secret []byte
+	token  string
+	// config is read on each event, to pick up changes
+	// without restarting the server
+	configPath string
+	databaseID string
+	cli        *http.Client
+}
+
+func newGitHubHook(q *jobQueue) (*githubHook, error) {
+	if githubSecretPath == "" || githubTokenPath == "" {
+		return nil, fmt.Errorf("'--github-config' requires '--github-webhook-secret-path' and '--github-token-path'")
+	}
+	secret, err := agentauth.ReadSecret(githubSecretPath)
+	if err != nil {
+		return nil, err
+	}
+	token, err := agentauth.ReadSecret(githubTokenPath)
+	if err != nil {
+		return nil, err
+	}
+	return &githubHook{
+		q:          q,
+		secret:     secret,
+		token:      string(token),
+		configPath: githubConfigPath,
+		databaseID: githubDatabaseID,
+		cli:        &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// verify returns true if the 'X-Hub-Signature-256' header
+// is the HMAC-SHA256 of the body with the webhook secret.
+func (h *githubHook) verify(body []byte, sig string) bool {
+	if !strings.HasPrefix(sig, "sha256=") {
+		return false
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(sig, "sha256="))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+func (h *githubHook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !h.verify(body, r.Header.Get("X-Hub-Signature-256")) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	if r.Header.Get("X-GitHub-Event") != "pull_request" {
+		// e.g. 'ping' on webhook creation
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	var ev githubPullRequestEvent
+	if err = json.Unmarshal(body, &ev); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	switch ev.Action {
+	case "opened", "reopened", "synchronize":
+		if !ev.trusted() {
+			lg.Info("skipped untrusted pull request",
+				zap.String("repository", ev.Repository.FullName),
+				zap.Int("number", ev.Number),
+				zap.String("author-association", ev.PullRequest.AuthorAssociation),
+			)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	case "labeled":
+		// only GitHub users with triage access can label, and other
+		// labels do not trigger runs of trusted pull requests again
+		if ev.Label.Name != githubOKToTestLabel {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	default:
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	base, head, err := h.submit(ev)
+	if err != nil {
+		lg.Warn("failed to submit pull request jobs", zap.String("repository", ev.Repository.FullName), zap.Int("number", ev.Number), zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	lg.Info("submitted pull request jobs",
+		zap.String("pull-request", head.PullRequest),
+		zap.String("base-job", base.ID),
+		zap.String("head-job", head.ID),
+	)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode([]job{base, head})
+}
+
+// submit enqueues the base commit and then the head commit, so
+// that results are compared once the head job finishes.
+func (h *githubHook) submit(ev githubPullRequestEvent) (base, head job, err error) {
+	config, err := ioutil.ReadFile(h.configPath)
+	if err != nil {
+		return job{}, job{}, err
+	}
+	pr := fmt.Sprintf("%s#%d", ev.Repository.FullName, ev.Number)
+	base, err = h.q.submit(job{
+		DatabaseID:  h.databaseID,
+		EtcdGitRef:  ev.PullRequest.Base.SHA,
+		PullRequest: pr,
+	}, config)
+	if err != nil {
+		return job{}, job{}, err
+	}
+	head, err = h.q.submit(job{
+		DatabaseID:  h.databaseID,
+		EtcdGitRef:  ev.PullRequest.Head.SHA,
+		PullRequest: pr,
+		BaseJobID:   base.ID,
+		CommentsURL: ev.PullRequest.CommentsURL,
+	}, config)
+	return base, head, err
+}
+
+// onFinish comments the results on the pull request,
+// when the head job of a pull request finishes.
+func (h *githubHook) onFinish(j job) {
+	if j.BaseJobID == "" || j.CommentsURL == "" {
+		return
+	}
+	body := h.summary(j)
+	if err := h.comment(j.CommentsURL, body); err != nil {
+		lg.Warn("failed to comment on pull request", zap.String("pull-request", j.PullRequest), zap.Error(err))
+		return
+	}
+	lg.Info("commented on pull request", zap.String("pull-request", j.PullRequest), zap.String("job", j.ID))
+}
+
+// summary returns the Markdown comment of the head job
+// compared with its base job.
+func (h *githubHook) summary(head job) string {
+	base, _ := h.q.get(head.BaseJobID)
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "#### dbtester: %s\n\n", head.TestTitle)
+	fmt.Fprintf(buf, "`%s` at %s compared with base %s (jobs %s, %s)\n\n", head.DatabaseID, shortSHA(head.EtcdGitRef), shortSHA(base.EtcdGitRef), base.ID, head.ID)
+
+	baseRun, berr := lastHistoryRun(h.q.historyPath(base.ID))
+	headRun, herr := lastHistoryRun(h.q.historyPath(head.ID))
+	for _, jr := range []struct {
+		j   job
+		err error
+	}{{base, berr}, {head, herr}} {
+		switch {
+		case jr.j.State != jobSucceeded:
+			fmt.Fprintf(buf, "Job %s at %s %s: %s\n\n", jr.j.ID, shortSHA(jr.j.EtcdGitRef), jr.j.State, jr.j.Error)
+		case jr.err != nil:
+			fmt.Fprintf(buf, "Job %s at %s has no results: %v\n\n", jr.j.ID, shortSHA(jr.j.EtcdGitRef), jr.err)
+		}
+	}
+	if berr != nil || herr != nil {
+		return buf.String()
+	}
+
+	buf.WriteString("| Metric | Base | Pull request | Delta |\n")
+	buf.WriteString("|---|--:|--:|--:|\n")
+	for _, m := range dbtester.BenchmarkMetrics {
+		bv, bok := baseRun.Metrics[m]
+		hv, hok := headRun.Metrics[m]
+		if !bok || !hok {
+			continue
+		}
+		delta := "n/a"
+		if bv != 0 {
+			d := (hv - bv) / bv * 100
+			delta = fmt.Sprintf("%+.2f%%", d)
+			// flag changes for the worse beyond noise
+			if math.Abs(d) >= 5 && (d > 0) != dbtester.IsHigherBetter(m) {
+				delta += " :warning:"
+			}
+		}
+		fmt.Fprintf(buf, "| %s | %.4f | %.4f | %s |\n", m, bv, hv, delta)
+	}
+	return buf.String()
+}
+
+func (h *githubHook) comment(url, body string) error {
+	bts, err := json.Marshal(map[string]string{"body": body})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(bts))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "token "+h.token)
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := h.cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		o, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("GitHub returned %s (%s)", resp.Status, strings.TrimSpace(string(o)))
+	}
+	return nil
+}
+
+// lastHistoryRun returns the last run of the history file.
+func lastHistoryRun(fpath string) (dbtester.HistoryRun, error) {
+	runs, err := dbtester.ReadHistory(fpath)
+	if err != nil {
+		return dbtester.HistoryRun{}, err
+	}
+	if len(runs) == 0 {
+		return dbtester.HistoryRun{}, fmt.Errorf("no run in %q", filepath.Base(fpath))
+	}
+	return runs[len(runs)-1], nil
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
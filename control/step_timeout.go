@@ -0,0 +1,63 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package control
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// stepTimeoutError is returned when a step exceeds its wall-clock budget.
+type stepTimeoutError struct {
+	step    string
+	timeout time.Duration
+}
+
+func (e *stepTimeoutError) Error() string {
+	return fmt.Sprintf("%s exceeded timeout %v", e.step, e.timeout)
+}
+
+func isStepTimeout(err error) bool {
+	_, ok := err.(*stepTimeoutError)
+	return ok
+}
+
+// runStep runs the step, and returns stepTimeoutError if it does not
+// finish in 'timeoutSeconds' (0 for no timeout). The timed out step is
+// aborted by canceling its context, and waited for, so that it does not
+// change results while databases are stopped and results are uploaded.
+func runStep(lg *zap.Logger, step string, timeoutSeconds int64, fn func(ctx context.Context) error) error {
+	if timeoutSeconds <= 0 {
+		return fn(context.Background())
+	}
+	timeout := time.Duration(timeoutSeconds) * time.Second
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errc := make(chan error, 1)
+	go func() { errc <- fn(ctx) }()
+	select {
+	case err := <-errc:
+		return err
+	case <-time.After(timeout):
+		lg.Warn("step timed out; aborting", zap.String("step", step), zap.Duration("timeout", timeout))
+		cancel()
+		err := <-errc
+		lg.Warn("step aborted", zap.String("step", step), zap.Error(err))
+		return &stepTimeoutError{step: step, timeout: timeout}
+	}
+}
@@ -14,6 +14,7 @@
 		dbtesterpb/flag_zetcd.proto
 		dbtesterpb/flag_zookeeper.proto
 		dbtesterpb/message.proto
+		dbtesterpb/report.proto
 
 	It has these top-level messages:
 		ConfigAnalyzeMachineInitial
@@ -22,8 +23,12 @@
 		ConfigAnalyzeMachineImage
 		ConfigAnalyzeMachineREADME
 		ConfigClientMachineInitial
+		RemoteStorageDestination
 		ConfigClientMachineBenchmarkOptions
+		ConfigSLO
+		ConfigCompactionPolicy
 		ConfigClientMachineBenchmarkSteps
+		ConfigClientMachineHook
 		ConfigClientMachineAgentControl
 		Flag_Cetcd_Beta
 		Flag_Consul_V1_0_2
@@ -33,8 +38,20 @@
 		Flag_Etcd_V3_3
 		Flag_Zetcd_Beta
 		Flag_Zookeeper_R3_5_3Beta
+		HardwareProfile
+		Phase
 		Request
 		Response
+		UploadRequest
+		UploadFileStatus
+		UploadResponse
+		LogRequest
+		LogLine
+		Report
+		ReportPercentile
+		ReportError
+		ReportOpType
+		ReportSLO
 */
 package dbtesterpb
 
@@ -74,6 +91,35 @@ type ConfigAnalyzeMachineInitial struct {
 	ServerWriteBytesDeltaByKeyNumberPath    string   `protobuf:"bytes,14,opt,name=ServerWriteBytesDeltaByKeyNumberPath,proto3" json:"ServerWriteBytesDeltaByKeyNumberPath,omitempty" yaml:"server_write_bytes_delta_by_key_number_path"`
 	ServerSystemMetricsInterpolatedPathList []string `protobuf:"bytes,15,rep,name=ServerSystemMetricsInterpolatedPathList" json:"ServerSystemMetricsInterpolatedPathList,omitempty" yaml:"server_system_metrics_interpolated_path_list"`
 	AllAggregatedOutputPath                 string   `protobuf:"bytes,16,opt,name=AllAggregatedOutputPath,proto3" json:"AllAggregatedOutputPath,omitempty" yaml:"all_aggregated_output_path"`
+	// ClientLatencyHeatmapPath is the per-second latency histograms
+	// written by control, to be plotted to ClientLatencyHeatmapPlotPath.
+	ClientLatencyHeatmapPath     string `protobuf:"bytes,17,opt,name=ClientLatencyHeatmapPath,proto3" json:"ClientLatencyHeatmapPath,omitempty" yaml:"client_latency_heatmap_path"`
+	ClientLatencyHeatmapPlotPath string `protobuf:"bytes,18,opt,name=ClientLatencyHeatmapPlotPath,proto3" json:"ClientLatencyHeatmapPlotPath,omitempty" yaml:"client_latency_heatmap_plot_path"`
+	// ClientReadConsistencyPath is the serializable and linearizable read latencies
+	// written by control, to be plotted to ClientReadConsistencyPlotPath.
+	ClientReadConsistencyPath     string `protobuf:"bytes,19,opt,name=ClientReadConsistencyPath,proto3" json:"ClientReadConsistencyPath,omitempty" yaml:"client_read_consistency_path"`
+	ClientReadConsistencyPlotPath string `protobuf:"bytes,20,opt,name=ClientReadConsistencyPlotPath,proto3" json:"ClientReadConsistencyPlotPath,omitempty" yaml:"client_read_consistency_plot_path"`
+	// ServerEtcdStatusPathList is the etcd status CSVs of members polled by agents,
+	// with per-second WAL fsync and backend commit latencies. Client p99 spikes
+	// in ClientLatencyHeatmapPath are joined with them per second, and the
+	// subsystem that explains each spike window is written to
+	// ClientLatencySpikeCorrelationPath.
+	ServerEtcdStatusPathList          []string `protobuf:"bytes,21,rep,name=ServerEtcdStatusPathList" json:"ServerEtcdStatusPathList,omitempty" yaml:"server_etcd_status_path_list"`
+	ClientLatencySpikeCorrelationPath string   `protobuf:"bytes,22,opt,name=ClientLatencySpikeCorrelationPath,proto3" json:"ClientLatencySpikeCorrelationPath,omitempty" yaml:"client_latency_spike_correlation_path"`
+	// ClientPhasesPath is the workload phases written by control, to annotate
+	// each second of AllAggregatedOutputPath with its phase.
+	ClientPhasesPath string `protobuf:"bytes,23,opt,name=ClientPhasesPath,proto3" json:"ClientPhasesPath,omitempty" yaml:"client_phases_path"`
+	// ClientTimelinePath is the path to write the events of the run from all
+	// sources below and ClientPhasesPath, ServerEtcdStatusPathList, and
+	// ClientLatencySpikeCorrelationPath, sorted on the clock of control
+	// (empty to skip). Agent timestamps are corrected by ClientClockOffsetsPath.
+	ClientTimelinePath          string `protobuf:"bytes,24,opt,name=ClientTimelinePath,proto3" json:"ClientTimelinePath,omitempty" yaml:"client_timeline_path"`
+	ClientClockOffsetsPath      string `protobuf:"bytes,25,opt,name=ClientClockOffsetsPath,proto3" json:"ClientClockOffsetsPath,omitempty" yaml:"client_clock_offsets_path"`
+	ClientUpgradesPath          string `protobuf:"bytes,26,opt,name=ClientUpgradesPath,proto3" json:"ClientUpgradesPath,omitempty" yaml:"client_upgrades_path"`
+	ClientMembershipChangesPath string `protobuf:"bytes,27,opt,name=ClientMembershipChangesPath,proto3" json:"ClientMembershipChangesPath,omitempty" yaml:"client_membership_changes_path"`
+	// ServerDatabaseEventsPathList is the database events parsed by agents,
+	// in the order of members.
+	ServerDatabaseEventsPathList []string `protobuf:"bytes,28,rep,name=ServerDatabaseEventsPathList" json:"ServerDatabaseEventsPathList,omitempty" yaml:"server_database_events_path_list"`
 }
 
 func (m *ConfigAnalyzeMachineInitial) Reset()         { *m = ConfigAnalyzeMachineInitial{} }
@@ -131,6 +177,9 @@ func (*ConfigAnalyzeMachineImage) Descriptor() ([]byte, []int) {
 type ConfigAnalyzeMachineREADME struct {
 	OutputPath string                       `protobuf:"bytes,1,opt,name=OutputPath,proto3" json:"OutputPath,omitempty" yaml:"output_path"`
 	Images     []*ConfigAnalyzeMachineImage `protobuf:"bytes,2,rep,name=Images" json:"Images,omitempty" yaml:"images"`
+	// HTMLOutputPath is the path to write a self-contained HTML report,
+	// with inlined images, summary table, and configuration (empty to skip).
+	HTMLOutputPath string `protobuf:"bytes,3,opt,name=HTMLOutputPath,proto3" json:"HTMLOutputPath,omitempty" yaml:"html_output_path"`
 }
 
 func (m *ConfigAnalyzeMachineREADME) Reset()         { *m = ConfigAnalyzeMachineREADME{} }
@@ -269,6 +318,120 @@ func (m *ConfigAnalyzeMachineInitial) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.AllAggregatedOutputPath)))
 		i += copy(dAtA[i:], m.AllAggregatedOutputPath)
 	}
+	if len(m.ClientLatencyHeatmapPath) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientLatencyHeatmapPath)))
+		i += copy(dAtA[i:], m.ClientLatencyHeatmapPath)
+	}
+	if len(m.ClientLatencyHeatmapPlotPath) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientLatencyHeatmapPlotPath)))
+		i += copy(dAtA[i:], m.ClientLatencyHeatmapPlotPath)
+	}
+	if len(m.ClientReadConsistencyPath) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientReadConsistencyPath)))
+		i += copy(dAtA[i:], m.ClientReadConsistencyPath)
+	}
+	if len(m.ClientReadConsistencyPlotPath) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientReadConsistencyPlotPath)))
+		i += copy(dAtA[i:], m.ClientReadConsistencyPlotPath)
+	}
+	if len(m.ServerEtcdStatusPathList) > 0 {
+		for _, s := range m.ServerEtcdStatusPathList {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ClientLatencySpikeCorrelationPath) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientLatencySpikeCorrelationPath)))
+		i += copy(dAtA[i:], m.ClientLatencySpikeCorrelationPath)
+	}
+	if len(m.ClientPhasesPath) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientPhasesPath)))
+		i += copy(dAtA[i:], m.ClientPhasesPath)
+	}
+	if len(m.ClientTimelinePath) > 0 {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientTimelinePath)))
+		i += copy(dAtA[i:], m.ClientTimelinePath)
+	}
+	if len(m.ClientClockOffsetsPath) > 0 {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientClockOffsetsPath)))
+		i += copy(dAtA[i:], m.ClientClockOffsetsPath)
+	}
+	if len(m.ClientUpgradesPath) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientUpgradesPath)))
+		i += copy(dAtA[i:], m.ClientUpgradesPath)
+	}
+	if len(m.ClientMembershipChangesPath) > 0 {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientMembershipChangesPath)))
+		i += copy(dAtA[i:], m.ClientMembershipChangesPath)
+	}
+	if len(m.ServerDatabaseEventsPathList) > 0 {
+		for _, s := range m.ServerDatabaseEventsPathList {
+			dAtA[i] = 0xe2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -428,6 +591,12 @@ func (m *ConfigAnalyzeMachineREADME) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.HTMLOutputPath) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.HTMLOutputPath)))
+		i += copy(dAtA[i:], m.HTMLOutputPath)
+	}
 	return i, nil
 }
 
@@ -509,6 +678,58 @@ func (m *ConfigAnalyzeMachineInitial) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
 	}
+	l = len(m.ClientLatencyHeatmapPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.ClientLatencyHeatmapPlotPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.ClientReadConsistencyPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.ClientReadConsistencyPlotPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	if len(m.ServerEtcdStatusPathList) > 0 {
+		for _, s := range m.ServerEtcdStatusPathList {
+			l = len(s)
+			n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+		}
+	}
+	l = len(m.ClientLatencySpikeCorrelationPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.ClientPhasesPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.ClientTimelinePath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.ClientClockOffsetsPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.ClientUpgradesPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.ClientMembershipChangesPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	if len(m.ServerDatabaseEventsPathList) > 0 {
+		for _, s := range m.ServerDatabaseEventsPathList {
+			l = len(s)
+			n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -585,6 +806,10 @@ func (m *ConfigAnalyzeMachineREADME) Size() (n int) {
 			n += 1 + l + sovConfigAnalyzeMachine(uint64(l))
 		}
 	}
+	l = len(m.HTMLOutputPath)
+	if l > 0 {
+		n += 1 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
 	return n
 }
 
@@ -1094,6 +1319,354 @@ func (m *ConfigAnalyzeMachineInitial) Unmarshal(dAtA []byte) error {
 			}
 			m.AllAggregatedOutputPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyHeatmapPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyHeatmapPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyHeatmapPlotPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyHeatmapPlotPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientReadConsistencyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientReadConsistencyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientReadConsistencyPlotPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientReadConsistencyPlotPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerEtcdStatusPathList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerEtcdStatusPathList = append(m.ServerEtcdStatusPathList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencySpikeCorrelationPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencySpikeCorrelationPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPhasesPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientPhasesPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientTimelinePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientTimelinePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientClockOffsetsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientClockOffsetsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUpgradesPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientUpgradesPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientMembershipChangesPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientMembershipChangesPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerDatabaseEventsPathList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerDatabaseEventsPathList = append(m.ServerDatabaseEventsPathList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigAnalyzeMachine(dAtA[iNdEx:])
@@ -1644,6 +2217,35 @@ func (m *ConfigAnalyzeMachineREADME) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTMLOutputPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HTMLOutputPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigAnalyzeMachine(dAtA[iNdEx:])
@@ -1775,68 +2377,91 @@ func init() {
 }
 
 var fileDescriptorConfigAnalyzeMachine = []byte{
-	// 999 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6e, 0xdc, 0x44,
-	0x18, 0xaf, 0x9b, 0x26, 0x90, 0x49, 0xd3, 0x96, 0x01, 0xb5, 0x4b, 0x82, 0xd6, 0xc1, 0x69, 0x48,
-	0xaa, 0x42, 0x52, 0x12, 0x28, 0x12, 0x27, 0x76, 0xb3, 0x3d, 0x44, 0x34, 0x10, 0x39, 0x0b, 0x84,
-	0xd3, 0x68, 0xec, 0x9d, 0x78, 0x47, 0xf1, 0x3f, 0x79, 0xc6, 0x65, 0x0d, 0x57, 0x24, 0x24, 0x24,
-	0x24, 0xb8, 0x71, 0xe2, 0xc8, 0xb3, 0xf4, 0xc8, 0x13, 0x18, 0x08, 0x6f, 0xe0, 0x17, 0xa0, 0x9a,
-	0x6f, 0x9c, 0xc4, 0xbb, 0xf1, 0xfe, 0xb9, 0xad, 0xe7, 0xfb, 0xfd, 0xfb, 0x3e, 0xcf, 0x8e, 0x07,
-	0x6d, 0xf6, 0x1c, 0xc9, 0x84, 0x64, 0x49, 0xec, 0xec, 0xb8, 0x51, 0x78, 0xca, 0x3d, 0x42, 0x43,
-	0xea, 0x67, 0xdf, 0x33, 0x12, 0x50, 0xb7, 0xcf, 0x43, 0xb6, 0x1d, 0x27, 0x91, 0x8c, 0x30, 0xba,
-	0x02, 0xae, 0x7c, 0xe0, 0x71, 0xd9, 0x4f, 0x9d, 0x6d, 0x37, 0x0a, 0x76, 0xbc, 0xc8, 0x8b, 0x76,
-	0x00, 0xe2, 0xa4, 0xa7, 0xf0, 0x04, 0x0f, 0xf0, 0x4b, 0x53, 0xad, 0xbf, 0x97, 0xd1, 0xea, 0x3e,
-	0x68, 0xb7, 0xb4, 0xf4, 0xa1, 0x56, 0x3e, 0x08, 0xb9, 0xe4, 0xd4, 0xc7, 0x4d, 0x84, 0x3a, 0x54,
-	0x52, 0x87, 0x0a, 0x76, 0xd0, 0x69, 0x18, 0x6b, 0xc6, 0xd6, 0xa2, 0x5d, 0x59, 0xc1, 0x6b, 0x68,
-	0xe9, 0xe2, 0xa9, 0x4b, 0xbd, 0xc6, 0x4d, 0x00, 0x54, 0x97, 0xf0, 0x13, 0xf4, 0xe6, 0xc5, 0x63,
-	0x87, 0x09, 0x37, 0xe1, 0xb1, 0xe4, 0x51, 0xd8, 0x98, 0x03, 0x64, 0x5d, 0x09, 0x3f, 0x45, 0xe8,
-	0x88, 0xca, 0xfe, 0x51, 0xc2, 0x4e, 0xf9, 0xa0, 0x71, 0x4b, 0x01, 0xdb, 0xf7, 0x8b, 0xdc, 0xc4,
-	0x19, 0x0d, 0xfc, 0x4f, 0xad, 0x98, 0xca, 0x3e, 0x89, 0xa1, 0x68, 0xd9, 0x15, 0x24, 0xfe, 0xd1,
-	0x40, 0xeb, 0xfb, 0x3e, 0x67, 0xa1, 0x3c, 0xce, 0x84, 0x64, 0xc1, 0x21, 0x93, 0x09, 0x77, 0xc5,
-	0x41, 0xa8, 0x26, 0x13, 0xf9, 0x54, 0xb2, 0x9e, 0x42, 0x37, 0xe6, 0x41, 0x71, 0xb7, 0xc8, 0xcd,
-	0x6d, 0xad, 0xe8, 0x02, 0x89, 0x08, 0x60, 0x91, 0x40, 0xd3, 0x08, 0xaf, 0xf0, 0x88, 0x32, 0xb5,
-	0xec, 0x59, 0xe4, 0xf1, 0xcf, 0x06, 0xda, 0xd0, 0xb8, 0xe7, 0x54, 0xb2, 0xd0, 0xcd, 0xba, 0xfd,
-	0x24, 0x4a, 0xbd, 0x7e, 0x9c, 0xca, 0x2e, 0x0f, 0x98, 0x60, 0x09, 0x67, 0x02, 0x82, 0x2c, 0x40,
-	0x90, 0x8f, 0x8a, 0xdc, 0x7c, 0x32, 0x14, 0xc4, 0xd7, 0x3c, 0x22, 0x2f, 0x89, 0x44, 0x5e, 0x32,
-	0xcb, 0x28, 0xb3, 0x59, 0xe0, 0x1f, 0xd0, 0xda, 0x10, 0xb0, 0xc3, 0x85, 0x4c, 0xb8, 0x93, 0xaa,
-	0x41, 0xb7, 0x7c, 0x1f, 0x62, 0xbc, 0x06, 0x31, 0x76, 0x8a, 0xdc, 0x7c, 0x5c, 0x1b, 0xa3, 0x57,
-	0xe1, 0x10, 0xea, 0xfb, 0x65, 0x82, 0xa9, 0xc2, 0xf8, 0x57, 0x03, 0x6d, 0x8e, 0x05, 0x1d, 0xb1,
-	0xc4, 0x65, 0xa1, 0xe4, 0x3e, 0x83, 0x10, 0xaf, 0x43, 0x88, 0xa7, 0x45, 0x6e, 0xee, 0x4e, 0x0f,
-	0x11, 0x5f, 0x72, 0xcb, 0x2c, 0xb3, 0xda, 0xe0, 0x9f, 0x0c, 0xf4, 0x70, 0x2c, 0xf6, 0x38, 0x0d,
-	0x02, 0x9a, 0x64, 0x90, 0x67, 0x11, 0xf2, 0xec, 0x15, 0xb9, 0xb9, 0x33, 0x3d, 0x8f, 0xd0, 0xc4,
-	0x32, 0xcc, 0x4c, 0x06, 0x38, 0x46, 0xef, 0x0c, 0xe1, 0xda, 0xd9, 0xe7, 0x2c, 0xfb, 0x22, 0x0d,
-	0x1c, 0x96, 0x40, 0x00, 0x04, 0x01, 0xde, 0x2f, 0x72, 0x73, 0xab, 0x36, 0x80, 0x93, 0x91, 0x33,
-	0x96, 0x91, 0x10, 0x18, 0xa5, 0xf3, 0x44, 0x45, 0x9c, 0x21, 0xf3, 0x98, 0x25, 0x2f, 0x58, 0xd2,
-	0xe1, 0xe2, 0xec, 0x38, 0xa6, 0x2e, 0xfb, 0x4a, 0x50, 0x8f, 0x55, 0xbb, 0x5e, 0x1a, 0xdd, 0x0a,
-	0x02, 0x08, 0xaa, 0xdb, 0x33, 0x22, 0x14, 0x85, 0xa4, 0x8a, 0x33, 0xd2, 0xf1, 0x34, 0x5d, 0x1c,
-	0xa0, 0x55, 0x0d, 0x39, 0x64, 0x41, 0x94, 0x5c, 0xeb, 0xf5, 0x36, 0xd8, 0x3e, 0x2e, 0x72, 0x73,
-	0x73, 0xc8, 0x36, 0x00, 0x74, 0x6d, 0xab, 0x93, 0xf4, 0xd4, 0x5b, 0x5e, 0xd7, 0x75, 0x9b, 0xd1,
-	0x5e, 0x3b, 0x93, 0x4c, 0x74, 0x98, 0x2f, 0xe9, 0xa8, 0xef, 0x32, 0xf8, 0x7e, 0x5c, 0xe4, 0xe6,
-	0x87, 0x43, 0xbe, 0x09, 0xa3, 0x3d, 0xe2, 0x28, 0x1a, 0xe9, 0x29, 0x5e, 0x6d, 0x82, 0x59, 0x1c,
-	0xd4, 0x61, 0xf0, 0x50, 0xe3, 0xbe, 0x49, 0xb8, 0x64, 0xe3, 0xa3, 0xdc, 0x19, 0xdd, 0xff, 0x65,
-	0x94, 0xef, 0x14, 0x6d, 0x6a, 0x96, 0x99, 0x3c, 0xf0, 0x6f, 0x06, 0xda, 0xd4, 0xc0, 0x89, 0x27,
-	0xd8, 0x73, 0x2e, 0x64, 0xe3, 0xee, 0xda, 0xdc, 0xd6, 0x62, 0xfb, 0x93, 0x22, 0x37, 0xf7, 0x86,
-	0xf2, 0x4c, 0x3b, 0x24, 0x89, 0xcf, 0x85, 0xb4, 0xec, 0x59, 0x7d, 0x30, 0x41, 0x0f, 0x5a, 0xbe,
-	0xdf, 0xf2, 0xbc, 0x84, 0x79, 0xaa, 0xf0, 0x65, 0x2a, 0xe3, 0x54, 0xc2, 0x48, 0xee, 0xc1, 0x48,
-	0x36, 0x8a, 0xdc, 0x7c, 0x57, 0x47, 0x50, 0x67, 0x0f, 0xbd, 0x44, 0x92, 0x08, 0xa0, 0xe5, 0x04,
-	0xc6, 0xa9, 0x58, 0xff, 0xab, 0x43, 0xa8, 0xe6, 0x0b, 0x57, 0x83, 0xc7, 0x1c, 0xad, 0x8c, 0x91,
-	0xd9, 0x3f, 0xfe, 0x5a, 0x7f, 0xfd, 0xda, 0x8f, 0x8a, 0xdc, 0xdc, 0x98, 0x96, 0x87, 0xb8, 0xe2,
-	0x85, 0x65, 0x4f, 0x10, 0x9b, 0x60, 0xd5, 0x3d, 0xe9, 0xea, 0xef, 0xe8, 0x8c, 0x56, 0x72, 0x20,
-	0xc7, 0x5b, 0x75, 0x4f, 0xba, 0xd6, 0x1f, 0x37, 0x51, 0xa3, 0x6e, 0x02, 0x47, 0x7e, 0x24, 0xf1,
-	0x23, 0xb4, 0xb0, 0x1f, 0xf9, 0x69, 0x10, 0x96, 0xed, 0xbd, 0x51, 0xe4, 0xe6, 0x72, 0x79, 0xe0,
-	0xc0, 0xba, 0x65, 0x97, 0x00, 0xbc, 0x89, 0xe6, 0x4f, 0x5a, 0x03, 0x2e, 0xca, 0x74, 0x15, 0xe4,
-	0x80, 0xd0, 0x01, 0x17, 0x96, 0xad, 0xeb, 0x0a, 0xf8, 0x2d, 0x00, 0xe7, 0x46, 0x81, 0xd9, 0x05,
-	0x10, 0xea, 0xf8, 0x33, 0xb4, 0x3c, 0x3c, 0x62, 0xfd, 0xb1, 0x5f, 0x29, 0x72, 0xf3, 0xbe, 0x26,
-	0x5c, 0x9b, 0xe9, 0x30, 0x01, 0xef, 0xa3, 0x3b, 0x57, 0x0b, 0xb0, 0x71, 0xe7, 0x61, 0xe3, 0xae,
-	0x16, 0xb9, 0xf9, 0xe0, 0xba, 0x84, 0xde, 0x9c, 0x23, 0x14, 0xeb, 0x17, 0x03, 0xbd, 0x5d, 0x7b,
-	0x09, 0x0a, 0xa8, 0xc7, 0xf0, 0x7b, 0x68, 0xbe, 0xcb, 0xa5, 0xcf, 0xca, 0x01, 0xdd, 0x2b, 0x72,
-	0xf3, 0xb6, 0x56, 0x96, 0x6a, 0xd9, 0xb2, 0x75, 0x19, 0xaf, 0xa3, 0x5b, 0xb0, 0x6d, 0xf5, 0x74,
-	0xee, 0x16, 0xb9, 0xb9, 0x74, 0x75, 0x61, 0xb1, 0x6c, 0x28, 0x2a, 0x50, 0x37, 0x8b, 0x59, 0x39,
-	0x99, 0x0a, 0x48, 0x66, 0x31, 0xb3, 0x6c, 0x28, 0x5a, 0x7f, 0x1a, 0x68, 0xa5, 0x2e, 0x8f, 0xfd,
-	0xac, 0xd5, 0x39, 0x7c, 0xa6, 0xee, 0x47, 0x95, 0x7f, 0x89, 0x31, 0x7a, 0x3f, 0x1a, 0xfa, 0x5b,
-	0x54, 0x90, 0xf8, 0x08, 0x2d, 0x40, 0x47, 0xea, 0x05, 0xce, 0x6d, 0x2d, 0xed, 0x6e, 0x6c, 0x5f,
-	0xdd, 0x1b, 0xb7, 0xc7, 0xf6, 0x5f, 0x7d, 0x7d, 0x1c, 0xe8, 0x96, 0x5d, 0xea, 0xb4, 0xdf, 0x7a,
-	0xf9, 0x6f, 0xf3, 0xc6, 0xcb, 0xf3, 0xa6, 0xf1, 0xd7, 0x79, 0xd3, 0xf8, 0xe7, 0xbc, 0x69, 0xfc,
-	0xfe, 0x5f, 0xf3, 0x86, 0xb3, 0x00, 0x57, 0xcb, 0xbd, 0x57, 0x01, 0x00, 0x00, 0xff, 0xff, 0x47,
-	0x8f, 0xbe, 0x24, 0xc0, 0x0a, 0x00, 0x00,
+	// 1364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x5d, 0x6f, 0xdb, 0xb6,
+	0x1a, 0xae, 0x92, 0x26, 0xe7, 0x94, 0xe9, 0x27, 0xdb, 0x93, 0x28, 0x49, 0x1b, 0xa5, 0x4a, 0xd3,
+	0xa4, 0x68, 0x4f, 0xd2, 0xd3, 0x9e, 0x75, 0xc0, 0xae, 0x96, 0xd8, 0x01, 0x1a, 0xac, 0x59, 0x03,
+	0xdb, 0xdd, 0x3a, 0x60, 0x80, 0x40, 0xcb, 0x8c, 0x4d, 0x44, 0x5f, 0x10, 0xe9, 0x2e, 0xda, 0x80,
+	0x5d, 0x0d, 0x18, 0x30, 0x60, 0xc0, 0x76, 0xb7, 0x9b, 0xed, 0xf7, 0xf4, 0x72, 0xbf, 0x40, 0xd8,
+	0xba, 0xcb, 0xdd, 0xe9, 0x0f, 0x6c, 0xe0, 0x4b, 0x26, 0x96, 0x1c, 0xc9, 0xf6, 0xee, 0x6c, 0xf2,
+	0xf9, 0x7a, 0xa9, 0x57, 0xa4, 0x88, 0x36, 0x3a, 0x6d, 0x41, 0xb9, 0xa0, 0x71, 0xd4, 0xde, 0x76,
+	0xc3, 0xe0, 0x88, 0x75, 0x1d, 0x12, 0x10, 0x2f, 0xf9, 0x92, 0x3a, 0x3e, 0x71, 0x7b, 0x2c, 0xa0,
+	0x5b, 0x51, 0x1c, 0x8a, 0x10, 0xa3, 0x01, 0x70, 0xe9, 0xbf, 0x5d, 0x26, 0x7a, 0xfd, 0xf6, 0x96,
+	0x1b, 0xfa, 0xdb, 0xdd, 0xb0, 0x1b, 0x6e, 0x03, 0xa4, 0xdd, 0x3f, 0x82, 0x7f, 0xf0, 0x07, 0x7e,
+	0x29, 0xaa, 0xfd, 0xf3, 0x02, 0x5a, 0xae, 0x81, 0xf6, 0x8e, 0x92, 0x3e, 0x50, 0xca, 0xfb, 0x01,
+	0x13, 0x8c, 0x78, 0x78, 0x05, 0xa1, 0x3a, 0x11, 0xa4, 0x4d, 0x38, 0xdd, 0xaf, 0x9b, 0xc6, 0xaa,
+	0xb1, 0x79, 0xa9, 0x91, 0x1b, 0xc1, 0xab, 0x68, 0xee, 0xf4, 0x5f, 0x8b, 0x74, 0xcd, 0x29, 0x00,
+	0xe4, 0x87, 0xf0, 0x63, 0x74, 0xf3, 0xf4, 0x6f, 0x9d, 0x72, 0x37, 0x66, 0x91, 0x60, 0x61, 0x60,
+	0x4e, 0x03, 0xb2, 0x6c, 0x0a, 0x3f, 0x43, 0xe8, 0x90, 0x88, 0xde, 0x61, 0x4c, 0x8f, 0xd8, 0x89,
+	0x79, 0x51, 0x02, 0x77, 0xe7, 0xb3, 0xd4, 0xc2, 0x09, 0xf1, 0xbd, 0x0f, 0xec, 0x88, 0x88, 0x9e,
+	0x13, 0xc1, 0xa4, 0xdd, 0xc8, 0x21, 0xf1, 0x37, 0x06, 0x5a, 0xab, 0x79, 0x8c, 0x06, 0xa2, 0x99,
+	0x70, 0x41, 0xfd, 0x03, 0x2a, 0x62, 0xe6, 0xf2, 0xfd, 0x40, 0xae, 0x4c, 0xe8, 0x11, 0x41, 0x3b,
+	0x12, 0x6d, 0xce, 0x80, 0xe2, 0x93, 0x2c, 0xb5, 0xb6, 0x94, 0xa2, 0x0b, 0x24, 0x87, 0x03, 0xcb,
+	0xf1, 0x15, 0xcd, 0x61, 0x39, 0x9e, 0x23, 0x4d, 0xed, 0xc6, 0x24, 0xf2, 0xf8, 0x3b, 0x03, 0xad,
+	0x2b, 0xdc, 0x0b, 0x22, 0x68, 0xe0, 0x26, 0xad, 0x5e, 0x1c, 0xf6, 0xbb, 0xbd, 0xa8, 0x2f, 0x5a,
+	0xcc, 0xa7, 0x9c, 0xc6, 0x8c, 0x72, 0x08, 0x32, 0x0b, 0x41, 0xfe, 0x9f, 0xa5, 0xd6, 0xe3, 0x42,
+	0x10, 0x4f, 0xf1, 0x1c, 0x71, 0x46, 0x74, 0xc4, 0x19, 0x53, 0x47, 0x99, 0xcc, 0x02, 0x7f, 0x85,
+	0x56, 0x0b, 0xc0, 0x3a, 0xe3, 0x22, 0x66, 0xed, 0xbe, 0x5c, 0xe8, 0x1d, 0xcf, 0x83, 0x18, 0xff,
+	0x82, 0x18, 0xdb, 0x59, 0x6a, 0x3d, 0x2c, 0x8d, 0xd1, 0xc9, 0x71, 0x1c, 0xe2, 0x79, 0x3a, 0xc1,
+	0x58, 0x61, 0xfc, 0x83, 0x81, 0x36, 0x2a, 0x41, 0x87, 0x34, 0x76, 0x69, 0x20, 0x98, 0x47, 0x21,
+	0xc4, 0xbf, 0x21, 0xc4, 0xb3, 0x2c, 0xb5, 0x9e, 0x8c, 0x0f, 0x11, 0x9d, 0x71, 0x75, 0x96, 0x49,
+	0x6d, 0xf0, 0xb7, 0x06, 0xba, 0x57, 0x89, 0x6d, 0xf6, 0x7d, 0x9f, 0xc4, 0x09, 0xe4, 0xb9, 0x04,
+	0x79, 0x9e, 0x66, 0xa9, 0xb5, 0x3d, 0x3e, 0x0f, 0x57, 0x44, 0x1d, 0x66, 0x22, 0x03, 0x1c, 0xa1,
+	0xdb, 0x05, 0xdc, 0x6e, 0xf2, 0x11, 0x4d, 0x3e, 0xee, 0xfb, 0x6d, 0x1a, 0x43, 0x00, 0x04, 0x01,
+	0x1e, 0x65, 0xa9, 0xb5, 0x59, 0x1a, 0xa0, 0x9d, 0x38, 0xc7, 0x34, 0x71, 0x02, 0x60, 0x68, 0xe7,
+	0x91, 0x8a, 0x38, 0x41, 0x56, 0x93, 0xc6, 0x6f, 0x68, 0x5c, 0x67, 0xfc, 0xb8, 0x19, 0x11, 0x97,
+	0xbe, 0xe2, 0xa4, 0x4b, 0xf3, 0x55, 0xcf, 0x0d, 0xb7, 0x02, 0x07, 0x82, 0xac, 0xf6, 0xd8, 0xe1,
+	0x92, 0xe2, 0xf4, 0x25, 0x67, 0xa8, 0xe2, 0x71, 0xba, 0xd8, 0x47, 0xcb, 0x0a, 0x72, 0x40, 0xfd,
+	0x30, 0x3e, 0x57, 0xeb, 0x65, 0xb0, 0x7d, 0x98, 0xa5, 0xd6, 0x46, 0xc1, 0xd6, 0x07, 0x74, 0x69,
+	0xa9, 0xa3, 0xf4, 0xe4, 0x53, 0x5e, 0x53, 0xf3, 0x0d, 0x4a, 0x3a, 0xbb, 0x89, 0xa0, 0xbc, 0x4e,
+	0x3d, 0x41, 0x86, 0x7d, 0xaf, 0x80, 0xef, 0x7b, 0x59, 0x6a, 0xfd, 0xaf, 0xe0, 0x1b, 0x53, 0xd2,
+	0x71, 0xda, 0x92, 0xe6, 0x74, 0x24, 0xaf, 0x34, 0xc1, 0x24, 0x0e, 0x72, 0x33, 0xb8, 0xa7, 0x70,
+	0x9f, 0xc6, 0x4c, 0xd0, 0xea, 0x28, 0x57, 0x87, 0xfb, 0x5f, 0x47, 0xf9, 0x42, 0xd2, 0xc6, 0x66,
+	0x99, 0xc8, 0x03, 0xff, 0x68, 0xa0, 0x0d, 0x05, 0x1c, 0xb9, 0x83, 0xbd, 0x60, 0x5c, 0x98, 0xd7,
+	0x56, 0xa7, 0x37, 0x2f, 0xed, 0xbe, 0x9f, 0xa5, 0xd6, 0xd3, 0x42, 0x9e, 0x71, 0x9b, 0xa4, 0xe3,
+	0x31, 0x2e, 0xec, 0xc6, 0xa4, 0x3e, 0xd8, 0x41, 0x0b, 0x3b, 0x9e, 0xb7, 0xd3, 0xed, 0xc6, 0xb4,
+	0x2b, 0x27, 0x5e, 0xf6, 0x45, 0xd4, 0x17, 0xb0, 0x24, 0xd7, 0x61, 0x49, 0xd6, 0xb3, 0xd4, 0xba,
+	0xab, 0x22, 0xc8, 0xbd, 0x87, 0x9c, 0x21, 0x9d, 0x10, 0xa0, 0x7a, 0x05, 0xaa, 0x54, 0x70, 0x1b,
+	0x99, 0x85, 0xb7, 0xe2, 0x39, 0x25, 0xc2, 0x27, 0x11, 0x38, 0xdc, 0x00, 0x87, 0xfb, 0x59, 0x6a,
+	0xd9, 0xa5, 0xef, 0x58, 0x4f, 0x61, 0xb5, 0x45, 0xa5, 0x0e, 0x0e, 0xd1, 0xed, 0xd2, 0x39, 0x2f,
+	0x54, 0x95, 0xe0, 0xe1, 0xfe, 0xae, 0xf2, 0xf1, 0x42, 0x51, 0xfa, 0x2a, 0x0f, 0x09, 0x62, 0x8a,
+	0x16, 0xd5, 0xbc, 0xec, 0xbe, 0x5a, 0x18, 0x70, 0xc6, 0x01, 0x07, 0x6e, 0x37, 0xc1, 0x6d, 0x23,
+	0x4b, 0xad, 0xb5, 0x82, 0x1b, 0x74, 0xb5, 0x3b, 0x00, 0x6b, 0xa7, 0x6a, 0x25, 0x1c, 0xa3, 0x3b,
+	0xe5, 0x93, 0xa7, 0x85, 0xdd, 0xaa, 0xd8, 0xa4, 0xce, 0x5b, 0x0d, 0x2a, 0x1b, 0x2d, 0x89, 0x5d,
+	0x64, 0xaa, 0xde, 0xd9, 0x13, 0x6e, 0xa7, 0x29, 0x88, 0xe8, 0xf3, 0xb3, 0xa6, 0xfc, 0xcf, 0xea,
+	0x74, 0xb1, 0x32, 0xdd, 0x94, 0x54, 0xb8, 0x1d, 0x87, 0x03, 0x36, 0xdf, 0x84, 0x95, 0x42, 0xf8,
+	0x6b, 0x74, 0xb7, 0xb0, 0xbe, 0xcd, 0x88, 0x1d, 0xd3, 0x5a, 0x18, 0xc7, 0xd4, 0x23, 0x70, 0x6a,
+	0xc8, 0xe2, 0xe6, 0xa1, 0xb8, 0xc7, 0x59, 0x6a, 0x3d, 0x2a, 0x7d, 0x6a, 0x5c, 0x92, 0x1c, 0x77,
+	0xc0, 0xd2, 0x05, 0x8e, 0x97, 0xc6, 0xfb, 0xe8, 0xba, 0x02, 0x1d, 0xf6, 0x08, 0xd7, 0x5f, 0x03,
+	0x0b, 0x60, 0x77, 0x27, 0x4b, 0xad, 0xc5, 0x82, 0x5d, 0x04, 0x10, 0xad, 0x7d, 0x8e, 0x86, 0x5f,
+	0x22, 0xac, 0xc6, 0xe4, 0xc9, 0xef, 0xb1, 0x40, 0x1d, 0xa7, 0x26, 0x88, 0x59, 0x59, 0x6a, 0x2d,
+	0x17, 0xc4, 0x84, 0x06, 0x69, 0xb9, 0x12, 0x2a, 0xfe, 0x1c, 0xcd, 0xab, 0xd1, 0x9a, 0x17, 0xba,
+	0xc7, 0x2f, 0x8f, 0x8e, 0x38, 0x15, 0x2a, 0xe1, 0x22, 0x88, 0xde, 0xcb, 0x52, 0x6b, 0xb5, 0x20,
+	0xea, 0x4a, 0xa0, 0x13, 0x2a, 0xa4, 0x56, 0xae, 0xd0, 0x18, 0xc4, 0x7d, 0x15, 0x75, 0x63, 0xd2,
+	0xd1, 0xb5, 0x2f, 0x55, 0xc4, 0xed, 0x6b, 0x50, 0x31, 0x6e, 0x9e, 0x8a, 0x8f, 0xd1, 0xb2, 0x1a,
+	0x3d, 0xa0, 0x72, 0xa3, 0xe3, 0x3d, 0x16, 0xd5, 0x7a, 0x24, 0xe8, 0x6a, 0xe5, 0x65, 0x50, 0x7e,
+	0x90, 0xa5, 0xd6, 0x7a, 0x41, 0xd9, 0x3f, 0x43, 0x3b, 0xae, 0x82, 0x9f, 0x1e, 0x2c, 0x23, 0xd4,
+	0xe4, 0x8b, 0xae, 0x8f, 0x3a, 0xfd, 0xdd, 0xba, 0xf7, 0x86, 0x06, 0x62, 0xd0, 0xa0, 0xb7, 0x57,
+	0xa7, 0x8b, 0x2f, 0xfa, 0xe9, 0xf9, 0xa9, 0xe1, 0x0e, 0x05, 0x7c, 0xbe, 0x49, 0x47, 0x0a, 0xda,
+	0x7f, 0xc9, 0x4f, 0xa8, 0x92, 0xef, 0xf3, 0x92, 0xdd, 0x0e, 0x33, 0xb4, 0x54, 0xb1, 0x09, 0xd6,
+	0x9a, 0x9f, 0x98, 0xc6, 0xf0, 0x42, 0x54, 0xef, 0xa6, 0x8e, 0xcb, 0xdf, 0xd8, 0x8d, 0x11, 0x62,
+	0x23, 0xac, 0x5a, 0xaf, 0x5b, 0xe6, 0xd4, 0x3f, 0xb0, 0x12, 0x27, 0xa2, 0xda, 0xaa, 0xf5, 0xba,
+	0x65, 0xff, 0x32, 0x85, 0xcc, 0xb2, 0x15, 0x90, 0x1b, 0x06, 0x7e, 0x80, 0x66, 0x6b, 0xa1, 0xd7,
+	0xf7, 0x03, 0x5d, 0xde, 0x8d, 0x2c, 0xb5, 0xae, 0xe8, 0xe7, 0x0c, 0xe3, 0x76, 0x43, 0x03, 0xf0,
+	0x06, 0x9a, 0x79, 0xbd, 0x73, 0xc2, 0xb8, 0x39, 0x35, 0x8c, 0x3c, 0x71, 0xc8, 0x09, 0xe3, 0x76,
+	0x43, 0xcd, 0x4b, 0xe0, 0x67, 0x00, 0x9c, 0x1e, 0x06, 0x26, 0xa7, 0x40, 0x98, 0xc7, 0x1f, 0xa2,
+	0x2b, 0xc5, 0x25, 0x56, 0x57, 0x95, 0xa5, 0x2c, 0xb5, 0xe6, 0x15, 0xe1, 0xdc, 0x9a, 0x16, 0x09,
+	0xb8, 0x86, 0xae, 0x0e, 0x06, 0xa0, 0x81, 0x66, 0xa0, 0x81, 0x96, 0xb3, 0xd4, 0x5a, 0x38, 0x2f,
+	0xa1, 0x1a, 0x66, 0x88, 0x62, 0x7f, 0x6f, 0xa0, 0xc5, 0xd2, 0x2b, 0x9c, 0x4f, 0xba, 0x14, 0xdf,
+	0x47, 0x33, 0x2d, 0x26, 0x3c, 0xaa, 0x17, 0xe8, 0x7a, 0x96, 0x5a, 0x97, 0x95, 0xb2, 0x90, 0xc3,
+	0x76, 0x43, 0x4d, 0xe3, 0x35, 0x74, 0x11, 0xde, 0x17, 0xb5, 0x3a, 0xd7, 0xb2, 0xd4, 0x9a, 0x1b,
+	0x5c, 0xb7, 0xec, 0x06, 0x4c, 0x4a, 0x50, 0x2b, 0x89, 0xa8, 0x39, 0x3d, 0x0c, 0x12, 0x49, 0x44,
+	0xed, 0x06, 0x4c, 0xda, 0x7f, 0x1a, 0x68, 0xa9, 0x2c, 0x4f, 0x63, 0x6f, 0xa7, 0x7e, 0xb0, 0x27,
+	0x6f, 0x77, 0xb9, 0x33, 0xde, 0x18, 0xbe, 0xdd, 0x15, 0x0e, 0xf5, 0x1c, 0x12, 0x1f, 0xa2, 0x59,
+	0xa8, 0x48, 0x3e, 0xc0, 0xe9, 0xcd, 0xb9, 0x27, 0xeb, 0x5b, 0x83, 0x5b, 0xef, 0x56, 0x65, 0xfd,
+	0xf9, 0xc7, 0xc7, 0x80, 0x6e, 0x37, 0xb4, 0x8e, 0x5c, 0xfd, 0xe7, 0xad, 0x83, 0x17, 0xb9, 0x34,
+	0xaa, 0xae, 0xdc, 0xea, 0xf7, 0x84, 0xef, 0x15, 0xbf, 0x33, 0x86, 0x28, 0xbb, 0xb7, 0xde, 0xfe,
+	0xbe, 0x72, 0xe1, 0xed, 0xbb, 0x15, 0xe3, 0xd7, 0x77, 0x2b, 0xc6, 0x6f, 0xef, 0x56, 0x8c, 0x9f,
+	0xfe, 0x58, 0xb9, 0xd0, 0x9e, 0x85, 0xdb, 0xf5, 0xd3, 0xbf, 0x07, 0x00, 0x21, 0x38, 0xda, 0xbf,
+	0xc3, 0x0f, 0x00, 0x00,
 }
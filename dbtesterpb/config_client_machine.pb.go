@@ -8,6 +8,9 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 
+import sortkeys "github.com/gogo/protobuf/sortkeys"
+import binary "encoding/binary"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -27,11 +30,101 @@ type ConfigClientMachineInitial struct {
 	ClientLatencyDistributionSummaryPath    string `protobuf:"bytes,8,opt,name=ClientLatencyDistributionSummaryPath,proto3" json:"ClientLatencyDistributionSummaryPath,omitempty" yaml:"client_latency_distribution_summary_path"`
 	ClientLatencyByKeyNumberPath            string `protobuf:"bytes,9,opt,name=ClientLatencyByKeyNumberPath,proto3" json:"ClientLatencyByKeyNumberPath,omitempty" yaml:"client_latency_by_key_number_path"`
 	ServerDiskSpaceUsageSummaryPath         string `protobuf:"bytes,10,opt,name=ServerDiskSpaceUsageSummaryPath,proto3" json:"ServerDiskSpaceUsageSummaryPath,omitempty" yaml:"server_disk_space_usage_summary_path"`
-	GoogleCloudProjectName                  string `protobuf:"bytes,100,opt,name=GoogleCloudProjectName,proto3" json:"GoogleCloudProjectName,omitempty" yaml:"google_cloud_project_name"`
-	GoogleCloudStorageKeyPath               string `protobuf:"bytes,101,opt,name=GoogleCloudStorageKeyPath,proto3" json:"GoogleCloudStorageKeyPath,omitempty" yaml:"google_cloud_storage_key_path"`
-	GoogleCloudStorageKey                   string `protobuf:"bytes,102,opt,name=GoogleCloudStorageKey,proto3" json:"GoogleCloudStorageKey,omitempty"`
-	GoogleCloudStorageBucketName            string `protobuf:"bytes,103,opt,name=GoogleCloudStorageBucketName,proto3" json:"GoogleCloudStorageBucketName,omitempty" yaml:"google_cloud_storage_bucket_name"`
-	GoogleCloudStorageSubDirectory          string `protobuf:"bytes,104,opt,name=GoogleCloudStorageSubDirectory,proto3" json:"GoogleCloudStorageSubDirectory,omitempty" yaml:"google_cloud_storage_sub_directory"`
+	ClientMembershipChangesPath             string `protobuf:"bytes,11,opt,name=ClientMembershipChangesPath,proto3" json:"ClientMembershipChangesPath,omitempty" yaml:"client_membership_changes_path"`
+	ClientBackupRestoreSummaryPath          string `protobuf:"bytes,12,opt,name=ClientBackupRestoreSummaryPath,proto3" json:"ClientBackupRestoreSummaryPath,omitempty" yaml:"client_backup_restore_summary_path"`
+	// ClientUploadManifestPath is the path to write the manifest of uploaded files.
+	ClientUploadManifestPath string `protobuf:"bytes,13,opt,name=ClientUploadManifestPath,proto3" json:"ClientUploadManifestPath,omitempty" yaml:"client_upload_manifest_path"`
+	// TestID scopes all agent state (logs, data directories, processes) of this test,
+	// so that multiple tests can run on the same agents concurrently.
+	TestID string `protobuf:"bytes,14,opt,name=TestID,proto3" json:"TestID,omitempty" yaml:"test_id"`
+	// PortOffset is added to all database ports, including 'database_port_to_connect'.
+	// Concurrent tests on the same agents must use different offsets.
+	PortOffset int64 `protobuf:"varint,15,opt,name=PortOffset,proto3" json:"PortOffset,omitempty" yaml:"port_offset"`
+	// ClientLatencyDistributionCorrectedPath is the path to write measured and
+	// coordinated-omission-corrected latency percentiles. Corrected latencies are
+	// measured from when each request should have been sent at the target rate.
+	ClientLatencyDistributionCorrectedPath string `protobuf:"bytes,16,opt,name=ClientLatencyDistributionCorrectedPath,proto3" json:"ClientLatencyDistributionCorrectedPath,omitempty" yaml:"client_latency_distribution_corrected_path"`
+	// ClientLatencyHeatmapPath is the path to write per-second latency histograms,
+	// to render latency heatmaps across the run.
+	ClientLatencyHeatmapPath string `protobuf:"bytes,17,opt,name=ClientLatencyHeatmapPath,proto3" json:"ClientLatencyHeatmapPath,omitempty" yaml:"client_latency_heatmap_path"`
+	// ClientReportPath is the path to write the report that control prints to stdout.
+	ClientReportPath string `protobuf:"bytes,18,opt,name=ClientReportPath,proto3" json:"ClientReportPath,omitempty" yaml:"client_report_path"`
+	// TestName is the experiment name, the top directory of uploaded files.
+	// Defaults to 'test_id', or else the test title.
+	TestName string `protobuf:"bytes,19,opt,name=TestName,proto3" json:"TestName,omitempty" yaml:"test_name"`
+	// RunTimestamp identifies a run of the experiment. Defaults to when control starts.
+	RunTimestamp string `protobuf:"bytes,20,opt,name=RunTimestamp,proto3" json:"RunTimestamp,omitempty" yaml:"run_timestamp"`
+	// ClientReadYourWritesPath is the path to write staleness of reads
+	// from other endpoints, for 'read-your-writes' type.
+	ClientReadYourWritesPath string `protobuf:"bytes,21,opt,name=ClientReadYourWritesPath,proto3" json:"ClientReadYourWritesPath,omitempty" yaml:"client_read_your_writes_path"`
+	// ClientRepeatSummaryPath is the path to write the metrics of each repeated
+	// benchmark with confidence intervals, when 'repeat' is greater than 1.
+	ClientRepeatSummaryPath string `protobuf:"bytes,22,opt,name=ClientRepeatSummaryPath,proto3" json:"ClientRepeatSummaryPath,omitempty" yaml:"client_repeat_summary_path"`
+	// ClientOpenMetricsPath is the path to write the final latency histogram and
+	// request counters in OpenMetrics text format, to push to a Prometheus Pushgateway.
+	ClientOpenMetricsPath string `protobuf:"bytes,23,opt,name=ClientOpenMetricsPath,proto3" json:"ClientOpenMetricsPath,omitempty" yaml:"client_open_metrics_path"`
+	// ClientReadConsistencyPath is the path to write serializable and linearizable
+	// read latency percentiles side by side, for 'read-consistency' type.
+	ClientReadConsistencyPath string `protobuf:"bytes,24,opt,name=ClientReadConsistencyPath,proto3" json:"ClientReadConsistencyPath,omitempty" yaml:"client_read_consistency_path"`
+	// ClientLeaderTimeseriesPath is the path to write the role (leader or follower)
+	// of each member by second during the benchmark, to label per-member results.
+	ClientLeaderTimeseriesPath string `protobuf:"bytes,25,opt,name=ClientLeaderTimeseriesPath,proto3" json:"ClientLeaderTimeseriesPath,omitempty" yaml:"client_leader_timeseries_path"`
+	// ClientAuthPenaltyPath is the path to write anonymous and authenticated
+	// write latency percentiles and throughput side by side, for 'auth' type.
+	ClientAuthPenaltyPath string `protobuf:"bytes,26,opt,name=ClientAuthPenaltyPath,proto3" json:"ClientAuthPenaltyPath,omitempty" yaml:"client_auth_penalty_path"`
+	// ClientWatchSummaryPath is the path to write the number of established
+	// watchers, watch creation latencies, and received events, for 'watch' type.
+	ClientWatchSummaryPath string `protobuf:"bytes,27,opt,name=ClientWatchSummaryPath,proto3" json:"ClientWatchSummaryPath,omitempty" yaml:"client_watch_summary_path"`
+	// ClientPhasesPath is the path to write the workload phases (warmup, load,
+	// fault-injection, recovery, drain) with their start and end timestamps.
+	ClientPhasesPath string `protobuf:"bytes,28,opt,name=ClientPhasesPath,proto3" json:"ClientPhasesPath,omitempty" yaml:"client_phases_path"`
+	// ClientUpgradesPath is the path to write the restart time and downtime of
+	// each member, with 'upgrade_etcd_git_ref'.
+	ClientUpgradesPath string `protobuf:"bytes,29,opt,name=ClientUpgradesPath,proto3" json:"ClientUpgradesPath,omitempty" yaml:"client_upgrades_path"`
+	// ClientSnapshotTransferSummaryPath is the path to write the catch-up time,
+	// network usage, and leader latency of a restarted member, for
+	// 'snapshot-transfer' type.
+	ClientSnapshotTransferSummaryPath string `protobuf:"bytes,30,opt,name=ClientSnapshotTransferSummaryPath,proto3" json:"ClientSnapshotTransferSummaryPath,omitempty" yaml:"client_snapshot_transfer_summary_path"`
+	// AgentAuthSecretPath is the path to the secret shared with agents
+	// ('--auth-secret-path'), to sign all requests to agents.
+	AgentAuthSecretPath string `protobuf:"bytes,31,opt,name=AgentAuthSecretPath,proto3" json:"AgentAuthSecretPath,omitempty" yaml:"agent_auth_secret_path"`
+	// ClientClockOffsetsPath is the path to write the clock offset of each
+	// agent from control, measured before step 2.
+	ClientClockOffsetsPath string `protobuf:"bytes,32,opt,name=ClientClockOffsetsPath,proto3" json:"ClientClockOffsetsPath,omitempty" yaml:"client_clock_offsets_path"`
+	// ClientOpTypeSummaryPath is the path to write the throughput and latency
+	// percentiles per operation type (e.g. reads, writes, ranges) of the benchmark.
+	ClientOpTypeSummaryPath string `protobuf:"bytes,33,opt,name=ClientOpTypeSummaryPath,proto3" json:"ClientOpTypeSummaryPath,omitempty" yaml:"client_op_type_summary_path"`
+	// ClientWatchCompactionSummaryPath is the path to write the compacted
+	// watch resumes, re-lists, and their latencies, for 'watch-compaction' type.
+	ClientWatchCompactionSummaryPath string `protobuf:"bytes,34,opt,name=ClientWatchCompactionSummaryPath,proto3" json:"ClientWatchCompactionSummaryPath,omitempty" yaml:"client_watch_compaction_summary_path"`
+	// ClientDeleteRangeSummaryPath is the path to write the latencies of probe
+	// reads and writes before and during range deletes, and during compaction
+	// of the deleted revisions, for 'delete-range' type.
+	ClientDeleteRangeSummaryPath string `protobuf:"bytes,35,opt,name=ClientDeleteRangeSummaryPath,proto3" json:"ClientDeleteRangeSummaryPath,omitempty" yaml:"client_delete_range_summary_path"`
+	// ClientTenancySummaryPath is the path to write the reader latencies with
+	// and without the concurrent bulk writer, for 'tenancy' type.
+	ClientTenancySummaryPath string `protobuf:"bytes,36,opt,name=ClientTenancySummaryPath,proto3" json:"ClientTenancySummaryPath,omitempty" yaml:"client_tenancy_summary_path"`
+	// ClientReportDataPath is the path to write the machine-readable report,
+	// with the extension of each format in 'client_report_formats'.
+	ClientReportDataPath string `protobuf:"bytes,37,opt,name=ClientReportDataPath,proto3" json:"ClientReportDataPath,omitempty" yaml:"client_report_data_path"`
+	// ClientReportFormats is the formats of the machine-readable report,
+	// 'csv', 'json', 'protobuf', or registered with 'RegisterReportEncoder'.
+	ClientReportFormats []string `protobuf:"bytes,38,rep,name=ClientReportFormats" json:"ClientReportFormats,omitempty" yaml:"client_report_formats"`
+	// ClientComparisonReportPath is the path to write the comparison of
+	// all databases of a 'control --database-ids' run, one row per database.
+	ClientComparisonReportPath     string `protobuf:"bytes,39,opt,name=ClientComparisonReportPath,proto3" json:"ClientComparisonReportPath,omitempty" yaml:"client_comparison_report_path"`
+	GoogleCloudProjectName         string `protobuf:"bytes,100,opt,name=GoogleCloudProjectName,proto3" json:"GoogleCloudProjectName,omitempty" yaml:"google_cloud_project_name"`
+	GoogleCloudStorageKeyPath      string `protobuf:"bytes,101,opt,name=GoogleCloudStorageKeyPath,proto3" json:"GoogleCloudStorageKeyPath,omitempty" yaml:"google_cloud_storage_key_path"`
+	GoogleCloudStorageKey          string `protobuf:"bytes,102,opt,name=GoogleCloudStorageKey,proto3" json:"GoogleCloudStorageKey,omitempty"`
+	GoogleCloudStorageBucketName   string `protobuf:"bytes,103,opt,name=GoogleCloudStorageBucketName,proto3" json:"GoogleCloudStorageBucketName,omitempty" yaml:"google_cloud_storage_bucket_name"`
+	GoogleCloudStorageSubDirectory string `protobuf:"bytes,104,opt,name=GoogleCloudStorageSubDirectory,proto3" json:"GoogleCloudStorageSubDirectory,omitempty" yaml:"google_cloud_storage_sub_directory"`
+	// RemoteStorageDestinations is the list of destinations to upload to.
+	// If empty, it uploads to Google Cloud Storage with 'google_cloud_storage_*' options,
+	// or nowhere if 'google_cloud_storage_bucket_name' is empty (offline).
+	RemoteStorageDestinations []*RemoteStorageDestination `protobuf:"bytes,105,rep,name=RemoteStorageDestinations" json:"RemoteStorageDestinations,omitempty" yaml:"remote_storage_destinations"`
+	// Labels are free-form run metadata (e.g. machine-type, disk-type, branch, commit).
+	// Uploaded objects are stored under 'key=value' sub-directories of sorted labels.
+	Labels map[string]string `protobuf:"bytes,200,rep,name=Labels" json:"Labels,omitempty" yaml:"labels" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *ConfigClientMachineInitial) Reset()         { *m = ConfigClientMachineInitial{} }
@@ -41,6 +134,25 @@ func (*ConfigClientMachineInitial) Descriptor() ([]byte, []int) {
 	return fileDescriptorConfigClientMachine, []int{0}
 }
 
+// RemoteStorageDestination represents a destination to upload test results and logs.
+type RemoteStorageDestination struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty" yaml:"name"`
+	// Type is either "google-cloud-storage" or "local".
+	Type string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty" yaml:"type"`
+	// Bucket is the bucket name for "google-cloud-storage".
+	Bucket       string `protobuf:"bytes,3,opt,name=Bucket,proto3" json:"Bucket,omitempty" yaml:"bucket"`
+	SubDirectory string `protobuf:"bytes,4,opt,name=SubDirectory,proto3" json:"SubDirectory,omitempty" yaml:"sub_directory"`
+	// LocalDirectory is the root directory for "local".
+	LocalDirectory string `protobuf:"bytes,5,opt,name=LocalDirectory,proto3" json:"LocalDirectory,omitempty" yaml:"local_directory"`
+}
+
+func (m *RemoteStorageDestination) Reset()         { *m = RemoteStorageDestination{} }
+func (m *RemoteStorageDestination) String() string { return proto.CompactTextString(m) }
+func (*RemoteStorageDestination) ProtoMessage()    {}
+func (*RemoteStorageDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{1}
+}
+
 // ConfigClientMachineBenchmarkOptions represents benchmark options.
 type ConfigClientMachineBenchmarkOptions struct {
 	Type                       string  `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty" yaml:"type"`
@@ -53,13 +165,218 @@ type ConfigClientMachineBenchmarkOptions struct {
 	KeySizeBytes               int64   `protobuf:"varint,8,opt,name=KeySizeBytes,proto3" json:"KeySizeBytes,omitempty" yaml:"key_size_bytes"`
 	ValueSizeBytes             int64   `protobuf:"varint,9,opt,name=ValueSizeBytes,proto3" json:"ValueSizeBytes,omitempty" yaml:"value_size_bytes"`
 	StaleRead                  bool    `protobuf:"varint,10,opt,name=StaleRead,proto3" json:"StaleRead,omitempty" yaml:"stale_read"`
+	// WorkloadName is the name of registered workload for 'custom' type.
+	WorkloadName string `protobuf:"bytes,11,opt,name=WorkloadName,proto3" json:"WorkloadName,omitempty" yaml:"workload_name"`
+	// WorkloadPluginPath is the path to Go plugin that registers the workload.
+	WorkloadPluginPath string `protobuf:"bytes,12,opt,name=WorkloadPluginPath,proto3" json:"WorkloadPluginPath,omitempty" yaml:"workload_plugin_path"`
+	// ScalingMemberNumbers is the sequence of cluster sizes to reconfigure to
+	// during the benchmark (e.g. [1, 3, 5, 3, 1]). The first is the initial size.
+	// Members are added and removed in 'peer_ips' order. Only etcd is supported.
+	ScalingMemberNumbers []int64 `protobuf:"varint,13,rep,packed,name=ScalingMemberNumbers" json:"ScalingMemberNumbers,omitempty" yaml:"scaling_member_numbers"`
+	// ScalingIntervalSeconds is the number of seconds between reconfigurations.
+	ScalingIntervalSeconds int64 `protobuf:"varint,14,opt,name=ScalingIntervalSeconds,proto3" json:"ScalingIntervalSeconds,omitempty" yaml:"scaling_interval_seconds"`
+	// BackupRestoreKeyNumbers is the sequence of total key numbers to back up
+	// and restore at, for 'backup-restore' type.
+	BackupRestoreKeyNumbers []int64 `protobuf:"varint,15,rep,packed,name=BackupRestoreKeyNumbers" json:"BackupRestoreKeyNumbers,omitempty" yaml:"backup_restore_key_numbers"`
+	// MaxErrorRate aborts the benchmark when the ratio of failed requests
+	// exceeds it (e.g. 0.1). Partial results are still saved, and databases stopped.
+	MaxErrorRate float64 `protobuf:"fixed64,16,opt,name=MaxErrorRate,proto3" json:"MaxErrorRate,omitempty" yaml:"max_error_rate"`
+	// MaxConsecutiveErrors aborts the benchmark after this many consecutive failed requests.
+	MaxConsecutiveErrors int64 `protobuf:"varint,17,opt,name=MaxConsecutiveErrors,proto3" json:"MaxConsecutiveErrors,omitempty" yaml:"max_consecutive_errors"`
+	// ListPageSize is the number of keys per page, for 'list' type
+	// that enumerates the whole keyspace after loading 'request_number' keys.
+	ListPageSize int64 `protobuf:"varint,18,opt,name=ListPageSize,proto3" json:"ListPageSize,omitempty" yaml:"list_page_size"`
+	// ListIterations is the number of full-keyspace enumerations, for 'list' type.
+	ListIterations int64 `protobuf:"varint,19,opt,name=ListIterations,proto3" json:"ListIterations,omitempty" yaml:"list_iterations"`
+	// KubernetesObjects is the number of objects created before the
+	// 'kubernetes-apiserver' workload (default 10000).
+	KubernetesObjects int64 `protobuf:"varint,20,opt,name=KubernetesObjects,proto3" json:"KubernetesObjects,omitempty" yaml:"kubernetes_objects"`
+	// KubernetesHotObjects is the number of frequently updated objects (default 100).
+	KubernetesHotObjects int64 `protobuf:"varint,21,opt,name=KubernetesHotObjects,proto3" json:"KubernetesHotObjects,omitempty" yaml:"kubernetes_hot_objects"`
+	// KubernetesListRatio is the ratio of LIST requests to updates (default 0.1).
+	KubernetesListRatio float64 `protobuf:"fixed64,22,opt,name=KubernetesListRatio,proto3" json:"KubernetesListRatio,omitempty" yaml:"kubernetes_list_ratio"`
+	// KubernetesWatchers is the number of watchers on all objects (default 10).
+	KubernetesWatchers int64 `protobuf:"varint,23,opt,name=KubernetesWatchers,proto3" json:"KubernetesWatchers,omitempty" yaml:"kubernetes_watchers"`
+	// Repeat is the number of times to run the benchmark on the same cluster,
+	// to compute confidence intervals of results (default 1).
+	Repeat int64 `protobuf:"varint,24,opt,name=Repeat,proto3" json:"Repeat,omitempty" yaml:"repeat"`
+	// CompactionPolicy compacts etcd periodically during 'write' benchmarks.
+	CompactionPolicy *ConfigCompactionPolicy `protobuf:"bytes,25,opt,name=CompactionPolicy" json:"CompactionPolicy,omitempty" yaml:"compaction_policy"`
+	// TraceSampleRatio is the fraction of requests to trace (e.g. 0.001), zero to disable.
+	// Trace context is propagated to etcd as gRPC 'traceparent' metadata.
+	TraceSampleRatio float64 `protobuf:"fixed64,26,opt,name=TraceSampleRatio,proto3" json:"TraceSampleRatio,omitempty" yaml:"trace_sample_ratio"`
+	// TraceOTLPEndpoint is the OTLP/HTTP endpoint to export spans to
+	// (e.g. 'http://localhost:4318').
+	TraceOTLPEndpoint string `protobuf:"bytes,27,opt,name=TraceOTLPEndpoint,proto3" json:"TraceOTLPEndpoint,omitempty" yaml:"trace_otlp_endpoint"`
+	// SLOs are evaluated on the results of the benchmark. The report states
+	// pass or fail of each SLO, and control exits with error if any fails.
+	SLOs []*ConfigSLO `protobuf:"bytes,28,rep,name=SLOs" json:"SLOs,omitempty" yaml:"slos"`
+	// ValueEncoding is 'random' (default) for random bytes, or 'json' or 'protobuf'
+	// for serialized documents of about 'value_size_bytes', since compression and
+	// storage behave differently with structured payloads.
+	ValueEncoding string `protobuf:"bytes,29,opt,name=ValueEncoding,proto3" json:"ValueEncoding,omitempty" yaml:"value_encoding"`
+	// ValueSchemaFields is the number of fields of each document object (default 8).
+	ValueSchemaFields int64 `protobuf:"varint,30,opt,name=ValueSchemaFields,proto3" json:"ValueSchemaFields,omitempty" yaml:"value_schema_fields"`
+	// ValueSchemaDepth is the nesting depth of document objects (default 2).
+	ValueSchemaDepth int64 `protobuf:"varint,31,opt,name=ValueSchemaDepth,proto3" json:"ValueSchemaDepth,omitempty" yaml:"value_schema_depth"`
+	// EtcdCompression is the gRPC compressor of etcd clients, 'none' (default) or 'gzip'.
+	// The server must accept the compressed messages (see 'extra_flags' of etcd).
+	EtcdCompression string `protobuf:"bytes,32,opt,name=EtcdCompression,proto3" json:"EtcdCompression,omitempty" yaml:"etcd_compression"`
+	// ValueCompression compresses values client-side before writes, and decompresses
+	// values after reads, 'none' (default) or 'gzip'. Compression time is part of latencies.
+	ValueCompression string `protobuf:"bytes,33,opt,name=ValueCompression,proto3" json:"ValueCompression,omitempty" yaml:"value_compression"`
+	// TraceFile is the file recorded with '--record' to replay, for 'trace' type.
+	// Operations are replayed in order at their recorded intended times, with
+	// values regenerated from their sizes and hashes, so that replays against
+	// different databases send identical requests. 'request_number', if set,
+	// replays the first operations only.
+	TraceFile string `protobuf:"bytes,34,opt,name=TraceFile,proto3" json:"TraceFile,omitempty" yaml:"trace_file"`
+	// WatcherNumber is the number of watchers on the keys of 'request_number'
+	// writes, for 'watch' type (default 10000). Watchers are multiplexed over
+	// 'connection_number' gRPC connections, so that the number of watchers is
+	// bound by the server rather than client sockets.
+	WatcherNumber int64 `protobuf:"varint,35,opt,name=WatcherNumber,proto3" json:"WatcherNumber,omitempty" yaml:"watcher_number"`
+	// WatchStreamsPerConnection is the number of gRPC watch streams on each
+	// connection, shared by its watchers (default 1).
+	WatchStreamsPerConnection int64 `protobuf:"varint,36,opt,name=WatchStreamsPerConnection,proto3" json:"WatchStreamsPerConnection,omitempty" yaml:"watch_streams_per_connection"`
+	// DialConcurrency is the number of client connections established at once
+	// (0 for all at once). Clients connect lazily, so each connection is
+	// verified with a request before the next one is dialed in its place.
+	DialConcurrency int64 `protobuf:"varint,37,opt,name=DialConcurrency,proto3" json:"DialConcurrency,omitempty" yaml:"dial_concurrency"`
+	// DialJitterMs is the upper bound of a random delay before each dial.
+	DialJitterMs int64 `protobuf:"varint,38,opt,name=DialJitterMs,proto3" json:"DialJitterMs,omitempty" yaml:"dial_jitter_ms"`
+	// VerifyConnections sends a request on every connection before the
+	// measured phase begins, so that connection setup is not measured.
+	VerifyConnections bool `protobuf:"varint,39,opt,name=VerifyConnections,proto3" json:"VerifyConnections,omitempty" yaml:"verify_connections"`
+	// UpgradeEtcdGitRef is the git ref of etcd to rolling-upgrade members to
+	// during the benchmark. Members start at 'etcd_git_ref' (or the installed
+	// binary), and are restarted one at a time with the new binary, keeping
+	// their data, in 'peer_ips' order. Only etcd is supported.
+	UpgradeEtcdGitRef string `protobuf:"bytes,40,opt,name=UpgradeEtcdGitRef,proto3" json:"UpgradeEtcdGitRef,omitempty" yaml:"upgrade_etcd_git_ref"`
+	// UpgradeIntervalSeconds is the number of seconds before the first
+	// upgrade, and between a member rejoining and the next upgrade.
+	UpgradeIntervalSeconds int64 `protobuf:"varint,41,opt,name=UpgradeIntervalSeconds,proto3" json:"UpgradeIntervalSeconds,omitempty" yaml:"upgrade_interval_seconds"`
+	// KeyEncoding is the encoding of the key number, 'decimal' (default,
+	// zero-padded to 'key_size_bytes') or 'binary' (big-endian bytes, etcd only).
+	KeyEncoding string `protobuf:"bytes,42,opt,name=KeyEncoding,proto3" json:"KeyEncoding,omitempty" yaml:"key_encoding"`
+	// KeyPathDepth is the number of directories above each key (0 for flat
+	// keys), and KeyPathFanout is the number of sub-directories of each
+	// directory (e.g. depth 2 and fanout 10 writes '3/7/0000000073'). Parent
+	// znodes are created before the benchmark for Zookeeper.
+	KeyPathDepth  int64 `protobuf:"varint,43,opt,name=KeyPathDepth,proto3" json:"KeyPathDepth,omitempty" yaml:"key_path_depth"`
+	KeyPathFanout int64 `protobuf:"varint,44,opt,name=KeyPathFanout,proto3" json:"KeyPathFanout,omitempty" yaml:"key_path_fanout"`
+	// DropPageCache syncs and drops the page cache of agent machines right
+	// before the measured reads of 'read' and 'read-oneshot', to measure cold
+	// reads (requires agents to run as root). ColdRestart also restarts the
+	// databases meanwhile, so that their in-memory caches are cold as well.
+	DropPageCache bool `protobuf:"varint,45,opt,name=DropPageCache,proto3" json:"DropPageCache,omitempty" yaml:"drop_page_cache"`
+	ColdRestart   bool `protobuf:"varint,46,opt,name=ColdRestart,proto3" json:"ColdRestart,omitempty" yaml:"cold_restart"`
+	// ConsulCatalogServices is the number of services registered in the Consul
+	// catalog before 'consul-catalog' (default 100), and ConsulCatalogInstances
+	// is the number of instances of each service (default 10).
+	ConsulCatalogServices  int64 `protobuf:"varint,47,opt,name=ConsulCatalogServices,proto3" json:"ConsulCatalogServices,omitempty" yaml:"consul_catalog_services"`
+	ConsulCatalogInstances int64 `protobuf:"varint,48,opt,name=ConsulCatalogInstances,proto3" json:"ConsulCatalogInstances,omitempty" yaml:"consul_catalog_instances"`
+	// ConsulCatalogCheckRatio is the ratio of health check updates (default
+	// 0.6), and ConsulCatalogQueryRatio is the ratio of health queries of a
+	// service (default 0.3). The rest registers or deregisters instances.
+	ConsulCatalogCheckRatio float64 `protobuf:"fixed64,49,opt,name=ConsulCatalogCheckRatio,proto3" json:"ConsulCatalogCheckRatio,omitempty" yaml:"consul_catalog_check_ratio"`
+	ConsulCatalogQueryRatio float64 `protobuf:"fixed64,50,opt,name=ConsulCatalogQueryRatio,proto3" json:"ConsulCatalogQueryRatio,omitempty" yaml:"consul_catalog_query_ratio"`
+	// WatchDisconnectSeconds is how long each watcher of 'watch-compaction'
+	// stays disconnected before resuming from its last revision, so that it
+	// falls behind compactions (default 2).
+	WatchDisconnectSeconds int64 `protobuf:"varint,51,opt,name=WatchDisconnectSeconds,proto3" json:"WatchDisconnectSeconds,omitempty" yaml:"watch_disconnect_seconds"`
+	// ClientDelaysMs is the artificial delay added before each request, to
+	// simulate clients far from the cluster without remote load generators.
+	// Clients are assigned the delays in round-robin order (e.g. '[0, 80]' for
+	// half of the clients in another region). Delays are included in latencies.
+	ClientDelaysMs []int64 `protobuf:"varint,52,rep,packed,name=ClientDelaysMs" json:"ClientDelaysMs,omitempty" yaml:"client_delays_ms"`
+	// ClientDelayJitterMs is the maximum random delay added to 'client_delays_ms'.
+	ClientDelayJitterMs int64 `protobuf:"varint,53,opt,name=ClientDelayJitterMs,proto3" json:"ClientDelayJitterMs,omitempty" yaml:"client_delay_jitter_ms"`
+	// DeleteRangeKeys is the number of keys under each prefix that one request
+	// of 'delete-range' deletes (default 10000). 'request_number' prefixes are
+	// loaded before deleting.
+	DeleteRangeKeys int64 `protobuf:"varint,54,opt,name=DeleteRangeKeys,proto3" json:"DeleteRangeKeys,omitempty" yaml:"delete_range_keys"`
+	// DeleteRangeProbeSeconds is how long probe reads and writes run before
+	// range deletes, as the baseline of their latencies (default 5).
+	DeleteRangeProbeSeconds int64 `protobuf:"varint,55,opt,name=DeleteRangeProbeSeconds,proto3" json:"DeleteRangeProbeSeconds,omitempty" yaml:"delete_range_probe_seconds"`
+	// TenancyReaderKeys is the number of keys that the latency-sensitive reader
+	// of 'tenancy' reads, under its own prefix (default 1000).
+	TenancyReaderKeys int64 `protobuf:"varint,56,opt,name=TenancyReaderKeys,proto3" json:"TenancyReaderKeys,omitempty" yaml:"tenancy_reader_keys"`
+	// TenancyWriterClients is the number of clients of the bulk writer of
+	// 'tenancy', writing under another prefix without rate limit
+	// (default 'client_number').
+	TenancyWriterClients int64 `protobuf:"varint,57,opt,name=TenancyWriterClients,proto3" json:"TenancyWriterClients,omitempty" yaml:"tenancy_writer_clients"`
+	// PriorityClasses is the priority class of clients, sent with each etcd
+	// request as gRPC metadata, to evaluate experimental server-side QoS.
+	// Clients are assigned the classes in round-robin order (e.g. '[high, low,
+	// low, low]' for a quarter of the clients in 'high'). Latencies are
+	// reported per operation type and class.
+	PriorityClasses []string `protobuf:"bytes,58,rep,name=PriorityClasses" json:"PriorityClasses,omitempty" yaml:"priority_classes"`
+	// PriorityMetadataKey is the gRPC metadata key of 'priority_classes'
+	// (default 'x-dbtester-priority').
+	PriorityMetadataKey string `protobuf:"bytes,59,opt,name=PriorityMetadataKey,proto3" json:"PriorityMetadataKey,omitempty" yaml:"priority_metadata_key"`
+	// ClientInterceptors is the names of registered gRPC client interceptors
+	// on etcd v3 clients, outermost first. See 'RegisterClientInterceptor'.
+	ClientInterceptors []string `protobuf:"bytes,60,rep,name=ClientInterceptors" json:"ClientInterceptors,omitempty" yaml:"client_interceptors"`
+	// ClientInterceptorPluginPath is the path to Go plugin that registers
+	// the client interceptors.
+	ClientInterceptorPluginPath string `protobuf:"bytes,61,opt,name=ClientInterceptorPluginPath,proto3" json:"ClientInterceptorPluginPath,omitempty" yaml:"client_interceptor_plugin_path"`
+	// KeyPrefix is the namespace of benchmark keys (e.g. 'dbtester/run-1'),
+	// written as '<key_prefix>/<key>', so that total keys after 'write' count
+	// only the keys of the benchmark. Empty to count all keys.
+	KeyPrefix string `protobuf:"bytes,62,opt,name=KeyPrefix,proto3" json:"KeyPrefix,omitempty" yaml:"key_prefix"`
+	// FailOnTotalKeysMismatch fails 'write' if any member does not have
+	// 'request_number' keys in the benchmark namespace after the benchmark.
+	FailOnTotalKeysMismatch bool `protobuf:"varint,63,opt,name=FailOnTotalKeysMismatch,proto3" json:"FailOnTotalKeysMismatch,omitempty" yaml:"fail_on_total_keys_mismatch"`
 }
 
 func (m *ConfigClientMachineBenchmarkOptions) Reset()         { *m = ConfigClientMachineBenchmarkOptions{} }
 func (m *ConfigClientMachineBenchmarkOptions) String() string { return proto.CompactTextString(m) }
 func (*ConfigClientMachineBenchmarkOptions) ProtoMessage()    {}
 func (*ConfigClientMachineBenchmarkOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptorConfigClientMachine, []int{1}
+	return fileDescriptorConfigClientMachine, []int{2}
+}
+
+// ConfigSLO is a service level objective on a benchmark metric.
+// Exactly one of 'max' or 'min' must be set.
+type ConfigSLO struct {
+	// Metric is 'p50', 'p90', 'p99', 'p99.9' or 'avg' latency in milliseconds,
+	// 'throughput' in requests per second, or 'availability' in percent of
+	// successful requests.
+	Metric string `protobuf:"bytes,1,opt,name=Metric,proto3" json:"Metric,omitempty" yaml:"metric"`
+	// Max is the upper bound of the metric (e.g. p99 < 50).
+	Max float64 `protobuf:"fixed64,2,opt,name=Max,proto3" json:"Max,omitempty" yaml:"max"`
+	// Min is the lower bound of the metric (e.g. availability > 99.9).
+	Min float64 `protobuf:"fixed64,3,opt,name=Min,proto3" json:"Min,omitempty" yaml:"min"`
+}
+
+func (m *ConfigSLO) Reset()                    { *m = ConfigSLO{} }
+func (m *ConfigSLO) String() string            { return proto.CompactTextString(m) }
+func (*ConfigSLO) ProtoMessage()               {}
+func (*ConfigSLO) Descriptor() ([]byte, []int) { return fileDescriptorConfigClientMachine, []int{3} }
+
+// ConfigCompactionPolicy defines when and how much to compact etcd.
+type ConfigCompactionPolicy struct {
+	// Mode is "revision" to compact every 'interval_revisions' revisions,
+	// or "time" to compact every 'interval_seconds'.
+	Mode              string `protobuf:"bytes,1,opt,name=Mode,proto3" json:"Mode,omitempty" yaml:"mode"`
+	IntervalRevisions int64  `protobuf:"varint,2,opt,name=IntervalRevisions,proto3" json:"IntervalRevisions,omitempty" yaml:"interval_revisions"`
+	IntervalSeconds   int64  `protobuf:"varint,3,opt,name=IntervalSeconds,proto3" json:"IntervalSeconds,omitempty" yaml:"interval_seconds"`
+	// RetentionRevisions is the number of latest revisions to keep, in "revision" mode.
+	RetentionRevisions int64 `protobuf:"varint,4,opt,name=RetentionRevisions,proto3" json:"RetentionRevisions,omitempty" yaml:"retention_revisions"`
+	// RetentionSeconds keeps the revisions of the last seconds, in "time" mode.
+	RetentionSeconds int64 `protobuf:"varint,5,opt,name=RetentionSeconds,proto3" json:"RetentionSeconds,omitempty" yaml:"retention_seconds"`
+	// Physical is true to wait until compacted revisions are removed from the backend.
+	Physical bool `protobuf:"varint,6,opt,name=Physical,proto3" json:"Physical,omitempty" yaml:"physical"`
+	// Defragment is true to defragment all members after each compaction.
+	Defragment bool `protobuf:"varint,7,opt,name=Defragment,proto3" json:"Defragment,omitempty" yaml:"defragment"`
+}
+
+func (m *ConfigCompactionPolicy) Reset()         { *m = ConfigCompactionPolicy{} }
+func (m *ConfigCompactionPolicy) String() string { return proto.CompactTextString(m) }
+func (*ConfigCompactionPolicy) ProtoMessage()    {}
+func (*ConfigCompactionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{4}
 }
 
 // ConfigClientMachineBenchmarkSteps represents benchmark steps.
@@ -68,26 +385,113 @@ type ConfigClientMachineBenchmarkSteps struct {
 	Step2StressDatabase bool `protobuf:"varint,2,opt,name=Step2StressDatabase,proto3" json:"Step2StressDatabase,omitempty" yaml:"step2_stress_database"`
 	Step3StopDatabase   bool `protobuf:"varint,3,opt,name=Step3StopDatabase,proto3" json:"Step3StopDatabase,omitempty" yaml:"step3_stop_database"`
 	Step4UploadLogs     bool `protobuf:"varint,4,opt,name=Step4UploadLogs,proto3" json:"Step4UploadLogs,omitempty" yaml:"step4_upload_logs"`
+	// Step1TimeoutSeconds and Step2TimeoutSeconds are the wall-clock budgets
+	// of starting databases and running benchmarks. When exceeded, control
+	// stops all agents, uploads existing results, and exits with error.
+	// 0 for no timeout.
+	Step1TimeoutSeconds int64 `protobuf:"varint,5,opt,name=Step1TimeoutSeconds,proto3" json:"Step1TimeoutSeconds,omitempty" yaml:"step1_timeout_seconds"`
+	Step2TimeoutSeconds int64 `protobuf:"varint,6,opt,name=Step2TimeoutSeconds,proto3" json:"Step2TimeoutSeconds,omitempty" yaml:"step2_timeout_seconds"`
 }
 
 func (m *ConfigClientMachineBenchmarkSteps) Reset()         { *m = ConfigClientMachineBenchmarkSteps{} }
 func (m *ConfigClientMachineBenchmarkSteps) String() string { return proto.CompactTextString(m) }
 func (*ConfigClientMachineBenchmarkSteps) ProtoMessage()    {}
 func (*ConfigClientMachineBenchmarkSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptorConfigClientMachine, []int{2}
+	return fileDescriptorConfigClientMachine, []int{5}
+}
+
+// ConfigClientMachineHook is a user command to run around a benchmark step,
+// for site-specific setup (e.g. dropping page caches before step 2).
+type ConfigClientMachineHook struct {
+	// Point is when to run: 'pre-step1', 'post-step1', 'pre-step2',
+	// 'post-step2', 'pre-step3', or 'post-step3'. Failed 'pre-step1' and
+	// 'pre-step2' hooks fail the step, and other failed hooks are logged,
+	// so that databases are still stopped.
+	Point string `protobuf:"bytes,1,opt,name=Point,proto3" json:"Point,omitempty" yaml:"point"`
+	// Target is 'local' (default) to run on the control machine, or 'agents'
+	// to run on all agents, whose '--exec-allowlist' must allow the command.
+	Target string `protobuf:"bytes,2,opt,name=Target,proto3" json:"Target,omitempty" yaml:"target"`
+	// Command is the executable and its arguments, run without a shell.
+	Command []string `protobuf:"bytes,3,rep,name=Command" json:"Command,omitempty" yaml:"command"`
+	// TimeoutSeconds is the maximum duration of the command (default 60).
+	TimeoutSeconds int64 `protobuf:"varint,4,opt,name=TimeoutSeconds,proto3" json:"TimeoutSeconds,omitempty" yaml:"timeout_seconds"`
+}
+
+func (m *ConfigClientMachineHook) Reset()         { *m = ConfigClientMachineHook{} }
+func (m *ConfigClientMachineHook) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineHook) ProtoMessage()    {}
+func (*ConfigClientMachineHook) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{6}
 }
 
 // ConfigClientMachineAgentControl represents control options on client machine.
 type ConfigClientMachineAgentControl struct {
-	DatabaseID                          string                               `protobuf:"bytes,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty" yaml:"database_id"`
-	DatabaseDescription                 string                               `protobuf:"bytes,2,opt,name=DatabaseDescription,proto3" json:"DatabaseDescription,omitempty" yaml:"database_description"`
-	DatabaseTag                         string                               `protobuf:"bytes,3,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty" yaml:"database_tag"`
-	PeerIPs                             []string                             `protobuf:"bytes,4,rep,name=PeerIPs" json:"PeerIPs,omitempty" yaml:"peer_ips"`
-	PeerIPsString                       string                               `protobuf:"bytes,5,opt,name=PeerIPsString,proto3" json:"PeerIPsString,omitempty" yaml:"peer_ips_string"`
-	AgentPortToConnect                  int64                                `protobuf:"varint,6,opt,name=AgentPortToConnect,proto3" json:"AgentPortToConnect,omitempty" yaml:"agent_port_to_connect"`
-	AgentEndpoints                      []string                             `protobuf:"bytes,7,rep,name=AgentEndpoints" json:"AgentEndpoints,omitempty" yaml:"agent_endpoints"`
-	DatabasePortToConnect               int64                                `protobuf:"varint,8,opt,name=DatabasePortToConnect,proto3" json:"DatabasePortToConnect,omitempty" yaml:"database_port_to_connect"`
-	DatabaseEndpoints                   []string                             `protobuf:"bytes,9,rep,name=DatabaseEndpoints" json:"DatabaseEndpoints,omitempty" yaml:"database_endpoints"`
+	DatabaseID            string   `protobuf:"bytes,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty" yaml:"database_id"`
+	DatabaseDescription   string   `protobuf:"bytes,2,opt,name=DatabaseDescription,proto3" json:"DatabaseDescription,omitempty" yaml:"database_description"`
+	DatabaseTag           string   `protobuf:"bytes,3,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty" yaml:"database_tag"`
+	PeerIPs               []string `protobuf:"bytes,4,rep,name=PeerIPs" json:"PeerIPs,omitempty" yaml:"peer_ips"`
+	PeerIPsString         string   `protobuf:"bytes,5,opt,name=PeerIPsString,proto3" json:"PeerIPsString,omitempty" yaml:"peer_ips_string"`
+	AgentPortToConnect    int64    `protobuf:"varint,6,opt,name=AgentPortToConnect,proto3" json:"AgentPortToConnect,omitempty" yaml:"agent_port_to_connect"`
+	AgentEndpoints        []string `protobuf:"bytes,7,rep,name=AgentEndpoints" json:"AgentEndpoints,omitempty" yaml:"agent_endpoints"`
+	DatabasePortToConnect int64    `protobuf:"varint,8,opt,name=DatabasePortToConnect,proto3" json:"DatabasePortToConnect,omitempty" yaml:"database_port_to_connect"`
+	DatabaseEndpoints     []string `protobuf:"bytes,9,rep,name=DatabaseEndpoints" json:"DatabaseEndpoints,omitempty" yaml:"database_endpoints"`
+	// LatencyProfile is the name of network latency profile between peers
+	// (e.g. "same-zone", "cross-zone", "cross-region").
+	LatencyProfile string `protobuf:"bytes,10,opt,name=LatencyProfile,proto3" json:"LatencyProfile,omitempty" yaml:"latency_profile"`
+	// EtcdGitRef is the git ref (branch, tag, or commit) of etcd to build from source
+	// on agents, instead of using the pre-installed etcd binary.
+	EtcdGitRef string `protobuf:"bytes,11,opt,name=EtcdGitRef,proto3" json:"EtcdGitRef,omitempty" yaml:"etcd_git_ref"`
+	// DiskWriteDelayMs adds latency to every disk write and flush (fsync) of the
+	// database, by running it on a device-mapper delay target on agents.
+	DiskWriteDelayMs int64 `protobuf:"varint,12,opt,name=DiskWriteDelayMs,proto3" json:"DiskWriteDelayMs,omitempty" yaml:"disk_write_delay_ms"`
+	// DiskReadDelayMs adds latency to every disk read of the database.
+	DiskReadDelayMs int64 `protobuf:"varint,13,opt,name=DiskReadDelayMs,proto3" json:"DiskReadDelayMs,omitempty" yaml:"disk_read_delay_ms"`
+	// ClockSkewMs offsets the system clock of members after databases are started,
+	// to test lease and session behavior under skewed clocks (negative to set back).
+	ClockSkewMs int64 `protobuf:"varint,14,opt,name=ClockSkewMs,proto3" json:"ClockSkewMs,omitempty" yaml:"clock_skew_ms"`
+	// ClockSkewMemberIndexes are the indexes of skewed members (default first member only).
+	ClockSkewMemberIndexes []int64 `protobuf:"varint,15,rep,packed,name=ClockSkewMemberIndexes" json:"ClockSkewMemberIndexes,omitempty" yaml:"clock_skew_member_indexes"`
+	// AntagonistCPUCores is the number of busy-looping threads on each member host,
+	// running from database start to stop, to simulate noisy neighbors.
+	AntagonistCPUCores int64 `protobuf:"varint,16,opt,name=AntagonistCPUCores,proto3" json:"AntagonistCPUCores,omitempty" yaml:"antagonist_cpu_cores"`
+	// AntagonistMemoryBytes is the size of memory held resident on each member host.
+	AntagonistMemoryBytes int64 `protobuf:"varint,17,opt,name=AntagonistMemoryBytes,proto3" json:"AntagonistMemoryBytes,omitempty" yaml:"antagonist_memory_bytes"`
+	// AntagonistDiskWriteBytesPerSecond is the rate of synced disk writes on each member host.
+	AntagonistDiskWriteBytesPerSecond int64 `protobuf:"varint,18,opt,name=AntagonistDiskWriteBytesPerSecond,proto3" json:"AntagonistDiskWriteBytesPerSecond,omitempty" yaml:"antagonist_disk_write_bytes_per_second"`
+	// Durability is the fsync policy of the database write-ahead log, 'fsync' (default)
+	// or 'no-fsync' ('--unsafe-no-fsync' for etcd v3.5+, 'forceSync=no' for Zookeeper).
+	// Runs are labeled 'durability', to compare the cost of fsync in one matrix.
+	Durability string `protobuf:"bytes,19,opt,name=Durability,proto3" json:"Durability,omitempty" yaml:"durability"`
+	// HardwareCheck compares hardware profiles of agents before starting
+	// databases, 'warn' to log differences or 'abort' to fail (empty to skip).
+	HardwareCheck string `protobuf:"bytes,20,opt,name=HardwareCheck,proto3" json:"HardwareCheck,omitempty" yaml:"hardware_check"`
+	// HardwareTolerancePercent is the allowed difference of CPU count and memory
+	// from the first member. CPU model, disk type, and kernel must be equal.
+	HardwareTolerancePercent float64 `protobuf:"fixed64,21,opt,name=HardwareTolerancePercent,proto3" json:"HardwareTolerancePercent,omitempty" yaml:"hardware_tolerance_percent"`
+	// ClockOffsetWarnMs is the clock offset between hosts to warn about before
+	// step 2, since it corrupts joins of timestamps across hosts (default 100).
+	// Offsets applied by 'clock_skew_ms' are excluded.
+	ClockOffsetWarnMs int64 `protobuf:"varint,22,opt,name=ClockOffsetWarnMs,proto3" json:"ClockOffsetWarnMs,omitempty" yaml:"clock_offset_warn_ms"`
+	// BroadcastOrder is the order of requests to agents: 'parallel' (default)
+	// sends all at once, 'bootstrap-first' starts the members others join
+	// before the rest (default on Consul start), and 'sequential' sends one by
+	// one in index order, waiting for each response.
+	BroadcastOrder string `protobuf:"bytes,23,opt,name=BroadcastOrder,proto3" json:"BroadcastOrder,omitempty" yaml:"broadcast_order"`
+	// EndpointZones is the zone of each database endpoint, in the order of
+	// 'peer_ips' (e.g. 'us-west1-a'), to evaluate locality-aware reads.
+	EndpointZones []string `protobuf:"bytes,24,rep,name=EndpointZones" json:"EndpointZones,omitempty" yaml:"endpoint_zones"`
+	// ClientZone is the zone of the tester client. If not empty, read
+	// connections prefer endpoints in the same zone.
+	ClientZone string `protobuf:"bytes,25,opt,name=ClientZone,proto3" json:"ClientZone,omitempty" yaml:"client_zone"`
+	// ZoneSpilloverPercent is the percentage of read connections sent to
+	// endpoints in other zones, when 'client_zone' is set (default 0).
+	ZoneSpilloverPercent float64 `protobuf:"fixed64,26,opt,name=ZoneSpilloverPercent,proto3" json:"ZoneSpilloverPercent,omitempty" yaml:"zone_spillover_percent"`
+	// Hooks are user commands to run around benchmark steps, in order.
+	Hooks []*ConfigClientMachineHook `protobuf:"bytes,27,rep,name=Hooks" json:"Hooks,omitempty" yaml:"hooks"`
+	// DatabaseLogLevel is the log level of the database, 'debug', 'info',
+	// 'warn', or 'error' (empty for the database default). etcd v3.2 and
+	// v3.3 only support 'debug'.
+	DatabaseLogLevel                    string                               `protobuf:"bytes,28,opt,name=DatabaseLogLevel,proto3" json:"DatabaseLogLevel,omitempty" yaml:"database_log_level"`
 	Flag_Etcd_Other                     *Flag_Etcd_Other                     `protobuf:"bytes,100,opt,name=flag__etcd__other,json=flagEtcdOther" json:"flag__etcd__other,omitempty" yaml:"etcd__other"`
 	Flag_Etcd_Tip                       *Flag_Etcd_Tip                       `protobuf:"bytes,101,opt,name=flag__etcd__tip,json=flagEtcdTip" json:"flag__etcd__tip,omitempty" yaml:"etcd__tip"`
 	Flag_Etcd_V3_2                      *Flag_Etcd_V3_2                      `protobuf:"bytes,102,opt,name=flag__etcd__v3_2,json=flagEtcdV32" json:"flag__etcd__v3_2,omitempty" yaml:"etcd__v3_2"`
@@ -104,13 +508,17 @@ func (m *ConfigClientMachineAgentControl) Reset()         { *m = ConfigClientMac
 func (m *ConfigClientMachineAgentControl) String() string { return proto.CompactTextString(m) }
 func (*ConfigClientMachineAgentControl) ProtoMessage()    {}
 func (*ConfigClientMachineAgentControl) Descriptor() ([]byte, []int) {
-	return fileDescriptorConfigClientMachine, []int{3}
+	return fileDescriptorConfigClientMachine, []int{7}
 }
 
 func init() {
 	proto.RegisterType((*ConfigClientMachineInitial)(nil), "dbtesterpb.ConfigClientMachineInitial")
+	proto.RegisterType((*RemoteStorageDestination)(nil), "dbtesterpb.RemoteStorageDestination")
 	proto.RegisterType((*ConfigClientMachineBenchmarkOptions)(nil), "dbtesterpb.ConfigClientMachineBenchmarkOptions")
+	proto.RegisterType((*ConfigSLO)(nil), "dbtesterpb.ConfigSLO")
+	proto.RegisterType((*ConfigCompactionPolicy)(nil), "dbtesterpb.ConfigCompactionPolicy")
 	proto.RegisterType((*ConfigClientMachineBenchmarkSteps)(nil), "dbtesterpb.ConfigClientMachineBenchmarkSteps")
+	proto.RegisterType((*ConfigClientMachineHook)(nil), "dbtesterpb.ConfigClientMachineHook")
 	proto.RegisterType((*ConfigClientMachineAgentControl)(nil), "dbtesterpb.ConfigClientMachineAgentControl")
 }
 func (m *ConfigClientMachineInitial) Marshal() (dAtA []byte, err error) {
@@ -188,6 +596,236 @@ func (m *ConfigClientMachineInitial) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ServerDiskSpaceUsageSummaryPath)))
 		i += copy(dAtA[i:], m.ServerDiskSpaceUsageSummaryPath)
 	}
+	if len(m.ClientMembershipChangesPath) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientMembershipChangesPath)))
+		i += copy(dAtA[i:], m.ClientMembershipChangesPath)
+	}
+	if len(m.ClientBackupRestoreSummaryPath) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientBackupRestoreSummaryPath)))
+		i += copy(dAtA[i:], m.ClientBackupRestoreSummaryPath)
+	}
+	if len(m.ClientUploadManifestPath) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientUploadManifestPath)))
+		i += copy(dAtA[i:], m.ClientUploadManifestPath)
+	}
+	if len(m.TestID) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.TestID)))
+		i += copy(dAtA[i:], m.TestID)
+	}
+	if m.PortOffset != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.PortOffset))
+	}
+	if len(m.ClientLatencyDistributionCorrectedPath) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientLatencyDistributionCorrectedPath)))
+		i += copy(dAtA[i:], m.ClientLatencyDistributionCorrectedPath)
+	}
+	if len(m.ClientLatencyHeatmapPath) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientLatencyHeatmapPath)))
+		i += copy(dAtA[i:], m.ClientLatencyHeatmapPath)
+	}
+	if len(m.ClientReportPath) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientReportPath)))
+		i += copy(dAtA[i:], m.ClientReportPath)
+	}
+	if len(m.TestName) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.TestName)))
+		i += copy(dAtA[i:], m.TestName)
+	}
+	if len(m.RunTimestamp) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.RunTimestamp)))
+		i += copy(dAtA[i:], m.RunTimestamp)
+	}
+	if len(m.ClientReadYourWritesPath) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientReadYourWritesPath)))
+		i += copy(dAtA[i:], m.ClientReadYourWritesPath)
+	}
+	if len(m.ClientRepeatSummaryPath) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientRepeatSummaryPath)))
+		i += copy(dAtA[i:], m.ClientRepeatSummaryPath)
+	}
+	if len(m.ClientOpenMetricsPath) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientOpenMetricsPath)))
+		i += copy(dAtA[i:], m.ClientOpenMetricsPath)
+	}
+	if len(m.ClientReadConsistencyPath) > 0 {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientReadConsistencyPath)))
+		i += copy(dAtA[i:], m.ClientReadConsistencyPath)
+	}
+	if len(m.ClientLeaderTimeseriesPath) > 0 {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientLeaderTimeseriesPath)))
+		i += copy(dAtA[i:], m.ClientLeaderTimeseriesPath)
+	}
+	if len(m.ClientAuthPenaltyPath) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientAuthPenaltyPath)))
+		i += copy(dAtA[i:], m.ClientAuthPenaltyPath)
+	}
+	if len(m.ClientWatchSummaryPath) > 0 {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientWatchSummaryPath)))
+		i += copy(dAtA[i:], m.ClientWatchSummaryPath)
+	}
+	if len(m.ClientPhasesPath) > 0 {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientPhasesPath)))
+		i += copy(dAtA[i:], m.ClientPhasesPath)
+	}
+	if len(m.ClientUpgradesPath) > 0 {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientUpgradesPath)))
+		i += copy(dAtA[i:], m.ClientUpgradesPath)
+	}
+	if len(m.ClientSnapshotTransferSummaryPath) > 0 {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientSnapshotTransferSummaryPath)))
+		i += copy(dAtA[i:], m.ClientSnapshotTransferSummaryPath)
+	}
+	if len(m.AgentAuthSecretPath) > 0 {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.AgentAuthSecretPath)))
+		i += copy(dAtA[i:], m.AgentAuthSecretPath)
+	}
+	if len(m.ClientClockOffsetsPath) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientClockOffsetsPath)))
+		i += copy(dAtA[i:], m.ClientClockOffsetsPath)
+	}
+	if len(m.ClientOpTypeSummaryPath) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientOpTypeSummaryPath)))
+		i += copy(dAtA[i:], m.ClientOpTypeSummaryPath)
+	}
+	if len(m.ClientWatchCompactionSummaryPath) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientWatchCompactionSummaryPath)))
+		i += copy(dAtA[i:], m.ClientWatchCompactionSummaryPath)
+	}
+	if len(m.ClientDeleteRangeSummaryPath) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientDeleteRangeSummaryPath)))
+		i += copy(dAtA[i:], m.ClientDeleteRangeSummaryPath)
+	}
+	if len(m.ClientTenancySummaryPath) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientTenancySummaryPath)))
+		i += copy(dAtA[i:], m.ClientTenancySummaryPath)
+	}
+	if len(m.ClientReportDataPath) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientReportDataPath)))
+		i += copy(dAtA[i:], m.ClientReportDataPath)
+	}
+	if len(m.ClientReportFormats) > 0 {
+		for _, s := range m.ClientReportFormats {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x2
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ClientComparisonReportPath) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientComparisonReportPath)))
+		i += copy(dAtA[i:], m.ClientComparisonReportPath)
+	}
 	if len(m.GoogleCloudProjectName) > 0 {
 		dAtA[i] = 0xa2
 		i++
@@ -228,6 +866,92 @@ func (m *ConfigClientMachineInitial) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.GoogleCloudStorageSubDirectory)))
 		i += copy(dAtA[i:], m.GoogleCloudStorageSubDirectory)
 	}
+	if len(m.RemoteStorageDestinations) > 0 {
+		for _, msg := range m.RemoteStorageDestinations {
+			dAtA[i] = 0xca
+			i++
+			dAtA[i] = 0x6
+			i++
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k, _ := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		sortkeys.Strings(keysForLabels)
+		for _, k := range keysForLabels {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0xc
+			i++
+			v := m.Labels[string(k)]
+			mapSize := 1 + len(k) + sovConfigClientMachine(uint64(len(k))) + 1 + len(v) + sovConfigClientMachine(uint64(len(v)))
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *RemoteStorageDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteStorageDestination) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Bucket) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Bucket)))
+		i += copy(dAtA[i:], m.Bucket)
+	}
+	if len(m.SubDirectory) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.SubDirectory)))
+		i += copy(dAtA[i:], m.SubDirectory)
+	}
+	if len(m.LocalDirectory) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.LocalDirectory)))
+		i += copy(dAtA[i:], m.LocalDirectory)
+	}
 	return i, nil
 }
 
@@ -320,518 +1044,5025 @@ func (m *ConfigClientMachineBenchmarkOptions) MarshalTo(dAtA []byte) (int, error
 		}
 		i++
 	}
-	return i, nil
-}
-
-func (m *ConfigClientMachineBenchmarkSteps) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if len(m.WorkloadName) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.WorkloadName)))
+		i += copy(dAtA[i:], m.WorkloadName)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConfigClientMachineBenchmarkSteps) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Step1StartDatabase {
-		dAtA[i] = 0x8
+	if len(m.WorkloadPluginPath) > 0 {
+		dAtA[i] = 0x62
 		i++
-		if m.Step1StartDatabase {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.WorkloadPluginPath)))
+		i += copy(dAtA[i:], m.WorkloadPluginPath)
+	}
+	if len(m.ScalingMemberNumbers) > 0 {
+		dAtA4 := make([]byte, len(m.ScalingMemberNumbers)*10)
+		var j3 int
+		for _, num1 := range m.ScalingMemberNumbers {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
+		dAtA[i] = 0x6a
 		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(j3))
+		i += copy(dAtA[i:], dAtA4[:j3])
 	}
-	if m.Step2StressDatabase {
-		dAtA[i] = 0x10
+	if m.ScalingIntervalSeconds != 0 {
+		dAtA[i] = 0x70
 		i++
-		if m.Step2StressDatabase {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ScalingIntervalSeconds))
+	}
+	if len(m.BackupRestoreKeyNumbers) > 0 {
+		dAtA6 := make([]byte, len(m.BackupRestoreKeyNumbers)*10)
+		var j5 int
+		for _, num1 := range m.BackupRestoreKeyNumbers {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
+		dAtA[i] = 0x7a
 		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA6[:j5])
 	}
-	if m.Step3StopDatabase {
-		dAtA[i] = 0x18
+	if m.MaxErrorRate != 0 {
+		dAtA[i] = 0x81
 		i++
-		if m.Step3StopDatabase {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxErrorRate))))
+		i += 8
 	}
-	if m.Step4UploadLogs {
-		dAtA[i] = 0x20
+	if m.MaxConsecutiveErrors != 0 {
+		dAtA[i] = 0x88
 		i++
-		if m.Step4UploadLogs {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MaxConsecutiveErrors))
 	}
-	return i, nil
-}
-
-func (m *ConfigClientMachineAgentControl) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.ListPageSize != 0 {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ListPageSize))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.DatabaseID) > 0 {
-		dAtA[i] = 0xa
+	if m.ListIterations != 0 {
+		dAtA[i] = 0x98
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseID)))
-		i += copy(dAtA[i:], m.DatabaseID)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ListIterations))
 	}
-	if len(m.DatabaseDescription) > 0 {
-		dAtA[i] = 0x12
+	if m.KubernetesObjects != 0 {
+		dAtA[i] = 0xa0
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseDescription)))
-		i += copy(dAtA[i:], m.DatabaseDescription)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.KubernetesObjects))
 	}
-	if len(m.DatabaseTag) > 0 {
-		dAtA[i] = 0x1a
+	if m.KubernetesHotObjects != 0 {
+		dAtA[i] = 0xa8
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseTag)))
-		i += copy(dAtA[i:], m.DatabaseTag)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.KubernetesHotObjects))
 	}
-	if len(m.PeerIPs) > 0 {
-		for _, s := range m.PeerIPs {
-			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	if m.KubernetesListRatio != 0 {
+		dAtA[i] = 0xb1
+		i++
+		dAtA[i] = 0x1
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.KubernetesListRatio))))
+		i += 8
 	}
-	if len(m.PeerIPsString) > 0 {
-		dAtA[i] = 0x2a
+	if m.KubernetesWatchers != 0 {
+		dAtA[i] = 0xb8
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.PeerIPsString)))
-		i += copy(dAtA[i:], m.PeerIPsString)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.KubernetesWatchers))
 	}
-	if m.AgentPortToConnect != 0 {
-		dAtA[i] = 0x30
+	if m.Repeat != 0 {
+		dAtA[i] = 0xc0
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.AgentPortToConnect))
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Repeat))
 	}
-	if len(m.AgentEndpoints) > 0 {
-		for _, s := range m.AgentEndpoints {
-			dAtA[i] = 0x3a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	if m.CompactionPolicy != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.CompactionPolicy.Size()))
+		n7, err := m.CompactionPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n7
 	}
-	if m.DatabasePortToConnect != 0 {
-		dAtA[i] = 0x40
+	if m.TraceSampleRatio != 0 {
+		dAtA[i] = 0xd1
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DatabasePortToConnect))
+		dAtA[i] = 0x1
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TraceSampleRatio))))
+		i += 8
 	}
-	if len(m.DatabaseEndpoints) > 0 {
-		for _, s := range m.DatabaseEndpoints {
-			dAtA[i] = 0x4a
+	if len(m.TraceOTLPEndpoint) > 0 {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.TraceOTLPEndpoint)))
+		i += copy(dAtA[i:], m.TraceOTLPEndpoint)
+	}
+	if len(m.SLOs) > 0 {
+		for _, msg := range m.SLOs {
+			dAtA[i] = 0xe2
 			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
+			dAtA[i] = 0x1
 			i++
-			i += copy(dAtA[i:], s)
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.Flag_Etcd_Other != nil {
-		dAtA[i] = 0xa2
+	if len(m.ValueEncoding) > 0 {
+		dAtA[i] = 0xea
 		i++
-		dAtA[i] = 0x6
+		dAtA[i] = 0x1
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_Other.Size()))
-		n3, err := m.Flag_Etcd_Other.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ValueEncoding)))
+		i += copy(dAtA[i:], m.ValueEncoding)
 	}
-	if m.Flag_Etcd_Tip != nil {
-		dAtA[i] = 0xaa
+	if m.ValueSchemaFields != 0 {
+		dAtA[i] = 0xf0
 		i++
-		dAtA[i] = 0x6
+		dAtA[i] = 0x1
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_Tip.Size()))
-		n4, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ValueSchemaFields))
 	}
-	if m.Flag_Etcd_V3_2 != nil {
-		dAtA[i] = 0xb2
+	if m.ValueSchemaDepth != 0 {
+		dAtA[i] = 0xf8
 		i++
-		dAtA[i] = 0x6
+		dAtA[i] = 0x1
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_V3_2.Size()))
-		n5, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ValueSchemaDepth))
 	}
-	if m.Flag_Etcd_V3_3 != nil {
-		dAtA[i] = 0xba
+	if len(m.EtcdCompression) > 0 {
+		dAtA[i] = 0x82
 		i++
-		dAtA[i] = 0x6
+		dAtA[i] = 0x2
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_V3_3.Size()))
-		n6, err := m.Flag_Etcd_V3_3.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.EtcdCompression)))
+		i += copy(dAtA[i:], m.EtcdCompression)
 	}
-	if m.Flag_Zookeeper_R3_5_3Beta != nil {
-		dAtA[i] = 0xc2
+	if len(m.ValueCompression) > 0 {
+		dAtA[i] = 0x8a
 		i++
-		dAtA[i] = 0xc
+		dAtA[i] = 0x2
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zookeeper_R3_5_3Beta.Size()))
-		n7, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ValueCompression)))
+		i += copy(dAtA[i:], m.ValueCompression)
 	}
-	if m.Flag_Consul_V1_0_2 != nil {
-		dAtA[i] = 0xe2
+	if len(m.TraceFile) > 0 {
+		dAtA[i] = 0x92
 		i++
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Consul_V1_0_2.Size()))
-		n8, err := m.Flag_Consul_V1_0_2.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.TraceFile)))
+		i += copy(dAtA[i:], m.TraceFile)
 	}
-	if m.Flag_Cetcd_Beta != nil {
-		dAtA[i] = 0x82
+	if m.WatcherNumber != 0 {
+		dAtA[i] = 0x98
 		i++
-		dAtA[i] = 0x19
+		dAtA[i] = 0x2
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Cetcd_Beta.Size()))
-		n9, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.WatcherNumber))
 	}
-	if m.Flag_Zetcd_Beta != nil {
-		dAtA[i] = 0xa2
+	if m.WatchStreamsPerConnection != 0 {
+		dAtA[i] = 0xa0
 		i++
-		dAtA[i] = 0x1f
+		dAtA[i] = 0x2
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zetcd_Beta.Size()))
-		n10, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.WatchStreamsPerConnection))
 	}
-	if m.ConfigClientMachineBenchmarkOptions != nil {
-		dAtA[i] = 0xc2
+	if m.DialConcurrency != 0 {
+		dAtA[i] = 0xa8
 		i++
-		dAtA[i] = 0x3e
+		dAtA[i] = 0x2
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkOptions.Size()))
-		n11, err := m.ConfigClientMachineBenchmarkOptions.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DialConcurrency))
 	}
-	if m.ConfigClientMachineBenchmarkSteps != nil {
-		dAtA[i] = 0xca
+	if m.DialJitterMs != 0 {
+		dAtA[i] = 0xb0
 		i++
-		dAtA[i] = 0x3e
+		dAtA[i] = 0x2
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkSteps.Size()))
-		n12, err := m.ConfigClientMachineBenchmarkSteps.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DialJitterMs))
+	}
+	if m.VerifyConnections {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.VerifyConnections {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n12
+		i++
 	}
-	return i, nil
-}
-
-func encodeVarintConfigClientMachine(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.UpgradeEtcdGitRef) > 0 {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.UpgradeEtcdGitRef)))
+		i += copy(dAtA[i:], m.UpgradeEtcdGitRef)
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *ConfigClientMachineInitial) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.PathPrefix)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.UpgradeIntervalSeconds != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.UpgradeIntervalSeconds))
 	}
-	l = len(m.LogPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if len(m.KeyEncoding) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.KeyEncoding)))
+		i += copy(dAtA[i:], m.KeyEncoding)
 	}
-	l = len(m.ClientSystemMetricsPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.KeyPathDepth != 0 {
+		dAtA[i] = 0xd8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.KeyPathDepth))
 	}
-	l = len(m.ClientSystemMetricsInterpolatedPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.KeyPathFanout != 0 {
+		dAtA[i] = 0xe0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.KeyPathFanout))
 	}
-	l = len(m.ClientLatencyThroughputTimeseriesPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.DropPageCache {
+		dAtA[i] = 0xe8
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.DropPageCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	l = len(m.ClientLatencyDistributionAllPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.ColdRestart {
+		dAtA[i] = 0xf0
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.ColdRestart {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	l = len(m.ClientLatencyDistributionPercentilePath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.ConsulCatalogServices != 0 {
+		dAtA[i] = 0xf8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConsulCatalogServices))
 	}
-	l = len(m.ClientLatencyDistributionSummaryPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.ConsulCatalogInstances != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConsulCatalogInstances))
 	}
-	l = len(m.ClientLatencyByKeyNumberPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.ConsulCatalogCheckRatio != 0 {
+		dAtA[i] = 0x89
+		i++
+		dAtA[i] = 0x3
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ConsulCatalogCheckRatio))))
+		i += 8
 	}
-	l = len(m.ServerDiskSpaceUsageSummaryPath)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if m.ConsulCatalogQueryRatio != 0 {
+		dAtA[i] = 0x91
+		i++
+		dAtA[i] = 0x3
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ConsulCatalogQueryRatio))))
+		i += 8
 	}
-	l = len(m.GoogleCloudProjectName)
-	if l > 0 {
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if m.WatchDisconnectSeconds != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.WatchDisconnectSeconds))
 	}
-	l = len(m.GoogleCloudStorageKeyPath)
-	if l > 0 {
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if len(m.ClientDelaysMs) > 0 {
+		dAtA9 := make([]byte, len(m.ClientDelaysMs)*10)
+		var j8 int
+		for _, num1 := range m.ClientDelaysMs {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(j8))
+		i += copy(dAtA[i:], dAtA9[:j8])
 	}
-	l = len(m.GoogleCloudStorageKey)
-	if l > 0 {
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if m.ClientDelayJitterMs != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ClientDelayJitterMs))
 	}
-	l = len(m.GoogleCloudStorageBucketName)
-	if l > 0 {
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if m.DeleteRangeKeys != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DeleteRangeKeys))
 	}
-	l = len(m.GoogleCloudStorageSubDirectory)
-	if l > 0 {
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if m.DeleteRangeProbeSeconds != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DeleteRangeProbeSeconds))
 	}
-	return n
-}
+	if m.TenancyReaderKeys != 0 {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.TenancyReaderKeys))
+	}
+	if m.TenancyWriterClients != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.TenancyWriterClients))
+	}
+	if len(m.PriorityClasses) > 0 {
+		for _, s := range m.PriorityClasses {
+			dAtA[i] = 0xd2
+			i++
+			dAtA[i] = 0x3
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.PriorityMetadataKey) > 0 {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.PriorityMetadataKey)))
+		i += copy(dAtA[i:], m.PriorityMetadataKey)
+	}
+	if len(m.ClientInterceptors) > 0 {
+		for _, s := range m.ClientInterceptors {
+			dAtA[i] = 0xe2
+			i++
+			dAtA[i] = 0x3
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ClientInterceptorPluginPath) > 0 {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientInterceptorPluginPath)))
+		i += copy(dAtA[i:], m.ClientInterceptorPluginPath)
+	}
+	if len(m.KeyPrefix) > 0 {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.KeyPrefix)))
+		i += copy(dAtA[i:], m.KeyPrefix)
+	}
+	if m.FailOnTotalKeysMismatch {
+		dAtA[i] = 0xf8
+		i++
+		dAtA[i] = 0x3
+		i++
+		if m.FailOnTotalKeysMismatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
 
-func (m *ConfigClientMachineBenchmarkOptions) Size() (n int) {
+func (m *ConfigSLO) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigSLO) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if len(m.Metric) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Metric)))
+		i += copy(dAtA[i:], m.Metric)
 	}
-	if m.RequestNumber != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.RequestNumber))
+	if m.Max != 0 {
+		dAtA[i] = 0x11
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Max))))
+		i += 8
 	}
-	if m.ConnectionNumber != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.ConnectionNumber))
+	if m.Min != 0 {
+		dAtA[i] = 0x19
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Min))))
+		i += 8
 	}
-	if m.ClientNumber != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.ClientNumber))
+	return i, nil
+}
+
+func (m *ConfigCompactionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ConnectionClientNumbers) > 0 {
-		l = 0
-		for _, e := range m.ConnectionClientNumbers {
-			l += sovConfigClientMachine(uint64(e))
-		}
-		n += 1 + sovConfigClientMachine(uint64(l)) + l
+	return dAtA[:n], nil
+}
+
+func (m *ConfigCompactionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
 	}
-	if m.RateLimitRequestsPerSecond != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.RateLimitRequestsPerSecond))
+	if m.IntervalRevisions != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.IntervalRevisions))
 	}
-	if m.SameKey {
-		n += 2
+	if m.IntervalSeconds != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.IntervalSeconds))
 	}
-	if m.KeySizeBytes != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.KeySizeBytes))
+	if m.RetentionRevisions != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.RetentionRevisions))
 	}
-	if m.ValueSizeBytes != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.ValueSizeBytes))
+	if m.RetentionSeconds != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.RetentionSeconds))
 	}
-	if m.StaleRead {
-		n += 2
+	if m.Physical {
+		dAtA[i] = 0x30
+		i++
+		if m.Physical {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	if m.Defragment {
+		dAtA[i] = 0x38
+		i++
+		if m.Defragment {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
 }
 
-func (m *ConfigClientMachineBenchmarkSteps) Size() (n int) {
+func (m *ConfigClientMachineBenchmarkSteps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineBenchmarkSteps) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Step1StartDatabase {
-		n += 2
+		dAtA[i] = 0x8
+		i++
+		if m.Step1StartDatabase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.Step2StressDatabase {
-		n += 2
+		dAtA[i] = 0x10
+		i++
+		if m.Step2StressDatabase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.Step3StopDatabase {
-		n += 2
+		dAtA[i] = 0x18
+		i++
+		if m.Step3StopDatabase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.Step4UploadLogs {
-		n += 2
+		dAtA[i] = 0x20
+		i++
+		if m.Step4UploadLogs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	if m.Step1TimeoutSeconds != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Step1TimeoutSeconds))
+	}
+	if m.Step2TimeoutSeconds != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Step2TimeoutSeconds))
+	}
+	return i, nil
 }
 
-func (m *ConfigClientMachineAgentControl) Size() (n int) {
+func (m *ConfigClientMachineHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineHook) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DatabaseID)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
-	}
-	l = len(m.DatabaseDescription)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if len(m.Point) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Point)))
+		i += copy(dAtA[i:], m.Point)
 	}
-	l = len(m.DatabaseTag)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
+	if len(m.Target) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Target)))
+		i += copy(dAtA[i:], m.Target)
 	}
-	if len(m.PeerIPs) > 0 {
-		for _, s := range m.PeerIPs {
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			dAtA[i] = 0x1a
+			i++
 			l = len(s)
-			n += 1 + l + sovConfigClientMachine(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	l = len(m.PeerIPsString)
-	if l > 0 {
-		n += 1 + l + sovConfigClientMachine(uint64(l))
-	}
-	if m.AgentPortToConnect != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.AgentPortToConnect))
-	}
-	if len(m.AgentEndpoints) > 0 {
-		for _, s := range m.AgentEndpoints {
-			l = len(s)
-			n += 1 + l + sovConfigClientMachine(uint64(l))
-		}
+	if m.TimeoutSeconds != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.TimeoutSeconds))
 	}
-	if m.DatabasePortToConnect != 0 {
-		n += 1 + sovConfigClientMachine(uint64(m.DatabasePortToConnect))
+	return i, nil
+}
+
+func (m *ConfigClientMachineAgentControl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DatabaseEndpoints) > 0 {
-		for _, s := range m.DatabaseEndpoints {
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DatabaseID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseID)))
+		i += copy(dAtA[i:], m.DatabaseID)
+	}
+	if len(m.DatabaseDescription) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseDescription)))
+		i += copy(dAtA[i:], m.DatabaseDescription)
+	}
+	if len(m.DatabaseTag) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseTag)))
+		i += copy(dAtA[i:], m.DatabaseTag)
+	}
+	if len(m.PeerIPs) > 0 {
+		for _, s := range m.PeerIPs {
+			dAtA[i] = 0x22
+			i++
 			l = len(s)
-			n += 1 + l + sovConfigClientMachine(uint64(l))
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.PeerIPsString) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.PeerIPsString)))
+		i += copy(dAtA[i:], m.PeerIPsString)
+	}
+	if m.AgentPortToConnect != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.AgentPortToConnect))
+	}
+	if len(m.AgentEndpoints) > 0 {
+		for _, s := range m.AgentEndpoints {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.DatabasePortToConnect != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DatabasePortToConnect))
+	}
+	if len(m.DatabaseEndpoints) > 0 {
+		for _, s := range m.DatabaseEndpoints {
+			dAtA[i] = 0x4a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.LatencyProfile) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.LatencyProfile)))
+		i += copy(dAtA[i:], m.LatencyProfile)
+	}
+	if len(m.EtcdGitRef) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.EtcdGitRef)))
+		i += copy(dAtA[i:], m.EtcdGitRef)
+	}
+	if m.DiskWriteDelayMs != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DiskWriteDelayMs))
+	}
+	if m.DiskReadDelayMs != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DiskReadDelayMs))
+	}
+	if m.ClockSkewMs != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ClockSkewMs))
+	}
+	if len(m.ClockSkewMemberIndexes) > 0 {
+		dAtA11 := make([]byte, len(m.ClockSkewMemberIndexes)*10)
+		var j10 int
+		for _, num1 := range m.ClockSkewMemberIndexes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(j10))
+		i += copy(dAtA[i:], dAtA11[:j10])
+	}
+	if m.AntagonistCPUCores != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.AntagonistCPUCores))
+	}
+	if m.AntagonistMemoryBytes != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.AntagonistMemoryBytes))
+	}
+	if m.AntagonistDiskWriteBytesPerSecond != 0 {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.AntagonistDiskWriteBytesPerSecond))
+	}
+	if len(m.Durability) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Durability)))
+		i += copy(dAtA[i:], m.Durability)
+	}
+	if len(m.HardwareCheck) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.HardwareCheck)))
+		i += copy(dAtA[i:], m.HardwareCheck)
+	}
+	if m.HardwareTolerancePercent != 0 {
+		dAtA[i] = 0xa9
+		i++
+		dAtA[i] = 0x1
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.HardwareTolerancePercent))))
+		i += 8
+	}
+	if m.ClockOffsetWarnMs != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ClockOffsetWarnMs))
+	}
+	if len(m.BroadcastOrder) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.BroadcastOrder)))
+		i += copy(dAtA[i:], m.BroadcastOrder)
+	}
+	if len(m.EndpointZones) > 0 {
+		for _, s := range m.EndpointZones {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ClientZone) > 0 {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientZone)))
+		i += copy(dAtA[i:], m.ClientZone)
+	}
+	if m.ZoneSpilloverPercent != 0 {
+		dAtA[i] = 0xd1
+		i++
+		dAtA[i] = 0x1
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ZoneSpilloverPercent))))
+		i += 8
+	}
+	if len(m.Hooks) > 0 {
+		for _, msg := range m.Hooks {
+			dAtA[i] = 0xda
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DatabaseLogLevel) > 0 {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseLogLevel)))
+		i += copy(dAtA[i:], m.DatabaseLogLevel)
+	}
+	if m.Flag_Etcd_Other != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_Other.Size()))
+		n12, err := m.Flag_Etcd_Other.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.Flag_Etcd_Tip != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_Tip.Size()))
+		n13, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.Flag_Etcd_V3_2 != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_V3_2.Size()))
+		n14, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.Flag_Etcd_V3_3 != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_V3_3.Size()))
+		n15, err := m.Flag_Etcd_V3_3.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.Flag_Zookeeper_R3_5_3Beta != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0xc
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zookeeper_R3_5_3Beta.Size()))
+		n16, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.Flag_Consul_V1_0_2 != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Consul_V1_0_2.Size()))
+		n17, err := m.Flag_Consul_V1_0_2.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.Flag_Cetcd_Beta != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x19
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Cetcd_Beta.Size()))
+		n18, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.Flag_Zetcd_Beta != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1f
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zetcd_Beta.Size()))
+		n19, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if m.ConfigClientMachineBenchmarkOptions != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkOptions.Size()))
+		n20, err := m.ConfigClientMachineBenchmarkOptions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.ConfigClientMachineBenchmarkSteps != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkSteps.Size()))
+		n21, err := m.ConfigClientMachineBenchmarkSteps.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	return i, nil
+}
+
+func encodeVarintConfigClientMachine(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ConfigClientMachineInitial) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PathPrefix)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.LogPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientSystemMetricsPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientSystemMetricsInterpolatedPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLatencyThroughputTimeseriesPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLatencyDistributionAllPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLatencyDistributionPercentilePath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLatencyDistributionSummaryPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLatencyByKeyNumberPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ServerDiskSpaceUsageSummaryPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientMembershipChangesPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientBackupRestoreSummaryPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientUploadManifestPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.TestID)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.PortOffset != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.PortOffset))
+	}
+	l = len(m.ClientLatencyDistributionCorrectedPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLatencyHeatmapPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientReportPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.TestName)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.RunTimestamp)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientReadYourWritesPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientRepeatSummaryPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientOpenMetricsPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientReadConsistencyPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLeaderTimeseriesPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientAuthPenaltyPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientWatchSummaryPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientPhasesPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientUpgradesPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientSnapshotTransferSummaryPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.AgentAuthSecretPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientClockOffsetsPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientOpTypeSummaryPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientWatchCompactionSummaryPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientDeleteRangeSummaryPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientTenancySummaryPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientReportDataPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.ClientReportFormats) > 0 {
+		for _, s := range m.ClientReportFormats {
+			l = len(s)
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.ClientComparisonReportPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.GoogleCloudProjectName)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.GoogleCloudStorageKeyPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.GoogleCloudStorageKey)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.GoogleCloudStorageBucketName)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.GoogleCloudStorageSubDirectory)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.RemoteStorageDestinations) > 0 {
+		for _, e := range m.RemoteStorageDestinations {
+			l = e.Size()
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovConfigClientMachine(uint64(len(k))) + 1 + len(v) + sovConfigClientMachine(uint64(len(v)))
+			n += mapEntrySize + 2 + sovConfigClientMachine(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *RemoteStorageDestination) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.Bucket)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.SubDirectory)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.LocalDirectory)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineBenchmarkOptions) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.RequestNumber != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.RequestNumber))
+	}
+	if m.ConnectionNumber != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ConnectionNumber))
+	}
+	if m.ClientNumber != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ClientNumber))
+	}
+	if len(m.ConnectionClientNumbers) > 0 {
+		l = 0
+		for _, e := range m.ConnectionClientNumbers {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 1 + sovConfigClientMachine(uint64(l)) + l
+	}
+	if m.RateLimitRequestsPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.RateLimitRequestsPerSecond))
+	}
+	if m.SameKey {
+		n += 2
+	}
+	if m.KeySizeBytes != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.KeySizeBytes))
+	}
+	if m.ValueSizeBytes != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ValueSizeBytes))
+	}
+	if m.StaleRead {
+		n += 2
+	}
+	l = len(m.WorkloadName)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.WorkloadPluginPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.ScalingMemberNumbers) > 0 {
+		l = 0
+		for _, e := range m.ScalingMemberNumbers {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 1 + sovConfigClientMachine(uint64(l)) + l
+	}
+	if m.ScalingIntervalSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ScalingIntervalSeconds))
+	}
+	if len(m.BackupRestoreKeyNumbers) > 0 {
+		l = 0
+		for _, e := range m.BackupRestoreKeyNumbers {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 1 + sovConfigClientMachine(uint64(l)) + l
+	}
+	if m.MaxErrorRate != 0 {
+		n += 10
+	}
+	if m.MaxConsecutiveErrors != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.MaxConsecutiveErrors))
+	}
+	if m.ListPageSize != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.ListPageSize))
+	}
+	if m.ListIterations != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.ListIterations))
+	}
+	if m.KubernetesObjects != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.KubernetesObjects))
+	}
+	if m.KubernetesHotObjects != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.KubernetesHotObjects))
+	}
+	if m.KubernetesListRatio != 0 {
+		n += 10
+	}
+	if m.KubernetesWatchers != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.KubernetesWatchers))
+	}
+	if m.Repeat != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.Repeat))
+	}
+	if m.CompactionPolicy != nil {
+		l = m.CompactionPolicy.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.TraceSampleRatio != 0 {
+		n += 10
+	}
+	l = len(m.TraceOTLPEndpoint)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.SLOs) > 0 {
+		for _, e := range m.SLOs {
+			l = e.Size()
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.ValueEncoding)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ValueSchemaFields != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.ValueSchemaFields))
+	}
+	if m.ValueSchemaDepth != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.ValueSchemaDepth))
+	}
+	l = len(m.EtcdCompression)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ValueCompression)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.TraceFile)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.WatcherNumber != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.WatcherNumber))
+	}
+	if m.WatchStreamsPerConnection != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.WatchStreamsPerConnection))
+	}
+	if m.DialConcurrency != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.DialConcurrency))
+	}
+	if m.DialJitterMs != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.DialJitterMs))
+	}
+	if m.VerifyConnections {
+		n += 3
+	}
+	l = len(m.UpgradeEtcdGitRef)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.UpgradeIntervalSeconds != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.UpgradeIntervalSeconds))
+	}
+	l = len(m.KeyEncoding)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.KeyPathDepth != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.KeyPathDepth))
+	}
+	if m.KeyPathFanout != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.KeyPathFanout))
+	}
+	if m.DropPageCache {
+		n += 3
+	}
+	if m.ColdRestart {
+		n += 3
+	}
+	if m.ConsulCatalogServices != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.ConsulCatalogServices))
+	}
+	if m.ConsulCatalogInstances != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.ConsulCatalogInstances))
+	}
+	if m.ConsulCatalogCheckRatio != 0 {
+		n += 10
+	}
+	if m.ConsulCatalogQueryRatio != 0 {
+		n += 10
+	}
+	if m.WatchDisconnectSeconds != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.WatchDisconnectSeconds))
+	}
+	if len(m.ClientDelaysMs) > 0 {
+		l = 0
+		for _, e := range m.ClientDelaysMs {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 2 + sovConfigClientMachine(uint64(l)) + l
+	}
+	if m.ClientDelayJitterMs != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.ClientDelayJitterMs))
+	}
+	if m.DeleteRangeKeys != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.DeleteRangeKeys))
+	}
+	if m.DeleteRangeProbeSeconds != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.DeleteRangeProbeSeconds))
+	}
+	if m.TenancyReaderKeys != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.TenancyReaderKeys))
+	}
+	if m.TenancyWriterClients != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.TenancyWriterClients))
+	}
+	if len(m.PriorityClasses) > 0 {
+		for _, s := range m.PriorityClasses {
+			l = len(s)
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.PriorityMetadataKey)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.ClientInterceptors) > 0 {
+		for _, s := range m.ClientInterceptors {
+			l = len(s)
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.ClientInterceptorPluginPath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.KeyPrefix)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.FailOnTotalKeysMismatch {
+		n += 3
+	}
+	return n
+}
+
+func (m *ConfigSLO) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Metric)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Max != 0 {
+		n += 9
+	}
+	if m.Min != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *ConfigCompactionPolicy) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.IntervalRevisions != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.IntervalRevisions))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.IntervalSeconds))
+	}
+	if m.RetentionRevisions != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.RetentionRevisions))
+	}
+	if m.RetentionSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.RetentionSeconds))
+	}
+	if m.Physical {
+		n += 2
+	}
+	if m.Defragment {
+		n += 2
+	}
+	return n
+}
+
+func (m *ConfigClientMachineBenchmarkSteps) Size() (n int) {
+	var l int
+	_ = l
+	if m.Step1StartDatabase {
+		n += 2
+	}
+	if m.Step2StressDatabase {
+		n += 2
+	}
+	if m.Step3StopDatabase {
+		n += 2
+	}
+	if m.Step4UploadLogs {
+		n += 2
+	}
+	if m.Step1TimeoutSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.Step1TimeoutSeconds))
+	}
+	if m.Step2TimeoutSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.Step2TimeoutSeconds))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineHook) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Point)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if m.TimeoutSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.TimeoutSeconds))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineAgentControl) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DatabaseID)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.DatabaseDescription)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.DatabaseTag)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.PeerIPs) > 0 {
+		for _, s := range m.PeerIPs {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.PeerIPsString)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.AgentPortToConnect != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.AgentPortToConnect))
+	}
+	if len(m.AgentEndpoints) > 0 {
+		for _, s := range m.AgentEndpoints {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if m.DatabasePortToConnect != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.DatabasePortToConnect))
+	}
+	if len(m.DatabaseEndpoints) > 0 {
+		for _, s := range m.DatabaseEndpoints {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.LatencyProfile)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.EtcdGitRef)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.DiskWriteDelayMs != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.DiskWriteDelayMs))
+	}
+	if m.DiskReadDelayMs != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.DiskReadDelayMs))
+	}
+	if m.ClockSkewMs != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.ClockSkewMs))
+	}
+	if len(m.ClockSkewMemberIndexes) > 0 {
+		l = 0
+		for _, e := range m.ClockSkewMemberIndexes {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 1 + sovConfigClientMachine(uint64(l)) + l
+	}
+	if m.AntagonistCPUCores != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.AntagonistCPUCores))
+	}
+	if m.AntagonistMemoryBytes != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.AntagonistMemoryBytes))
+	}
+	if m.AntagonistDiskWriteBytesPerSecond != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.AntagonistDiskWriteBytesPerSecond))
+	}
+	l = len(m.Durability)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.HardwareCheck)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.HardwareTolerancePercent != 0 {
+		n += 10
+	}
+	if m.ClockOffsetWarnMs != 0 {
+		n += 2 + sovConfigClientMachine(uint64(m.ClockOffsetWarnMs))
+	}
+	l = len(m.BroadcastOrder)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if len(m.EndpointZones) > 0 {
+		for _, s := range m.EndpointZones {
+			l = len(s)
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.ClientZone)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ZoneSpilloverPercent != 0 {
+		n += 10
+	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.DatabaseLogLevel)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Etcd_Other != nil {
+		l = m.Flag_Etcd_Other.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Etcd_Tip != nil {
+		l = m.Flag_Etcd_Tip.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Etcd_V3_2 != nil {
+		l = m.Flag_Etcd_V3_2.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Etcd_V3_3 != nil {
+		l = m.Flag_Etcd_V3_3.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Zookeeper_R3_5_3Beta != nil {
+		l = m.Flag_Zookeeper_R3_5_3Beta.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Consul_V1_0_2 != nil {
+		l = m.Flag_Consul_V1_0_2.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Cetcd_Beta != nil {
+		l = m.Flag_Cetcd_Beta.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.Flag_Zetcd_Beta != nil {
+		l = m.Flag_Zetcd_Beta.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ConfigClientMachineBenchmarkOptions != nil {
+		l = m.ConfigClientMachineBenchmarkOptions.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.ConfigClientMachineBenchmarkSteps != nil {
+		l = m.ConfigClientMachineBenchmarkSteps.Size()
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
+	return n
+}
+
+func sovConfigClientMachine(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozConfigClientMachine(x uint64) (n int) {
+	return sovConfigClientMachine(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineInitial: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineInitial: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSystemMetricsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSystemMetricsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSystemMetricsInterpolatedPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSystemMetricsInterpolatedPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyThroughputTimeseriesPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyThroughputTimeseriesPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyDistributionAllPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyDistributionAllPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyDistributionPercentilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyDistributionPercentilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyDistributionSummaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyDistributionSummaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyByKeyNumberPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyByKeyNumberPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerDiskSpaceUsageSummaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerDiskSpaceUsageSummaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientMembershipChangesPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientMembershipChangesPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientBackupRestoreSummaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientBackupRestoreSummaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUploadManifestPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientUploadManifestPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TestID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TestID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortOffset", wireType)
+			}
+			m.PortOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PortOffset |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyDistributionCorrectedPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyDistributionCorrectedPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyHeatmapPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLatencyHeatmapPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientReportPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientReportPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TestName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TestName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunTimestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunTimestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientReadYourWritesPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientReadYourWritesPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRepeatSummaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientRepeatSummaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOpenMetricsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOpenMetricsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientReadConsistencyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientReadConsistencyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLeaderTimeseriesPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLeaderTimeseriesPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientAuthPenaltyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientAuthPenaltyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientWatchSummaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientWatchSummaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPhasesPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientPhasesPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUpgradesPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientUpgradesPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSnapshotTransferSummaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSnapshotTransferSummaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentAuthSecretPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentAuthSecretPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientClockOffsetsPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientClockOffsetsPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOpTypeSummaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOpTypeSummaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientWatchCompactionSummaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientWatchCompactionSummaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDeleteRangeSummaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientDeleteRangeSummaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientTenancySummaryPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientTenancySummaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientReportDataPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientReportDataPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientReportFormats", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientReportFormats = append(m.ClientReportFormats, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientComparisonReportPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientComparisonReportPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKeyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageKeyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageBucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageBucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageSubDirectory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageSubDirectory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteStorageDestinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteStorageDestinations = append(m.RemoteStorageDestinations, &RemoteStorageDestination{})
+			if err := m.RemoteStorageDestinations[len(m.RemoteStorageDestinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 200:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthConfigClientMachine
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthConfigClientMachine
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthConfigClientMachine
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteStorageDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteStorageDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteStorageDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubDirectory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubDirectory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDirectory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalDirectory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestNumber", wireType)
+			}
+			m.RequestNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionNumber", wireType)
+			}
+			m.ConnectionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectionNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientNumber", wireType)
+			}
+			m.ClientNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionClientNumbers", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitRequestsPerSecond", wireType)
+			}
+			m.RateLimitRequestsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitRequestsPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SameKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SameKey = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySizeBytes", wireType)
+			}
+			m.KeySizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeySizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeBytes", wireType)
+			}
+			m.ValueSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueSizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleRead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StaleRead = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkloadName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadPluginPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkloadPluginPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ScalingMemberNumbers = append(m.ScalingMemberNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ScalingMemberNumbers = append(m.ScalingMemberNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingMemberNumbers", wireType)
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingIntervalSeconds", wireType)
+			}
+			m.ScalingIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScalingIntervalSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BackupRestoreKeyNumbers = append(m.BackupRestoreKeyNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BackupRestoreKeyNumbers = append(m.BackupRestoreKeyNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupRestoreKeyNumbers", wireType)
+			}
+		case 16:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxErrorRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxErrorRate = float64(math.Float64frombits(v))
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveErrors", wireType)
+			}
+			m.MaxConsecutiveErrors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveErrors |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListPageSize", wireType)
+			}
+			m.ListPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ListPageSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListIterations", wireType)
+			}
+			m.ListIterations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ListIterations |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesObjects", wireType)
+			}
+			m.KubernetesObjects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KubernetesObjects |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesHotObjects", wireType)
+			}
+			m.KubernetesHotObjects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KubernetesHotObjects |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesListRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.KubernetesListRatio = float64(math.Float64frombits(v))
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesWatchers", wireType)
+			}
+			m.KubernetesWatchers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KubernetesWatchers |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repeat", wireType)
+			}
+			m.Repeat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Repeat |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompactionPolicy == nil {
+				m.CompactionPolicy = &ConfigCompactionPolicy{}
+			}
+			if err := m.CompactionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceSampleRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TraceSampleRatio = float64(math.Float64frombits(v))
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceOTLPEndpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceOTLPEndpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SLOs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SLOs = append(m.SLOs, &ConfigSLO{})
+			if err := m.SLOs[len(m.SLOs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSchemaFields", wireType)
+			}
+			m.ValueSchemaFields = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueSchemaFields |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSchemaDepth", wireType)
+			}
+			m.ValueSchemaDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueSchemaDepth |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EtcdCompression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EtcdCompression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueCompression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueCompression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatcherNumber", wireType)
+			}
+			m.WatcherNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WatcherNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchStreamsPerConnection", wireType)
+			}
+			m.WatchStreamsPerConnection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WatchStreamsPerConnection |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DialConcurrency", wireType)
+			}
+			m.DialConcurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DialConcurrency |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DialJitterMs", wireType)
+			}
+			m.DialJitterMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DialJitterMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyConnections", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyConnections = bool(v != 0)
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeEtcdGitRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeEtcdGitRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeIntervalSeconds", wireType)
+			}
+			m.UpgradeIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeIntervalSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 43:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPathDepth", wireType)
+			}
+			m.KeyPathDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyPathDepth |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPathFanout", wireType)
+			}
+			m.KeyPathFanout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyPathFanout |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropPageCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DropPageCache = bool(v != 0)
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColdRestart", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColdRestart = bool(v != 0)
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsulCatalogServices", wireType)
+			}
+			m.ConsulCatalogServices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsulCatalogServices |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsulCatalogInstances", wireType)
+			}
+			m.ConsulCatalogInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsulCatalogInstances |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 49:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsulCatalogCheckRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ConsulCatalogCheckRatio = float64(math.Float64frombits(v))
+		case 50:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsulCatalogQueryRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ConsulCatalogQueryRatio = float64(math.Float64frombits(v))
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchDisconnectSeconds", wireType)
+			}
+			m.WatchDisconnectSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WatchDisconnectSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 52:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ClientDelaysMs = append(m.ClientDelaysMs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClientDelaysMs = append(m.ClientDelaysMs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDelaysMs", wireType)
+			}
+		case 53:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDelayJitterMs", wireType)
+			}
+			m.ClientDelayJitterMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientDelayJitterMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 54:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRangeKeys", wireType)
+			}
+			m.DeleteRangeKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteRangeKeys |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRangeProbeSeconds", wireType)
+			}
+			m.DeleteRangeProbeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteRangeProbeSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 56:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenancyReaderKeys", wireType)
+			}
+			m.TenancyReaderKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TenancyReaderKeys |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenancyWriterClients", wireType)
+			}
+			m.TenancyWriterClients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TenancyWriterClients |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClasses = append(m.PriorityClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityMetadataKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityMetadataKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientInterceptors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientInterceptors = append(m.ClientInterceptors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientInterceptorPluginPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientInterceptorPluginPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 63:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailOnTotalKeysMismatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailOnTotalKeysMismatch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Flag_Etcd_Other != nil {
-		l = m.Flag_Etcd_Other.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
-	}
-	if m.Flag_Etcd_Tip != nil {
-		l = m.Flag_Etcd_Tip.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
-	}
-	if m.Flag_Etcd_V3_2 != nil {
-		l = m.Flag_Etcd_V3_2.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
-	}
-	if m.Flag_Etcd_V3_3 != nil {
-		l = m.Flag_Etcd_V3_3.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
-	}
-	if m.Flag_Zookeeper_R3_5_3Beta != nil {
-		l = m.Flag_Zookeeper_R3_5_3Beta.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
-	}
-	if m.Flag_Consul_V1_0_2 != nil {
-		l = m.Flag_Consul_V1_0_2.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
-	}
-	if m.Flag_Cetcd_Beta != nil {
-		l = m.Flag_Cetcd_Beta.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
-	}
-	if m.Flag_Zetcd_Beta != nil {
-		l = m.Flag_Zetcd_Beta.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
-	}
-	if m.ConfigClientMachineBenchmarkOptions != nil {
-		l = m.ConfigClientMachineBenchmarkOptions.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
-	}
-	if m.ConfigClientMachineBenchmarkSteps != nil {
-		l = m.ConfigClientMachineBenchmarkSteps.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
-	}
-	return n
-}
 
-func sovConfigClientMachine(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozConfigClientMachine(x uint64) (n int) {
-	return sovConfigClientMachine(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
+func (m *ConfigSLO) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -854,15 +6085,15 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigClientMachineInitial: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigSLO: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigClientMachineInitial: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigSLO: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -887,11 +6118,83 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathPrefix = string(dAtA[iNdEx:postIndex])
+			m.Metric = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Max = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Min = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigCompactionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigCompactionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigCompactionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -916,13 +6219,32 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogPath = string(dAtA[iNdEx:postIndex])
+			m.Mode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalRevisions", wireType)
+			}
+			m.IntervalRevisions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalRevisions |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSystemMetricsPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
 			}
-			var stringLen uint64
+			m.IntervalSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -932,26 +6254,54 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.IntervalSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionRevisions", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RetentionRevisions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionRevisions |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionSeconds", wireType)
+			}
+			m.RetentionSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ClientSystemMetricsPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSystemMetricsInterpolatedPath", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Physical", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -961,26 +6311,17 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientSystemMetricsInterpolatedPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyThroughputTimeseriesPath", wireType)
+			m.Physical = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Defragment", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -990,26 +6331,67 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.Defragment = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthConfigClientMachine
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientLatencyThroughputTimeseriesPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyDistributionAllPath", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkSteps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step1StartDatabase", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1019,26 +6401,17 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientLatencyDistributionAllPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyDistributionPercentilePath", wireType)
+			m.Step1StartDatabase = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step2StressDatabase", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1048,26 +6421,17 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientLatencyDistributionPercentilePath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyDistributionSummaryPath", wireType)
+			m.Step2StressDatabase = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step3StopDatabase", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1077,26 +6441,17 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientLatencyDistributionSummaryPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatencyByKeyNumberPath", wireType)
+			m.Step3StopDatabase = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step4UploadLogs", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1106,26 +6461,17 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientLatencyByKeyNumberPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerDiskSpaceUsageSummaryPath", wireType)
+			m.Step4UploadLogs = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step1TimeoutSeconds", wireType)
 			}
-			var stringLen uint64
+			m.Step1TimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1135,26 +6481,16 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Step1TimeoutSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServerDiskSpaceUsageSummaryPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 100:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudProjectName", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step2TimeoutSeconds", wireType)
 			}
-			var stringLen uint64
+			m.Step2TimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1164,24 +6500,64 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Step2TimeoutSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthConfigClientMachine
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleCloudProjectName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 101:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKeyPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Point", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1206,11 +6582,11 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleCloudStorageKeyPath = string(dAtA[iNdEx:postIndex])
+			m.Point = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 102:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1235,11 +6611,11 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleCloudStorageKey = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 103:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageBucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1264,13 +6640,13 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleCloudStorageBucketName = string(dAtA[iNdEx:postIndex])
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 104:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageSubDirectory", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var stringLen uint64
+			m.TimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1280,21 +6656,11 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.TimeoutSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GoogleCloudStorageSubDirectory = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
@@ -1316,7 +6682,7 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
+func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1339,15 +6705,15 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigClientMachineAgentControl: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigClientMachineAgentControl: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1372,13 +6738,13 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.DatabaseID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseDescription", wireType)
 			}
-			m.RequestNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1388,16 +6754,26 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestNumber |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
 			}
-			m.ConnectionNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1407,16 +6783,26 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConnectionNumber |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPs", wireType)
 			}
-			m.ClientNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1426,78 +6812,55 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientNumber |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerIPs = append(m.PeerIPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 5:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowConfigClientMachine
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (int64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowConfigClientMachine
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthConfigClientMachine
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPsString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowConfigClientMachine
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (int64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionClientNumbers", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerIPsString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitRequestsPerSecond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentPortToConnect", wireType)
 			}
-			m.RateLimitRequestsPerSecond = 0
+			m.AgentPortToConnect = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1507,16 +6870,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RateLimitRequestsPerSecond |= (int64(b) & 0x7F) << shift
+				m.AgentPortToConnect |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SameKey", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentEndpoints", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1526,17 +6889,26 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SameKey = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentEndpoints = append(m.AgentEndpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeySizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabasePortToConnect", wireType)
 			}
-			m.KeySizeBytes = 0
+			m.DatabasePortToConnect = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1546,16 +6918,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeySizeBytes |= (int64(b) & 0x7F) << shift
+				m.DatabasePortToConnect |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseEndpoints", wireType)
 			}
-			m.ValueSizeBytes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1565,16 +6937,26 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValueSizeBytes |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseEndpoints = append(m.DatabaseEndpoints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaleRead", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyProfile", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1584,67 +6966,55 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StaleRead = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthConfigClientMachine
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowConfigClientMachine
+			m.LatencyProfile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EtcdGitRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkSteps: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkSteps: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.EtcdGitRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step1StartDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskWriteDelayMs", wireType)
 			}
-			var v int
+			m.DiskWriteDelayMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1654,17 +7024,16 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.DiskWriteDelayMs |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Step1StartDatabase = bool(v != 0)
-		case 2:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step2StressDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskReadDelayMs", wireType)
 			}
-			var v int
+			m.DiskReadDelayMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1674,17 +7043,16 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.DiskReadDelayMs |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Step2StressDatabase = bool(v != 0)
-		case 3:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step3StopDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockSkewMs", wireType)
 			}
-			var v int
+			m.ClockSkewMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1694,17 +7062,78 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.ClockSkewMs |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Step3StopDatabase = bool(v != 0)
-		case 4:
+		case 15:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ClockSkewMemberIndexes = append(m.ClockSkewMemberIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClockSkewMemberIndexes = append(m.ClockSkewMemberIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockSkewMemberIndexes", wireType)
+			}
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step4UploadLogs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AntagonistCPUCores", wireType)
 			}
-			var v int
+			m.AntagonistCPUCores = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1714,67 +7143,16 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.AntagonistCPUCores |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Step4UploadLogs = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowConfigClientMachine
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigClientMachineAgentControl: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigClientMachineAgentControl: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntagonistMemoryBytes", wireType)
 			}
-			var stringLen uint64
+			m.AntagonistMemoryBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1784,24 +7162,33 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.AntagonistMemoryBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntagonistDiskWriteBytesPerSecond", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.AntagonistDiskWriteBytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AntagonistDiskWriteBytesPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.DatabaseID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseDescription", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Durability", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1826,11 +7213,11 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseDescription = string(dAtA[iNdEx:postIndex])
+			m.Durability = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HardwareCheck", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1855,13 +7242,24 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			m.HardwareCheck = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPs", wireType)
+		case 21:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardwareTolerancePercent", wireType)
 			}
-			var stringLen uint64
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.HardwareTolerancePercent = float64(math.Float64frombits(v))
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockOffsetWarnMs", wireType)
+			}
+			m.ClockOffsetWarnMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1871,24 +7269,14 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ClockOffsetWarnMs |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PeerIPs = append(m.PeerIPs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPsString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BroadcastOrder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1913,13 +7301,13 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerIPsString = string(dAtA[iNdEx:postIndex])
+			m.BroadcastOrder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentPortToConnect", wireType)
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointZones", wireType)
 			}
-			m.AgentPortToConnect = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1929,14 +7317,24 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AgentPortToConnect |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndpointZones = append(m.EndpointZones, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentEndpoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1961,13 +7359,24 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AgentEndpoints = append(m.AgentEndpoints, string(dAtA[iNdEx:postIndex]))
+			m.ClientZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabasePortToConnect", wireType)
+		case 26:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneSpilloverPercent", wireType)
 			}
-			m.DatabasePortToConnect = 0
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ZoneSpilloverPercent = float64(math.Float64frombits(v))
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1977,14 +7386,26 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatabasePortToConnect |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, &ConfigClientMachineHook{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseEndpoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseLogLevel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2009,7 +7430,7 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseEndpoints = append(m.DatabaseEndpoints, string(dAtA[iNdEx:postIndex]))
+			m.DatabaseLogLevel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
@@ -2472,112 +7893,301 @@ func init() {
 }
 
 var fileDescriptorConfigClientMachine = []byte{
-	// 1711 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xcd, 0x6e, 0xdb, 0xc8,
-	0x1d, 0x5f, 0x45, 0xc9, 0xc6, 0x1e, 0xe7, 0x73, 0x12, 0x27, 0x8a, 0xe3, 0x78, 0x1c, 0x26, 0xe9,
-	0x7a, 0xb1, 0x8d, 0x9d, 0x48, 0xd9, 0x05, 0x5a, 0xb4, 0x68, 0x57, 0xf6, 0xb6, 0x0d, 0xe2, 0xdd,
-	0xa8, 0x94, 0x37, 0x45, 0x83, 0xa2, 0xd3, 0x11, 0x35, 0xa6, 0xb8, 0xa6, 0x38, 0x2c, 0x67, 0x18,
-	0x54, 0xee, 0xad, 0x28, 0x50, 0xb4, 0xa7, 0x3d, 0xee, 0xb1, 0x0f, 0xd0, 0xe7, 0x28, 0x72, 0xec,
-	0x13, 0x10, 0x6d, 0xf6, 0xd2, 0x5e, 0x89, 0x3e, 0xc0, 0x62, 0xfe, 0x24, 0xa5, 0xa1, 0x44, 0xc9,
-	0xba, 0x89, 0xf3, 0xff, 0x7d, 0xcd, 0x70, 0xbe, 0x28, 0xf4, 0xbd, 0x7e, 0x4f, 0x71, 0xa9, 0x78,
-	0x14, 0xf6, 0xf6, 0x1c, 0x11, 0x1c, 0x7b, 0x2e, 0x75, 0x7c, 0x8f, 0x07, 0x8a, 0x0e, 0x99, 0x33,
-	0xf0, 0x02, 0xbe, 0x1b, 0x46, 0x42, 0x09, 0x8c, 0x26, 0xb8, 0x8d, 0xc7, 0xae, 0xa7, 0x06, 0x71,
-	0x6f, 0xd7, 0x11, 0xc3, 0x3d, 0x57, 0xb8, 0x62, 0x0f, 0x20, 0xbd, 0xf8, 0x18, 0x9e, 0xe0, 0x01,
-	0x7e, 0x65, 0xd4, 0x8d, 0x0d, 0xc3, 0xe2, 0xd8, 0x67, 0x2e, 0xe5, 0xca, 0xe9, 0xe7, 0x35, 0x32,
-	0x5d, 0x3b, 0x15, 0xe2, 0x84, 0xf3, 0x90, 0x47, 0x39, 0x60, 0x73, 0x1a, 0xe0, 0x88, 0x40, 0xc6,
-	0x7e, 0x5e, 0xbd, 0x3b, 0x43, 0x37, 0xb4, 0x67, 0x8a, 0xce, 0xa4, 0x68, 0x7d, 0x7b, 0x09, 0x6d,
-	0xec, 0x43, 0x7f, 0xf7, 0xa1, 0xbb, 0x9f, 0x67, 0xbd, 0x7d, 0x1e, 0x78, 0xca, 0x63, 0x3e, 0xfe,
-	0x04, 0xa1, 0x0e, 0x53, 0x83, 0x4e, 0xc4, 0x8f, 0xbd, 0x3f, 0x34, 0x6a, 0xdb, 0xb5, 0x9d, 0xd5,
-	0xf6, 0xad, 0x34, 0x21, 0x78, 0xc4, 0x86, 0xfe, 0x0f, 0xad, 0x90, 0xa9, 0x01, 0x0d, 0xa1, 0x68,
-	0xd9, 0x06, 0x12, 0x3f, 0x46, 0x17, 0x0f, 0x85, 0xab, 0x1b, 0x1a, 0xe7, 0x80, 0x74, 0x23, 0x4d,
-	0xc8, 0xd5, 0x8c, 0xe4, 0x0b, 0x97, 0x6a, 0xa2, 0x65, 0x17, 0x18, 0x4c, 0xd1, 0xed, 0xcc, 0xbe,
-	0x3b, 0x92, 0x8a, 0x0f, 0x3f, 0xe7, 0x2a, 0xf2, 0x1c, 0x09, 0xf4, 0x3a, 0xd0, 0x1f, 0xa5, 0x09,
-	0xb9, 0x9f, 0xd1, 0xf3, 0xd7, 0x22, 0x01, 0x49, 0x87, 0x19, 0x34, 0x17, 0x9c, 0xa7, 0x82, 0xff,
-	0x5c, 0x43, 0x0f, 0x2a, 0x6a, 0xcf, 0x03, 0x3d, 0x2c, 0xc2, 0x67, 0x8a, 0xf7, 0xc1, 0xed, 0x3c,
-	0xb8, 0x35, 0xd3, 0x84, 0xec, 0x2e, 0x72, 0xf3, 0x0c, 0x5e, 0x6e, 0xbd, 0x8c, 0x3c, 0xfe, 0x5b,
-	0x0d, 0x3d, 0xca, 0x70, 0x87, 0x4c, 0xf1, 0xc0, 0x19, 0x1d, 0x0d, 0x22, 0x11, 0xbb, 0x83, 0x30,
-	0x56, 0x47, 0xde, 0x90, 0x4b, 0x1e, 0x79, 0x3c, 0xeb, 0xf6, 0x05, 0x08, 0xf2, 0x2c, 0x4d, 0xc8,
-	0x93, 0x52, 0x10, 0x3f, 0xe3, 0x51, 0x35, 0x26, 0x52, 0x35, 0x66, 0xe6, 0x51, 0x96, 0xb3, 0xc0,
-	0x7f, 0x44, 0xdb, 0x25, 0xe0, 0x81, 0x27, 0x55, 0xe4, 0xf5, 0x62, 0xe5, 0x89, 0xe0, 0x53, 0xdf,
-	0x87, 0x18, 0xef, 0x43, 0x8c, 0xbd, 0x34, 0x21, 0x1f, 0x55, 0xc6, 0xe8, 0x1b, 0x1c, 0xca, 0x7c,
-	0x3f, 0x4f, 0x70, 0xa6, 0x30, 0xfe, 0xba, 0x86, 0x3e, 0x98, 0x0b, 0xea, 0xf0, 0xc8, 0xe1, 0x81,
-	0xf2, 0x7c, 0x0e, 0x21, 0x2e, 0x42, 0x88, 0x4f, 0xd2, 0x84, 0x34, 0xcf, 0x0e, 0x11, 0x8e, 0xb9,
-	0x79, 0x96, 0x65, 0x6d, 0xf0, 0x5f, 0x6a, 0xe8, 0xe1, 0x5c, 0x6c, 0x37, 0x1e, 0x0e, 0x59, 0x34,
-	0x82, 0x3c, 0x2b, 0x90, 0xa7, 0x95, 0x26, 0x64, 0xef, 0xec, 0x3c, 0x32, 0x23, 0xe6, 0x61, 0x96,
-	0x32, 0xc0, 0x21, 0xda, 0x2c, 0xe1, 0xda, 0xa3, 0x17, 0x7c, 0xf4, 0x45, 0x3c, 0xec, 0xf1, 0x08,
-	0x02, 0xac, 0x42, 0x80, 0xef, 0xa7, 0x09, 0xd9, 0xa9, 0x0c, 0xd0, 0x1b, 0xd1, 0x13, 0x3e, 0xa2,
-	0x01, 0x30, 0x72, 0xe7, 0x85, 0x8a, 0x78, 0x84, 0x48, 0x97, 0x47, 0x6f, 0x78, 0x74, 0xe0, 0xc9,
-	0x93, 0x6e, 0xc8, 0x1c, 0xfe, 0xa5, 0x64, 0x2e, 0x37, 0x7b, 0x8d, 0xa6, 0xa7, 0x82, 0x04, 0x82,
-	0xee, 0xed, 0x09, 0x95, 0x9a, 0x42, 0x63, 0xcd, 0x99, 0xea, 0xf1, 0x59, 0xba, 0xf8, 0x37, 0xe8,
-	0xd6, 0xcf, 0x85, 0x70, 0x7d, 0xbe, 0xef, 0x8b, 0xb8, 0xdf, 0x89, 0xc4, 0x57, 0xdc, 0x51, 0x5f,
-	0xb0, 0x21, 0x6f, 0xf4, 0xc1, 0xf1, 0x61, 0x9a, 0x90, 0xed, 0xcc, 0xd1, 0x05, 0x1c, 0x75, 0x34,
-	0x90, 0x86, 0x19, 0x92, 0x06, 0x6c, 0xc8, 0x2d, 0x7b, 0x8e, 0x06, 0x3e, 0x46, 0x77, 0x8c, 0x4a,
-	0x57, 0x89, 0x88, 0xb9, 0xfc, 0x05, 0xcf, 0xba, 0xc4, 0xc1, 0x60, 0x27, 0x4d, 0xc8, 0xc3, 0x0a,
-	0x03, 0x99, 0x81, 0x61, 0x28, 0xb3, 0xbe, 0xcc, 0x97, 0xc2, 0xcf, 0xd0, 0x7a, 0x65, 0xb1, 0x71,
-	0xac, 0x3d, 0xec, 0xea, 0x22, 0x16, 0x68, 0x73, 0xb6, 0xd0, 0x8e, 0x9d, 0x13, 0x9e, 0x8d, 0x80,
-	0x0b, 0x01, 0x3f, 0x4a, 0x13, 0xf2, 0xc1, 0x82, 0x80, 0x3d, 0x20, 0xe4, 0x03, 0xb1, 0x50, 0x10,
-	0xc7, 0x68, 0x6b, 0xb6, 0xde, 0x8d, 0x7b, 0x07, 0x5e, 0xc4, 0x1d, 0x25, 0xa2, 0x51, 0x63, 0x00,
-	0x96, 0x8f, 0xd3, 0x84, 0x7c, 0xb8, 0xc0, 0x52, 0xc6, 0x3d, 0xda, 0x2f, 0x38, 0x96, 0x7d, 0x86,
-	0xa8, 0xf5, 0xcf, 0x0b, 0xe8, 0x41, 0xc5, 0x29, 0xd3, 0xe6, 0x81, 0x33, 0x18, 0xb2, 0xe8, 0xe4,
-	0x65, 0xa8, 0x97, 0x80, 0xc4, 0x0f, 0xd0, 0xf9, 0xa3, 0x51, 0xc8, 0xf3, 0x83, 0xe6, 0x6a, 0x9a,
-	0x90, 0xb5, 0x2c, 0x84, 0x1a, 0x85, 0xdc, 0xb2, 0xa1, 0x88, 0x7f, 0x82, 0x2e, 0xdb, 0xfc, 0xf7,
-	0x31, 0x97, 0x2a, 0x9b, 0xc0, 0x70, 0xc2, 0xd4, 0xdb, 0x77, 0xd2, 0x84, 0xac, 0x67, 0xe8, 0x28,
-	0x2b, 0xe7, 0x0b, 0xc0, 0xb2, 0xcb, 0x78, 0xfc, 0x0b, 0x74, 0x6d, 0x5f, 0x04, 0x01, 0x77, 0xb4,
-	0x69, 0xae, 0x51, 0x07, 0x8d, 0xcd, 0x34, 0x21, 0x8d, 0x7c, 0x49, 0x8d, 0x11, 0x63, 0x99, 0x19,
-	0x16, 0xfe, 0x11, 0xba, 0x94, 0x75, 0x28, 0x57, 0x39, 0x0f, 0x2a, 0x8d, 0x34, 0x21, 0x37, 0x4b,
-	0x0b, 0xb3, 0x50, 0x28, 0xa1, 0xf1, 0x6f, 0xd1, 0xed, 0x89, 0xa2, 0x59, 0x91, 0x8d, 0x0b, 0xdb,
-	0xf5, 0x9d, 0xba, 0x39, 0xf5, 0x8d, 0x38, 0x25, 0x4d, 0xa9, 0x0f, 0xbd, 0x6a, 0x11, 0xec, 0xa1,
-	0x0d, 0x9b, 0x29, 0x7e, 0xe8, 0x0d, 0x3d, 0x95, 0x8f, 0x80, 0xec, 0xf0, 0xa8, 0xcb, 0x1d, 0x11,
-	0xf4, 0x61, 0x6b, 0xaf, 0xb7, 0x3f, 0x4c, 0x13, 0xf2, 0x28, 0x1f, 0x35, 0xa6, 0x38, 0xf5, 0x35,
-	0x98, 0xe6, 0x03, 0x28, 0xf5, 0x6e, 0x4a, 0x25, 0xe0, 0x2d, 0x7b, 0x81, 0x98, 0x3e, 0xef, 0xbb,
-	0x6c, 0x08, 0x13, 0x5e, 0xef, 0xd6, 0x2b, 0xe6, 0x79, 0x2f, 0xd9, 0x10, 0x16, 0x91, 0x65, 0x17,
-	0x18, 0xfc, 0x63, 0x74, 0xe9, 0x05, 0x1f, 0x75, 0xbd, 0x53, 0xde, 0x1e, 0x29, 0x2e, 0x61, 0x47,
-	0x2d, 0xbd, 0x41, 0xbd, 0xe6, 0xa4, 0x77, 0xca, 0x69, 0x4f, 0xd7, 0x2d, 0xbb, 0x04, 0xc7, 0xfb,
-	0xe8, 0xca, 0x2b, 0xe6, 0xc7, 0x7c, 0x22, 0xb0, 0x0a, 0x02, 0x77, 0xd3, 0x84, 0xdc, 0xce, 0x04,
-	0xde, 0xe8, 0x7a, 0x49, 0x62, 0x8a, 0x82, 0x5b, 0x68, 0xb5, 0xab, 0x98, 0xcf, 0x6d, 0xce, 0xfa,
-	0xb0, 0xb9, 0xad, 0xb4, 0xd7, 0xd3, 0x84, 0x5c, 0xcf, 0x43, 0xeb, 0x12, 0x8d, 0x38, 0xeb, 0x5b,
-	0xf6, 0x04, 0x67, 0x25, 0xe7, 0xd0, 0xfd, 0x45, 0x13, 0xb9, 0xab, 0x78, 0x28, 0xf1, 0x4b, 0x84,
-	0xf5, 0x8f, 0xa7, 0x5d, 0xc5, 0x22, 0x75, 0xc0, 0x14, 0xeb, 0x31, 0x99, 0x4d, 0xea, 0x95, 0x36,
-	0x49, 0x13, 0x72, 0xb7, 0xf0, 0xe0, 0xe1, 0x53, 0x2a, 0x35, 0x88, 0xf6, 0x73, 0x94, 0x65, 0x57,
-	0x50, 0xb1, 0x8d, 0x6e, 0xe8, 0xd6, 0x66, 0x57, 0x45, 0x5c, 0xca, 0xb1, 0xe2, 0x39, 0x50, 0xdc,
-	0x4e, 0x13, 0xb2, 0x39, 0x51, 0x6c, 0x52, 0x09, 0x28, 0x43, 0xb2, 0x8a, 0x8c, 0x0f, 0xd1, 0x75,
-	0xdd, 0xdc, 0xea, 0x2a, 0x11, 0x8e, 0x15, 0xeb, 0xa0, 0xb8, 0x95, 0x26, 0x64, 0x63, 0xa2, 0xd8,
-	0xd2, 0xcb, 0x3e, 0x34, 0xf4, 0x66, 0x89, 0xf8, 0x67, 0xe8, 0xaa, 0x6e, 0x7c, 0xf6, 0x65, 0xe8,
-	0x0b, 0xd6, 0x3f, 0x14, 0xae, 0x84, 0xc5, 0xb0, 0x62, 0x2e, 0x29, 0xad, 0xf5, 0x8c, 0xc6, 0x80,
-	0xa0, 0xbe, 0x70, 0xa5, 0x65, 0x4f, 0x93, 0xac, 0x3f, 0x5d, 0x41, 0xa4, 0x62, 0x80, 0x3f, 0x75,
-	0x79, 0xa0, 0xf6, 0x45, 0xa0, 0x22, 0x01, 0x97, 0xd2, 0xc2, 0xf7, 0xf9, 0xc1, 0xec, 0xa5, 0xb4,
-	0xc8, 0x49, 0xbd, 0xbe, 0x65, 0x1b, 0x48, 0xfc, 0x4b, 0x74, 0xa3, 0x78, 0x3a, 0xe0, 0xd2, 0x89,
-	0x3c, 0xd8, 0x75, 0xf2, 0x0b, 0xaa, 0xf1, 0x5e, 0xc6, 0x02, 0xfd, 0x09, 0xca, 0xb2, 0xab, 0xb8,
-	0xf8, 0x07, 0x68, 0xad, 0x68, 0x3e, 0x62, 0x6e, 0x7e, 0x59, 0xbd, 0x9d, 0x26, 0xe4, 0xc6, 0x94,
-	0x94, 0x62, 0xae, 0x65, 0x9b, 0x58, 0xbd, 0x64, 0x3a, 0x9c, 0x47, 0xcf, 0x3b, 0x7a, 0xa4, 0xea,
-	0xe5, 0x2b, 0x72, 0xc8, 0x79, 0x44, 0xbd, 0x50, 0x5a, 0x76, 0x81, 0xc1, 0x3f, 0x45, 0x97, 0xf3,
-	0x9f, 0x5d, 0x15, 0x79, 0x81, 0x9b, 0xdf, 0x10, 0x37, 0xd2, 0x84, 0xdc, 0x2a, 0x93, 0xf4, 0xfb,
-	0xf7, 0x02, 0xd7, 0xb2, 0xcb, 0x04, 0xdc, 0x41, 0x18, 0x86, 0xb1, 0x23, 0x22, 0x75, 0x24, 0xf2,
-	0x4d, 0x23, 0xdf, 0x06, 0x8c, 0x39, 0xc4, 0x34, 0x86, 0x86, 0x22, 0x52, 0x54, 0x09, 0x9a, 0xef,
-	0x3b, 0x96, 0x5d, 0xc1, 0xc5, 0x6d, 0x74, 0x05, 0x5a, 0x3f, 0x0b, 0xfa, 0xa1, 0xf0, 0x02, 0x25,
-	0x1b, 0x17, 0xa1, 0x27, 0x46, 0xa8, 0x4c, 0x8d, 0x17, 0x00, 0xcb, 0x9e, 0x62, 0xe0, 0x5f, 0xa3,
-	0xf5, 0x62, 0x54, 0xca, 0xc1, 0xb2, 0x3d, 0xe1, 0x41, 0x9a, 0x10, 0x32, 0x35, 0x96, 0x33, 0xd9,
-	0xaa, 0x15, 0xf0, 0x0b, 0x74, 0xbd, 0x28, 0x4c, 0x12, 0xae, 0x42, 0xc2, 0x7b, 0x69, 0x42, 0xee,
-	0x4c, 0xc9, 0x1a, 0x21, 0x67, 0x79, 0x98, 0xa2, 0xeb, 0xf0, 0xf1, 0x04, 0x5f, 0x6d, 0x94, 0x0a,
-	0x35, 0xe0, 0x11, 0xdc, 0x50, 0xd6, 0x9a, 0xf7, 0x76, 0x27, 0x5f, 0x58, 0xbb, 0x33, 0x20, 0x73,
-	0x6a, 0x1a, 0xcd, 0x96, 0x7d, 0x59, 0x43, 0x3f, 0x53, 0x4e, 0xff, 0xa5, 0x7e, 0xc6, 0xbf, 0x42,
-	0x57, 0x4d, 0xae, 0xf2, 0x42, 0xb8, 0x9f, 0xac, 0x35, 0xef, 0xce, 0x93, 0x57, 0x5e, 0xd8, 0xbe,
-	0x99, 0x26, 0xe4, 0x9a, 0x29, 0xae, 0xbc, 0xd0, 0xb2, 0xd7, 0x0a, 0xe9, 0x23, 0x2f, 0xc4, 0xaf,
-	0xd1, 0x35, 0x93, 0xf5, 0xa6, 0x45, 0x9b, 0x70, 0x2b, 0x59, 0x6b, 0x6e, 0xce, 0x53, 0xd6, 0x18,
-	0x73, 0x37, 0x9c, 0xb4, 0x1a, 0xda, 0xaf, 0x5a, 0xcd, 0x0a, 0xed, 0x16, 0x5c, 0x5a, 0x16, 0x6b,
-	0xb7, 0x2a, 0xb5, 0x5b, 0x25, 0xed, 0x16, 0xfe, 0x6b, 0x0d, 0x6d, 0x66, 0xc4, 0xf1, 0xc7, 0x30,
-	0xa5, 0x51, 0x8b, 0x7e, 0x4c, 0x5b, 0xb4, 0xc7, 0x15, 0x6b, 0xbc, 0xad, 0x81, 0xd3, 0xce, 0xac,
-	0x53, 0x35, 0xa1, 0x7d, 0x3f, 0x4d, 0xc8, 0xbd, 0xcc, 0xb5, 0x1a, 0x61, 0xd9, 0xeb, 0x5a, 0xe0,
-	0x75, 0x51, 0xb4, 0x5b, 0x1f, 0xb7, 0xda, 0x5c, 0x31, 0xfc, 0x15, 0xba, 0x99, 0x29, 0x67, 0x9f,
-	0xdd, 0x94, 0xbe, 0x79, 0x4a, 0x9f, 0xd0, 0x66, 0xe3, 0x1f, 0xe7, 0x20, 0xc2, 0xf6, 0x6c, 0x84,
-	0x32, 0xd0, 0x3c, 0xdb, 0xca, 0x15, 0xcb, 0xbe, 0xa2, 0x09, 0xfb, 0xd0, 0xf8, 0xea, 0xe9, 0x93,
-	0x26, 0xfe, 0x5d, 0x31, 0xd3, 0x9c, 0x6c, 0x68, 0xa0, 0xaf, 0x5f, 0xd7, 0xe7, 0x4d, 0x35, 0x03,
-	0x65, 0x4e, 0x35, 0xa3, 0x39, 0x9f, 0x6a, 0xfb, 0xba, 0x05, 0x7a, 0x33, 0x76, 0x38, 0x35, 0x1c,
-	0xfe, 0x3f, 0xd7, 0xe1, 0xb4, 0xda, 0xe1, 0x74, 0xc6, 0xe1, 0xf5, 0xd8, 0xe1, 0xef, 0xb5, 0xa5,
-	0x2e, 0x7c, 0x8d, 0xff, 0x5e, 0x04, 0xd3, 0x3d, 0xd3, 0x74, 0x09, 0x9e, 0x79, 0xaa, 0xf4, 0x8a,
-	0x1a, 0x15, 0x59, 0x51, 0x7f, 0x8b, 0x2f, 0x71, 0xd7, 0xfc, 0xa6, 0xb6, 0xc4, 0x51, 0xde, 0xf8,
-	0x5f, 0x16, 0xf0, 0xf1, 0xb2, 0x01, 0x81, 0x65, 0x6e, 0x80, 0x93, 0x78, 0xfa, 0xf8, 0x93, 0x96,
-	0x7d, 0xb6, 0x69, 0xfb, 0xe6, 0xdb, 0xff, 0x6c, 0xbd, 0xf7, 0xf6, 0xdd, 0x56, 0xed, 0x5f, 0xef,
-	0xb6, 0x6a, 0xff, 0x7e, 0xb7, 0x55, 0xfb, 0xe6, 0xdb, 0xad, 0xf7, 0x7a, 0xef, 0xc3, 0x3f, 0x36,
-	0xad, 0xef, 0x02, 0x00, 0x00, 0xff, 0xff, 0x25, 0x94, 0x69, 0xa1, 0xab, 0x12, 0x00, 0x00,
+	// 4725 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x7b, 0xcd, 0x73, 0xdc, 0x46,
+	0x7a, 0xfe, 0x8e, 0x46, 0x92, 0xa5, 0xa6, 0xf5, 0x05, 0x7d, 0x41, 0x94, 0x4c, 0x50, 0x90, 0x64,
+	0xcb, 0x6b, 0xeb, 0x8b, 0xb4, 0xbd, 0x6b, 0xff, 0x76, 0x7f, 0x1b, 0x73, 0x28, 0xd9, 0x5a, 0x91,
+	0x26, 0x17, 0x43, 0x5b, 0x59, 0x27, 0xb5, 0xd8, 0x1e, 0x4c, 0x73, 0x06, 0x26, 0x06, 0x8d, 0xed,
+	0xee, 0x91, 0x34, 0x4a, 0x55, 0x72, 0x49, 0x25, 0x95, 0x54, 0xa5, 0x6a, 0x73, 0xdb, 0x63, 0xce,
+	0xa9, 0x1c, 0xf2, 0x67, 0xf8, 0x98, 0xaa, 0x5c, 0x72, 0x9a, 0x4a, 0x9c, 0xaa, 0x54, 0x72, 0x9d,
+	0xca, 0x1f, 0x90, 0x7a, 0xdf, 0x6e, 0x00, 0x0d, 0x0c, 0x86, 0xd4, 0x49, 0x9c, 0xee, 0xe7, 0x79,
+	0xfa, 0xed, 0xaf, 0xb7, 0xdf, 0x7e, 0xd1, 0x22, 0xef, 0xf6, 0x7b, 0x8a, 0x49, 0xc5, 0x44, 0xd6,
+	0x7b, 0x10, 0xf1, 0x74, 0x3f, 0x1e, 0x84, 0x51, 0x12, 0xb3, 0x54, 0x85, 0x23, 0x1a, 0x0d, 0xe3,
+	0x94, 0xdd, 0xcf, 0x04, 0x57, 0xdc, 0x21, 0x25, 0x6e, 0xf9, 0xde, 0x20, 0x56, 0xc3, 0x71, 0xef,
+	0x7e, 0xc4, 0x47, 0x0f, 0x06, 0x7c, 0xc0, 0x1f, 0x20, 0xa4, 0x37, 0xde, 0xc7, 0x5f, 0xf8, 0x03,
+	0xff, 0xd2, 0xd4, 0xe5, 0x65, 0xab, 0x89, 0xfd, 0x84, 0x0e, 0x42, 0xa6, 0xa2, 0xbe, 0xa9, 0xf3,
+	0xea, 0x75, 0xaf, 0x39, 0x3f, 0x60, 0x2c, 0x63, 0xc2, 0x00, 0x6e, 0xd4, 0x01, 0x11, 0x4f, 0xe5,
+	0x38, 0x31, 0xb5, 0xd7, 0xe7, 0xe8, 0x96, 0xf6, 0x5c, 0x65, 0x54, 0x56, 0xfa, 0xff, 0x78, 0x9b,
+	0x2c, 0x77, 0xb0, 0xbf, 0x1d, 0xec, 0xee, 0xb6, 0xee, 0xed, 0xd3, 0x34, 0x56, 0x31, 0x4d, 0x9c,
+	0x4f, 0x08, 0xd9, 0xa5, 0x6a, 0xb8, 0x2b, 0xd8, 0x7e, 0xfc, 0xca, 0x6d, 0xad, 0xb6, 0xee, 0x9e,
+	0xde, 0xb8, 0x32, 0x9b, 0x7a, 0xce, 0x84, 0x8e, 0x92, 0xcf, 0xfc, 0x8c, 0xaa, 0x61, 0x98, 0x61,
+	0xa5, 0x1f, 0x58, 0x48, 0xe7, 0x1e, 0x79, 0x6b, 0x8b, 0x0f, 0xa0, 0xc0, 0x3d, 0x86, 0xa4, 0x8b,
+	0xb3, 0xa9, 0x77, 0x4e, 0x93, 0x12, 0x3e, 0x08, 0x81, 0xe8, 0x07, 0x39, 0xc6, 0x09, 0xc9, 0x55,
+	0xdd, 0x7c, 0x77, 0x22, 0x15, 0x1b, 0x6d, 0x33, 0x25, 0xe2, 0x48, 0x22, 0xbd, 0x8d, 0xf4, 0x3b,
+	0xb3, 0xa9, 0x77, 0x53, 0xd3, 0xcd, 0xb4, 0x48, 0x44, 0x86, 0x23, 0x0d, 0x35, 0x82, 0x8b, 0x54,
+	0x9c, 0xbf, 0x6c, 0x91, 0x5b, 0x0d, 0x75, 0x4f, 0x53, 0x18, 0x16, 0x9e, 0x50, 0xc5, 0xfa, 0xd8,
+	0xda, 0x71, 0x6c, 0x6d, 0x6d, 0x36, 0xf5, 0xee, 0x1f, 0xd6, 0x5a, 0x6c, 0xf1, 0x4c, 0xd3, 0x6f,
+	0x22, 0xef, 0xfc, 0x6d, 0x8b, 0xdc, 0xd1, 0xb8, 0x2d, 0xaa, 0x58, 0x1a, 0x4d, 0xf6, 0x86, 0x82,
+	0x8f, 0x07, 0xc3, 0x6c, 0xac, 0xf6, 0xe2, 0x11, 0x93, 0x4c, 0xc4, 0x4c, 0x77, 0xfb, 0x04, 0x1a,
+	0xf2, 0xd1, 0x6c, 0xea, 0x3d, 0xac, 0x18, 0x92, 0x68, 0x5e, 0xa8, 0x0a, 0x62, 0xa8, 0x0a, 0xa6,
+	0x31, 0xe5, 0xcd, 0x9a, 0x70, 0xfe, 0x8c, 0xac, 0x56, 0x80, 0x9b, 0xb1, 0x54, 0x22, 0xee, 0x8d,
+	0x55, 0xcc, 0xd3, 0xcf, 0x93, 0x04, 0xcd, 0x38, 0x89, 0x66, 0x3c, 0x98, 0x4d, 0xbd, 0x0f, 0x1a,
+	0xcd, 0xe8, 0x5b, 0x9c, 0x90, 0x26, 0x89, 0xb1, 0xe0, 0x48, 0x61, 0xe7, 0xf7, 0x2d, 0xf2, 0xde,
+	0x42, 0xd0, 0x2e, 0x13, 0x11, 0x4b, 0x55, 0x9c, 0x30, 0x34, 0xe2, 0x2d, 0x34, 0xe2, 0x93, 0xd9,
+	0xd4, 0x5b, 0x3b, 0xda, 0x88, 0xac, 0xe0, 0x1a, 0x5b, 0xde, 0xb4, 0x19, 0xe7, 0xaf, 0x5b, 0xe4,
+	0xf6, 0x42, 0x6c, 0x77, 0x3c, 0x1a, 0x51, 0x31, 0x41, 0x7b, 0x4e, 0xa1, 0x3d, 0xeb, 0xb3, 0xa9,
+	0xf7, 0xe0, 0x68, 0x7b, 0xa4, 0x26, 0x1a, 0x63, 0xde, 0xa8, 0x01, 0x27, 0x23, 0x37, 0x2a, 0xb8,
+	0x8d, 0xc9, 0x33, 0x36, 0xf9, 0x6a, 0x3c, 0xea, 0x31, 0x81, 0x06, 0x9c, 0x46, 0x03, 0x3e, 0x9c,
+	0x4d, 0xbd, 0xbb, 0x8d, 0x06, 0xf4, 0x26, 0xe1, 0x01, 0x9b, 0x84, 0x29, 0x32, 0x4c, 0xcb, 0x87,
+	0x2a, 0x3a, 0x13, 0xe2, 0x75, 0x99, 0x78, 0xc1, 0xc4, 0x66, 0x2c, 0x0f, 0xba, 0x19, 0x8d, 0xd8,
+	0xd7, 0x92, 0x0e, 0x98, 0xdd, 0x6b, 0x52, 0x5f, 0x0a, 0x12, 0x09, 0xd0, 0xdb, 0x83, 0x50, 0x02,
+	0x25, 0x1c, 0x03, 0xa7, 0xd6, 0xe3, 0xa3, 0x74, 0x9d, 0x03, 0x72, 0xdd, 0xb8, 0x1e, 0x06, 0xe6,
+	0xc8, 0x61, 0x9c, 0x75, 0x86, 0x34, 0x1d, 0x98, 0x8d, 0xb0, 0x84, 0xcd, 0xbe, 0x3f, 0x9b, 0x7a,
+	0x77, 0x2a, 0x7d, 0x1d, 0x15, 0xe8, 0x30, 0xd2, 0x70, 0xd3, 0xe0, 0x61, 0x6a, 0xce, 0x98, 0xac,
+	0xe8, 0xea, 0x0d, 0x1a, 0x1d, 0x8c, 0xb3, 0x80, 0x49, 0xc5, 0x45, 0xa5, 0x9b, 0x6f, 0x63, 0x7b,
+	0xf7, 0x66, 0x53, 0xef, 0xfd, 0x4a, 0x7b, 0x3d, 0x24, 0x84, 0x42, 0x33, 0x6a, 0x9d, 0x3c, 0x42,
+	0xd4, 0xe9, 0x11, 0x57, 0x23, 0xbe, 0xce, 0x12, 0x4e, 0xfb, 0xdb, 0x34, 0x8d, 0xf7, 0x99, 0x54,
+	0xd8, 0xe0, 0x19, 0x6c, 0xf0, 0xdd, 0xd9, 0xd4, 0xf3, 0x2b, 0x0d, 0x8e, 0x11, 0x1a, 0x8e, 0x0c,
+	0xd6, 0xb4, 0xb4, 0x50, 0xc7, 0xf9, 0x31, 0x39, 0xb9, 0xc7, 0xa4, 0x7a, 0xba, 0xe9, 0x9e, 0x45,
+	0x45, 0x67, 0x36, 0xf5, 0xce, 0x6a, 0x45, 0x70, 0xff, 0x61, 0xdc, 0xf7, 0x03, 0x83, 0x40, 0xb7,
+	0xce, 0x85, 0xda, 0xd9, 0xdf, 0x97, 0x4c, 0xb9, 0xe7, 0x56, 0x5b, 0x77, 0xdb, 0x15, 0xb7, 0xce,
+	0x85, 0x0a, 0x39, 0x56, 0xfa, 0x81, 0x85, 0x74, 0xfe, 0xae, 0x45, 0xde, 0x5d, 0xb8, 0x82, 0x3b,
+	0x5c, 0x08, 0x16, 0xe5, 0x9e, 0xf4, 0x3c, 0x1a, 0xf1, 0xf1, 0x6c, 0xea, 0x3d, 0x3a, 0x7a, 0x93,
+	0x44, 0x39, 0xd5, 0xf4, 0xf2, 0x0d, 0x1b, 0x29, 0xc7, 0xd5, 0x20, 0xbf, 0x64, 0x54, 0x8d, 0x68,
+	0x86, 0x06, 0x5c, 0x58, 0x30, 0xae, 0xb9, 0x01, 0x43, 0x8d, 0xad, 0x8e, 0xeb, 0xbc, 0x8e, 0xf3,
+	0x94, 0x9c, 0xd7, 0x75, 0x01, 0x83, 0x71, 0x41, 0x6d, 0x07, 0xb5, 0xdf, 0x99, 0x4d, 0xbd, 0x6b,
+	0x15, 0x6d, 0x81, 0x10, 0x23, 0x39, 0x47, 0x73, 0x1e, 0x92, 0x53, 0x30, 0x01, 0x5f, 0xd1, 0x11,
+	0x73, 0x2f, 0xa2, 0xc4, 0xa5, 0xd9, 0xd4, 0x3b, 0x6f, 0x4d, 0x52, 0x4a, 0x47, 0xcc, 0x0f, 0x0a,
+	0x94, 0xf3, 0x33, 0xf2, 0x76, 0x30, 0x4e, 0xd1, 0x71, 0x2b, 0x3a, 0xca, 0xdc, 0x4b, 0xc8, 0x72,
+	0x67, 0x53, 0xef, 0x92, 0x66, 0x89, 0x71, 0x1a, 0xaa, 0xbc, 0xda, 0x0f, 0x2a, 0x68, 0x27, 0xca,
+	0x87, 0x27, 0x60, 0xb4, 0xff, 0x6b, 0x3e, 0x16, 0xcf, 0x45, 0xac, 0xcc, 0xbe, 0xba, 0x8c, 0x4a,
+	0xef, 0xcd, 0xa6, 0xde, 0xad, 0x5a, 0x17, 0x68, 0x3f, 0x9c, 0xf0, 0xb1, 0x08, 0x5f, 0x22, 0xb8,
+	0x3a, 0x3e, 0xf3, 0x42, 0xe5, 0xd9, 0x1d, 0xb0, 0x8c, 0x51, 0x65, 0xef, 0xa5, 0x2b, 0x0b, 0xce,
+	0x6e, 0x81, 0xc8, 0xda, 0x1e, 0x5a, 0xa4, 0xe2, 0xfc, 0x9a, 0x5c, 0xd6, 0x55, 0x3b, 0x19, 0x4b,
+	0xed, 0xd0, 0xe0, 0x2a, 0xca, 0xdf, 0x9a, 0x4d, 0x3d, 0xaf, 0x22, 0xcf, 0x33, 0x96, 0xd6, 0x02,
+	0x83, 0x66, 0x05, 0x87, 0x91, 0x6b, 0x65, 0xbf, 0x3a, 0x3c, 0x95, 0xb1, 0xc4, 0xf9, 0x47, 0x79,
+	0xf7, 0xb0, 0x11, 0x8a, 0x4a, 0xb0, 0x69, 0x62, 0xb1, 0x92, 0x33, 0x24, 0xcb, 0x66, 0x79, 0x31,
+	0xda, 0x67, 0xa2, 0x76, 0xd4, 0x5f, 0xc3, 0x76, 0xee, 0xce, 0xa6, 0xde, 0xed, 0xea, 0x42, 0x45,
+	0xf0, 0xfc, 0xf1, 0x7e, 0x88, 0x56, 0x39, 0x56, 0x9f, 0x8f, 0xd5, 0x70, 0x97, 0xa5, 0x34, 0x51,
+	0xba, 0x33, 0xcb, 0x0b, 0xc6, 0x8a, 0x8e, 0x21, 0x82, 0xd3, 0xc0, 0xea, 0x58, 0xd5, 0x14, 0x9c,
+	0x3f, 0x25, 0x57, 0x74, 0xc5, 0x73, 0xaa, 0xa2, 0xa1, 0x3d, 0xcd, 0xd7, 0x51, 0xfb, 0xf6, 0x6c,
+	0xea, 0xad, 0x56, 0xb4, 0x5f, 0x02, 0xb0, 0x36, 0xcb, 0x0b, 0x34, 0xca, 0x5d, 0xb6, 0x3b, 0xa4,
+	0xd2, 0x0c, 0xcc, 0x8d, 0x05, 0xbb, 0x2c, 0x43, 0x48, 0x75, 0x97, 0x95, 0x34, 0x67, 0x87, 0x38,
+	0xb9, 0x93, 0x1c, 0x08, 0xda, 0x37, 0x62, 0xef, 0xa0, 0x98, 0x37, 0x9b, 0x7a, 0xd7, 0x6b, 0x6e,
+	0x56, 0x83, 0x8c, 0x5c, 0x03, 0xd5, 0xf9, 0x73, 0x72, 0x53, 0x97, 0x76, 0x53, 0x9a, 0xc9, 0x21,
+	0x57, 0x7b, 0x82, 0xa6, 0x72, 0x9f, 0x09, 0x7b, 0x10, 0x56, 0x50, 0xff, 0xe1, 0x6c, 0xea, 0x7d,
+	0x58, 0xd1, 0x97, 0x86, 0x13, 0x2a, 0x43, 0xaa, 0x0d, 0xc8, 0xd1, 0xd2, 0x4e, 0x97, 0x5c, 0xfc,
+	0x7c, 0x60, 0x66, 0xa4, 0xcb, 0x22, 0xc1, 0xb4, 0x13, 0xf2, 0xb0, 0xc5, 0x9b, 0xb3, 0xa9, 0xf7,
+	0x8e, 0x6e, 0x91, 0x0e, 0x8a, 0x19, 0x95, 0x08, 0x33, 0x4d, 0x34, 0xb1, 0xcb, 0xe9, 0xec, 0x24,
+	0x3c, 0x3a, 0xd0, 0xfe, 0x5d, 0x8f, 0xd4, 0xea, 0x82, 0xe9, 0x8c, 0x00, 0x68, 0x8e, 0x05, 0x59,
+	0x9d, 0xce, 0xba, 0x86, 0xf3, 0xdb, 0xdc, 0x29, 0xec, 0x64, 0x7b, 0x93, 0xac, 0x72, 0xc0, 0xde,
+	0x5c, 0xe0, 0x97, 0x79, 0x16, 0xaa, 0x49, 0xc6, 0x9a, 0xbd, 0xc2, 0x9c, 0x4c, 0x19, 0xbd, 0xe2,
+	0x52, 0xea, 0xf0, 0x51, 0x46, 0xa3, 0x7a, 0xa0, 0xe6, 0x2f, 0x88, 0x5e, 0xf5, 0xc2, 0x8c, 0x0a,
+	0x4e, 0xad, 0xcd, 0x23, 0x85, 0x1d, 0x9e, 0x07, 0x68, 0x9b, 0x2c, 0x61, 0x8a, 0x05, 0x10, 0x60,
+	0xd8, 0x0d, 0xdf, 0xc2, 0x86, 0x3f, 0x98, 0x4d, 0xbd, 0xf7, 0x2a, 0x0d, 0xf7, 0x11, 0x1e, 0x0a,
+	0xc0, 0xd7, 0x1a, 0x3d, 0x54, 0xb0, 0x3c, 0xe8, 0xf6, 0x58, 0x4a, 0xd3, 0x68, 0x62, 0x37, 0x76,
+	0x7b, 0xc1, 0x80, 0x2a, 0x0d, 0xad, 0xb5, 0xb3, 0x50, 0xc7, 0xf9, 0x86, 0x5c, 0xb2, 0x4f, 0xac,
+	0x4d, 0xaa, 0x28, 0xea, 0xdf, 0x41, 0x7d, 0x7f, 0x36, 0xf5, 0x56, 0x9a, 0x0e, 0xbb, 0x3e, 0x55,
+	0xd4, 0x68, 0x37, 0xf2, 0x9d, 0x80, 0x5c, 0xb4, 0xcb, 0x9f, 0x70, 0x31, 0xa2, 0x4a, 0xba, 0xef,
+	0xae, 0xb6, 0xef, 0x9e, 0xde, 0x58, 0x9d, 0x4d, 0xbd, 0x1b, 0x4d, 0xb2, 0xfb, 0x1a, 0xe6, 0x07,
+	0x4d, 0xe4, 0xd2, 0xa3, 0xe2, 0xfc, 0x88, 0x58, 0xf2, 0xd4, 0x3a, 0x9e, 0xdf, 0x5b, 0xe0, 0x51,
+	0xa3, 0x02, 0x5c, 0x3d, 0xa9, 0x0f, 0xd1, 0x82, 0x7d, 0xf2, 0x05, 0xe7, 0x83, 0x84, 0x75, 0x12,
+	0x3e, 0xee, 0xef, 0x0a, 0xfe, 0x1d, 0x8b, 0xf4, 0x09, 0xde, 0xaf, 0xef, 0x93, 0x01, 0xe2, 0x60,
+	0x9f, 0x8c, 0xfb, 0x61, 0xa6, 0x91, 0xe6, 0x44, 0x5f, 0xa0, 0xe1, 0xec, 0x93, 0x6b, 0x56, 0x4d,
+	0x57, 0x71, 0x41, 0x07, 0xec, 0x19, 0xd3, 0x13, 0xcb, 0xea, 0xdd, 0xa8, 0x34, 0x20, 0x35, 0x18,
+	0x23, 0x7d, 0x73, 0x02, 0x2d, 0x94, 0x72, 0x3e, 0x22, 0x97, 0x1b, 0x2b, 0xdd, 0x7d, 0x68, 0x23,
+	0x68, 0xae, 0x84, 0x65, 0x3e, 0x5f, 0xb1, 0x31, 0x8e, 0x0e, 0x98, 0x1e, 0x81, 0x41, 0x7d, 0x99,
+	0x37, 0x1a, 0xd8, 0x43, 0x82, 0x19, 0x88, 0x43, 0x05, 0x21, 0x3c, 0x9f, 0xaf, 0xef, 0x8e, 0x7b,
+	0x9b, 0x31, 0x04, 0x7d, 0x5c, 0x4c, 0xdc, 0x61, 0x3d, 0x3c, 0x6f, 0x6c, 0x52, 0x8e, 0x7b, 0x61,
+	0x3f, 0xe7, 0xf8, 0xc1, 0x11, 0xa2, 0x70, 0x2d, 0xbf, 0x16, 0xb0, 0x11, 0x57, 0xcc, 0xd4, 0x6e,
+	0x32, 0xa9, 0xe2, 0x94, 0xc2, 0xb6, 0x97, 0x6e, 0xbc, 0xda, 0xbe, 0xbb, 0xb4, 0x76, 0xfb, 0x7e,
+	0x99, 0x46, 0xb9, 0xbf, 0x08, 0x6c, 0xef, 0x42, 0x81, 0x98, 0xc2, 0xa4, 0xbe, 0x25, 0xe9, 0x07,
+	0x8b, 0x9b, 0x73, 0x7e, 0x43, 0x4e, 0x6e, 0xd1, 0x1e, 0x4b, 0xa4, 0xfb, 0x7d, 0x0b, 0x5b, 0x5e,
+	0xb3, 0x5b, 0x5e, 0x9c, 0xab, 0xb9, 0xaf, 0x59, 0x8f, 0x53, 0x25, 0x26, 0x1b, 0x17, 0x66, 0x53,
+	0xef, 0x8c, 0xb6, 0x23, 0xc1, 0x62, 0x3f, 0x30, 0xaa, 0xcb, 0x9f, 0x92, 0x25, 0x0b, 0xe9, 0x9c,
+	0x27, 0xed, 0x03, 0x36, 0xd1, 0xa9, 0x9d, 0x00, 0xfe, 0x74, 0x2e, 0x91, 0x13, 0x2f, 0x68, 0x32,
+	0x66, 0x3a, 0x73, 0x13, 0xe8, 0x1f, 0x9f, 0x1d, 0xfb, 0x69, 0xcb, 0xff, 0xfb, 0x63, 0xc4, 0x5d,
+	0x64, 0xb8, 0x73, 0x8b, 0x1c, 0xc7, 0x45, 0x81, 0x4a, 0x1b, 0xe7, 0x66, 0x53, 0x6f, 0x49, 0x1b,
+	0xa0, 0x27, 0x1e, 0x2b, 0x01, 0x04, 0x8e, 0xdc, 0x3d, 0x56, 0x07, 0x81, 0xeb, 0xf7, 0x03, 0xac,
+	0x74, 0xde, 0x27, 0x27, 0xf5, 0x9a, 0x30, 0xc9, 0x1f, 0xab, 0x33, 0x7a, 0x2d, 0xf9, 0x81, 0x01,
+	0x40, 0x7c, 0x5c, 0x59, 0x1e, 0xc7, 0xeb, 0xf1, 0x71, 0x6d, 0x25, 0x54, 0xd0, 0xce, 0x06, 0x39,
+	0xbb, 0xc5, 0x23, 0x9a, 0x94, 0x7c, 0x9d, 0x76, 0x59, 0x9e, 0x4d, 0xbd, 0x2b, 0x79, 0xb2, 0x2a,
+	0xa2, 0x89, 0xad, 0x50, 0x63, 0xf8, 0xff, 0x75, 0x87, 0xdc, 0x6a, 0x98, 0x94, 0x0d, 0x96, 0x46,
+	0xc3, 0x11, 0x15, 0x07, 0x3b, 0x99, 0x9e, 0xd6, 0xbc, 0xe7, 0xad, 0xc3, 0x7a, 0xfe, 0x0b, 0x72,
+	0x26, 0x60, 0xbf, 0x1b, 0x43, 0xf4, 0x8f, 0x77, 0x73, 0x1c, 0xa7, 0xf6, 0xc6, 0xb5, 0xd9, 0xd4,
+	0xbb, 0x9c, 0xaf, 0x2a, 0xac, 0x36, 0x77, 0x7b, 0x3f, 0xa8, 0xe2, 0x9d, 0x2f, 0xc9, 0xf9, 0x0e,
+	0x4f, 0x53, 0x86, 0x27, 0x96, 0xd1, 0x68, 0xa3, 0xc6, 0x8d, 0xd9, 0xd4, 0x73, 0x8d, 0x37, 0x2c,
+	0x10, 0x85, 0xcc, 0x1c, 0x0b, 0x46, 0x56, 0x77, 0xc8, 0xa8, 0x1c, 0x47, 0x15, 0x6b, 0x64, 0x8d,
+	0x4f, 0xcd, 0x15, 0x2a, 0x68, 0xe7, 0x37, 0xe4, 0x6a, 0xa9, 0x68, 0xd7, 0x48, 0xf7, 0xc4, 0x6a,
+	0xfb, 0x6e, 0xbb, 0x12, 0x5e, 0x94, 0xe6, 0x54, 0x34, 0x25, 0x9c, 0xfe, 0xcd, 0x22, 0x4e, 0x4c,
+	0x96, 0x03, 0xaa, 0xd8, 0x56, 0x3c, 0x8a, 0x95, 0x19, 0x01, 0xb9, 0xcb, 0x44, 0x97, 0x45, 0x3c,
+	0xed, 0x63, 0xd6, 0xaa, 0x6d, 0xe7, 0x0c, 0x04, 0x55, 0x2c, 0x4c, 0x00, 0x1c, 0x9a, 0x01, 0x94,
+	0x90, 0x28, 0x82, 0x30, 0x89, 0xa7, 0x7d, 0x3f, 0x38, 0x44, 0x0c, 0x52, 0x99, 0x5d, 0x3a, 0x42,
+	0x67, 0x09, 0x89, 0xa8, 0x53, 0x76, 0x2a, 0x53, 0xd2, 0x11, 0x3a, 0x60, 0x3f, 0xc8, 0x31, 0xce,
+	0xcf, 0xc9, 0xdb, 0xcf, 0xd8, 0xa4, 0x1b, 0xbf, 0x66, 0x1b, 0x13, 0xc5, 0xa4, 0x7b, 0xaa, 0x3e,
+	0x83, 0xe0, 0xaf, 0x65, 0xfc, 0x9a, 0x85, 0x3d, 0xa8, 0xf7, 0x83, 0x0a, 0xdc, 0xe9, 0x90, 0xb3,
+	0xdf, 0xc0, 0x7e, 0x2b, 0x05, 0x4e, 0xa3, 0xc0, 0xf5, 0xd9, 0xd4, 0xbb, 0xaa, 0x05, 0x70, 0x3f,
+	0x56, 0x24, 0x6a, 0x14, 0x67, 0x9d, 0x9c, 0xee, 0x2a, 0x9a, 0x30, 0xb8, 0x8b, 0x60, 0xde, 0xe6,
+	0xd4, 0xc6, 0xe5, 0xd9, 0xd4, 0xbb, 0x60, 0x8c, 0x86, 0x2a, 0xbc, 0xc5, 0xf8, 0x41, 0x89, 0x83,
+	0x09, 0x7f, 0xce, 0xc5, 0x01, 0xe4, 0x15, 0x70, 0x1f, 0x2f, 0xd5, 0xb7, 0xd2, 0x4b, 0x53, 0x6b,
+	0x3c, 0x79, 0x05, 0x0d, 0x41, 0x77, 0xfe, 0x7b, 0x37, 0x19, 0x0f, 0xe2, 0xd4, 0x4a, 0xa6, 0x58,
+	0x41, 0x77, 0xa1, 0x91, 0x21, 0x28, 0x0f, 0xba, 0xe7, 0xa9, 0xce, 0xd7, 0xe4, 0x52, 0x37, 0xa2,
+	0x49, 0x9c, 0x0e, 0x74, 0x26, 0x27, 0x5f, 0x3e, 0x67, 0x70, 0xf9, 0x58, 0x51, 0xaf, 0xd4, 0x28,
+	0x93, 0x10, 0x2a, 0xd7, 0x4e, 0x23, 0xdd, 0xf9, 0x13, 0x72, 0xc5, 0x94, 0x63, 0x72, 0xf6, 0x05,
+	0x4d, 0xf4, 0x34, 0x4b, 0xcc, 0x9a, 0xb4, 0xed, 0x1b, 0x52, 0x2e, 0x1c, 0x1b, 0xa0, 0x59, 0x2d,
+	0xd2, 0x0f, 0x16, 0x48, 0xc0, 0x55, 0xb8, 0x92, 0x02, 0x2a, 0x72, 0x6c, 0xd2, 0x3d, 0x87, 0x66,
+	0x5b, 0x57, 0xe1, 0x5a, 0x3e, 0xa9, 0xcc, 0xd7, 0xc1, 0xb2, 0x5f, 0xa0, 0x02, 0x8b, 0x6b, 0x9b,
+	0xbe, 0x7a, 0x2c, 0x04, 0x17, 0xb0, 0x62, 0x31, 0xc9, 0xd2, 0xb2, 0x17, 0xd7, 0x88, 0xbe, 0x0a,
+	0x19, 0x54, 0x87, 0xb0, 0xe4, 0xfd, 0xa0, 0x02, 0x87, 0x31, 0xdd, 0xa6, 0xaf, 0xe0, 0x76, 0xca,
+	0xa2, 0xb1, 0x8a, 0x5f, 0x30, 0xac, 0x92, 0x98, 0x2a, 0xa9, 0x8c, 0x29, 0xc8, 0x44, 0x25, 0x4c,
+	0x4b, 0xc2, 0x98, 0x36, 0xd1, 0xc1, 0xaa, 0xad, 0x18, 0xb2, 0x50, 0x03, 0x5c, 0x83, 0xae, 0x53,
+	0x5f, 0xf2, 0x49, 0x8c, 0xf9, 0xab, 0x81, 0x5e, 0xb5, 0x7e, 0x50, 0x81, 0xa3, 0x17, 0x8e, 0xa5,
+	0x7a, 0xaa, 0x98, 0x30, 0x27, 0xee, 0x45, 0x14, 0xb0, 0xbd, 0x30, 0x08, 0xc4, 0x05, 0xc0, 0x0f,
+	0x6a, 0x0c, 0xe7, 0x19, 0xb9, 0xf0, 0x6c, 0xdc, 0x63, 0x22, 0x65, 0x8a, 0xc9, 0x9d, 0x1e, 0xc4,
+	0x57, 0x12, 0x93, 0x25, 0x6d, 0xfb, 0xfe, 0x78, 0x50, 0x40, 0x42, 0xae, 0x31, 0x7e, 0x30, 0xcf,
+	0x83, 0x61, 0x2a, 0x0b, 0xbf, 0xe4, 0x2a, 0xd7, 0xbb, 0x5c, 0x1f, 0x26, 0x4b, 0x0f, 0x6e, 0x78,
+	0x85, 0x66, 0x23, 0x1d, 0xe2, 0xe0, 0xb2, 0x1c, 0xec, 0x0f, 0xc0, 0x78, 0x4c, 0x92, 0xb4, 0xec,
+	0x38, 0xd8, 0x52, 0xc5, 0x7e, 0x63, 0x1f, 0xfd, 0xa0, 0x89, 0xec, 0x7c, 0x45, 0x9c, 0xb2, 0x18,
+	0x2f, 0x2c, 0xb0, 0xd8, 0xae, 0xa2, 0xa1, 0x2b, 0xb3, 0xa9, 0xb7, 0x3c, 0x27, 0xf9, 0xd2, 0x80,
+	0xfc, 0xa0, 0x81, 0x09, 0x47, 0xaf, 0x4e, 0xc0, 0x60, 0xf6, 0xa3, 0x6d, 0x1f, 0xbd, 0x3a, 0x69,
+	0xe3, 0x07, 0x06, 0xe0, 0x24, 0xe4, 0x7c, 0x79, 0x39, 0xda, 0xe5, 0x49, 0x1c, 0x4d, 0x30, 0x95,
+	0xb1, 0xb4, 0xe6, 0x37, 0x04, 0x2c, 0x35, 0x64, 0xf5, 0x38, 0xca, 0xeb, 0xc2, 0x0c, 0x2b, 0xf1,
+	0x38, 0xaa, 0xe2, 0x21, 0x3f, 0xb0, 0x27, 0x68, 0xc4, 0xba, 0x74, 0x94, 0x25, 0x4c, 0x8f, 0xdc,
+	0x32, 0x8e, 0x9c, 0x35, 0xbf, 0x0a, 0x10, 0xa1, 0x44, 0x48, 0x3e, 0x6c, 0x73, 0x34, 0x67, 0x8b,
+	0x5c, 0xc0, 0xb2, 0x9d, 0xbd, 0xad, 0xdd, 0xc7, 0x69, 0x3f, 0xe3, 0x71, 0xaa, 0x4c, 0x0e, 0xc3,
+	0x1a, 0x32, 0xad, 0xc5, 0x55, 0x92, 0x85, 0xcc, 0x80, 0xfc, 0x60, 0x9e, 0xe8, 0x7c, 0x46, 0x8e,
+	0x77, 0xb7, 0x76, 0xa4, 0x7b, 0x03, 0x63, 0xb5, 0xcb, 0xf3, 0x5d, 0xef, 0x6e, 0xed, 0xd8, 0xc7,
+	0xbd, 0x4c, 0xb8, 0xf4, 0x03, 0xe4, 0xc0, 0x71, 0x8f, 0x9e, 0xfb, 0x71, 0x1a, 0xf1, 0x7e, 0x9c,
+	0x0e, 0x4c, 0x92, 0xc2, 0xda, 0x39, 0xda, 0xd7, 0x33, 0x53, 0xef, 0x07, 0x55, 0x3c, 0x74, 0x45,
+	0xbb, 0xfe, 0x68, 0xc8, 0x46, 0xf4, 0x49, 0xcc, 0x92, 0xbe, 0x74, 0x57, 0xea, 0xb3, 0x6f, 0x0e,
+	0x0c, 0xc4, 0x84, 0xfb, 0x08, 0xf2, 0x83, 0x79, 0x22, 0x8c, 0xb1, 0x55, 0xb8, 0xc9, 0x32, 0x93,
+	0x64, 0xa8, 0xec, 0xa1, 0x8a, 0x58, 0x1f, 0x30, 0x7e, 0x30, 0x47, 0x73, 0x1e, 0x93, 0x73, 0x8f,
+	0x55, 0xd4, 0x87, 0x69, 0x14, 0x4c, 0xca, 0x98, 0xa7, 0x26, 0xad, 0x60, 0x9d, 0x63, 0xf0, 0x15,
+	0x32, 0x8c, 0x4a, 0x84, 0x1f, 0xd4, 0x39, 0x10, 0xce, 0xa0, 0xb4, 0xad, 0xa3, 0xf3, 0x07, 0xd6,
+	0xfa, 0xd1, 0x16, 0x55, 0x84, 0xe6, 0x58, 0x70, 0x24, 0xe2, 0xdc, 0x3d, 0x89, 0x13, 0x66, 0xf2,
+	0x02, 0xd6, 0x91, 0xa8, 0x27, 0x7b, 0x3f, 0x4e, 0x98, 0x1f, 0x94, 0x38, 0x98, 0x1f, 0xb3, 0x33,
+	0x4c, 0x10, 0x74, 0xab, 0xee, 0xd9, 0xcc, 0x6e, 0x2a, 0xc3, 0xb1, 0x0a, 0x1e, 0xf2, 0x8b, 0x58,
+	0xd0, 0x55, 0x82, 0xd1, 0x11, 0x04, 0x15, 0x65, 0x40, 0x83, 0xf7, 0xf6, 0xb6, 0x9d, 0x5f, 0x34,
+	0xf9, 0x32, 0x8d, 0xc5, 0xf8, 0xa4, 0x0c, 0x8d, 0xfc, 0x60, 0xb1, 0x12, 0x8c, 0xf6, 0x66, 0x4c,
+	0x93, 0x0e, 0x4f, 0xa3, 0xb1, 0x10, 0x90, 0x76, 0x74, 0xef, 0xd4, 0xa3, 0x86, 0x7e, 0x4c, 0x93,
+	0x30, 0x2a, 0x11, 0x7e, 0x50, 0xe7, 0x80, 0x1f, 0x87, 0xa2, 0x5f, 0xc6, 0x4a, 0x31, 0xb1, 0x0d,
+	0x37, 0xf4, 0x5a, 0x6f, 0x51, 0xe3, 0x3b, 0xac, 0x0e, 0x47, 0x10, 0xba, 0xd8, 0x70, 0xf0, 0xc1,
+	0xdf, 0x30, 0x11, 0xef, 0x4f, 0x4a, 0xcb, 0x24, 0x5e, 0xc5, 0x4f, 0x55, 0xd6, 0x0f, 0x42, 0xac,
+	0x9e, 0xe1, 0x5a, 0xac, 0xf3, 0x9c, 0x6d, 0x72, 0xc1, 0xe4, 0xe0, 0x60, 0x4d, 0x7c, 0x01, 0x81,
+	0xd9, 0xbe, 0x7b, 0xb7, 0x1e, 0x4e, 0x98, 0xe4, 0x1d, 0x7e, 0x49, 0x0f, 0x07, 0x18, 0xdd, 0xed,
+	0xfb, 0xc1, 0x3c, 0x13, 0x8e, 0x7d, 0x53, 0x58, 0x3f, 0xf6, 0xdf, 0xaf, 0x1f, 0xfb, 0xb9, 0x66,
+	0xc3, 0xb1, 0xdf, 0x2c, 0xe1, 0x7c, 0x4a, 0x96, 0x9e, 0xb1, 0x49, 0xb1, 0x89, 0x7f, 0x8c, 0x56,
+	0x5e, 0x9d, 0x4d, 0xbd, 0x8b, 0x65, 0xc4, 0x57, 0x6e, 0x61, 0x1b, 0x6b, 0xa2, 0x45, 0x08, 0x78,
+	0xf4, 0x76, 0xfb, 0xa0, 0x29, 0x5a, 0xc4, 0xaf, 0xec, 0x66, 0xab, 0x55, 0xe0, 0xce, 0x1f, 0x91,
+	0x33, 0xe6, 0xf7, 0x13, 0x9a, 0xf2, 0xb1, 0x72, 0x3f, 0xac, 0x9f, 0x9c, 0x05, 0x7f, 0x1f, 0x01,
+	0x7e, 0x50, 0x25, 0x80, 0xc2, 0xa6, 0xe0, 0x19, 0x1c, 0xc6, 0x1d, 0x1a, 0x0d, 0x99, 0x7b, 0x0f,
+	0x27, 0xcc, 0x52, 0xe8, 0x0b, 0x9e, 0xe9, 0xc3, 0x3b, 0x02, 0x80, 0x1f, 0x54, 0x09, 0xd0, 0xfb,
+	0x0e, 0x4f, 0xfa, 0x10, 0xac, 0x50, 0xa1, 0xdc, 0xfb, 0xc8, 0xb7, 0x7a, 0x1f, 0xf1, 0xa4, 0x8f,
+	0x61, 0x0e, 0x15, 0xca, 0x0f, 0x6c, 0xac, 0xf3, 0xc7, 0xe4, 0x72, 0x07, 0x9f, 0x31, 0x74, 0xa8,
+	0xa2, 0x09, 0x1f, 0xc0, 0xa7, 0xc2, 0x38, 0x62, 0xd2, 0x7d, 0x80, 0xdd, 0xb0, 0x53, 0x4e, 0x08,
+	0x0b, 0x23, 0x8d, 0x0b, 0xa5, 0x01, 0x42, 0xb2, 0xba, 0x49, 0x00, 0xe6, 0xbb, 0x52, 0xf1, 0x34,
+	0x95, 0x8a, 0xa6, 0x20, 0xfd, 0xb0, 0x3e, 0xdf, 0x35, 0xe9, 0x38, 0x47, 0x42, 0x72, 0xb3, 0x51,
+	0x02, 0xbf, 0x78, 0xd8, 0x35, 0x9d, 0x21, 0x8b, 0x0e, 0xf4, 0x91, 0xf4, 0x08, 0x8f, 0x24, 0xfb,
+	0x8b, 0x47, 0x55, 0x3d, 0x02, 0x68, 0x7e, 0x34, 0x2d, 0x52, 0x99, 0x6b, 0xe0, 0x57, 0x63, 0x26,
+	0x26, 0xba, 0x81, 0xb5, 0x23, 0x1a, 0xf8, 0x1d, 0x40, 0x9b, 0x1b, 0x28, 0x55, 0x60, 0x78, 0xd0,
+	0x9b, 0x6c, 0xc6, 0xd2, 0x6c, 0xc4, 0x7c, 0x3b, 0xac, 0xd7, 0x87, 0x47, 0x3b, 0xa5, 0x7e, 0x01,
+	0xb4, 0xb6, 0x43, 0xb3, 0x04, 0x5c, 0x61, 0x8a, 0x5c, 0x26, 0x9d, 0xc8, 0x6d, 0xe9, 0x7e, 0xb4,
+	0xda, 0xae, 0x3a, 0xa3, 0x32, 0x1d, 0x4a, 0x27, 0x12, 0x5d, 0x49, 0x8d, 0x02, 0x39, 0x6f, 0xab,
+	0xa4, 0x70, 0x49, 0x1f, 0xd7, 0x43, 0x30, 0x5b, 0xc9, 0x76, 0x4d, 0x4d, 0x6c, 0xe7, 0x09, 0x39,
+	0x67, 0xe5, 0x57, 0x9f, 0xb1, 0x89, 0x74, 0x3f, 0xa9, 0x5f, 0x8e, 0x2b, 0x29, 0xda, 0x03, 0x36,
+	0x91, 0xe0, 0x28, 0xab, 0x24, 0x98, 0x1f, 0xab, 0x68, 0x57, 0xf0, 0x1e, 0xcb, 0xc7, 0xef, 0x27,
+	0xa8, 0x67, 0xcd, 0x4f, 0x45, 0x2f, 0x03, 0x68, 0x39, 0x82, 0x8b, 0x54, 0x30, 0x44, 0xd1, 0x09,
+	0xda, 0x00, 0xbf, 0xf2, 0xa0, 0xa9, 0x3f, 0xad, 0x9f, 0xeb, 0x79, 0x82, 0x57, 0x20, 0xc6, 0x18,
+	0x3b, 0x4f, 0x84, 0x78, 0xd6, 0x14, 0xe2, 0x67, 0x3b, 0xa1, 0x87, 0x46, 0xba, 0x9f, 0xd6, 0x07,
+	0x33, 0x17, 0xc4, 0x2f, 0x7f, 0xc2, 0xdc, 0xc6, 0x21, 0x9e, 0x6d, 0xa2, 0xc3, 0xa9, 0xb3, 0x2b,
+	0x62, 0x2e, 0x62, 0x35, 0xe9, 0x24, 0x54, 0x4a, 0x26, 0xdd, 0xcf, 0x30, 0xa7, 0x6b, 0x4d, 0x74,
+	0x66, 0x00, 0x61, 0xa4, 0x11, 0x7e, 0x50, 0xe7, 0x40, 0x58, 0x9c, 0x17, 0x6d, 0x33, 0x45, 0xfb,
+	0x54, 0x51, 0xb8, 0x6b, 0xff, 0x3f, 0xf4, 0xa2, 0x56, 0x58, 0x5c, 0x48, 0x8d, 0x0c, 0x4a, 0x5f,
+	0xbc, 0x9b, 0xc8, 0x10, 0x16, 0x6b, 0x2b, 0xd1, 0x55, 0x47, 0x2c, 0x53, 0x70, 0xcd, 0xf9, 0x19,
+	0x5a, 0x67, 0x0d, 0xa0, 0x59, 0x3c, 0xb1, 0x05, 0xf2, 0x83, 0x06, 0x66, 0xf9, 0x46, 0xc1, 0x2a,
+	0xb5, 0xae, 0xb9, 0x3f, 0x5f, 0xf0, 0x46, 0xc1, 0x12, 0xae, 0x5e, 0x78, 0x0f, 0x53, 0x83, 0x50,
+	0x05, 0x7c, 0xb4, 0x7e, 0x72, 0xf5, 0xff, 0xeb, 0xa1, 0x0a, 0x3a, 0x74, 0xf3, 0xe2, 0xaa, 0xc4,
+	0xc1, 0x07, 0x97, 0x27, 0x34, 0x4e, 0x76, 0xd2, 0x3d, 0xae, 0x68, 0x02, 0xd3, 0xbe, 0x1d, 0xcb,
+	0x11, 0x6c, 0x50, 0xf7, 0x17, 0xe8, 0x91, 0xad, 0xcc, 0xe4, 0x3e, 0x8d, 0x93, 0x90, 0xa7, 0xa1,
+	0x02, 0x28, 0x2e, 0x9b, 0x70, 0x64, 0xc0, 0x7e, 0xb0, 0x48, 0xc6, 0x7f, 0x4d, 0x4e, 0x17, 0x01,
+	0x2d, 0xdc, 0x13, 0xf4, 0x77, 0x54, 0x93, 0xcf, 0xb2, 0xee, 0x09, 0xfa, 0xc3, 0xab, 0x1f, 0x18,
+	0x80, 0xb3, 0x4a, 0xda, 0xdb, 0xf4, 0x15, 0x66, 0xb2, 0x5a, 0x1b, 0x67, 0x67, 0x53, 0x8f, 0x14,
+	0x77, 0x4c, 0x3f, 0x80, 0x2a, 0x44, 0xc4, 0xa9, 0xdb, 0x9e, 0x43, 0xc4, 0x29, 0x20, 0xe2, 0xd4,
+	0xff, 0xd7, 0x36, 0xb9, 0xd2, 0x7c, 0x91, 0x80, 0xbc, 0xda, 0x36, 0xef, 0x37, 0xe4, 0xd5, 0x46,
+	0xbc, 0x0f, 0x79, 0x35, 0xa8, 0x84, 0xd0, 0x24, 0x3f, 0xb4, 0x03, 0xf6, 0x22, 0x96, 0x18, 0x9a,
+	0x1c, 0xab, 0x87, 0xb6, 0xc5, 0x89, 0x2f, 0x72, 0x8c, 0x1f, 0xcc, 0xf3, 0x60, 0xdd, 0xd7, 0x83,
+	0x88, 0x76, 0x3d, 0xda, 0x9a, 0x0f, 0x1e, 0xea, 0x1c, 0x58, 0xa3, 0x01, 0x53, 0x2c, 0x85, 0xbe,
+	0x94, 0x46, 0x1d, 0xaf, 0x6f, 0x72, 0x91, 0x63, 0x6c, 0xab, 0x1a, 0x98, 0x10, 0x2b, 0x17, 0xa5,
+	0xb9, 0x5d, 0x27, 0xea, 0xde, 0xad, 0x54, 0x2b, 0x0c, 0x9b, 0x63, 0x39, 0x0f, 0xc8, 0xa9, 0xdd,
+	0xe1, 0x44, 0xc6, 0x11, 0x4d, 0xdc, 0x93, 0xf5, 0x94, 0x57, 0x66, 0x6a, 0xfc, 0xa0, 0x00, 0x39,
+	0x1f, 0x13, 0xb2, 0xc9, 0xf6, 0x05, 0x1d, 0x8c, 0x58, 0xaa, 0x4c, 0x96, 0xcc, 0x5a, 0xb2, 0xfd,
+	0xa2, 0xce, 0x0f, 0x2c, 0xa0, 0xff, 0x57, 0xc7, 0xc9, 0xcd, 0xc3, 0x52, 0xa7, 0x5d, 0xc5, 0x32,
+	0x09, 0x99, 0x25, 0xf8, 0xe3, 0x51, 0x57, 0x51, 0xfd, 0x51, 0xa9, 0x47, 0xa5, 0x9e, 0xee, 0x53,
+	0x76, 0x28, 0x28, 0x01, 0x13, 0x62, 0x5c, 0x11, 0xf6, 0x0d, 0xca, 0x0f, 0x1a, 0xa8, 0xe0, 0x70,
+	0xa0, 0x74, 0x0d, 0x42, 0x69, 0x29, 0x0b, 0xc5, 0x63, 0xa8, 0x68, 0x39, 0x1c, 0x50, 0x5c, 0xc3,
+	0x70, 0x5c, 0x4a, 0x4b, 0xb2, 0x89, 0x0c, 0x0e, 0x1b, 0x8a, 0xd7, 0xbb, 0x8a, 0x67, 0x85, 0x62,
+	0x1b, 0x15, 0xad, 0xb9, 0x04, 0xc5, 0x75, 0xf8, 0x22, 0x90, 0x59, 0x7a, 0xf3, 0x44, 0x38, 0xa7,
+	0xa0, 0xf0, 0x23, 0xfd, 0xca, 0x67, 0x8b, 0x0f, 0xf4, 0xba, 0x38, 0x65, 0xcf, 0x24, 0x68, 0x7d,
+	0x94, 0x3f, 0x12, 0x4a, 0xf8, 0x00, 0x96, 0x58, 0x8d, 0x94, 0xf7, 0xf4, 0x11, 0x3c, 0x12, 0xe0,
+	0x63, 0x55, 0x5d, 0x15, 0xb5, 0x9e, 0x3e, 0xc2, 0x87, 0x06, 0x7c, 0x6c, 0x1d, 0xf0, 0x4d, 0xe4,
+	0x62, 0xf4, 0x6a, 0x9a, 0x27, 0x9b, 0x34, 0xd7, 0x16, 0x68, 0xd6, 0xc8, 0xfe, 0xb4, 0x45, 0xae,
+	0x36, 0x2c, 0x84, 0x2f, 0x39, 0x3f, 0x70, 0xde, 0x25, 0x27, 0x76, 0xf1, 0x86, 0xae, 0x37, 0xf8,
+	0xf9, 0xd9, 0xd4, 0x7b, 0x3b, 0x7f, 0xa5, 0x84, 0x77, 0x72, 0x5d, 0x0d, 0x1e, 0x69, 0x8f, 0x8a,
+	0x01, 0x53, 0xee, 0xb1, 0xba, 0x47, 0x52, 0x58, 0x0e, 0xaf, 0x9f, 0xf0, 0x0f, 0xe7, 0x43, 0xf2,
+	0x56, 0x87, 0x8f, 0x46, 0x34, 0xed, 0xbb, 0xed, 0xd5, 0x76, 0xf5, 0xa9, 0x54, 0xa4, 0x2b, 0xfc,
+	0x20, 0x87, 0x40, 0x7a, 0xaa, 0xd6, 0xd7, 0xe3, 0xf5, 0x20, 0x7b, 0xae, 0x97, 0x35, 0x86, 0xff,
+	0xcf, 0xd7, 0x88, 0xd7, 0xd0, 0x41, 0xfc, 0x2e, 0xdf, 0xe1, 0xa9, 0x12, 0x1c, 0x9f, 0xda, 0xe6,
+	0x0b, 0xe0, 0xe9, 0xe6, 0xfc, 0x53, 0xdb, 0x7c, 0xc1, 0xe0, 0x3b, 0x2e, 0x0b, 0xe9, 0xfc, 0x8a,
+	0x5c, 0xcc, 0x7f, 0x6d, 0x32, 0x19, 0x89, 0x18, 0x3f, 0x38, 0x98, 0x51, 0xb0, 0x36, 0x48, 0x21,
+	0xd0, 0x2f, 0x51, 0x7e, 0xd0, 0xc4, 0x85, 0x90, 0x3e, 0x2f, 0xde, 0xa3, 0x03, 0xf3, 0x15, 0xc6,
+	0x0a, 0xe9, 0x0b, 0x29, 0x45, 0xe1, 0x42, 0x63, 0x61, 0x21, 0x5b, 0xbe, 0xcb, 0x98, 0x78, 0xba,
+	0x0b, 0xc3, 0xd4, 0xae, 0x3e, 0xfc, 0xcd, 0x18, 0x13, 0x61, 0x9c, 0x49, 0x3f, 0xc8, 0x31, 0x70,
+	0xfd, 0x30, 0x7f, 0x76, 0x95, 0x80, 0xcb, 0xd3, 0xdc, 0x07, 0x98, 0x9c, 0x04, 0x1b, 0x51, 0xa7,
+	0x40, 0x2a, 0x04, 0x67, 0x97, 0x38, 0x38, 0x8c, 0xf0, 0x4a, 0x6d, 0x8f, 0x9b, 0x2b, 0xe4, 0xfc,
+	0x72, 0xd4, 0x6f, 0x23, 0xf0, 0x9b, 0xaf, 0xe2, 0xf9, 0xed, 0xd3, 0x0f, 0x1a, 0xb8, 0x30, 0xe1,
+	0x58, 0x9a, 0xa7, 0x78, 0xa4, 0xfb, 0xd6, 0x6a, 0xbb, 0x6a, 0x94, 0x56, 0xcb, 0xf3, 0x42, 0x30,
+	0xe1, 0x55, 0x06, 0xbc, 0xc3, 0xc9, 0x47, 0xa5, 0x6a, 0xd8, 0xa9, 0x7a, 0x7c, 0x5d, 0x8c, 0xe5,
+	0x9c, 0x6d, 0xcd, 0x0a, 0x70, 0x96, 0xe5, 0x15, 0xa5, 0x85, 0xa7, 0xd1, 0x42, 0xeb, 0x2c, 0x2b,
+	0x64, 0x2d, 0x23, 0xe7, 0x79, 0x98, 0x7b, 0xd5, 0x4f, 0xde, 0x76, 0x05, 0x87, 0xfc, 0x87, 0x79,
+	0xe6, 0x69, 0xf5, 0x35, 0x7f, 0x2f, 0x97, 0x69, 0x00, 0xe4, 0x5e, 0x2b, 0x0c, 0xe7, 0x27, 0x84,
+	0x58, 0x77, 0xf4, 0xa5, 0xfa, 0x62, 0xa9, 0xde, 0xcd, 0x2d, 0xa8, 0xf3, 0x4b, 0x72, 0x1e, 0x9e,
+	0x85, 0x62, 0x54, 0x89, 0x81, 0xfa, 0xb6, 0x74, 0xdf, 0xae, 0x9f, 0x7f, 0xf8, 0xbc, 0x14, 0x03,
+	0x52, 0x13, 0xe4, 0x43, 0x74, 0x3f, 0xc7, 0x73, 0xbe, 0x80, 0x14, 0x88, 0x3c, 0x80, 0xa8, 0x37,
+	0x97, 0x3a, 0x53, 0x3f, 0xdf, 0x51, 0x0a, 0x5f, 0x6f, 0x95, 0x4a, 0x75, 0x96, 0xf3, 0x19, 0x59,
+	0xc2, 0xd7, 0x2c, 0xdd, 0x03, 0xf6, 0x72, 0x3b, 0xff, 0x2a, 0x50, 0xf9, 0xec, 0x05, 0xaf, 0x60,
+	0xe4, 0x01, 0x7b, 0x89, 0x7c, 0x1b, 0xac, 0xdf, 0xd4, 0xe4, 0x3f, 0xf1, 0xb3, 0xc3, 0xd3, 0xb4,
+	0xcf, 0x5e, 0xb1, 0x3c, 0xfd, 0x5f, 0x79, 0x53, 0x53, 0xca, 0x20, 0x32, 0x8c, 0x35, 0xd4, 0x0f,
+	0x16, 0x68, 0xc0, 0x41, 0xf8, 0x79, 0xaa, 0xe8, 0x80, 0xa7, 0xb1, 0x54, 0x9d, 0xdd, 0xaf, 0x3b,
+	0x5c, 0x30, 0x89, 0x9f, 0x00, 0xda, 0xf6, 0x3e, 0xa7, 0x05, 0x26, 0x8c, 0xb2, 0x31, 0x3c, 0xad,
+	0x04, 0xd1, 0x06, 0x2a, 0x5c, 0xbf, 0xcb, 0xd2, 0x6d, 0x36, 0xe2, 0x62, 0xa2, 0x3f, 0x39, 0x5d,
+	0xa8, 0x5f, 0xbf, 0x2d, 0xcd, 0x11, 0xe2, 0xf2, 0x2f, 0x4f, 0xcd, 0x02, 0xce, 0x5f, 0x90, 0x9b,
+	0x65, 0x45, 0x31, 0x57, 0x58, 0x57, 0x7e, 0xa5, 0xd3, 0x9f, 0x09, 0x1e, 0xcd, 0xa6, 0xde, 0xbd,
+	0xb9, 0x56, 0xac, 0x59, 0xc7, 0x96, 0x2a, 0x5f, 0xeb, 0x8e, 0xd6, 0xc6, 0x88, 0x64, 0x2c, 0x68,
+	0x2f, 0x4e, 0x62, 0x35, 0x31, 0x6f, 0x2d, 0xed, 0x88, 0xa4, 0xa8, 0x03, 0x5f, 0x5a, 0xfc, 0x80,
+	0x84, 0xdf, 0x97, 0x54, 0xf4, 0x5f, 0x52, 0xc1, 0xf0, 0x3a, 0x6e, 0xde, 0x5b, 0x5a, 0xf9, 0x98,
+	0xa1, 0xa9, 0xd6, 0x37, 0x79, 0x3f, 0xa8, 0xe2, 0x1d, 0x4a, 0xdc, 0xbc, 0x60, 0x8f, 0x27, 0x4c,
+	0xd0, 0x34, 0x62, 0xe6, 0x99, 0xb9, 0x7b, 0xb9, 0x7e, 0x75, 0x2f, 0xb4, 0x54, 0x0e, 0xcd, 0x5f,
+	0xaf, 0xfb, 0xc1, 0x42, 0x19, 0xc8, 0x8c, 0x59, 0xcf, 0xad, 0x9e, 0x53, 0x91, 0x6e, 0x4b, 0xf7,
+	0x4a, 0x7d, 0x15, 0xd8, 0x8f, 0xb5, 0xc2, 0x97, 0x54, 0xa4, 0xb8, 0x5a, 0xe7, 0x99, 0xe0, 0x01,
+	0x36, 0x04, 0xa7, 0xfd, 0x88, 0x4a, 0xb5, 0x23, 0xfa, 0x4c, 0xb8, 0x57, 0xeb, 0x1e, 0xa0, 0x97,
+	0xd7, 0x87, 0x1c, 0x00, 0x7e, 0x50, 0x63, 0xc0, 0xb0, 0xe5, 0x2e, 0xe5, 0x5b, 0x9e, 0x32, 0xe9,
+	0xba, 0xab, 0xed, 0xea, 0xb0, 0xe5, 0x5e, 0x28, 0x7c, 0x0d, 0xf5, 0x7e, 0x50, 0xc5, 0xc3, 0xd9,
+	0xa7, 0x0f, 0x46, 0xf8, 0xe9, 0x5e, 0xab, 0x9f, 0x7d, 0xe6, 0x3a, 0x05, 0x5c, 0x3f, 0xb0, 0x90,
+	0x70, 0xb3, 0x85, 0x7f, 0xbb, 0x59, 0x9c, 0x24, 0xfc, 0x05, 0x13, 0xf9, 0x50, 0xeb, 0x2f, 0x03,
+	0xd6, 0xcd, 0x16, 0xa8, 0xa1, 0xcc, 0x61, 0xe5, 0x30, 0x37, 0xd2, 0x9d, 0x67, 0xe4, 0x04, 0xc4,
+	0x1e, 0xd2, 0xbd, 0x8e, 0x49, 0xfd, 0x5b, 0x47, 0x3c, 0xc0, 0x00, 0xac, 0x1d, 0x98, 0x0c, 0x81,
+	0xeb, 0x07, 0x5a, 0x03, 0xb2, 0xea, 0xb9, 0xdf, 0xdd, 0xe2, 0x83, 0x2d, 0xf6, 0x82, 0x25, 0xf3,
+	0x2f, 0x1b, 0x0b, 0x77, 0x0d, 0x39, 0x9c, 0x04, 0x30, 0xe0, 0xe4, 0x6a, 0x34, 0x27, 0x24, 0x17,
+	0xf0, 0x3f, 0xf0, 0xe8, 0x7c, 0x67, 0xc8, 0xd5, 0x90, 0x09, 0x7c, 0x86, 0xb4, 0xb4, 0xf6, 0x8e,
+	0x6d, 0xe3, 0x1c, 0xc8, 0x1e, 0x4c, 0xab, 0xd8, 0x0f, 0xce, 0x00, 0x14, 0x5c, 0xf2, 0x0e, 0xfc,
+	0x76, 0x9e, 0x93, 0x73, 0x36, 0x57, 0xc5, 0x19, 0x3e, 0x42, 0x5a, 0x5a, 0xbb, 0xbe, 0x48, 0x5e,
+	0xc5, 0x99, 0xfd, 0x88, 0xb9, 0x28, 0xf4, 0x83, 0xa5, 0x5c, 0x7a, 0x2f, 0xce, 0x9c, 0x6f, 0xc9,
+	0x79, 0x9b, 0xf5, 0x62, 0x3d, 0x5c, 0xc3, 0xa7, 0x47, 0x4b, 0x6b, 0x37, 0x16, 0x29, 0x03, 0xc6,
+	0xde, 0xb3, 0x65, 0xa9, 0xa5, 0xfd, 0xcd, 0xfa, 0x5a, 0x83, 0xf6, 0xba, 0x3b, 0x38, 0x52, 0x7b,
+	0xbd, 0x51, 0x7b, 0xbd, 0xa2, 0xbd, 0xee, 0xfc, 0x4d, 0x8b, 0xdc, 0xd0, 0xc4, 0xe2, 0x3f, 0x64,
+	0x85, 0xa1, 0x58, 0x0f, 0x3f, 0x0e, 0xd7, 0xc3, 0x1e, 0x53, 0x14, 0xde, 0xe8, 0x40, 0x4b, 0x77,
+	0xe7, 0x5b, 0x6a, 0x26, 0x54, 0x17, 0x65, 0x13, 0xc2, 0x0f, 0x2e, 0x83, 0xc0, 0xb7, 0x79, 0x65,
+	0xb0, 0xfe, 0xf1, 0xfa, 0x06, 0x53, 0xd4, 0xf9, 0x8e, 0x5c, 0xd2, 0xca, 0x26, 0xe5, 0x17, 0xbe,
+	0x78, 0x14, 0x3e, 0x0c, 0xd7, 0xdc, 0x7f, 0x3a, 0x86, 0x26, 0xac, 0xce, 0x9b, 0x50, 0x05, 0xda,
+	0xfb, 0xb1, 0x5a, 0xe3, 0x07, 0x67, 0x81, 0xa0, 0xb3, 0x85, 0xdf, 0x3c, 0x7a, 0xb8, 0xe6, 0xfc,
+	0x36, 0x5f, 0x69, 0x91, 0x1e, 0x1a, 0xec, 0xeb, 0xef, 0xdb, 0x8b, 0x96, 0x9a, 0x85, 0xaa, 0xec,
+	0xdb, 0xb2, 0xd8, 0x2c, 0xb5, 0x0e, 0x94, 0x60, 0x6f, 0x8a, 0x16, 0x5e, 0x5b, 0x2d, 0xfc, 0xef,
+	0xc2, 0x16, 0x5e, 0x37, 0xb7, 0xf0, 0x7a, 0xae, 0x85, 0x6f, 0x8b, 0x16, 0xfe, 0xa1, 0xf5, 0x46,
+	0x2f, 0x73, 0xdc, 0xff, 0x7e, 0x0b, 0x1b, 0x7d, 0x70, 0xc4, 0x2e, 0xaf, 0xf3, 0xec, 0xcb, 0x58,
+	0x2f, 0xaf, 0x0b, 0x79, 0x66, 0xbe, 0x69, 0xbc, 0x49, 0xd3, 0xce, 0x1f, 0x5a, 0x6f, 0x70, 0x03,
+	0x76, 0xff, 0x47, 0x1b, 0x78, 0xef, 0x4d, 0x0d, 0x44, 0x56, 0xc5, 0x81, 0x17, 0xe6, 0xc1, 0xad,
+	0x4c, 0xc2, 0xa3, 0xe3, 0x23, 0xe9, 0xee, 0xf7, 0xff, 0xb1, 0xf2, 0xa3, 0xef, 0x7f, 0x58, 0x69,
+	0xfd, 0xcb, 0x0f, 0x2b, 0xad, 0x7f, 0xfb, 0x61, 0xa5, 0xf5, 0xef, 0x3f, 0xac, 0xb4, 0xfe, 0xf0,
+	0x9f, 0x2b, 0x3f, 0xea, 0x9d, 0xc4, 0xff, 0x39, 0xb8, 0xfe, 0x7f, 0x03, 0x00, 0xac, 0xf5, 0xec,
+	0x50, 0x33, 0x39, 0x00, 0x00,
 }
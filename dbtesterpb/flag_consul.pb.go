@@ -17,6 +17,25 @@ var _ = math.Inf
 
 // See https://github.com/hashicorp/consul for more.
 type Flag_Consul_V1_0_2 struct {
+	// ClientAgentIPs is the list of loader machine IPs to run Consul client agents,
+	// launched by dbtester agents on those machines. If not empty, benchmark
+	// requests are sent to the client agents, instead of directly to the servers.
+	// See https://www.consul.io/docs/internals/architecture.html for more.
+	ClientAgentIPs []string `protobuf:"bytes,1,rep,name=ClientAgentIPs" json:"ClientAgentIPs,omitempty" yaml:"client_agent_ips"`
+	// DatacenterSizes splits 'peer_ips' into federated datacenters, in order
+	// (e.g. [3, 3] for 'dc1' of the first 3 servers, and 'dc2' of the rest).
+	// Each datacenter elects its own leader, and joins 'dc1' over WAN.
+	// Client agents are in 'dc1'.
+	DatacenterSizes []int64 `protobuf:"varint,2,rep,packed,name=DatacenterSizes" json:"DatacenterSizes,omitempty" yaml:"datacenter_sizes"`
+	// TargetDatacenter is the datacenter (e.g. 'dc2') to read and write,
+	// with requests sent to 'dc1' and forwarded across datacenters.
+	// Empty for the local datacenter.
+	TargetDatacenter string `protobuf:"bytes,3,opt,name=TargetDatacenter,proto3" json:"TargetDatacenter,omitempty" yaml:"target_datacenter"`
+	// ACLMasterToken enables ACLs in 'dc1' with the default policy 'allow',
+	// so that anonymous requests are still served. Required for 'auth' type,
+	// which creates a client token with this token.
+	// See https://www.consul.io/docs/guides/acl.html for more.
+	ACLMasterToken string `protobuf:"bytes,4,opt,name=ACLMasterToken,proto3" json:"ACLMasterToken,omitempty" yaml:"acl_master_token"`
 }
 
 func (m *Flag_Consul_V1_0_2) Reset()                    { *m = Flag_Consul_V1_0_2{} }
@@ -42,6 +61,51 @@ func (m *Flag_Consul_V1_0_2) MarshalTo(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClientAgentIPs) > 0 {
+		for _, s := range m.ClientAgentIPs {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.DatacenterSizes) > 0 {
+		dAtA2 := make([]byte, len(m.DatacenterSizes)*10)
+		var j1 int
+		for _, num1 := range m.DatacenterSizes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFlagConsul(dAtA, i, uint64(j1))
+		i += copy(dAtA[i:], dAtA2[:j1])
+	}
+	if len(m.TargetDatacenter) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFlagConsul(dAtA, i, uint64(len(m.TargetDatacenter)))
+		i += copy(dAtA[i:], m.TargetDatacenter)
+	}
+	if len(m.ACLMasterToken) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFlagConsul(dAtA, i, uint64(len(m.ACLMasterToken)))
+		i += copy(dAtA[i:], m.ACLMasterToken)
+	}
 	return i, nil
 }
 
@@ -57,6 +121,27 @@ func encodeVarintFlagConsul(dAtA []byte, offset int, v uint64) int {
 func (m *Flag_Consul_V1_0_2) Size() (n int) {
 	var l int
 	_ = l
+	if len(m.ClientAgentIPs) > 0 {
+		for _, s := range m.ClientAgentIPs {
+			l = len(s)
+			n += 1 + l + sovFlagConsul(uint64(l))
+		}
+	}
+	if len(m.DatacenterSizes) > 0 {
+		l = 0
+		for _, e := range m.DatacenterSizes {
+			l += sovFlagConsul(uint64(e))
+		}
+		n += 1 + sovFlagConsul(uint64(l)) + l
+	}
+	l = len(m.TargetDatacenter)
+	if l > 0 {
+		n += 1 + l + sovFlagConsul(uint64(l))
+	}
+	l = len(m.ACLMasterToken)
+	if l > 0 {
+		n += 1 + l + sovFlagConsul(uint64(l))
+	}
 	return n
 }
 
@@ -102,6 +187,155 @@ func (m *Flag_Consul_V1_0_2) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: flag__consul__v1_0_2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientAgentIPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagConsul
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagConsul
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientAgentIPs = append(m.ClientAgentIPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFlagConsul
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DatacenterSizes = append(m.DatacenterSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFlagConsul
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthFlagConsul
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFlagConsul
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DatacenterSizes = append(m.DatacenterSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatacenterSizes", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDatacenter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagConsul
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagConsul
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDatacenter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ACLMasterToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagConsul
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagConsul
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ACLMasterToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFlagConsul(dAtA[iNdEx:])
@@ -231,14 +465,24 @@ var (
 func init() { proto.RegisterFile("dbtesterpb/flag_consul.proto", fileDescriptorFlagConsul) }
 
 var fileDescriptorFlagConsul = []byte{
-	// 133 bytes of a gzipped FileDescriptorProto
+	// 293 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x49, 0x2a, 0x49,
 	0x2d, 0x2e, 0x49, 0x2d, 0x2a, 0x48, 0xd2, 0x4f, 0xcb, 0x49, 0x4c, 0x8f, 0x4f, 0xce, 0xcf, 0x2b,
 	0x2e, 0xcd, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x42, 0xc8, 0x4a, 0xe9, 0xa6, 0x67,
 	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xa7, 0xe7, 0xa7, 0xe7, 0xeb, 0x83, 0x95,
-	0x24, 0x95, 0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0xaa, 0x24, 0xc6, 0x25, 0x02, 0x36,
-	0x0f, 0x6a, 0x60, 0x7c, 0x7c, 0x99, 0x61, 0xbc, 0x41, 0xbc, 0x91, 0x93, 0xc8, 0x89, 0x87, 0x72,
-	0x0c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8c, 0xc7,
-	0x72, 0x0c, 0x49, 0x6c, 0x60, 0x4d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0xe5, 0xaa,
-	0xa0, 0x8f, 0x00, 0x00, 0x00,
+	0x24, 0x95, 0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0xaa, 0xb4, 0x91, 0x89, 0x4b, 0x04,
+	0x6c, 0x20, 0xd4, 0xc4, 0xf8, 0xf8, 0x32, 0xc3, 0x78, 0x83, 0x78, 0x23, 0x21, 0x67, 0x2e, 0x3e,
+	0xe7, 0x9c, 0xcc, 0xd4, 0xbc, 0x12, 0xc7, 0xf4, 0xd4, 0xbc, 0x12, 0xcf, 0x80, 0x62, 0x09, 0x46,
+	0x05, 0x66, 0x0d, 0x4e, 0x27, 0xe9, 0x4f, 0xf7, 0xe4, 0xc5, 0x2b, 0x13, 0x73, 0x73, 0xac, 0x94,
+	0x92, 0xc1, 0xf2, 0xf1, 0x89, 0x20, 0x05, 0xf1, 0x99, 0x05, 0xc5, 0x4a, 0x41, 0x68, 0x5a, 0x84,
+	0x5c, 0xb9, 0xf8, 0x5d, 0x12, 0x4b, 0x12, 0x93, 0x53, 0xf3, 0x4a, 0x52, 0x8b, 0x82, 0x33, 0xab,
+	0x52, 0x8b, 0x25, 0x98, 0x14, 0x98, 0x35, 0x98, 0x91, 0x4d, 0x49, 0x81, 0x2b, 0x88, 0x2f, 0x06,
+	0xa9, 0x50, 0x0a, 0x42, 0xd7, 0x23, 0xe4, 0xc1, 0x25, 0x10, 0x92, 0x58, 0x94, 0x9e, 0x5a, 0x82,
+	0x90, 0x90, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xf9, 0x74, 0x4f, 0x5e, 0x02, 0x62, 0x4e,
+	0x09, 0x58, 0x45, 0x3c, 0xc2, 0x38, 0xa5, 0x20, 0x0c, 0x5d, 0x20, 0x5f, 0x39, 0x3a, 0xfb, 0xf8,
+	0x26, 0x82, 0x02, 0x2b, 0x24, 0x3f, 0x3b, 0x35, 0x4f, 0x82, 0x05, 0x6c, 0x0e, 0x92, 0x7b, 0x12,
+	0x93, 0x73, 0xe2, 0x73, 0xc1, 0x0a, 0xe2, 0x4b, 0x40, 0x2a, 0x94, 0x82, 0xd0, 0xb4, 0x38, 0x89,
+	0x9c, 0x78, 0x28, 0xc7, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
+	0x31, 0xce, 0x78, 0x2c, 0xc7, 0x90, 0xc4, 0x06, 0x0e, 0x50, 0x63, 0xc0, 0x00, 0xf9, 0xb4, 0x45,
+	0xfa, 0xab, 0x01, 0x00, 0x00,
 }
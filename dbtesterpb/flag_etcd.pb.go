@@ -19,6 +19,22 @@ var _ = math.Inf
 type Flag_Etcd_Other struct {
 	SnapshotCount  int64 `protobuf:"varint,1,opt,name=SnapshotCount,proto3" json:"SnapshotCount,omitempty" yaml:"snapshot_count"`
 	QuotaSizeBytes int64 `protobuf:"varint,2,opt,name=QuotaSizeBytes,proto3" json:"QuotaSizeBytes,omitempty" yaml:"quota_size_bytes"`
+	// Learner is true to add the last member as a learner (non-voting member).
+	// Requires etcd v3.4 or later.
+	Learner bool `protobuf:"varint,3,opt,name=Learner,proto3" json:"Learner,omitempty" yaml:"learner"`
+	// LearnerPromoteSeconds is the number of seconds after the benchmark starts
+	// to promote the learner. 0 to not promote.
+	LearnerPromoteSeconds int64 `protobuf:"varint,4,opt,name=LearnerPromoteSeconds,proto3" json:"LearnerPromoteSeconds,omitempty" yaml:"learner_promote_seconds"`
+	// MaxRequestBytes is for '--max-request-bytes' flag, the maximum client
+	// request size the server accepts (0 for etcd default 1.5 MiB).
+	MaxRequestBytes int64 `protobuf:"varint,5,opt,name=MaxRequestBytes,proto3" json:"MaxRequestBytes,omitempty" yaml:"max_request_bytes"`
+	// ExtraFlags are passed to etcd as they are, for flags of custom
+	// builds (e.g. server-side gRPC compression).
+	ExtraFlags []string `protobuf:"bytes,6,rep,name=ExtraFlags" json:"ExtraFlags,omitempty" yaml:"extra_flags"`
+	// GRPCProxyIPs is the list of machine IPs to run etcd gRPC proxies
+	// (e.g. in another datacenter), launched by dbtester agents on those
+	// machines. If not empty, benchmark requests are sent to the proxies.
+	GRPCProxyIPs []string `protobuf:"bytes,7,rep,name=GRPCProxyIPs" json:"GRPCProxyIPs,omitempty" yaml:"grpc_proxy_ips"`
 }
 
 func (m *Flag_Etcd_Other) Reset()                    { *m = Flag_Etcd_Other{} }
@@ -30,6 +46,22 @@ func (*Flag_Etcd_Other) Descriptor() ([]byte, []int) { return fileDescriptorFlag
 type Flag_Etcd_Tip struct {
 	SnapshotCount  int64 `protobuf:"varint,1,opt,name=SnapshotCount,proto3" json:"SnapshotCount,omitempty" yaml:"snapshot_count"`
 	QuotaSizeBytes int64 `protobuf:"varint,2,opt,name=QuotaSizeBytes,proto3" json:"QuotaSizeBytes,omitempty" yaml:"quota_size_bytes"`
+	// Learner is true to add the last member as a learner (non-voting member).
+	// Requires etcd v3.4 or later.
+	Learner bool `protobuf:"varint,3,opt,name=Learner,proto3" json:"Learner,omitempty" yaml:"learner"`
+	// LearnerPromoteSeconds is the number of seconds after the benchmark starts
+	// to promote the learner. 0 to not promote.
+	LearnerPromoteSeconds int64 `protobuf:"varint,4,opt,name=LearnerPromoteSeconds,proto3" json:"LearnerPromoteSeconds,omitempty" yaml:"learner_promote_seconds"`
+	// MaxRequestBytes is for '--max-request-bytes' flag, the maximum client
+	// request size the server accepts (0 for etcd default 1.5 MiB).
+	MaxRequestBytes int64 `protobuf:"varint,5,opt,name=MaxRequestBytes,proto3" json:"MaxRequestBytes,omitempty" yaml:"max_request_bytes"`
+	// ExtraFlags are passed to etcd as they are, for flags of custom
+	// builds (e.g. server-side gRPC compression).
+	ExtraFlags []string `protobuf:"bytes,6,rep,name=ExtraFlags" json:"ExtraFlags,omitempty" yaml:"extra_flags"`
+	// GRPCProxyIPs is the list of machine IPs to run etcd gRPC proxies
+	// (e.g. in another datacenter), launched by dbtester agents on those
+	// machines. If not empty, benchmark requests are sent to the proxies.
+	GRPCProxyIPs []string `protobuf:"bytes,7,rep,name=GRPCProxyIPs" json:"GRPCProxyIPs,omitempty" yaml:"grpc_proxy_ips"`
 }
 
 func (m *Flag_Etcd_Tip) Reset()                    { *m = Flag_Etcd_Tip{} }
@@ -41,6 +73,9 @@ func (*Flag_Etcd_Tip) Descriptor() ([]byte, []int) { return fileDescriptorFlagEt
 type Flag_Etcd_V3_2 struct {
 	SnapshotCount  int64 `protobuf:"varint,1,opt,name=SnapshotCount,proto3" json:"SnapshotCount,omitempty" yaml:"snapshot_count"`
 	QuotaSizeBytes int64 `protobuf:"varint,2,opt,name=QuotaSizeBytes,proto3" json:"QuotaSizeBytes,omitempty" yaml:"quota_size_bytes"`
+	// MaxRequestBytes is for '--max-request-bytes' flag, the maximum client
+	// request size the server accepts (0 for etcd default 1.5 MiB).
+	MaxRequestBytes int64 `protobuf:"varint,3,opt,name=MaxRequestBytes,proto3" json:"MaxRequestBytes,omitempty" yaml:"max_request_bytes"`
 }
 
 func (m *Flag_Etcd_V3_2) Reset()                    { *m = Flag_Etcd_V3_2{} }
@@ -52,6 +87,9 @@ func (*Flag_Etcd_V3_2) Descriptor() ([]byte, []int) { return fileDescriptorFlagE
 type Flag_Etcd_V3_3 struct {
 	SnapshotCount  int64 `protobuf:"varint,1,opt,name=SnapshotCount,proto3" json:"SnapshotCount,omitempty" yaml:"snapshot_count"`
 	QuotaSizeBytes int64 `protobuf:"varint,2,opt,name=QuotaSizeBytes,proto3" json:"QuotaSizeBytes,omitempty" yaml:"quota_size_bytes"`
+	// MaxRequestBytes is for '--max-request-bytes' flag, the maximum client
+	// request size the server accepts (0 for etcd default 1.5 MiB).
+	MaxRequestBytes int64 `protobuf:"varint,3,opt,name=MaxRequestBytes,proto3" json:"MaxRequestBytes,omitempty" yaml:"max_request_bytes"`
 }
 
 func (m *Flag_Etcd_V3_3) Reset()                    { *m = Flag_Etcd_V3_3{} }
@@ -90,6 +128,56 @@ func (m *Flag_Etcd_Other) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintFlagEtcd(dAtA, i, uint64(m.QuotaSizeBytes))
 	}
+	if m.Learner {
+		dAtA[i] = 0x18
+		i++
+		if m.Learner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.LearnerPromoteSeconds != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintFlagEtcd(dAtA, i, uint64(m.LearnerPromoteSeconds))
+	}
+	if m.MaxRequestBytes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintFlagEtcd(dAtA, i, uint64(m.MaxRequestBytes))
+	}
+	if len(m.ExtraFlags) > 0 {
+		for _, s := range m.ExtraFlags {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.GRPCProxyIPs) > 0 {
+		for _, s := range m.GRPCProxyIPs {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -118,6 +206,56 @@ func (m *Flag_Etcd_Tip) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintFlagEtcd(dAtA, i, uint64(m.QuotaSizeBytes))
 	}
+	if m.Learner {
+		dAtA[i] = 0x18
+		i++
+		if m.Learner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.LearnerPromoteSeconds != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintFlagEtcd(dAtA, i, uint64(m.LearnerPromoteSeconds))
+	}
+	if m.MaxRequestBytes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintFlagEtcd(dAtA, i, uint64(m.MaxRequestBytes))
+	}
+	if len(m.ExtraFlags) > 0 {
+		for _, s := range m.ExtraFlags {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.GRPCProxyIPs) > 0 {
+		for _, s := range m.GRPCProxyIPs {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -146,6 +284,11 @@ func (m *Flag_Etcd_V3_2) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintFlagEtcd(dAtA, i, uint64(m.QuotaSizeBytes))
 	}
+	if m.MaxRequestBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintFlagEtcd(dAtA, i, uint64(m.MaxRequestBytes))
+	}
 	return i, nil
 }
 
@@ -174,6 +317,11 @@ func (m *Flag_Etcd_V3_3) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintFlagEtcd(dAtA, i, uint64(m.QuotaSizeBytes))
 	}
+	if m.MaxRequestBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintFlagEtcd(dAtA, i, uint64(m.MaxRequestBytes))
+	}
 	return i, nil
 }
 
@@ -195,6 +343,27 @@ func (m *Flag_Etcd_Other) Size() (n int) {
 	if m.QuotaSizeBytes != 0 {
 		n += 1 + sovFlagEtcd(uint64(m.QuotaSizeBytes))
 	}
+	if m.Learner {
+		n += 2
+	}
+	if m.LearnerPromoteSeconds != 0 {
+		n += 1 + sovFlagEtcd(uint64(m.LearnerPromoteSeconds))
+	}
+	if m.MaxRequestBytes != 0 {
+		n += 1 + sovFlagEtcd(uint64(m.MaxRequestBytes))
+	}
+	if len(m.ExtraFlags) > 0 {
+		for _, s := range m.ExtraFlags {
+			l = len(s)
+			n += 1 + l + sovFlagEtcd(uint64(l))
+		}
+	}
+	if len(m.GRPCProxyIPs) > 0 {
+		for _, s := range m.GRPCProxyIPs {
+			l = len(s)
+			n += 1 + l + sovFlagEtcd(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -207,6 +376,27 @@ func (m *Flag_Etcd_Tip) Size() (n int) {
 	if m.QuotaSizeBytes != 0 {
 		n += 1 + sovFlagEtcd(uint64(m.QuotaSizeBytes))
 	}
+	if m.Learner {
+		n += 2
+	}
+	if m.LearnerPromoteSeconds != 0 {
+		n += 1 + sovFlagEtcd(uint64(m.LearnerPromoteSeconds))
+	}
+	if m.MaxRequestBytes != 0 {
+		n += 1 + sovFlagEtcd(uint64(m.MaxRequestBytes))
+	}
+	if len(m.ExtraFlags) > 0 {
+		for _, s := range m.ExtraFlags {
+			l = len(s)
+			n += 1 + l + sovFlagEtcd(uint64(l))
+		}
+	}
+	if len(m.GRPCProxyIPs) > 0 {
+		for _, s := range m.GRPCProxyIPs {
+			l = len(s)
+			n += 1 + l + sovFlagEtcd(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -219,6 +409,9 @@ func (m *Flag_Etcd_V3_2) Size() (n int) {
 	if m.QuotaSizeBytes != 0 {
 		n += 1 + sovFlagEtcd(uint64(m.QuotaSizeBytes))
 	}
+	if m.MaxRequestBytes != 0 {
+		n += 1 + sovFlagEtcd(uint64(m.MaxRequestBytes))
+	}
 	return n
 }
 
@@ -231,6 +424,9 @@ func (m *Flag_Etcd_V3_3) Size() (n int) {
 	if m.QuotaSizeBytes != 0 {
 		n += 1 + sovFlagEtcd(uint64(m.QuotaSizeBytes))
 	}
+	if m.MaxRequestBytes != 0 {
+		n += 1 + sovFlagEtcd(uint64(m.MaxRequestBytes))
+	}
 	return n
 }
 
@@ -314,6 +510,122 @@ func (m *Flag_Etcd_Other) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Learner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagEtcd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Learner = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearnerPromoteSeconds", wireType)
+			}
+			m.LearnerPromoteSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagEtcd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LearnerPromoteSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRequestBytes", wireType)
+			}
+			m.MaxRequestBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagEtcd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRequestBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagEtcd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagEtcd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraFlags = append(m.ExtraFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPCProxyIPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagEtcd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagEtcd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GRPCProxyIPs = append(m.GRPCProxyIPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFlagEtcd(dAtA[iNdEx:])
@@ -402,6 +714,122 @@ func (m *Flag_Etcd_Tip) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Learner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagEtcd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Learner = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearnerPromoteSeconds", wireType)
+			}
+			m.LearnerPromoteSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagEtcd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LearnerPromoteSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRequestBytes", wireType)
+			}
+			m.MaxRequestBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagEtcd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRequestBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraFlags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagEtcd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagEtcd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraFlags = append(m.ExtraFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPCProxyIPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagEtcd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagEtcd
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GRPCProxyIPs = append(m.GRPCProxyIPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFlagEtcd(dAtA[iNdEx:])
@@ -490,6 +918,25 @@ func (m *Flag_Etcd_V3_2) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRequestBytes", wireType)
+			}
+			m.MaxRequestBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagEtcd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRequestBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFlagEtcd(dAtA[iNdEx:])
@@ -578,6 +1025,25 @@ func (m *Flag_Etcd_V3_3) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRequestBytes", wireType)
+			}
+			m.MaxRequestBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagEtcd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRequestBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFlagEtcd(dAtA[iNdEx:])
@@ -707,21 +1173,33 @@ var (
 func init() { proto.RegisterFile("dbtesterpb/flag_etcd.proto", fileDescriptorFlagEtcd) }
 
 var fileDescriptorFlagEtcd = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x49, 0x2a, 0x49,
-	0x2d, 0x2e, 0x49, 0x2d, 0x2a, 0x48, 0xd2, 0x4f, 0xcb, 0x49, 0x4c, 0x8f, 0x4f, 0x2d, 0x49, 0x4e,
-	0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x42, 0xc8, 0x49, 0xe9, 0xa6, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xa7, 0xe7, 0xa7, 0xe7, 0xeb, 0x83, 0x95, 0x24, 0x95,
-	0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0xaa, 0x34, 0x93, 0x91, 0x4b, 0x10, 0x6c, 0x1c,
-	0xd8, 0xbc, 0xf8, 0xf8, 0xfc, 0x92, 0x8c, 0xd4, 0x22, 0x21, 0x7b, 0x2e, 0xde, 0xe0, 0xbc, 0xc4,
-	0x82, 0xe2, 0x8c, 0xfc, 0x12, 0xe7, 0xfc, 0xd2, 0xbc, 0x12, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x66,
-	0x27, 0xc9, 0x4f, 0xf7, 0xe4, 0x45, 0x2b, 0x13, 0x73, 0x73, 0xac, 0x94, 0x8a, 0xa1, 0xd2, 0xf1,
-	0xc9, 0x20, 0x79, 0xa5, 0x20, 0x54, 0xf5, 0x42, 0xce, 0x5c, 0x7c, 0x81, 0xa5, 0xf9, 0x25, 0x89,
-	0xc1, 0x99, 0x55, 0xa9, 0x4e, 0x95, 0x25, 0xa9, 0xc5, 0x12, 0x4c, 0x60, 0x13, 0xa4, 0x3f, 0xdd,
-	0x93, 0x17, 0x87, 0x98, 0x50, 0x08, 0x92, 0x8f, 0x2f, 0xce, 0xac, 0x4a, 0x8d, 0x4f, 0x02, 0xa9,
-	0x50, 0x0a, 0x42, 0xd3, 0xa2, 0x34, 0x9d, 0x91, 0x8b, 0x1f, 0xd9, 0x6d, 0x25, 0x99, 0x05, 0x83,
-	0xc4, 0x65, 0x33, 0x18, 0xb9, 0x04, 0x90, 0x5d, 0x56, 0x66, 0x1c, 0x6f, 0x34, 0x78, 0x9d, 0x66,
-	0x3c, 0x38, 0x9c, 0xe6, 0x24, 0x72, 0xe2, 0xa1, 0x1c, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe3, 0xb1, 0x1c, 0x43, 0x12, 0x1b, 0x38, 0x21, 0x1a,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x3f, 0x04, 0x0f, 0xe1, 0x02, 0x00, 0x00,
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x94, 0xc1, 0x8a, 0xd4, 0x30,
+	0x00, 0x86, 0xb7, 0x56, 0x77, 0x35, 0xe8, 0xae, 0x06, 0x57, 0xeb, 0x2a, 0xed, 0x90, 0xd3, 0x1c,
+	0x74, 0x07, 0x1c, 0xf0, 0x20, 0x88, 0xd0, 0xc1, 0x15, 0x41, 0xa1, 0x66, 0x2e, 0xde, 0x42, 0xda,
+	0xc9, 0x76, 0x0a, 0x6d, 0xd3, 0x4d, 0x52, 0x69, 0xf7, 0x49, 0x7c, 0xa4, 0x3d, 0xfa, 0x04, 0x65,
+	0x1c, 0xdf, 0xa0, 0x4f, 0x20, 0x4d, 0x76, 0xb0, 0x33, 0xcc, 0xc9, 0x93, 0x0c, 0x7b, 0x4b, 0xf8,
+	0xbf, 0xff, 0x2f, 0x7f, 0x7e, 0x28, 0x38, 0x99, 0x85, 0x8a, 0x49, 0xc5, 0x44, 0x11, 0x8e, 0xce,
+	0x53, 0x1a, 0x13, 0xa6, 0xa2, 0xd9, 0x69, 0x21, 0xb8, 0xe2, 0x10, 0xfc, 0xd5, 0x4e, 0x5e, 0xc5,
+	0x89, 0x9a, 0x97, 0xe1, 0x69, 0xc4, 0xb3, 0x51, 0xcc, 0x63, 0x3e, 0xd2, 0x48, 0x58, 0x9e, 0xeb,
+	0x9b, 0xbe, 0xe8, 0x93, 0xb1, 0xa2, 0x85, 0x0d, 0x1e, 0xe9, 0x38, 0x9d, 0x47, 0x08, 0x57, 0x73,
+	0x26, 0xe0, 0x7b, 0xf0, 0x60, 0x9a, 0xd3, 0x42, 0xce, 0xb9, 0x9a, 0xf0, 0x32, 0x57, 0x8e, 0x35,
+	0xb0, 0x86, 0xb6, 0xff, 0xac, 0x6d, 0xbc, 0xe3, 0x9a, 0x66, 0xe9, 0x5b, 0x24, 0xaf, 0x65, 0x12,
+	0x75, 0x3a, 0xc2, 0xeb, 0x3c, 0x9c, 0x80, 0xc3, 0xaf, 0x25, 0x57, 0x74, 0x9a, 0x5c, 0x32, 0xbf,
+	0x56, 0x4c, 0x3a, 0xb7, 0x74, 0xc2, 0xf3, 0xb6, 0xf1, 0x9e, 0x9a, 0x84, 0x8b, 0x4e, 0x27, 0x32,
+	0xb9, 0x64, 0x24, 0xec, 0x08, 0x84, 0x37, 0x2c, 0xf0, 0x25, 0x38, 0xf8, 0xcc, 0xa8, 0xc8, 0x99,
+	0x70, 0xec, 0x81, 0x35, 0xbc, 0xeb, 0xc3, 0xb6, 0xf1, 0x0e, 0x8d, 0x3b, 0x35, 0x02, 0xc2, 0x2b,
+	0x04, 0x7e, 0x03, 0xc7, 0xd7, 0xc7, 0x40, 0xf0, 0x8c, 0x2b, 0x36, 0x65, 0x11, 0xcf, 0x67, 0xd2,
+	0xb9, 0xad, 0xbf, 0x8c, 0xda, 0xc6, 0x73, 0xd7, 0xbc, 0xa4, 0x30, 0x1c, 0x91, 0x06, 0x44, 0x78,
+	0x7b, 0x00, 0x3c, 0x03, 0x47, 0x5f, 0x68, 0x85, 0xd9, 0x45, 0xc9, 0xa4, 0x32, 0x6d, 0xee, 0xe8,
+	0xcc, 0x17, 0x6d, 0xe3, 0x39, 0x26, 0x33, 0xa3, 0x15, 0x11, 0x86, 0x58, 0xd5, 0xd9, 0x34, 0xc1,
+	0x37, 0x00, 0x7c, 0xa8, 0x94, 0xa0, 0x67, 0x29, 0x8d, 0xa5, 0xb3, 0x3f, 0xb0, 0x87, 0xf7, 0xfc,
+	0x27, 0x6d, 0xe3, 0x41, 0x13, 0xc1, 0x3a, 0x8d, 0x74, 0x63, 0x48, 0x84, 0x7b, 0x24, 0x7c, 0x07,
+	0xee, 0x7f, 0xc4, 0xc1, 0x24, 0x10, 0xbc, 0xaa, 0x3f, 0x05, 0xd2, 0x39, 0xd0, 0xce, 0xde, 0x18,
+	0xb1, 0x28, 0xa2, 0xae, 0x4d, 0x55, 0x93, 0xa4, 0x90, 0x08, 0xaf, 0xe1, 0xa8, 0xb1, 0xc1, 0x51,
+	0x7f, 0x62, 0x95, 0x14, 0x37, 0x03, 0xef, 0xd2, 0xc0, 0x0b, 0x0b, 0x3c, 0xec, 0x0f, 0xfc, 0x7d,
+	0x4c, 0x5e, 0xff, 0x27, 0x0b, 0x6f, 0x79, 0x59, 0xfb, 0x1f, 0x5e, 0x76, 0x5b, 0xc5, 0xf1, 0x6e,
+	0x55, 0xf4, 0x1f, 0x5f, 0xfd, 0x72, 0xf7, 0xae, 0x96, 0xae, 0xf5, 0x73, 0xe9, 0x5a, 0x8b, 0xa5,
+	0x6b, 0xfd, 0xf8, 0xed, 0xee, 0x85, 0xfb, 0xfa, 0x37, 0x3d, 0xfe, 0x33, 0x00, 0x21, 0x17, 0x8f,
+	0xa7, 0xff, 0x05, 0x00, 0x00,
 }
@@ -40,6 +40,12 @@ type Flag_Zookeeper_R3_5_3Beta struct {
 	SyncLimit            int64 `protobuf:"varint,104,opt,name=SyncLimit,proto3" json:"SyncLimit,omitempty" yaml:"sync_limit"`
 	SnapCount            int64 `protobuf:"varint,105,opt,name=SnapCount,proto3" json:"SnapCount,omitempty" yaml:"snap_count"`
 	MaxClientConnections int64 `protobuf:"varint,106,opt,name=MaxClientConnections,proto3" json:"MaxClientConnections,omitempty" yaml:"max_client_connections"`
+	// ObserverNumber is the number of observer (non-voting) members.
+	// The last 'ObserverNumber' peers in 'peer_ips' become observers.
+	// See https://zookeeper.apache.org/doc/trunk/zookeeperObservers.html for more.
+	ObserverNumber int64 `protobuf:"varint,107,opt,name=ObserverNumber,proto3" json:"ObserverNumber,omitempty" yaml:"observer_number"`
+	// ReadFromObservers is true to send read requests only to observers.
+	ReadFromObservers bool `protobuf:"varint,108,opt,name=ReadFromObservers,proto3" json:"ReadFromObservers,omitempty" yaml:"read_from_observers"`
 }
 
 func (m *Flag_Zookeeper_R3_5_3Beta) Reset()         { *m = Flag_Zookeeper_R3_5_3Beta{} }
@@ -133,6 +139,25 @@ func (m *Flag_Zookeeper_R3_5_3Beta) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintFlagZookeeper(dAtA, i, uint64(m.MaxClientConnections))
 	}
+	if m.ObserverNumber != 0 {
+		dAtA[i] = 0xd8
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintFlagZookeeper(dAtA, i, uint64(m.ObserverNumber))
+	}
+	if m.ReadFromObservers {
+		dAtA[i] = 0xe0
+		i++
+		dAtA[i] = 0x6
+		i++
+		if m.ReadFromObservers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -180,6 +205,12 @@ func (m *Flag_Zookeeper_R3_5_3Beta) Size() (n int) {
 	if m.MaxClientConnections != 0 {
 		n += 2 + sovFlagZookeeper(uint64(m.MaxClientConnections))
 	}
+	if m.ObserverNumber != 0 {
+		n += 2 + sovFlagZookeeper(uint64(m.ObserverNumber))
+	}
+	if m.ReadFromObservers {
+		n += 3
+	}
 	return n
 }
 
@@ -435,6 +466,45 @@ func (m *Flag_Zookeeper_R3_5_3Beta) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 107:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverNumber", wireType)
+			}
+			m.ObserverNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagZookeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserverNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 108:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadFromObservers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagZookeeper
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadFromObservers = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFlagZookeeper(dAtA[iNdEx:])
@@ -564,32 +634,36 @@ var (
 func init() { proto.RegisterFile("dbtesterpb/flag_zookeeper.proto", fileDescriptorFlagZookeeper) }
 
 var fileDescriptorFlagZookeeper = []byte{
-	// 419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x8e, 0xd3, 0x30,
-	0x14, 0x87, 0xc7, 0x4c, 0x05, 0x8c, 0x25, 0x04, 0x98, 0x22, 0x59, 0x08, 0x92, 0x92, 0x55, 0x37,
-	0x33, 0x45, 0xaa, 0xd8, 0xb0, 0x4c, 0x67, 0x33, 0x23, 0x2a, 0x41, 0x18, 0x24, 0x76, 0x96, 0xe3,
-	0x3a, 0x19, 0xb7, 0xb5, 0x1d, 0x25, 0x2f, 0xa3, 0x94, 0x93, 0x70, 0x07, 0x2e, 0x32, 0x4b, 0x4e,
-	0x50, 0x41, 0xb9, 0x41, 0x4e, 0x80, 0xe2, 0x68, 0x92, 0x0a, 0xca, 0xee, 0xfd, 0xf9, 0xbe, 0x5f,
-	0x14, 0x3d, 0x63, 0x7f, 0x11, 0x83, 0x2c, 0x40, 0xe6, 0x59, 0x3c, 0x49, 0xd6, 0x3c, 0x65, 0x5f,
-	0xad, 0x5d, 0x49, 0x99, 0xc9, 0xfc, 0x2c, 0xcb, 0x2d, 0x58, 0x82, 0x7b, 0xe0, 0xc5, 0x69, 0xaa,
-	0xe0, 0xba, 0x8c, 0xcf, 0x84, 0xd5, 0x93, 0xd4, 0xa6, 0x76, 0xe2, 0x90, 0xb8, 0x4c, 0x5c, 0xe7,
-	0x1a, 0x57, 0xb5, 0x6a, 0xf0, 0x7d, 0x80, 0x5f, 0xba, 0xcc, 0x3e, 0x94, 0xb1, 0x7c, 0xca, 0xde,
-	0xb2, 0x29, 0x8b, 0x25, 0x70, 0xf2, 0x11, 0x93, 0x4b, 0x7e, 0xc3, 0xcf, 0x2f, 0x4b, 0x90, 0x73,
-	0x5e, 0x85, 0x65, 0x92, 0xc8, 0x9c, 0xa2, 0x11, 0x1a, 0x0f, 0xc2, 0xd7, 0xf5, 0xd6, 0x7f, 0xb5,
-	0xe1, 0x7a, 0xfd, 0x2e, 0x58, 0xf2, 0x1b, 0xce, 0x16, 0x6c, 0x59, 0x82, 0x64, 0x9a, 0x57, 0x2c,
-	0x76, 0x5c, 0x10, 0x1d, 0x90, 0xc9, 0x29, 0x7e, 0xd0, 0x4c, 0xbf, 0xe8, 0x82, 0xde, 0x1b, 0xa1,
-	0xf1, 0x49, 0xf8, 0xac, 0xde, 0xfa, 0x8f, 0xf7, 0x72, 0x2a, 0x5d, 0x04, 0xd1, 0x1d, 0xd3, 0xe3,
-	0x15, 0x3d, 0xfe, 0x0f, 0x5e, 0x75, 0x78, 0x45, 0x08, 0x1e, 0xcc, 0x37, 0x17, 0xe7, 0x74, 0x31,
-	0x42, 0xe3, 0x47, 0x91, 0xab, 0x89, 0x87, 0xf1, 0x6c, 0xad, 0xa4, 0x81, 0x0f, 0x36, 0x07, 0x2a,
-	0x47, 0x68, 0x7c, 0x1c, 0xed, 0x4d, 0xc8, 0x1b, 0xfc, 0xf0, 0x4a, 0x89, 0xd5, 0x95, 0xd2, 0x92,
-	0x26, 0xcd, 0x36, 0x1c, 0xd6, 0x5b, 0xff, 0x49, 0xfb, 0x0d, 0x50, 0x62, 0xc5, 0x40, 0x69, 0x19,
-	0x44, 0x1d, 0x45, 0xa6, 0xf8, 0xe4, 0xc2, 0x28, 0x78, 0xaf, 0xb4, 0x02, 0x9a, 0x3a, 0xe5, 0x79,
-	0xbd, 0xf5, 0x9f, 0xb6, 0x8a, 0x32, 0x0a, 0xd8, 0xba, 0xd9, 0x05, 0x51, 0xcf, 0x35, 0xd2, 0xa7,
-	0x8d, 0x11, 0xad, 0x74, 0xfd, 0xb7, 0x54, 0x6c, 0x8c, 0xe8, 0xa4, 0x8e, 0x73, 0x92, 0xe1, 0xd9,
-	0xcc, 0x96, 0x06, 0xa8, 0xfa, 0x47, 0x32, 0x3c, 0x63, 0xa2, 0xd9, 0x35, 0xd2, 0x1d, 0x47, 0x3e,
-	0xe3, 0xe1, 0x9c, 0x57, 0xed, 0x1f, 0xce, 0xac, 0x31, 0x52, 0x80, 0xb2, 0xa6, 0xa0, 0x4b, 0xe7,
-	0xef, 0xdd, 0xad, 0xb9, 0x95, 0x70, 0x18, 0x13, 0x3d, 0x17, 0x44, 0x07, 0xf5, 0x70, 0x78, 0xfb,
-	0xcb, 0x3b, 0xba, 0xdd, 0x79, 0xe8, 0xc7, 0xce, 0x43, 0x3f, 0x77, 0x1e, 0xfa, 0xf6, 0xdb, 0x3b,
-	0x8a, 0xef, 0xbb, 0xa7, 0x34, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x63, 0xdf, 0x89, 0x1b, 0xa8,
-	0x02, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xdd, 0x6e, 0xd3, 0x30,
+	0x18, 0x86, 0x17, 0x36, 0x60, 0xb3, 0xc4, 0xcf, 0x4c, 0x41, 0x56, 0x05, 0x49, 0xf1, 0x51, 0x4f,
+	0xb6, 0x22, 0x55, 0x9c, 0x70, 0x98, 0x4e, 0x48, 0x9b, 0x56, 0x7e, 0xcc, 0x90, 0x38, 0xb3, 0x9c,
+	0xd4, 0xe9, 0xdc, 0xd6, 0x76, 0xe5, 0x38, 0x55, 0xca, 0x95, 0x70, 0x33, 0x9c, 0xef, 0x90, 0x2b,
+	0x88, 0xa0, 0xdc, 0x41, 0xae, 0x00, 0xc5, 0xa1, 0x4d, 0xb5, 0x9f, 0x33, 0xfb, 0x7b, 0x9f, 0xe7,
+	0x8d, 0xa2, 0xcf, 0x20, 0x18, 0x45, 0x96, 0xa7, 0x96, 0x9b, 0x79, 0xd4, 0x4b, 0x66, 0x6c, 0x4c,
+	0xbf, 0x6b, 0x3d, 0xe5, 0x7c, 0xce, 0xcd, 0xf1, 0xdc, 0x68, 0xab, 0x21, 0x68, 0x80, 0xf6, 0xd1,
+	0x58, 0xd8, 0xcb, 0x2c, 0x3a, 0x8e, 0xb5, 0xec, 0x8d, 0xf5, 0x58, 0xf7, 0x1c, 0x12, 0x65, 0x89,
+	0xbb, 0xb9, 0x8b, 0x3b, 0xd5, 0x2a, 0xfe, 0x79, 0x1f, 0xbc, 0x74, 0x9d, 0x4d, 0x29, 0xa5, 0xa6,
+	0x4f, 0xdf, 0xd2, 0x3e, 0x8d, 0xb8, 0x65, 0xf0, 0x33, 0x80, 0x67, 0x6c, 0xc1, 0x4e, 0xce, 0x32,
+	0xcb, 0x87, 0x2c, 0x0f, 0xb3, 0x24, 0xe1, 0x06, 0x79, 0x1d, 0xaf, 0xbb, 0x17, 0xbe, 0x2e, 0x8b,
+	0xe0, 0xd5, 0x92, 0xc9, 0xd9, 0x3b, 0x3c, 0x61, 0x0b, 0x46, 0x47, 0x74, 0x92, 0x59, 0x4e, 0x25,
+	0xcb, 0x69, 0xe4, 0x38, 0x4c, 0x6e, 0x91, 0xe1, 0x11, 0x78, 0x58, 0x4d, 0xbf, 0xc9, 0x14, 0xdd,
+	0xeb, 0x78, 0xdd, 0x83, 0xf0, 0x59, 0x59, 0x04, 0x4f, 0xb6, 0x7a, 0x72, 0x99, 0x62, 0xb2, 0x66,
+	0x1a, 0x3c, 0x47, 0xbb, 0x77, 0xe0, 0xf9, 0x06, 0xcf, 0x21, 0x04, 0x7b, 0xc3, 0xe5, 0xe9, 0x09,
+	0x1a, 0x75, 0xbc, 0xee, 0x23, 0xe2, 0xce, 0xd0, 0x07, 0x60, 0x30, 0x13, 0x5c, 0xd9, 0x4f, 0xda,
+	0x58, 0xc4, 0x3b, 0x5e, 0x77, 0x97, 0x6c, 0x4d, 0xe0, 0x1b, 0xb0, 0x7f, 0x21, 0xe2, 0xe9, 0x85,
+	0x90, 0x1c, 0x25, 0x55, 0x1a, 0xb6, 0xca, 0x22, 0x78, 0x5a, 0x7f, 0xc3, 0x8a, 0x78, 0x4a, 0xad,
+	0x90, 0x1c, 0x93, 0x0d, 0x05, 0xfb, 0xe0, 0xe0, 0x54, 0x09, 0x7b, 0x2e, 0xa4, 0xb0, 0x68, 0xec,
+	0x94, 0xe7, 0x65, 0x11, 0x1c, 0xd6, 0x8a, 0x50, 0xc2, 0xd2, 0x59, 0x95, 0x61, 0xd2, 0x70, 0x95,
+	0xf4, 0x65, 0xa9, 0xe2, 0x5a, 0xba, 0xbc, 0x2e, 0xa5, 0x4b, 0x15, 0x6f, 0xa4, 0x0d, 0xe7, 0x24,
+	0xc5, 0xe6, 0x03, 0x9d, 0x29, 0x8b, 0xc4, 0x0d, 0x49, 0xb1, 0x39, 0x8d, 0xab, 0x0c, 0x93, 0x86,
+	0x83, 0x5f, 0x41, 0x6b, 0xc8, 0xf2, 0xfa, 0x0f, 0x07, 0x5a, 0x29, 0x1e, 0x5b, 0xa1, 0x55, 0x8a,
+	0x26, 0xce, 0xdf, 0xda, 0x5b, 0xb5, 0xab, 0xd8, 0x61, 0x34, 0x6e, 0x38, 0x4c, 0x6e, 0xd5, 0x61,
+	0x08, 0x1e, 0x7f, 0x8c, 0x52, 0x6e, 0x16, 0xdc, 0x7c, 0xc8, 0x64, 0xc4, 0x0d, 0x9a, 0xba, 0xc2,
+	0x76, 0x59, 0x04, 0x2f, 0xea, 0x42, 0xfd, 0x3f, 0xa7, 0xca, 0x01, 0x98, 0x5c, 0x33, 0xe0, 0x39,
+	0x38, 0x24, 0x9c, 0x8d, 0xde, 0x1b, 0x2d, 0xd7, 0x49, 0x8a, 0x66, 0x1d, 0xaf, 0xbb, 0x1f, 0xfa,
+	0x65, 0x11, 0xb4, 0xeb, 0x1a, 0xc3, 0xd9, 0x88, 0x26, 0x46, 0x4b, 0xba, 0x2e, 0x4c, 0x31, 0xb9,
+	0x29, 0x86, 0xad, 0xab, 0x3f, 0xfe, 0xce, 0xd5, 0xca, 0xf7, 0x7e, 0xad, 0x7c, 0xef, 0xf7, 0xca,
+	0xf7, 0x7e, 0xfc, 0xf5, 0x77, 0xa2, 0x07, 0xee, 0x71, 0xf7, 0xff, 0x0d, 0x00, 0x3a, 0x97, 0xd3,
+	0x16, 0x3a, 0x03, 0x00, 0x00,
 }
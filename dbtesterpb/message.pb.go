@@ -11,6 +11,8 @@ import _ "github.com/gogo/protobuf/gogoproto"
 import context "golang.org/x/net/context"
 import grpc "google.golang.org/grpc"
 
+import sortkeys "github.com/gogo/protobuf/sortkeys"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -24,17 +26,63 @@ const (
 	Operation_Start     Operation = 0
 	Operation_Stop      Operation = 1
 	Operation_Heartbeat Operation = 2
+	Operation_Backup    Operation = 3
+	Operation_Restore   Operation = 4
+	// SkewClock steps the system clock of the member by 'ClockSkewMs',
+	// until the database is stopped.
+	Operation_SkewClock Operation = 5
+	// Upgrade restarts the running etcd member with the binary built at
+	// 'EtcdGitRef', keeping its data directory, as in a rolling upgrade.
+	Operation_Upgrade Operation = 6
+	// StopMember stops the running database process, keeping its data
+	// directory, so that it falls behind the rest of the cluster.
+	Operation_StopMember Operation = 7
+	// RestartMember restarts the database stopped by 'StopMember', and waits
+	// until it catches up with the cluster (e.g. by snapshot transfer).
+	Operation_RestartMember Operation = 8
+	// Profile returns the hardware profile of the agent machine,
+	// in any state of the database.
+	Operation_Profile Operation = 9
+	// Clock returns the wall clock of the agent machine, in any state of
+	// the database, to measure clock offsets between control and agents.
+	Operation_Clock Operation = 10
+	// Exec runs 'ExecCommand' on the agent machine, in any state of the
+	// database, if the agent '--exec-allowlist' allows the executable.
+	Operation_Exec Operation = 11
+	// DropPageCache syncs and drops the page cache of the agent machine, and
+	// restarts the running database meanwhile if 'RestartDatabase' is set.
+	Operation_DropPageCache Operation = 12
 )
 
 var Operation_name = map[int32]string{
-	0: "Start",
-	1: "Stop",
-	2: "Heartbeat",
+	0:  "Start",
+	1:  "Stop",
+	2:  "Heartbeat",
+	3:  "Backup",
+	4:  "Restore",
+	5:  "SkewClock",
+	6:  "Upgrade",
+	7:  "StopMember",
+	8:  "RestartMember",
+	9:  "Profile",
+	10: "Clock",
+	11: "Exec",
+	12: "DropPageCache",
 }
 var Operation_value = map[string]int32{
-	"Start":     0,
-	"Stop":      1,
-	"Heartbeat": 2,
+	"Start":         0,
+	"Stop":          1,
+	"Heartbeat":     2,
+	"Backup":        3,
+	"Restore":       4,
+	"SkewClock":     5,
+	"Upgrade":       6,
+	"StopMember":    7,
+	"RestartMember": 8,
+	"Profile":       9,
+	"Clock":         10,
+	"Exec":          11,
+	"DropPageCache": 12,
 }
 
 func (x Operation) String() string {
@@ -42,6 +90,37 @@ func (x Operation) String() string {
 }
 func (Operation) EnumDescriptor() ([]byte, []int) { return fileDescriptorMessage, []int{0} }
 
+// HardwareProfile describes the machine of an agent, to verify that
+// members run on homogeneous machines.
+type HardwareProfile struct {
+	CPUModel    string `protobuf:"bytes,1,opt,name=CPUModel,proto3" json:"CPUModel,omitempty"`
+	CPUCount    int64  `protobuf:"varint,2,opt,name=CPUCount,proto3" json:"CPUCount,omitempty"`
+	MemoryBytes int64  `protobuf:"varint,3,opt,name=MemoryBytes,proto3" json:"MemoryBytes,omitempty"`
+	// DiskType is 'ssd' or 'hdd' of the agent disk device, empty if unknown.
+	DiskType      string `protobuf:"bytes,4,opt,name=DiskType,proto3" json:"DiskType,omitempty"`
+	KernelVersion string `protobuf:"bytes,5,opt,name=KernelVersion,proto3" json:"KernelVersion,omitempty"`
+	// CPUArchitecture is the Go architecture of the agent (e.g. 'amd64', 'arm64').
+	CPUArchitecture string `protobuf:"bytes,6,opt,name=CPUArchitecture,proto3" json:"CPUArchitecture,omitempty"`
+}
+
+func (m *HardwareProfile) Reset()                    { *m = HardwareProfile{} }
+func (m *HardwareProfile) String() string            { return proto.CompactTextString(m) }
+func (*HardwareProfile) ProtoMessage()               {}
+func (*HardwareProfile) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{0} }
+
+// Phase is a named interval of the workload (e.g. warmup, load).
+// EndUnixNano is zero if the phase has not ended.
+type Phase struct {
+	Name          string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	StartUnixNano int64  `protobuf:"varint,2,opt,name=StartUnixNano,proto3" json:"StartUnixNano,omitempty"`
+	EndUnixNano   int64  `protobuf:"varint,3,opt,name=EndUnixNano,proto3" json:"EndUnixNano,omitempty"`
+}
+
+func (m *Phase) Reset()                    { *m = Phase{} }
+func (m *Phase) String() string            { return proto.CompactTextString(m) }
+func (*Phase) ProtoMessage()               {}
+func (*Phase) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{1} }
+
 type Request struct {
 	Operation        Operation  `protobuf:"varint,1,opt,name=Operation,proto3,enum=dbtesterpb.Operation" json:"Operation,omitempty"`
 	TriggerLogUpload bool       `protobuf:"varint,2,opt,name=TriggerLogUpload,proto3" json:"TriggerLogUpload,omitempty"`
@@ -54,36 +133,188 @@ type Request struct {
 	IPIndex                    uint32                      `protobuf:"varint,6,opt,name=IPIndex,proto3" json:"IPIndex,omitempty"`
 	CurrentClientNumber        int64                       `protobuf:"varint,7,opt,name=CurrentClientNumber,proto3" json:"CurrentClientNumber,omitempty"`
 	ConfigClientMachineInitial *ConfigClientMachineInitial `protobuf:"bytes,8,opt,name=ConfigClientMachineInitial" json:"ConfigClientMachineInitial,omitempty"`
-	Flag_Etcd_Other            *Flag_Etcd_Other            `protobuf:"bytes,100,opt,name=flag__etcd__other,json=flagEtcdOther" json:"flag__etcd__other,omitempty"`
-	Flag_Etcd_Tip              *Flag_Etcd_Tip              `protobuf:"bytes,101,opt,name=flag__etcd__tip,json=flagEtcdTip" json:"flag__etcd__tip,omitempty"`
-	Flag_Etcd_V3_2             *Flag_Etcd_V3_2             `protobuf:"bytes,102,opt,name=flag__etcd__v3_2,json=flagEtcdV32" json:"flag__etcd__v3_2,omitempty"`
-	Flag_Etcd_V3_3             *Flag_Etcd_V3_3             `protobuf:"bytes,103,opt,name=flag__etcd__v3_3,json=flagEtcdV33" json:"flag__etcd__v3_3,omitempty"`
-	Flag_Zookeeper_R3_5_3Beta  *Flag_Zookeeper_R3_5_3Beta  `protobuf:"bytes,200,opt,name=flag__zookeeper__r3_5_3_beta,json=flagZookeeperR353Beta" json:"flag__zookeeper__r3_5_3_beta,omitempty"`
-	Flag_Consul_V1_0_2         *Flag_Consul_V1_0_2         `protobuf:"bytes,300,opt,name=flag__consul__v1_0_2,json=flagConsulV102" json:"flag__consul__v1_0_2,omitempty"`
-	Flag_Cetcd_Beta            *Flag_Cetcd_Beta            `protobuf:"bytes,400,opt,name=flag__cetcd__beta,json=flagCetcdBeta" json:"flag__cetcd__beta,omitempty"`
-	Flag_Zetcd_Beta            *Flag_Zetcd_Beta            `protobuf:"bytes,500,opt,name=flag__zetcd__beta,json=flagZetcdBeta" json:"flag__zetcd__beta,omitempty"`
+	// LatencyProfile is the name of network latency profile to apply between peers.
+	LatencyProfile string `protobuf:"bytes,9,opt,name=LatencyProfile,proto3" json:"LatencyProfile,omitempty"`
+	// ClusterSize is the number of members in the cluster, when the
+	// cluster is only formed by the first members in 'PeerIPsString'.
+	// 0 means all peers.
+	ClusterSize int64 `protobuf:"varint,10,opt,name=ClusterSize,proto3" json:"ClusterSize,omitempty"`
+	// JoinExisting is true to join a running cluster, as a new member.
+	JoinExisting bool `protobuf:"varint,11,opt,name=JoinExisting,proto3" json:"JoinExisting,omitempty"`
+	// EtcdGitRef is the git ref of etcd to build from source and run.
+	EtcdGitRef string `protobuf:"bytes,12,opt,name=EtcdGitRef,proto3" json:"EtcdGitRef,omitempty"`
+	// TestID scopes agent state of the test. Empty for the default test.
+	TestID string `protobuf:"bytes,13,opt,name=TestID,proto3" json:"TestID,omitempty"`
+	// PortOffset is added to all database ports.
+	PortOffset int64 `protobuf:"varint,14,opt,name=PortOffset,proto3" json:"PortOffset,omitempty"`
+	// DiskWriteDelayMs and DiskReadDelayMs are added to disk writes and reads
+	// of the database, with a device-mapper delay target.
+	DiskWriteDelayMs int64 `protobuf:"varint,15,opt,name=DiskWriteDelayMs,proto3" json:"DiskWriteDelayMs,omitempty"`
+	DiskReadDelayMs  int64 `protobuf:"varint,16,opt,name=DiskReadDelayMs,proto3" json:"DiskReadDelayMs,omitempty"`
+	// ClockSkewMs is the offset of the member clock, for 'SkewClock' operation.
+	ClockSkewMs int64 `protobuf:"varint,17,opt,name=ClockSkewMs,proto3" json:"ClockSkewMs,omitempty"`
+	// Antagonist options consume host resources from database start to stop.
+	AntagonistCPUCores                int64 `protobuf:"varint,18,opt,name=AntagonistCPUCores,proto3" json:"AntagonistCPUCores,omitempty"`
+	AntagonistMemoryBytes             int64 `protobuf:"varint,19,opt,name=AntagonistMemoryBytes,proto3" json:"AntagonistMemoryBytes,omitempty"`
+	AntagonistDiskWriteBytesPerSecond int64 `protobuf:"varint,20,opt,name=AntagonistDiskWriteBytesPerSecond,proto3" json:"AntagonistDiskWriteBytesPerSecond,omitempty"`
+	// Durability is the fsync policy of the database write-ahead log.
+	Durability string `protobuf:"bytes,21,opt,name=Durability,proto3" json:"Durability,omitempty"`
+	// ConnectionNumber is the largest number of client connections
+	// of the benchmark, to size the file descriptor limits of the database.
+	ConnectionNumber int64 `protobuf:"varint,22,opt,name=ConnectionNumber,proto3" json:"ConnectionNumber,omitempty"`
+	// Phases are the workload phases so far, sent with 'Stop' to annotate
+	// monitor CSVs of the agent with the phase of each second.
+	Phases []*Phase `protobuf:"bytes,23,rep,name=Phases" json:"Phases,omitempty"`
+	// ExecCommand is the executable and its arguments to run on 'Exec',
+	// killed after 'ExecTimeoutSeconds'.
+	ExecCommand        []string `protobuf:"bytes,24,rep,name=ExecCommand" json:"ExecCommand,omitempty"`
+	ExecTimeoutSeconds int64    `protobuf:"varint,25,opt,name=ExecTimeoutSeconds,proto3" json:"ExecTimeoutSeconds,omitempty"`
+	// RestartDatabase restarts the database on 'DropPageCache'.
+	RestartDatabase bool `protobuf:"varint,26,opt,name=RestartDatabase,proto3" json:"RestartDatabase,omitempty"`
+	// DatabaseLogLevel is the log level of the database, if not default.
+	DatabaseLogLevel          string                     `protobuf:"bytes,27,opt,name=DatabaseLogLevel,proto3" json:"DatabaseLogLevel,omitempty"`
+	Flag_Etcd_Other           *Flag_Etcd_Other           `protobuf:"bytes,100,opt,name=flag__etcd__other,json=flagEtcdOther" json:"flag__etcd__other,omitempty"`
+	Flag_Etcd_Tip             *Flag_Etcd_Tip             `protobuf:"bytes,101,opt,name=flag__etcd__tip,json=flagEtcdTip" json:"flag__etcd__tip,omitempty"`
+	Flag_Etcd_V3_2            *Flag_Etcd_V3_2            `protobuf:"bytes,102,opt,name=flag__etcd__v3_2,json=flagEtcdV32" json:"flag__etcd__v3_2,omitempty"`
+	Flag_Etcd_V3_3            *Flag_Etcd_V3_3            `protobuf:"bytes,103,opt,name=flag__etcd__v3_3,json=flagEtcdV33" json:"flag__etcd__v3_3,omitempty"`
+	Flag_Zookeeper_R3_5_3Beta *Flag_Zookeeper_R3_5_3Beta `protobuf:"bytes,200,opt,name=flag__zookeeper__r3_5_3_beta,json=flagZookeeperR353Beta" json:"flag__zookeeper__r3_5_3_beta,omitempty"`
+	Flag_Consul_V1_0_2        *Flag_Consul_V1_0_2        `protobuf:"bytes,300,opt,name=flag__consul__v1_0_2,json=flagConsulV102" json:"flag__consul__v1_0_2,omitempty"`
+	Flag_Cetcd_Beta           *Flag_Cetcd_Beta           `protobuf:"bytes,400,opt,name=flag__cetcd__beta,json=flagCetcdBeta" json:"flag__cetcd__beta,omitempty"`
+	Flag_Zetcd_Beta           *Flag_Zetcd_Beta           `protobuf:"bytes,500,opt,name=flag__zetcd__beta,json=flagZetcdBeta" json:"flag__zetcd__beta,omitempty"`
 }
 
 func (m *Request) Reset()                    { *m = Request{} }
 func (m *Request) String() string            { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()               {}
-func (*Request) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{0} }
+func (*Request) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{2} }
 
 type Response struct {
 	Success bool `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
 	// DiskSpaceUsageBytes is the data size of the database on disk in bytes.
 	// It measures after database is requested to stop.
 	DiskSpaceUsageBytes int64 `protobuf:"varint,2,opt,name=DiskSpaceUsageBytes,proto3" json:"DiskSpaceUsageBytes,omitempty"`
+	// BackupSizeBytes is the size of the backup in bytes.
+	BackupSizeBytes int64 `protobuf:"varint,3,opt,name=BackupSizeBytes,proto3" json:"BackupSizeBytes,omitempty"`
+	// TookNanoseconds is the duration of backup or restore-to-usable.
+	TookNanoseconds int64 `protobuf:"varint,4,opt,name=TookNanoseconds,proto3" json:"TookNanoseconds,omitempty"`
+	// EtcdGitSHA is the commit of etcd built from 'EtcdGitRef'.
+	EtcdGitSHA string `protobuf:"bytes,5,opt,name=EtcdGitSHA,proto3" json:"EtcdGitSHA,omitempty"`
+	// ClockSkewNanoseconds is the clock offset applied by 'SkewClock' operation,
+	// measured as wall clock change minus monotonic clock change.
+	ClockSkewNanoseconds int64 `protobuf:"varint,6,opt,name=ClockSkewNanoseconds,proto3" json:"ClockSkewNanoseconds,omitempty"`
+	// ReceivedBytes is the number of bytes received by the host network
+	// interfaces while 'RestartMember' catches up with the cluster.
+	ReceivedBytes int64 `protobuf:"varint,7,opt,name=ReceivedBytes,proto3" json:"ReceivedBytes,omitempty"`
+	// DiskWriteBytes is the number of bytes the database process caused to be
+	// written to storage (from /proc/<pid>/io), measured on 'Stop'.
+	DiskWriteBytes int64 `protobuf:"varint,8,opt,name=DiskWriteBytes,proto3" json:"DiskWriteBytes,omitempty"`
+	// DiskReadBytes is the number of bytes the database process caused to be
+	// fetched from storage, and LogicalReadBytes is the number of bytes it read
+	// via system calls including from page cache, measured on 'Stop'.
+	DiskReadBytes    int64 `protobuf:"varint,9,opt,name=DiskReadBytes,proto3" json:"DiskReadBytes,omitempty"`
+	LogicalReadBytes int64 `protobuf:"varint,10,opt,name=LogicalReadBytes,proto3" json:"LogicalReadBytes,omitempty"`
+	// HardwareProfile is the machine of the agent, returned on 'Profile'.
+	HardwareProfile *HardwareProfile `protobuf:"bytes,11,opt,name=HardwareProfile" json:"HardwareProfile,omitempty"`
+	// UnixNano is the wall clock of the agent, returned on 'Clock'.
+	UnixNano int64 `protobuf:"varint,12,opt,name=UnixNano,proto3" json:"UnixNano,omitempty"`
+	// PID is the process ID of the database, and DataDir is its data
+	// directory on the agent, on 'Start'.
+	PID     int64  `protobuf:"varint,13,opt,name=PID,proto3" json:"PID,omitempty"`
+	DataDir string `protobuf:"bytes,14,opt,name=DataDir,proto3" json:"DataDir,omitempty"`
+	// DatabaseVersion is the version reported by the database binary
+	// (e.g. '3.3.0'), empty if unknown.
+	DatabaseVersion string `protobuf:"bytes,15,opt,name=DatabaseVersion,proto3" json:"DatabaseVersion,omitempty"`
+	// Ports maps the name of each port the database listens on
+	// (e.g. 'client', 'peer') to the port number, on 'Start'.
+	Ports map[string]int64 `protobuf:"bytes,16,rep,name=Ports" json:"Ports,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Message describes what the agent did, for control to log.
+	Message string `protobuf:"bytes,17,opt,name=Message,proto3" json:"Message,omitempty"`
+	// ExecOutput is the combined output of the command on 'Exec'.
+	ExecOutput string `protobuf:"bytes,18,opt,name=ExecOutput,proto3" json:"ExecOutput,omitempty"`
+	// CPUArchitecture is the Go architecture of the agent (e.g. 'amd64',
+	// 'arm64'), on 'Start'.
+	CPUArchitecture string `protobuf:"bytes,19,opt,name=CPUArchitecture,proto3" json:"CPUArchitecture,omitempty"`
+	// PeakMemoryBytes is the peak memory of the database process (or of
+	// its cgroup, with cgroup v2 monitor backend), on 'Stop'.
+	PeakMemoryBytes int64 `protobuf:"varint,20,opt,name=PeakMemoryBytes,proto3" json:"PeakMemoryBytes,omitempty"`
+	// OOMKilled is true if the OOM killer killed the database process
+	// before 'Stop'.
+	OOMKilled bool `protobuf:"varint,21,opt,name=OOMKilled,proto3" json:"OOMKilled,omitempty"`
 }
 
 func (m *Response) Reset()                    { *m = Response{} }
 func (m *Response) String() string            { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()               {}
-func (*Response) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{1} }
+func (*Response) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{3} }
+
+type UploadRequest struct {
+	// TimeoutSeconds is the maximum duration to wait for uploads to complete.
+	TimeoutSeconds int64  `protobuf:"varint,1,opt,name=TimeoutSeconds,proto3" json:"TimeoutSeconds,omitempty"`
+	TestID         string `protobuf:"bytes,2,opt,name=TestID,proto3" json:"TestID,omitempty"`
+}
+
+func (m *UploadRequest) Reset()                    { *m = UploadRequest{} }
+func (m *UploadRequest) String() string            { return proto.CompactTextString(m) }
+func (*UploadRequest) ProtoMessage()               {}
+func (*UploadRequest) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{4} }
+
+type UploadFileStatus struct {
+	Source      string `protobuf:"bytes,1,opt,name=Source,proto3" json:"Source,omitempty"`
+	Destination string `protobuf:"bytes,2,opt,name=Destination,proto3" json:"Destination,omitempty"`
+	// State is one of "pending", "uploaded", or "failed".
+	State   string   `protobuf:"bytes,3,opt,name=State,proto3" json:"State,omitempty"`
+	Error   string   `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+	Objects []string `protobuf:"bytes,5,rep,name=Objects" json:"Objects,omitempty"`
+}
+
+func (m *UploadFileStatus) Reset()                    { *m = UploadFileStatus{} }
+func (m *UploadFileStatus) String() string            { return proto.CompactTextString(m) }
+func (*UploadFileStatus) ProtoMessage()               {}
+func (*UploadFileStatus) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{5} }
+
+type UploadResponse struct {
+	// Success is true when all files are uploaded.
+	Success bool                `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Files   []*UploadFileStatus `protobuf:"bytes,2,rep,name=Files" json:"Files,omitempty"`
+}
+
+func (m *UploadResponse) Reset()                    { *m = UploadResponse{} }
+func (m *UploadResponse) String() string            { return proto.CompactTextString(m) }
+func (*UploadResponse) ProtoMessage()               {}
+func (*UploadResponse) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{6} }
+
+type LogRequest struct {
+	// TailLines is the number of last lines to send first.
+	TailLines int64 `protobuf:"varint,1,opt,name=TailLines,proto3" json:"TailLines,omitempty"`
+	// Follow is true to keep sending lines as the database log grows.
+	Follow bool   `protobuf:"varint,2,opt,name=Follow,proto3" json:"Follow,omitempty"`
+	TestID string `protobuf:"bytes,3,opt,name=TestID,proto3" json:"TestID,omitempty"`
+}
+
+func (m *LogRequest) Reset()                    { *m = LogRequest{} }
+func (m *LogRequest) String() string            { return proto.CompactTextString(m) }
+func (*LogRequest) ProtoMessage()               {}
+func (*LogRequest) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{7} }
+
+type LogLine struct {
+	Line string `protobuf:"bytes,1,opt,name=Line,proto3" json:"Line,omitempty"`
+}
+
+func (m *LogLine) Reset()                    { *m = LogLine{} }
+func (m *LogLine) String() string            { return proto.CompactTextString(m) }
+func (*LogLine) ProtoMessage()               {}
+func (*LogLine) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{8} }
 
 func init() {
+	proto.RegisterType((*HardwareProfile)(nil), "dbtesterpb.HardwareProfile")
+	proto.RegisterType((*Phase)(nil), "dbtesterpb.Phase")
 	proto.RegisterType((*Request)(nil), "dbtesterpb.Request")
 	proto.RegisterType((*Response)(nil), "dbtesterpb.Response")
+	proto.RegisterType((*UploadRequest)(nil), "dbtesterpb.UploadRequest")
+	proto.RegisterType((*UploadFileStatus)(nil), "dbtesterpb.UploadFileStatus")
+	proto.RegisterType((*UploadResponse)(nil), "dbtesterpb.UploadResponse")
+	proto.RegisterType((*LogRequest)(nil), "dbtesterpb.LogRequest")
+	proto.RegisterType((*LogLine)(nil), "dbtesterpb.LogLine")
 	proto.RegisterEnum("dbtesterpb.Operation", Operation_name, Operation_value)
 }
 
@@ -99,6 +330,8 @@ const _ = grpc.SupportPackageIsVersion4
 
 type TransporterClient interface {
 	Transfer(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+	WaitUpload(ctx context.Context, in *UploadRequest, opts ...grpc.CallOption) (*UploadResponse, error)
+	StreamLog(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (Transporter_StreamLogClient, error)
 }
 
 type transporterClient struct {
@@ -118,10 +351,53 @@ func (c *transporterClient) Transfer(ctx context.Context, in *Request, opts ...g
 	return out, nil
 }
 
+func (c *transporterClient) WaitUpload(ctx context.Context, in *UploadRequest, opts ...grpc.CallOption) (*UploadResponse, error) {
+	out := new(UploadResponse)
+	err := grpc.Invoke(ctx, "/dbtesterpb.Transporter/WaitUpload", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transporterClient) StreamLog(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (Transporter_StreamLogClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Transporter_serviceDesc.Streams[0], c.cc, "/dbtesterpb.Transporter/StreamLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transporterStreamLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Transporter_StreamLogClient interface {
+	Recv() (*LogLine, error)
+	grpc.ClientStream
+}
+
+type transporterStreamLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *transporterStreamLogClient) Recv() (*LogLine, error) {
+	m := new(LogLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for Transporter service
 
 type TransporterServer interface {
 	Transfer(context.Context, *Request) (*Response, error)
+	WaitUpload(context.Context, *UploadRequest) (*UploadResponse, error)
+	StreamLog(*LogRequest, Transporter_StreamLogServer) error
 }
 
 func RegisterTransporterServer(s *grpc.Server, srv TransporterServer) {
@@ -146,6 +422,45 @@ func _Transporter_Transfer_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transporter_WaitUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransporterServer).WaitUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dbtesterpb.Transporter/WaitUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransporterServer).WaitUpload(ctx, req.(*UploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transporter_StreamLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransporterServer).StreamLog(m, &transporterStreamLogServer{stream})
+}
+
+type Transporter_StreamLogServer interface {
+	Send(*LogLine) error
+	grpc.ServerStream
+}
+
+type transporterStreamLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *transporterStreamLogServer) Send(m *LogLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Transporter_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dbtesterpb.Transporter",
 	HandlerType: (*TransporterServer)(nil),
@@ -154,11 +469,107 @@ var _Transporter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Transfer",
 			Handler:    _Transporter_Transfer_Handler,
 		},
+		{
+			MethodName: "WaitUpload",
+			Handler:    _Transporter_WaitUpload_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLog",
+			Handler:       _Transporter_StreamLog_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "dbtesterpb/message.proto",
 }
 
+func (m *HardwareProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HardwareProfile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.CPUModel) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.CPUModel)))
+		i += copy(dAtA[i:], m.CPUModel)
+	}
+	if m.CPUCount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.CPUCount))
+	}
+	if m.MemoryBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.MemoryBytes))
+	}
+	if len(m.DiskType) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DiskType)))
+		i += copy(dAtA[i:], m.DiskType)
+	}
+	if len(m.KernelVersion) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.KernelVersion)))
+		i += copy(dAtA[i:], m.KernelVersion)
+	}
+	if len(m.CPUArchitecture) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.CPUArchitecture)))
+		i += copy(dAtA[i:], m.CPUArchitecture)
+	}
+	return i, nil
+}
+
+func (m *Phase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Phase) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.StartUnixNano != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.StartUnixNano))
+	}
+	if m.EndUnixNano != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.EndUnixNano))
+	}
+	return i, nil
+}
+
 func (m *Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -226,6 +637,157 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if len(m.LatencyProfile) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.LatencyProfile)))
+		i += copy(dAtA[i:], m.LatencyProfile)
+	}
+	if m.ClusterSize != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ClusterSize))
+	}
+	if m.JoinExisting {
+		dAtA[i] = 0x58
+		i++
+		if m.JoinExisting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.EtcdGitRef) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.EtcdGitRef)))
+		i += copy(dAtA[i:], m.EtcdGitRef)
+	}
+	if len(m.TestID) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TestID)))
+		i += copy(dAtA[i:], m.TestID)
+	}
+	if m.PortOffset != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.PortOffset))
+	}
+	if m.DiskWriteDelayMs != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.DiskWriteDelayMs))
+	}
+	if m.DiskReadDelayMs != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.DiskReadDelayMs))
+	}
+	if m.ClockSkewMs != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ClockSkewMs))
+	}
+	if m.AntagonistCPUCores != 0 {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.AntagonistCPUCores))
+	}
+	if m.AntagonistMemoryBytes != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.AntagonistMemoryBytes))
+	}
+	if m.AntagonistDiskWriteBytesPerSecond != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.AntagonistDiskWriteBytesPerSecond))
+	}
+	if len(m.Durability) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Durability)))
+		i += copy(dAtA[i:], m.Durability)
+	}
+	if m.ConnectionNumber != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConnectionNumber))
+	}
+	if len(m.Phases) > 0 {
+		for _, msg := range m.Phases {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ExecCommand) > 0 {
+		for _, s := range m.ExecCommand {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.ExecTimeoutSeconds != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ExecTimeoutSeconds))
+	}
+	if m.RestartDatabase {
+		dAtA[i] = 0xd0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.RestartDatabase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.DatabaseLogLevel) > 0 {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DatabaseLogLevel)))
+		i += copy(dAtA[i:], m.DatabaseLogLevel)
+	}
 	if m.Flag_Etcd_Other != nil {
 		dAtA[i] = 0xa2
 		i++
@@ -355,161 +917,2427 @@ func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.DiskSpaceUsageBytes))
 	}
-	return i, nil
-}
-
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.BackupSizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.BackupSizeBytes))
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *Request) Size() (n int) {
-	var l int
-	_ = l
-	if m.Operation != 0 {
-		n += 1 + sovMessage(uint64(m.Operation))
+	if m.TookNanoseconds != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.TookNanoseconds))
 	}
-	if m.TriggerLogUpload {
-		n += 2
+	if len(m.EtcdGitSHA) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.EtcdGitSHA)))
+		i += copy(dAtA[i:], m.EtcdGitSHA)
 	}
-	if m.DatabaseID != 0 {
-		n += 1 + sovMessage(uint64(m.DatabaseID))
+	if m.ClockSkewNanoseconds != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ClockSkewNanoseconds))
 	}
-	l = len(m.DatabaseTag)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.ReceivedBytes != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ReceivedBytes))
 	}
-	l = len(m.PeerIPsString)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.DiskWriteBytes != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.DiskWriteBytes))
 	}
-	if m.IPIndex != 0 {
-		n += 1 + sovMessage(uint64(m.IPIndex))
+	if m.DiskReadBytes != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.DiskReadBytes))
 	}
-	if m.CurrentClientNumber != 0 {
-		n += 1 + sovMessage(uint64(m.CurrentClientNumber))
+	if m.LogicalReadBytes != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.LogicalReadBytes))
 	}
-	if m.ConfigClientMachineInitial != nil {
-		l = m.ConfigClientMachineInitial.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	if m.HardwareProfile != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.HardwareProfile.Size()))
+		n10, err := m.HardwareProfile.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
 	}
-	if m.Flag_Etcd_Other != nil {
-		l = m.Flag_Etcd_Other.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.UnixNano != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.UnixNano))
 	}
-	if m.Flag_Etcd_Tip != nil {
-		l = m.Flag_Etcd_Tip.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.PID != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.PID))
 	}
-	if m.Flag_Etcd_V3_2 != nil {
-		l = m.Flag_Etcd_V3_2.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if len(m.DataDir) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DataDir)))
+		i += copy(dAtA[i:], m.DataDir)
 	}
-	if m.Flag_Etcd_V3_3 != nil {
-		l = m.Flag_Etcd_V3_3.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if len(m.DatabaseVersion) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DatabaseVersion)))
+		i += copy(dAtA[i:], m.DatabaseVersion)
 	}
-	if m.Flag_Zookeeper_R3_5_3Beta != nil {
-		l = m.Flag_Zookeeper_R3_5_3Beta.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if len(m.Ports) > 0 {
+		keysForPorts := make([]string, 0, len(m.Ports))
+		for k, _ := range m.Ports {
+			keysForPorts = append(keysForPorts, string(k))
+		}
+		sortkeys.Strings(keysForPorts)
+		for _, k := range keysForPorts {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			v := m.Ports[string(k)]
+			mapSize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + sovMessage(uint64(v))
+			i = encodeVarintMessage(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(v))
+		}
 	}
-	if m.Flag_Consul_V1_0_2 != nil {
-		l = m.Flag_Consul_V1_0_2.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
 	}
-	if m.Flag_Cetcd_Beta != nil {
-		l = m.Flag_Cetcd_Beta.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if len(m.ExecOutput) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ExecOutput)))
+		i += copy(dAtA[i:], m.ExecOutput)
 	}
-	if m.Flag_Zetcd_Beta != nil {
-		l = m.Flag_Zetcd_Beta.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if len(m.CPUArchitecture) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.CPUArchitecture)))
+		i += copy(dAtA[i:], m.CPUArchitecture)
 	}
-	return n
+	if m.PeakMemoryBytes != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.PeakMemoryBytes))
+	}
+	if m.OOMKilled {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.OOMKilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
 }
 
-func (m *Response) Size() (n int) {
+func (m *UploadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
+	if m.TimeoutSeconds != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.TimeoutSeconds))
 	}
-	if m.DiskSpaceUsageBytes != 0 {
-		n += 1 + sovMessage(uint64(m.DiskSpaceUsageBytes))
+	if len(m.TestID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TestID)))
+		i += copy(dAtA[i:], m.TestID)
 	}
-	return n
+	return i, nil
 }
 
-func sovMessage(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *UploadFileStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadFileStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if len(m.Objects) > 0 {
+		for _, s := range m.Objects {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	return n
+	return i, nil
 }
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *UploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessage
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *UploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		dAtA[i] = 0x8
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		i++
+	}
+	if len(m.Files) > 0 {
+		for _, msg := range m.Files {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintMessage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	return i, nil
+}
+
+func (m *LogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TailLines != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.TailLines))
+	}
+	if m.Follow {
+		dAtA[i] = 0x10
+		i++
+		if m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
-			}
-			m.Operation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+		i++
+	}
+	if len(m.TestID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TestID)))
+		i += copy(dAtA[i:], m.TestID)
+	}
+	return i, nil
+}
+
+func (m *LogLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogLine) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Line) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Line)))
+		i += copy(dAtA[i:], m.Line)
+	}
+	return i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *HardwareProfile) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.CPUModel)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CPUCount != 0 {
+		n += 1 + sovMessage(uint64(m.CPUCount))
+	}
+	if m.MemoryBytes != 0 {
+		n += 1 + sovMessage(uint64(m.MemoryBytes))
+	}
+	l = len(m.DiskType)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.KernelVersion)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.CPUArchitecture)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Phase) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartUnixNano != 0 {
+		n += 1 + sovMessage(uint64(m.StartUnixNano))
+	}
+	if m.EndUnixNano != 0 {
+		n += 1 + sovMessage(uint64(m.EndUnixNano))
+	}
+	return n
+}
+
+func (m *Request) Size() (n int) {
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovMessage(uint64(m.Operation))
+	}
+	if m.TriggerLogUpload {
+		n += 2
+	}
+	if m.DatabaseID != 0 {
+		n += 1 + sovMessage(uint64(m.DatabaseID))
+	}
+	l = len(m.DatabaseTag)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.PeerIPsString)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.IPIndex != 0 {
+		n += 1 + sovMessage(uint64(m.IPIndex))
+	}
+	if m.CurrentClientNumber != 0 {
+		n += 1 + sovMessage(uint64(m.CurrentClientNumber))
+	}
+	if m.ConfigClientMachineInitial != nil {
+		l = m.ConfigClientMachineInitial.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.LatencyProfile)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ClusterSize != 0 {
+		n += 1 + sovMessage(uint64(m.ClusterSize))
+	}
+	if m.JoinExisting {
+		n += 2
+	}
+	l = len(m.EtcdGitRef)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.TestID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.PortOffset != 0 {
+		n += 1 + sovMessage(uint64(m.PortOffset))
+	}
+	if m.DiskWriteDelayMs != 0 {
+		n += 1 + sovMessage(uint64(m.DiskWriteDelayMs))
+	}
+	if m.DiskReadDelayMs != 0 {
+		n += 2 + sovMessage(uint64(m.DiskReadDelayMs))
+	}
+	if m.ClockSkewMs != 0 {
+		n += 2 + sovMessage(uint64(m.ClockSkewMs))
+	}
+	if m.AntagonistCPUCores != 0 {
+		n += 2 + sovMessage(uint64(m.AntagonistCPUCores))
+	}
+	if m.AntagonistMemoryBytes != 0 {
+		n += 2 + sovMessage(uint64(m.AntagonistMemoryBytes))
+	}
+	if m.AntagonistDiskWriteBytesPerSecond != 0 {
+		n += 2 + sovMessage(uint64(m.AntagonistDiskWriteBytesPerSecond))
+	}
+	l = len(m.Durability)
+	if l > 0 {
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.ConnectionNumber != 0 {
+		n += 2 + sovMessage(uint64(m.ConnectionNumber))
+	}
+	if len(m.Phases) > 0 {
+		for _, e := range m.Phases {
+			l = e.Size()
+			n += 2 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.ExecCommand) > 0 {
+		for _, s := range m.ExecCommand {
+			l = len(s)
+			n += 2 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.ExecTimeoutSeconds != 0 {
+		n += 2 + sovMessage(uint64(m.ExecTimeoutSeconds))
+	}
+	if m.RestartDatabase {
+		n += 3
+	}
+	l = len(m.DatabaseLogLevel)
+	if l > 0 {
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Etcd_Other != nil {
+		l = m.Flag_Etcd_Other.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Etcd_Tip != nil {
+		l = m.Flag_Etcd_Tip.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Etcd_V3_2 != nil {
+		l = m.Flag_Etcd_V3_2.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Etcd_V3_3 != nil {
+		l = m.Flag_Etcd_V3_3.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Zookeeper_R3_5_3Beta != nil {
+		l = m.Flag_Zookeeper_R3_5_3Beta.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Consul_V1_0_2 != nil {
+		l = m.Flag_Consul_V1_0_2.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Cetcd_Beta != nil {
+		l = m.Flag_Cetcd_Beta.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.Flag_Zetcd_Beta != nil {
+		l = m.Flag_Zetcd_Beta.Size()
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.DiskSpaceUsageBytes != 0 {
+		n += 1 + sovMessage(uint64(m.DiskSpaceUsageBytes))
+	}
+	if m.BackupSizeBytes != 0 {
+		n += 1 + sovMessage(uint64(m.BackupSizeBytes))
+	}
+	if m.TookNanoseconds != 0 {
+		n += 1 + sovMessage(uint64(m.TookNanoseconds))
+	}
+	l = len(m.EtcdGitSHA)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ClockSkewNanoseconds != 0 {
+		n += 1 + sovMessage(uint64(m.ClockSkewNanoseconds))
+	}
+	if m.ReceivedBytes != 0 {
+		n += 1 + sovMessage(uint64(m.ReceivedBytes))
+	}
+	if m.DiskWriteBytes != 0 {
+		n += 1 + sovMessage(uint64(m.DiskWriteBytes))
+	}
+	if m.DiskReadBytes != 0 {
+		n += 1 + sovMessage(uint64(m.DiskReadBytes))
+	}
+	if m.LogicalReadBytes != 0 {
+		n += 1 + sovMessage(uint64(m.LogicalReadBytes))
+	}
+	if m.HardwareProfile != nil {
+		l = m.HardwareProfile.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.UnixNano != 0 {
+		n += 1 + sovMessage(uint64(m.UnixNano))
+	}
+	if m.PID != 0 {
+		n += 1 + sovMessage(uint64(m.PID))
+	}
+	l = len(m.DataDir)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.DatabaseVersion)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Ports) > 0 {
+		for k, v := range m.Ports {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + sovMessage(uint64(v))
+			n += mapEntrySize + 2 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	l = len(m.ExecOutput)
+	if l > 0 {
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	l = len(m.CPUArchitecture)
+	if l > 0 {
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if m.PeakMemoryBytes != 0 {
+		n += 2 + sovMessage(uint64(m.PeakMemoryBytes))
+	}
+	if m.OOMKilled {
+		n += 3
+	}
+	return n
+}
+
+func (m *UploadRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.TimeoutSeconds != 0 {
+		n += 1 + sovMessage(uint64(m.TimeoutSeconds))
+	}
+	l = len(m.TestID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *UploadFileStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Objects) > 0 {
+		for _, s := range m.Objects {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UploadResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LogRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.TailLines != 0 {
+		n += 1 + sovMessage(uint64(m.TailLines))
+	}
+	if m.Follow {
+		n += 2
+	}
+	l = len(m.TestID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *LogLine) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Line)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HardwareProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HardwareProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HardwareProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUModel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CPUModel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUCount", wireType)
+			}
+			m.CPUCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPUCount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryBytes", wireType)
+			}
+			m.MemoryBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiskType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KernelVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KernelVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUArchitecture", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CPUArchitecture = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Phase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Phase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Phase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartUnixNano", wireType)
+			}
+			m.StartUnixNano = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartUnixNano |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndUnixNano", wireType)
+			}
+			m.EndUnixNano = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndUnixNano |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= (Operation(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerLogUpload", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TriggerLogUpload = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			}
+			m.DatabaseID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatabaseID |= (DatabaseID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPsString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerIPsString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IPIndex", wireType)
+			}
+			m.IPIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IPIndex |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentClientNumber", wireType)
+			}
+			m.CurrentClientNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentClientNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineInitial", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigClientMachineInitial == nil {
+				m.ConfigClientMachineInitial = &ConfigClientMachineInitial{}
+			}
+			if err := m.ConfigClientMachineInitial.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyProfile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatencyProfile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterSize", wireType)
+			}
+			m.ClusterSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinExisting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JoinExisting = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EtcdGitRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EtcdGitRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TestID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TestID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortOffset", wireType)
+			}
+			m.PortOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PortOffset |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskWriteDelayMs", wireType)
+			}
+			m.DiskWriteDelayMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskWriteDelayMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskReadDelayMs", wireType)
+			}
+			m.DiskReadDelayMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskReadDelayMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockSkewMs", wireType)
+			}
+			m.ClockSkewMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClockSkewMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntagonistCPUCores", wireType)
+			}
+			m.AntagonistCPUCores = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AntagonistCPUCores |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntagonistMemoryBytes", wireType)
+			}
+			m.AntagonistMemoryBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AntagonistMemoryBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntagonistDiskWriteBytesPerSecond", wireType)
+			}
+			m.AntagonistDiskWriteBytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AntagonistDiskWriteBytesPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Durability", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Durability = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionNumber", wireType)
+			}
+			m.ConnectionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectionNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, &Phase{})
+			if err := m.Phases[len(m.Phases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecCommand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecCommand = append(m.ExecCommand, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecTimeoutSeconds", wireType)
+			}
+			m.ExecTimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecTimeoutSeconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartDatabase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartDatabase = bool(v != 0)
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseLogLevel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseLogLevel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Other", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_Other == nil {
+				m.Flag_Etcd_Other = &Flag_Etcd_Other{}
+			}
+			if err := m.Flag_Etcd_Other.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Tip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_Tip == nil {
+				m.Flag_Etcd_Tip = &Flag_Etcd_Tip{}
+			}
+			if err := m.Flag_Etcd_Tip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_V3_2 == nil {
+				m.Flag_Etcd_V3_2 = &Flag_Etcd_V3_2{}
+			}
+			if err := m.Flag_Etcd_V3_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd_V3_3 == nil {
+				m.Flag_Etcd_V3_3 = &Flag_Etcd_V3_3{}
+			}
+			if err := m.Flag_Etcd_V3_3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 200:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_5_3Beta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Zookeeper_R3_5_3Beta == nil {
+				m.Flag_Zookeeper_R3_5_3Beta = &Flag_Zookeeper_R3_5_3Beta{}
+			}
+			if err := m.Flag_Zookeeper_R3_5_3Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 300:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V1_0_2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Consul_V1_0_2 == nil {
+				m.Flag_Consul_V1_0_2 = &Flag_Consul_V1_0_2{}
+			}
+			if err := m.Flag_Consul_V1_0_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 400:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Cetcd_Beta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Cetcd_Beta == nil {
+				m.Flag_Cetcd_Beta = &Flag_Cetcd_Beta{}
+			}
+			if err := m.Flag_Cetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 500:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zetcd_Beta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Zetcd_Beta == nil {
+				m.Flag_Zetcd_Beta = &Flag_Zetcd_Beta{}
+			}
+			if err := m.Flag_Zetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskSpaceUsageBytes", wireType)
+			}
+			m.DiskSpaceUsageBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskSpaceUsageBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupSizeBytes", wireType)
+			}
+			m.BackupSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackupSizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TookNanoseconds", wireType)
+			}
+			m.TookNanoseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TookNanoseconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EtcdGitSHA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EtcdGitSHA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockSkewNanoseconds", wireType)
+			}
+			m.ClockSkewNanoseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClockSkewNanoseconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedBytes", wireType)
+			}
+			m.ReceivedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskWriteBytes", wireType)
+			}
+			m.DiskWriteBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskWriteBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskReadBytes", wireType)
+			}
+			m.DiskReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskReadBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicalReadBytes", wireType)
+			}
+			m.LogicalReadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogicalReadBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardwareProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HardwareProfile == nil {
+				m.HardwareProfile = &HardwareProfile{}
+			}
+			if err := m.HardwareProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixNano", wireType)
+			}
+			m.UnixNano = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnixNano |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PID", wireType)
+			}
+			m.PID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PID |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ports == nil {
+				m.Ports = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Operation |= (Operation(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerLogUpload", wireType)
+			m.Ports[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -519,17 +3347,26 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TriggerLogUpload = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
 			}
-			m.DatabaseID = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecOutput", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -539,14 +3376,24 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatabaseID |= (DatabaseID(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecOutput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUArchitecture", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -571,13 +3418,13 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			m.CPUArchitecture = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerIPsString", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakMemoryBytes", wireType)
 			}
-			var stringLen uint64
+			m.PeakMemoryBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -587,26 +3434,86 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.PeakMemoryBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OOMKilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OOMKilled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerIPsString = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UploadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IPIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			m.IPIndex = 0
+			m.TimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -616,16 +3523,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IPIndex |= (uint32(b) & 0x7F) << shift
+				m.TimeoutSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentClientNumber", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TestID", wireType)
 			}
-			m.CurrentClientNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -635,16 +3542,76 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentClientNumber |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TestID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UploadFileStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadFileStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadFileStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineInitial", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -654,30 +3621,26 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigClientMachineInitial == nil {
-				m.ConfigClientMachineInitial = &ConfigClientMachineInitial{}
-			}
-			if err := m.ConfigClientMachineInitial.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 100:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Other", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -687,30 +3650,55 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_Other == nil {
-				m.Flag_Etcd_Other = &Flag_Etcd_Other{}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			if err := m.Flag_Etcd_Other.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 101:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Tip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -720,30 +3708,26 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_Tip == nil {
-				m.Flag_Etcd_Tip = &Flag_Etcd_Tip{}
-			}
-			if err := m.Flag_Etcd_Tip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 102:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -753,30 +3737,76 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_V3_2 == nil {
-				m.Flag_Etcd_V3_2 = &Flag_Etcd_V3_2{}
-			}
-			if err := m.Flag_Etcd_V3_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Objects = append(m.Objects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 103:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_3", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UploadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -786,28 +3816,15 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Etcd_V3_3 == nil {
-				m.Flag_Etcd_V3_3 = &Flag_Etcd_V3_3{}
-			}
-			if err := m.Flag_Etcd_V3_3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 200:
+			m.Success = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_5_3Beta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -831,18 +3848,66 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Zookeeper_R3_5_3Beta == nil {
-				m.Flag_Zookeeper_R3_5_3Beta = &Flag_Zookeeper_R3_5_3Beta{}
-			}
-			if err := m.Flag_Zookeeper_R3_5_3Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Files = append(m.Files, &UploadFileStatus{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 300:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V1_0_2", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TailLines", wireType)
+			}
+			m.TailLines = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -852,30 +3917,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.TailLines |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Consul_V1_0_2 == nil {
-				m.Flag_Consul_V1_0_2 = &Flag_Consul_V1_0_2{}
-			}
-			if err := m.Flag_Consul_V1_0_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 400:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Cetcd_Beta", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -885,30 +3936,17 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Cetcd_Beta == nil {
-				m.Flag_Cetcd_Beta = &Flag_Cetcd_Beta{}
-			}
-			if err := m.Flag_Cetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 500:
+			m.Follow = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zetcd_Beta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TestID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -918,24 +3956,20 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Zetcd_Beta == nil {
-				m.Flag_Zetcd_Beta = &Flag_Zetcd_Beta{}
-			}
-			if err := m.Flag_Zetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TestID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -958,7 +3992,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *LogLine) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -981,17 +4015,17 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogLine: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogLine: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1001,31 +4035,21 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiskSpaceUsageBytes", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
 			}
-			m.DiskSpaceUsageBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DiskSpaceUsageBytes |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Line = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -1155,50 +4179,114 @@ var (
 func init() { proto.RegisterFile("dbtesterpb/message.proto", fileDescriptorMessage) }
 
 var fileDescriptorMessage = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xdf, 0x4e, 0x1b, 0x47,
-	0x14, 0xc6, 0xbd, 0x98, 0x3f, 0xf6, 0x58, 0xa6, 0xee, 0x00, 0xd5, 0xc8, 0x50, 0x77, 0x85, 0x2a,
-	0x64, 0x21, 0xd5, 0x06, 0xaf, 0x68, 0xaf, 0x8b, 0x69, 0x8b, 0xa5, 0x26, 0xa0, 0xb1, 0xe1, 0x82,
-	0x9b, 0xd1, 0xec, 0xfa, 0x78, 0x59, 0x61, 0xef, 0x6c, 0x66, 0xc7, 0x28, 0xe1, 0x2e, 0x6f, 0x90,
-	0xcb, 0x3c, 0x44, 0x1e, 0x84, 0xcb, 0x3c, 0x42, 0x42, 0x5e, 0x21, 0x0f, 0x10, 0xed, 0xac, 0x17,
-	0x0f, 0xd8, 0x4e, 0xee, 0x7c, 0xbe, 0xef, 0x9b, 0xdf, 0xce, 0x1f, 0x9f, 0x83, 0x48, 0xdf, 0x55,
-	0x10, 0x2b, 0x90, 0x91, 0xdb, 0x1c, 0x41, 0x1c, 0x73, 0x1f, 0x1a, 0x91, 0x14, 0x4a, 0x60, 0x34,
-	0x75, 0xaa, 0x7f, 0xf8, 0x81, 0xba, 0x1e, 0xbb, 0x0d, 0x4f, 0x8c, 0x9a, 0xbe, 0xf0, 0x45, 0x53,
-	0x47, 0xdc, 0xf1, 0x40, 0x57, 0xba, 0xd0, 0xbf, 0xd2, 0xa5, 0xd5, 0x1d, 0x03, 0xda, 0xe7, 0x8a,
-	0xbb, 0x3c, 0x06, 0x16, 0xf4, 0x27, 0x6e, 0xd5, 0x70, 0x07, 0x43, 0xee, 0x33, 0x50, 0x5e, 0xe6,
-	0xfd, 0xf6, 0xdc, 0xbb, 0x13, 0xe2, 0x06, 0x20, 0x02, 0x39, 0x07, 0xad, 0x03, 0x9e, 0x08, 0xe3,
-	0xf1, 0x70, 0xe2, 0x6e, 0xcf, 0x2c, 0x37, 0xd8, 0x33, 0xa6, 0x67, 0x98, 0x7b, 0x86, 0xe9, 0x89,
-	0x70, 0x10, 0xf8, 0xcc, 0x1b, 0x06, 0x10, 0x2a, 0x36, 0xe2, 0xde, 0x75, 0x10, 0x4e, 0x6e, 0x65,
-	0xf7, 0x6d, 0x01, 0xad, 0x51, 0x78, 0x35, 0x86, 0x58, 0x61, 0x07, 0x15, 0xcf, 0x22, 0x90, 0x5c,
-	0x05, 0x22, 0x24, 0x96, 0x6d, 0xd5, 0xd7, 0x5b, 0x5b, 0x8d, 0x29, 0xa7, 0xf1, 0x68, 0xd2, 0x69,
-	0x0e, 0xef, 0xa3, 0x4a, 0x4f, 0x06, 0xbe, 0x0f, 0xf2, 0x7f, 0xe1, 0x5f, 0x44, 0x43, 0xc1, 0xfb,
-	0x64, 0xc9, 0xb6, 0xea, 0x05, 0x3a, 0xa3, 0xe3, 0x3f, 0x11, 0x3a, 0x99, 0x5c, 0x5f, 0xe7, 0x84,
-	0xe4, 0xf5, 0x17, 0x7e, 0x31, 0xbf, 0x30, 0x75, 0xa9, 0x91, 0xc4, 0x36, 0x2a, 0x65, 0x55, 0x8f,
-	0xfb, 0x64, 0xd9, 0xb6, 0xea, 0x45, 0x6a, 0x4a, 0xf8, 0x77, 0x54, 0x3e, 0x07, 0x90, 0x9d, 0xf3,
-	0xb8, 0xab, 0x64, 0x10, 0xfa, 0x64, 0x45, 0x67, 0x9e, 0x8a, 0x98, 0xa0, 0xb5, 0xce, 0x79, 0x27,
-	0xec, 0xc3, 0x6b, 0xb2, 0x6a, 0x5b, 0xf5, 0x32, 0xcd, 0x4a, 0x7c, 0x80, 0x36, 0xda, 0x63, 0x29,
-	0x21, 0x54, 0x6d, 0x7d, 0x4b, 0x2f, 0xc7, 0x23, 0x17, 0x24, 0x59, 0xb3, 0xad, 0x7a, 0x9e, 0xce,
-	0xb3, 0xf0, 0x00, 0x55, 0xdb, 0xfa, 0x5e, 0x53, 0xf5, 0x45, 0x7a, 0xab, 0x9d, 0x30, 0x50, 0x01,
-	0x1f, 0x92, 0x82, 0x6d, 0xd5, 0x4b, 0xad, 0x3d, 0xf3, 0x6c, 0x8b, 0xd3, 0xf4, 0x3b, 0x24, 0xfc,
-	0x1f, 0xfa, 0x59, 0x3f, 0xae, 0xfe, 0x57, 0x31, 0x26, 0xd4, 0x35, 0x48, 0xd2, 0xd7, 0xf8, 0x5f,
-	0x4d, 0xfc, 0x4c, 0x88, 0x96, 0x13, 0xe9, 0x1f, 0xe5, 0xf5, 0xcf, 0x92, 0x12, 0xff, 0x8d, 0x7e,
-	0x32, 0x33, 0x2a, 0x88, 0x08, 0x68, 0xcc, 0xf6, 0x22, 0x8c, 0x0a, 0x22, 0x5a, 0xca, 0x20, 0xbd,
-	0x20, 0xc2, 0x6d, 0x54, 0x31, 0xfd, 0x5b, 0x87, 0xb5, 0xc8, 0x40, 0x33, 0x76, 0x16, 0x31, 0x92,
-	0xcc, 0x14, 0x72, 0xe9, 0xb4, 0xe6, 0x40, 0x1c, 0xe2, 0xff, 0x10, 0xe2, 0x98, 0x10, 0x07, 0x0f,
-	0xd0, 0x4e, 0x1a, 0x78, 0xec, 0x27, 0xc6, 0xa4, 0xc3, 0x8e, 0x98, 0xc3, 0x5c, 0x50, 0x9c, 0xdc,
-	0x5b, 0x9a, 0x58, 0x9f, 0x25, 0xce, 0x5f, 0x40, 0xb7, 0x12, 0xf7, 0x2a, 0xf3, 0xa8, 0x73, 0xe4,
-	0x1c, 0x83, 0xe2, 0xf8, 0x0c, 0x6d, 0xa6, 0xcb, 0xd2, 0xb6, 0x64, 0xec, 0xf6, 0x90, 0x1d, 0xb0,
-	0x16, 0xf9, 0xb0, 0xa4, 0xf9, 0xf6, 0x2c, 0xff, 0x69, 0x90, 0xae, 0x27, 0x6a, 0x5b, 0x6b, 0x97,
-	0x87, 0x07, 0x2d, 0x7c, 0x9a, 0x3d, 0xa7, 0x97, 0x1e, 0x4d, 0xef, 0xf6, 0x5d, 0x7e, 0xd1, 0x7b,
-	0x1a, 0xa9, 0xf4, 0x3d, 0xdb, 0x89, 0xa0, 0xb7, 0xf6, 0x48, 0xba, 0x33, 0x48, 0x5f, 0x17, 0x92,
-	0xee, 0x9e, 0x93, 0xae, 0x32, 0xd2, 0xee, 0x25, 0x2a, 0x50, 0x88, 0x23, 0x11, 0xc6, 0x90, 0xb4,
-	0x48, 0x77, 0xec, 0x79, 0x10, 0xc7, 0x7a, 0x02, 0x14, 0x68, 0x56, 0x26, 0x2d, 0x72, 0x12, 0xc4,
-	0x37, 0xdd, 0x88, 0x7b, 0x70, 0x91, 0xcc, 0xd5, 0xe3, 0x37, 0x0a, 0x62, 0xdd, 0xeb, 0x79, 0x3a,
-	0xcf, 0xda, 0x6f, 0x1a, 0xf3, 0x04, 0x17, 0xd1, 0x4a, 0x57, 0x71, 0xa9, 0x2a, 0x39, 0x5c, 0x40,
-	0xcb, 0x5d, 0x25, 0xa2, 0x8a, 0x85, 0xcb, 0xa8, 0x78, 0x0a, 0x5c, 0x2a, 0x17, 0xb8, 0xaa, 0x2c,
-	0xb5, 0xfe, 0x45, 0xa5, 0x9e, 0xe4, 0x61, 0x1c, 0x09, 0xa9, 0x40, 0xe2, 0xbf, 0x50, 0x41, 0x97,
-	0x03, 0x90, 0x78, 0xc3, 0x3c, 0xd1, 0x64, 0x60, 0x55, 0x37, 0x9f, 0x8a, 0xe9, 0x11, 0x76, 0x73,
-	0xc7, 0x9b, 0xf7, 0x9f, 0x6b, 0xb9, 0xfb, 0x87, 0x9a, 0xf5, 0xf1, 0xa1, 0x66, 0x7d, 0x7a, 0xa8,
-	0x59, 0xef, 0xbf, 0xd4, 0x72, 0xee, 0xaa, 0x9e, 0x78, 0xce, 0xb7, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x9b, 0x7a, 0x8c, 0xc2, 0x23, 0x06, 0x00, 0x00,
+	// 1729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x57, 0xdd, 0x6e, 0xdb, 0xc8,
+	0x15, 0x8e, 0x2c, 0xff, 0x48, 0xa3, 0x58, 0x56, 0xc6, 0x4e, 0x3a, 0xab, 0x78, 0xbd, 0xae, 0x50,
+	0x04, 0xee, 0x02, 0x75, 0xb2, 0x52, 0xd3, 0x2e, 0x8a, 0xde, 0x24, 0xb2, 0x77, 0xe3, 0xae, 0x1c,
+	0x09, 0x94, 0x9c, 0x05, 0x72, 0x51, 0x61, 0x44, 0x1d, 0xd1, 0x53, 0x53, 0x1c, 0x76, 0x38, 0x74,
+	0xec, 0x3c, 0x45, 0xb1, 0x57, 0x7d, 0x88, 0x3e, 0x42, 0x1f, 0x20, 0x40, 0x6f, 0x7a, 0xd7, 0x5e,
+	0xb6, 0xe9, 0x2b, 0xec, 0x03, 0x14, 0x67, 0x86, 0x94, 0x28, 0x4a, 0xde, 0x5c, 0x49, 0xe7, 0x3b,
+	0x1f, 0x3f, 0xce, 0x9c, 0x99, 0xf3, 0x43, 0xc2, 0xc6, 0x23, 0x0d, 0x91, 0x06, 0x15, 0x8e, 0x9e,
+	0x4e, 0x21, 0x8a, 0xb8, 0x07, 0xc7, 0xa1, 0x92, 0x5a, 0x52, 0x32, 0xf7, 0xd4, 0x7f, 0xe5, 0x09,
+	0x7d, 0x19, 0x8f, 0x8e, 0x5d, 0x39, 0x7d, 0xea, 0x49, 0x4f, 0x3e, 0x35, 0x94, 0x51, 0x3c, 0x31,
+	0x96, 0x31, 0xcc, 0x3f, 0xfb, 0x68, 0x7d, 0x3f, 0x23, 0x3a, 0xe6, 0x9a, 0x8f, 0x78, 0x04, 0x43,
+	0x31, 0x4e, 0xbc, 0xf5, 0x8c, 0x77, 0xe2, 0x73, 0x6f, 0x08, 0xda, 0x4d, 0x7d, 0x5f, 0xe4, 0x7d,
+	0xef, 0xa5, 0xbc, 0x02, 0x08, 0x41, 0xad, 0x90, 0x36, 0x04, 0x57, 0x06, 0x51, 0xec, 0x27, 0xde,
+	0xc7, 0x4b, 0x8f, 0x67, 0xb4, 0x97, 0x9c, 0x6e, 0xc6, 0xf9, 0x24, 0xe3, 0x74, 0x65, 0x30, 0x11,
+	0xde, 0xd0, 0xf5, 0x05, 0x04, 0x7a, 0x38, 0xe5, 0xee, 0xa5, 0x08, 0x92, 0xa8, 0x34, 0xfe, 0x55,
+	0x20, 0x3b, 0xaf, 0xb8, 0x1a, 0xbf, 0xe3, 0x0a, 0x7a, 0x4a, 0x4e, 0x84, 0x0f, 0xb4, 0x4e, 0x4a,
+	0xed, 0xde, 0xc5, 0xb9, 0x1c, 0x83, 0xcf, 0x0a, 0x87, 0x85, 0xa3, 0xb2, 0x33, 0xb3, 0x13, 0x5f,
+	0x5b, 0xc6, 0x81, 0x66, 0x6b, 0x87, 0x85, 0xa3, 0xa2, 0x33, 0xb3, 0xe9, 0x21, 0xa9, 0x9c, 0xc3,
+	0x54, 0xaa, 0xdb, 0x97, 0xb7, 0x1a, 0x22, 0x56, 0x34, 0xee, 0x2c, 0x84, 0x4f, 0x9f, 0x88, 0xe8,
+	0x6a, 0x70, 0x1b, 0x02, 0x5b, 0xb7, 0xca, 0xa9, 0x4d, 0x7f, 0x41, 0xb6, 0xbf, 0x03, 0x15, 0x80,
+	0xff, 0x06, 0x54, 0x24, 0x64, 0xc0, 0x36, 0x0c, 0x61, 0x11, 0xa4, 0x47, 0x64, 0xa7, 0xdd, 0xbb,
+	0x78, 0xa1, 0xdc, 0x4b, 0xa1, 0xc1, 0xd5, 0xb1, 0x02, 0xb6, 0x69, 0x78, 0x79, 0xb8, 0xe1, 0x92,
+	0x8d, 0xde, 0x25, 0x8f, 0x80, 0x52, 0xb2, 0xfe, 0x9a, 0x4f, 0x21, 0xd9, 0x8a, 0xf9, 0x8f, 0x2f,
+	0xeb, 0x6b, 0xae, 0xf4, 0x45, 0x20, 0x6e, 0x5e, 0xf3, 0x40, 0x26, 0x7b, 0x59, 0x04, 0x71, 0x43,
+	0xa7, 0xc1, 0x78, 0xc6, 0x49, 0x36, 0x94, 0x81, 0x1a, 0x3f, 0x54, 0xc9, 0x96, 0x03, 0x7f, 0x8e,
+	0x21, 0xd2, 0xb4, 0x45, 0xca, 0xdd, 0x10, 0x14, 0xd7, 0xb8, 0x78, 0x7c, 0x59, 0xb5, 0xf9, 0xf0,
+	0x78, 0x7e, 0x0c, 0xc7, 0x33, 0xa7, 0x33, 0xe7, 0xd1, 0x2f, 0x49, 0x6d, 0xa0, 0x84, 0xe7, 0x81,
+	0xea, 0x48, 0xef, 0x22, 0xf4, 0x25, 0x1f, 0x9b, 0xb5, 0x94, 0x9c, 0x25, 0x9c, 0xfe, 0x86, 0x90,
+	0x93, 0xe4, 0xf6, 0x9d, 0x9d, 0x98, 0xd5, 0x54, 0x9b, 0x8f, 0xb2, 0x6f, 0x98, 0x7b, 0x9d, 0x0c,
+	0x13, 0xb7, 0x91, 0x5a, 0x03, 0xee, 0x25, 0x81, 0xcf, 0x42, 0x18, 0x8e, 0x1e, 0x80, 0x3a, 0xeb,
+	0x45, 0x7d, 0xad, 0x44, 0xe0, 0xa5, 0xb1, 0x5f, 0x00, 0x29, 0x23, 0x5b, 0x67, 0xbd, 0xb3, 0x60,
+	0x0c, 0x37, 0x26, 0xe6, 0xdb, 0x4e, 0x6a, 0xd2, 0x67, 0x64, 0xb7, 0x1d, 0x2b, 0x05, 0x81, 0x6e,
+	0x9b, 0x4b, 0xf6, 0x3a, 0x9e, 0x8e, 0x40, 0xb1, 0x2d, 0x13, 0xb0, 0x55, 0x2e, 0x3a, 0x21, 0xf5,
+	0xb6, 0xb9, 0x96, 0x16, 0x3d, 0xb7, 0x97, 0xf2, 0x2c, 0x10, 0x5a, 0x70, 0x9f, 0x95, 0x0e, 0x0b,
+	0x47, 0x95, 0xe6, 0x93, 0xec, 0xde, 0xee, 0x66, 0x3b, 0x3f, 0xa1, 0x44, 0x9f, 0x90, 0x6a, 0x87,
+	0x6b, 0x08, 0xdc, 0xdb, 0xe4, 0x76, 0xb3, 0xb2, 0xd9, 0x5a, 0x0e, 0xc5, 0x18, 0xb5, 0xfd, 0x18,
+	0xdf, 0xd5, 0x17, 0xef, 0x81, 0x11, 0x7b, 0xd4, 0x19, 0x88, 0x36, 0xc8, 0xfd, 0x3f, 0x48, 0x11,
+	0x9c, 0xde, 0x88, 0x48, 0x63, 0x88, 0x2a, 0xe6, 0x94, 0x16, 0x30, 0x7a, 0x40, 0xc8, 0xa9, 0x76,
+	0xc7, 0xdf, 0x0a, 0xed, 0xc0, 0x84, 0xdd, 0x37, 0x6f, 0xca, 0x20, 0xf4, 0x11, 0xd9, 0x1c, 0x40,
+	0xa4, 0xcf, 0x4e, 0xd8, 0xb6, 0xf1, 0x25, 0x16, 0x3e, 0xd7, 0x93, 0x4a, 0x77, 0x27, 0x93, 0x08,
+	0x34, 0xab, 0x9a, 0x97, 0x67, 0x10, 0xbc, 0x25, 0x98, 0x27, 0xdf, 0x2b, 0xa1, 0xe1, 0x04, 0x7c,
+	0x7e, 0x7b, 0x1e, 0xb1, 0x1d, 0xc3, 0x5a, 0xc2, 0x31, 0x43, 0x10, 0x73, 0x80, 0x8f, 0x53, 0x6a,
+	0xcd, 0x50, 0xf3, 0xb0, 0xdd, 0xb3, 0x74, 0xaf, 0xfa, 0x57, 0xf0, 0xee, 0x3c, 0x62, 0x0f, 0xd2,
+	0x3d, 0xcf, 0x20, 0x7a, 0x4c, 0xe8, 0x8b, 0x40, 0x73, 0x4f, 0x06, 0x22, 0xd2, 0x26, 0xcf, 0x15,
+	0x44, 0x8c, 0x1a, 0xe2, 0x0a, 0x0f, 0xfd, 0x35, 0x79, 0x38, 0x47, 0xb3, 0xb5, 0x60, 0xd7, 0x3c,
+	0xb2, 0xda, 0x49, 0x3b, 0xe4, 0xe7, 0x73, 0xc7, 0x6c, 0x3f, 0xc6, 0xd7, 0x03, 0xd5, 0x07, 0x57,
+	0x06, 0x63, 0xb6, 0x67, 0x14, 0x3e, 0x4d, 0xc4, 0x58, 0x9e, 0xc4, 0x8a, 0x8f, 0x84, 0x2f, 0xf4,
+	0x2d, 0x7b, 0x68, 0xcf, 0x60, 0x8e, 0x60, 0x2c, 0xdb, 0x32, 0x08, 0xc0, 0xc5, 0xfc, 0x4b, 0x2e,
+	0xea, 0x23, 0x1b, 0xcb, 0x3c, 0x4e, 0x7f, 0x49, 0x36, 0x4d, 0x0d, 0x89, 0xd8, 0xcf, 0x0e, 0x8b,
+	0x47, 0x95, 0xe6, 0x83, 0xec, 0x8d, 0x34, 0x1e, 0x27, 0x21, 0x98, 0x5a, 0x71, 0x03, 0x6e, 0x5b,
+	0x4e, 0xa7, 0x3c, 0x18, 0x33, 0x76, 0x58, 0xc4, 0x24, 0xcb, 0x40, 0x18, 0x4c, 0x34, 0x07, 0x62,
+	0x0a, 0x32, 0xd6, 0x76, 0xb5, 0x11, 0xfb, 0xcc, 0x06, 0x73, 0xd9, 0x83, 0x07, 0xe9, 0x40, 0x84,
+	0x05, 0x29, 0x4d, 0x55, 0x56, 0x37, 0x77, 0x2e, 0x0f, 0x9b, 0xeb, 0x91, 0xfc, 0xef, 0x48, 0xaf,
+	0x03, 0xd7, 0xe0, 0xb3, 0xc7, 0x66, 0xe3, 0x4b, 0x38, 0xfd, 0x96, 0x3c, 0x30, 0xcd, 0xc2, 0x74,
+	0xa9, 0xe1, 0x50, 0xea, 0x4b, 0x50, 0x6c, 0x6c, 0xf2, 0xed, 0xf3, 0xec, 0xee, 0x96, 0x48, 0xce,
+	0x36, 0x42, 0x78, 0x99, 0xbb, 0x68, 0xd2, 0x17, 0x64, 0x27, 0xcb, 0xd1, 0x22, 0x64, 0x60, 0x64,
+	0x1e, 0xdf, 0x25, 0xa3, 0x45, 0xe8, 0x54, 0x52, 0x91, 0x81, 0x08, 0x69, 0x9b, 0xd4, 0xb2, 0xfe,
+	0xeb, 0xd6, 0xb0, 0xc9, 0x26, 0x46, 0x63, 0xff, 0x2e, 0x0d, 0xe4, 0xcc, 0x45, 0xde, 0xb4, 0x9a,
+	0x2b, 0x44, 0x5a, 0xcc, 0xfb, 0xa4, 0x48, 0x2b, 0x2b, 0xd2, 0xa2, 0x13, 0xb2, 0x6f, 0x09, 0xb3,
+	0xfe, 0x3c, 0x1c, 0xaa, 0xd6, 0xf0, 0xf9, 0xb0, 0x35, 0x1c, 0x81, 0xe6, 0xec, 0x43, 0xc1, 0x28,
+	0x1e, 0x2d, 0x2b, 0xae, 0x7e, 0xc0, 0x79, 0x88, 0xde, 0xb7, 0xa9, 0xcf, 0x69, 0x3d, 0x6f, 0xbd,
+	0x04, 0xcd, 0x69, 0x97, 0xec, 0xd9, 0xc7, 0x6c, 0x9b, 0x1f, 0x0e, 0xaf, 0xbf, 0x1a, 0x3e, 0x1b,
+	0x36, 0xd9, 0xdf, 0xd6, 0x8c, 0xfe, 0xe1, 0xb2, 0xfe, 0x22, 0xd1, 0xa9, 0x22, 0xda, 0x36, 0xd8,
+	0x9b, 0xaf, 0x9e, 0x35, 0xe9, 0xab, 0xf4, 0x38, 0x5d, 0xbb, 0x35, 0xb3, 0xda, 0xbf, 0x14, 0xef,
+	0x3a, 0xcf, 0x0c, 0xcb, 0x9e, 0x67, 0x1b, 0x01, 0xb3, 0xb4, 0x99, 0xd2, 0xfb, 0x8c, 0xd2, 0x8f,
+	0x77, 0x2a, 0xbd, 0xcf, 0x2b, 0xbd, 0x4d, 0x95, 0x1a, 0x3f, 0x6e, 0x92, 0x92, 0x03, 0x51, 0x28,
+	0x83, 0x08, 0xb0, 0x69, 0xf4, 0x63, 0xd7, 0x85, 0x28, 0x32, 0x3d, 0xb1, 0xe4, 0xa4, 0x26, 0x36,
+	0x0d, 0xcc, 0xe1, 0x7e, 0xc8, 0x5d, 0xb8, 0xc0, 0x41, 0xcd, 0x96, 0x0a, 0xdb, 0x89, 0x57, 0xb9,
+	0x30, 0x23, 0x5e, 0x72, 0xf7, 0x2a, 0x0e, 0xb1, 0x20, 0x67, 0x87, 0x8c, 0x3c, 0x8c, 0xcc, 0x81,
+	0x94, 0x57, 0xd8, 0xa3, 0xa3, 0x24, 0xd1, 0xd6, 0x2d, 0x33, 0x07, 0x67, 0x4a, 0x76, 0xff, 0xd5,
+	0x8b, 0xa4, 0xef, 0x65, 0x10, 0xda, 0x24, 0x7b, 0xb3, 0x8a, 0x98, 0x95, 0xdb, 0x34, 0x72, 0x2b,
+	0x7d, 0xd8, 0x4e, 0x1d, 0x70, 0x41, 0x5c, 0xc3, 0xd8, 0xae, 0xd2, 0x36, 0xc2, 0x45, 0x10, 0x5b,
+	0xd3, 0x62, 0x0d, 0x33, 0x6d, 0xaf, 0xe8, 0xe4, 0x50, 0x54, 0x4b, 0x2b, 0xb7, 0xa5, 0x95, 0xad,
+	0xda, 0x02, 0x88, 0x35, 0xa0, 0x23, 0x3d, 0xe1, 0x72, 0x7f, 0x4e, 0xb4, 0x5d, 0x6c, 0x09, 0xa7,
+	0xa7, 0x4b, 0x33, 0x1f, 0xab, 0x2c, 0xa7, 0x6e, 0x8e, 0xe2, 0xac, 0x9a, 0x13, 0x67, 0xb3, 0xd1,
+	0x7d, 0x3b, 0x0b, 0xa6, 0x36, 0xad, 0x91, 0x62, 0x2f, 0x69, 0x73, 0x45, 0x07, 0xff, 0xe2, 0x45,
+	0xc0, 0x62, 0x74, 0x22, 0x94, 0x69, 0x70, 0x65, 0x27, 0x35, 0x4d, 0xc7, 0x4a, 0xca, 0x54, 0x3a,
+	0xfb, 0xed, 0xd8, 0x99, 0x2e, 0x07, 0xd3, 0xe7, 0x64, 0x03, 0xbb, 0x22, 0x76, 0x34, 0x2c, 0xc7,
+	0x5f, 0x64, 0x97, 0x9b, 0xde, 0xb8, 0x63, 0xc3, 0x38, 0x0d, 0xb4, 0xba, 0x75, 0x2c, 0x1b, 0x5f,
+	0x7d, 0x6e, 0xbf, 0x05, 0x4c, 0x93, 0x2b, 0x3b, 0xa9, 0x69, 0x4e, 0xff, 0x06, 0xdc, 0x6e, 0xac,
+	0xc3, 0x58, 0x33, 0x9a, 0x9c, 0xfe, 0x0c, 0x59, 0x35, 0x6e, 0xee, 0xae, 0x1c, 0x37, 0x91, 0xd9,
+	0x03, 0x7e, 0x95, 0x6d, 0x7a, 0xb6, 0x65, 0xe5, 0x61, 0xba, 0x4f, 0xca, 0xdd, 0xee, 0xf9, 0x77,
+	0xc2, 0xf7, 0x61, 0x6c, 0xfa, 0x53, 0xc9, 0x99, 0x03, 0xf5, 0xaf, 0x09, 0x99, 0x6f, 0x00, 0xc3,
+	0x78, 0x05, 0xb7, 0xc9, 0xe8, 0x8a, 0x7f, 0xe9, 0x1e, 0xd9, 0xb8, 0xe6, 0x7e, 0x0c, 0x49, 0x9e,
+	0x58, 0xe3, 0x77, 0x6b, 0x5f, 0x17, 0x1a, 0x5d, 0xb2, 0x6d, 0x07, 0xc5, 0x74, 0x20, 0x7d, 0x42,
+	0xaa, 0xb9, 0x66, 0x53, 0xb0, 0x17, 0x6c, 0x11, 0xcd, 0x4c, 0x25, 0x6b, 0xd9, 0xa9, 0xa4, 0xf1,
+	0x43, 0x81, 0xd4, 0xac, 0xe2, 0x37, 0xc2, 0x87, 0xbe, 0xe6, 0x3a, 0x36, 0xe4, 0xbe, 0x8c, 0x95,
+	0x9b, 0xce, 0xd3, 0x89, 0x65, 0x86, 0x4c, 0xc0, 0x29, 0xc8, 0xce, 0xbf, 0x6b, 0xc9, 0x90, 0x39,
+	0x87, 0x70, 0xe5, 0xa8, 0x01, 0x26, 0x67, 0xcb, 0x8e, 0x35, 0x10, 0x3d, 0x55, 0x4a, 0xaa, 0x64,
+	0x2c, 0xb5, 0x06, 0x9e, 0x58, 0x77, 0xf4, 0x27, 0x70, 0x75, 0xc4, 0x36, 0x4c, 0x27, 0x4d, 0xcd,
+	0xc6, 0x1f, 0x49, 0x35, 0xdd, 0xe5, 0x27, 0x2b, 0x4c, 0x93, 0x6c, 0xe0, 0xca, 0xb1, 0xa6, 0x14,
+	0xf3, 0xfd, 0x20, 0xbf, 0x31, 0xc7, 0x52, 0x1b, 0x6f, 0x09, 0xe9, 0x48, 0x2f, 0x0d, 0xe1, 0x3e,
+	0x29, 0x0f, 0xb8, 0xf0, 0x3b, 0x22, 0x80, 0x34, 0x7a, 0x73, 0x00, 0x63, 0xf1, 0x8d, 0xf4, 0x7d,
+	0xf9, 0x2e, 0x19, 0xd9, 0x13, 0x2b, 0x13, 0xd0, 0xe2, 0x42, 0x40, 0x3f, 0x27, 0x5b, 0xd8, 0x87,
+	0x45, 0x60, 0x3e, 0x4a, 0xf0, 0x37, 0xfd, 0x28, 0xc1, 0xff, 0x5f, 0xfe, 0xbd, 0x90, 0xf9, 0x82,
+	0xa0, 0x65, 0x13, 0x2e, 0xa5, 0x6b, 0xf7, 0x68, 0x89, 0xac, 0xf7, 0xb5, 0x0c, 0x6b, 0x05, 0xba,
+	0x4d, 0xca, 0xaf, 0x80, 0x2b, 0x3d, 0x02, 0xae, 0x6b, 0x6b, 0x94, 0x90, 0x4d, 0x5b, 0xf9, 0x6a,
+	0x45, 0x5a, 0xc1, 0x2f, 0x91, 0x48, 0x4b, 0x05, 0xb5, 0x75, 0xe4, 0x61, 0x51, 0x32, 0xd5, 0xa9,
+	0xb6, 0x81, 0xbe, 0x8b, 0xd0, 0x53, 0x7c, 0x0c, 0xb5, 0x4d, 0x5a, 0x25, 0x04, 0xd5, 0xce, 0x01,
+	0x47, 0x9c, 0xda, 0x16, 0x7d, 0x40, 0xb6, 0x93, 0x81, 0x22, 0x81, 0x4a, 0xc8, 0x4f, 0x92, 0xbc,
+	0x56, 0xc6, 0x85, 0x58, 0x1d, 0x82, 0x0b, 0xc1, 0xe4, 0xa8, 0x55, 0xf0, 0xa1, 0x13, 0x25, 0xc3,
+	0x1e, 0xf7, 0xa0, 0xcd, 0xdd, 0x4b, 0xa8, 0xdd, 0x6f, 0xfe, 0xa3, 0x40, 0x2a, 0x03, 0xc5, 0x83,
+	0x28, 0x94, 0x4a, 0x83, 0xa2, 0xbf, 0x25, 0x25, 0x63, 0x4e, 0x40, 0xd1, 0xdd, 0xc5, 0x4c, 0x35,
+	0xc1, 0xad, 0xef, 0xad, 0x4a, 0xdf, 0xc6, 0x3d, 0x7a, 0x4a, 0xc8, 0xf7, 0x5c, 0xe8, 0xe4, 0xab,
+	0xe7, 0xb3, 0xe5, 0x53, 0x4b, 0x05, 0xea, 0xab, 0x5c, 0x33, 0x99, 0xdf, 0x93, 0x72, 0x5f, 0x2b,
+	0xe0, 0xd3, 0x8e, 0xf4, 0xe8, 0xc2, 0x77, 0xd2, 0xfc, 0x80, 0xeb, 0xbb, 0x39, 0x1c, 0x0f, 0xa2,
+	0x71, 0xef, 0x59, 0xe1, 0x25, 0xfb, 0xf0, 0xdf, 0x83, 0x7b, 0x1f, 0x3e, 0x1e, 0x14, 0xfe, 0xf9,
+	0xf1, 0xa0, 0xf0, 0xef, 0x8f, 0x07, 0x85, 0xff, 0x7c, 0x3c, 0x28, 0xfc, 0xf5, 0x7f, 0x07, 0xf7,
+	0x46, 0x9b, 0xe6, 0xcb, 0xb9, 0xf5, 0xff, 0x01, 0x00, 0x31, 0x10, 0xfc, 0x62, 0x6b, 0x10, 0x00,
+	0x00,
 }
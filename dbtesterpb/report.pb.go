@@ -0,0 +1,1630 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dbtesterpb/report.proto
+
+package dbtesterpb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import binary "encoding/binary"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Report is the machine-readable summary of a benchmark,
+// written in 'client_report_formats'.
+type Report struct {
+	DatabaseID          string  `protobuf:"bytes,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty"`
+	DatabaseTag         string  `protobuf:"bytes,2,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty"`
+	DatabaseDescription string  `protobuf:"bytes,3,opt,name=DatabaseDescription,proto3" json:"DatabaseDescription,omitempty"`
+	BenchmarkType       string  `protobuf:"bytes,4,opt,name=BenchmarkType,proto3" json:"BenchmarkType,omitempty"`
+	TotalSeconds        float64 `protobuf:"fixed64,5,opt,name=TotalSeconds,proto3" json:"TotalSeconds,omitempty"`
+	Requests            int64   `protobuf:"varint,6,opt,name=Requests,proto3" json:"Requests,omitempty"`
+	Errors              int64   `protobuf:"varint,7,opt,name=Errors,proto3" json:"Errors,omitempty"`
+	RequestsPerSecond   float64 `protobuf:"fixed64,8,opt,name=RequestsPerSecond,proto3" json:"RequestsPerSecond,omitempty"`
+	FastestLatencyMs    float64 `protobuf:"fixed64,9,opt,name=FastestLatencyMs,proto3" json:"FastestLatencyMs,omitempty"`
+	AverageLatencyMs    float64 `protobuf:"fixed64,10,opt,name=AverageLatencyMs,proto3" json:"AverageLatencyMs,omitempty"`
+	SlowestLatencyMs    float64 `protobuf:"fixed64,11,opt,name=SlowestLatencyMs,proto3" json:"SlowestLatencyMs,omitempty"`
+	StddevLatencyMs     float64 `protobuf:"fixed64,12,opt,name=StddevLatencyMs,proto3" json:"StddevLatencyMs,omitempty"`
+	// Percentiles are measured and coordinated-omission-corrected latencies.
+	Percentiles []*ReportPercentile `protobuf:"bytes,13,rep,name=Percentiles" json:"Percentiles,omitempty"`
+	ErrorDist   []*ReportError      `protobuf:"bytes,14,rep,name=ErrorDist" json:"ErrorDist,omitempty"`
+	// OpTypes are the summaries per operation type, if the workload mixes
+	// more than one type.
+	OpTypes []*ReportOpType `protobuf:"bytes,15,rep,name=OpTypes" json:"OpTypes,omitempty"`
+	SLOs    []*ReportSLO    `protobuf:"bytes,16,rep,name=SLOs" json:"SLOs,omitempty"`
+}
+
+func (m *Report) Reset()                    { *m = Report{} }
+func (m *Report) String() string            { return proto.CompactTextString(m) }
+func (*Report) ProtoMessage()               {}
+func (*Report) Descriptor() ([]byte, []int) { return fileDescriptorReport, []int{0} }
+
+type ReportPercentile struct {
+	Percentile         float64 `protobuf:"fixed64,1,opt,name=Percentile,proto3" json:"Percentile,omitempty"`
+	LatencyMs          float64 `protobuf:"fixed64,2,opt,name=LatencyMs,proto3" json:"LatencyMs,omitempty"`
+	CorrectedLatencyMs float64 `protobuf:"fixed64,3,opt,name=CorrectedLatencyMs,proto3" json:"CorrectedLatencyMs,omitempty"`
+}
+
+func (m *ReportPercentile) Reset()                    { *m = ReportPercentile{} }
+func (m *ReportPercentile) String() string            { return proto.CompactTextString(m) }
+func (*ReportPercentile) ProtoMessage()               {}
+func (*ReportPercentile) Descriptor() ([]byte, []int) { return fileDescriptorReport, []int{1} }
+
+type ReportError struct {
+	Error string `protobuf:"bytes,1,opt,name=Error,proto3" json:"Error,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (m *ReportError) Reset()                    { *m = ReportError{} }
+func (m *ReportError) String() string            { return proto.CompactTextString(m) }
+func (*ReportError) ProtoMessage()               {}
+func (*ReportError) Descriptor() ([]byte, []int) { return fileDescriptorReport, []int{2} }
+
+type ReportOpType struct {
+	OpType            string              `protobuf:"bytes,1,opt,name=OpType,proto3" json:"OpType,omitempty"`
+	Requests          int64               `protobuf:"varint,2,opt,name=Requests,proto3" json:"Requests,omitempty"`
+	Errors            int64               `protobuf:"varint,3,opt,name=Errors,proto3" json:"Errors,omitempty"`
+	RequestsPerSecond float64             `protobuf:"fixed64,4,opt,name=RequestsPerSecond,proto3" json:"RequestsPerSecond,omitempty"`
+	AverageLatencyMs  float64             `protobuf:"fixed64,5,opt,name=AverageLatencyMs,proto3" json:"AverageLatencyMs,omitempty"`
+	Percentiles       []*ReportPercentile `protobuf:"bytes,6,rep,name=Percentiles" json:"Percentiles,omitempty"`
+}
+
+func (m *ReportOpType) Reset()                    { *m = ReportOpType{} }
+func (m *ReportOpType) String() string            { return proto.CompactTextString(m) }
+func (*ReportOpType) ProtoMessage()               {}
+func (*ReportOpType) Descriptor() ([]byte, []int) { return fileDescriptorReport, []int{3} }
+
+type ReportSLO struct {
+	Metric string `protobuf:"bytes,1,opt,name=Metric,proto3" json:"Metric,omitempty"`
+	// Op is "<" for upper bounds, ">" for lower bounds.
+	Op        string  `protobuf:"bytes,2,opt,name=Op,proto3" json:"Op,omitempty"`
+	Threshold float64 `protobuf:"fixed64,3,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+	Value     float64 `protobuf:"fixed64,4,opt,name=Value,proto3" json:"Value,omitempty"`
+	Pass      bool    `protobuf:"varint,5,opt,name=Pass,proto3" json:"Pass,omitempty"`
+}
+
+func (m *ReportSLO) Reset()                    { *m = ReportSLO{} }
+func (m *ReportSLO) String() string            { return proto.CompactTextString(m) }
+func (*ReportSLO) ProtoMessage()               {}
+func (*ReportSLO) Descriptor() ([]byte, []int) { return fileDescriptorReport, []int{4} }
+
+func init() {
+	proto.RegisterType((*Report)(nil), "dbtesterpb.Report")
+	proto.RegisterType((*ReportPercentile)(nil), "dbtesterpb.ReportPercentile")
+	proto.RegisterType((*ReportError)(nil), "dbtesterpb.ReportError")
+	proto.RegisterType((*ReportOpType)(nil), "dbtesterpb.ReportOpType")
+	proto.RegisterType((*ReportSLO)(nil), "dbtesterpb.ReportSLO")
+}
+func (m *Report) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Report) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DatabaseID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintReport(dAtA, i, uint64(len(m.DatabaseID)))
+		i += copy(dAtA[i:], m.DatabaseID)
+	}
+	if len(m.DatabaseTag) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintReport(dAtA, i, uint64(len(m.DatabaseTag)))
+		i += copy(dAtA[i:], m.DatabaseTag)
+	}
+	if len(m.DatabaseDescription) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintReport(dAtA, i, uint64(len(m.DatabaseDescription)))
+		i += copy(dAtA[i:], m.DatabaseDescription)
+	}
+	if len(m.BenchmarkType) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintReport(dAtA, i, uint64(len(m.BenchmarkType)))
+		i += copy(dAtA[i:], m.BenchmarkType)
+	}
+	if m.TotalSeconds != 0 {
+		dAtA[i] = 0x29
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TotalSeconds))))
+		i += 8
+	}
+	if m.Requests != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintReport(dAtA, i, uint64(m.Requests))
+	}
+	if m.Errors != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintReport(dAtA, i, uint64(m.Errors))
+	}
+	if m.RequestsPerSecond != 0 {
+		dAtA[i] = 0x41
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RequestsPerSecond))))
+		i += 8
+	}
+	if m.FastestLatencyMs != 0 {
+		dAtA[i] = 0x49
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FastestLatencyMs))))
+		i += 8
+	}
+	if m.AverageLatencyMs != 0 {
+		dAtA[i] = 0x51
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AverageLatencyMs))))
+		i += 8
+	}
+	if m.SlowestLatencyMs != 0 {
+		dAtA[i] = 0x59
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SlowestLatencyMs))))
+		i += 8
+	}
+	if m.StddevLatencyMs != 0 {
+		dAtA[i] = 0x61
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.StddevLatencyMs))))
+		i += 8
+	}
+	if len(m.Percentiles) > 0 {
+		for _, msg := range m.Percentiles {
+			dAtA[i] = 0x6a
+			i++
+			i = encodeVarintReport(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ErrorDist) > 0 {
+		for _, msg := range m.ErrorDist {
+			dAtA[i] = 0x72
+			i++
+			i = encodeVarintReport(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.OpTypes) > 0 {
+		for _, msg := range m.OpTypes {
+			dAtA[i] = 0x7a
+			i++
+			i = encodeVarintReport(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.SLOs) > 0 {
+		for _, msg := range m.SLOs {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintReport(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ReportPercentile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportPercentile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		dAtA[i] = 0x9
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Percentile))))
+		i += 8
+	}
+	if m.LatencyMs != 0 {
+		dAtA[i] = 0x11
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LatencyMs))))
+		i += 8
+	}
+	if m.CorrectedLatencyMs != 0 {
+		dAtA[i] = 0x19
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CorrectedLatencyMs))))
+		i += 8
+	}
+	return i, nil
+}
+
+func (m *ReportError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintReport(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintReport(dAtA, i, uint64(m.Count))
+	}
+	return i, nil
+}
+
+func (m *ReportOpType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportOpType) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.OpType) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintReport(dAtA, i, uint64(len(m.OpType)))
+		i += copy(dAtA[i:], m.OpType)
+	}
+	if m.Requests != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintReport(dAtA, i, uint64(m.Requests))
+	}
+	if m.Errors != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintReport(dAtA, i, uint64(m.Errors))
+	}
+	if m.RequestsPerSecond != 0 {
+		dAtA[i] = 0x21
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RequestsPerSecond))))
+		i += 8
+	}
+	if m.AverageLatencyMs != 0 {
+		dAtA[i] = 0x29
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AverageLatencyMs))))
+		i += 8
+	}
+	if len(m.Percentiles) > 0 {
+		for _, msg := range m.Percentiles {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintReport(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ReportSLO) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportSLO) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metric) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintReport(dAtA, i, uint64(len(m.Metric)))
+		i += copy(dAtA[i:], m.Metric)
+	}
+	if len(m.Op) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintReport(dAtA, i, uint64(len(m.Op)))
+		i += copy(dAtA[i:], m.Op)
+	}
+	if m.Threshold != 0 {
+		dAtA[i] = 0x19
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Threshold))))
+		i += 8
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x21
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i += 8
+	}
+	if m.Pass {
+		dAtA[i] = 0x28
+		i++
+		if m.Pass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func encodeVarintReport(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Report) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DatabaseID)
+	if l > 0 {
+		n += 1 + l + sovReport(uint64(l))
+	}
+	l = len(m.DatabaseTag)
+	if l > 0 {
+		n += 1 + l + sovReport(uint64(l))
+	}
+	l = len(m.DatabaseDescription)
+	if l > 0 {
+		n += 1 + l + sovReport(uint64(l))
+	}
+	l = len(m.BenchmarkType)
+	if l > 0 {
+		n += 1 + l + sovReport(uint64(l))
+	}
+	if m.TotalSeconds != 0 {
+		n += 9
+	}
+	if m.Requests != 0 {
+		n += 1 + sovReport(uint64(m.Requests))
+	}
+	if m.Errors != 0 {
+		n += 1 + sovReport(uint64(m.Errors))
+	}
+	if m.RequestsPerSecond != 0 {
+		n += 9
+	}
+	if m.FastestLatencyMs != 0 {
+		n += 9
+	}
+	if m.AverageLatencyMs != 0 {
+		n += 9
+	}
+	if m.SlowestLatencyMs != 0 {
+		n += 9
+	}
+	if m.StddevLatencyMs != 0 {
+		n += 9
+	}
+	if len(m.Percentiles) > 0 {
+		for _, e := range m.Percentiles {
+			l = e.Size()
+			n += 1 + l + sovReport(uint64(l))
+		}
+	}
+	if len(m.ErrorDist) > 0 {
+		for _, e := range m.ErrorDist {
+			l = e.Size()
+			n += 1 + l + sovReport(uint64(l))
+		}
+	}
+	if len(m.OpTypes) > 0 {
+		for _, e := range m.OpTypes {
+			l = e.Size()
+			n += 1 + l + sovReport(uint64(l))
+		}
+	}
+	if len(m.SLOs) > 0 {
+		for _, e := range m.SLOs {
+			l = e.Size()
+			n += 2 + l + sovReport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReportPercentile) Size() (n int) {
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		n += 9
+	}
+	if m.LatencyMs != 0 {
+		n += 9
+	}
+	if m.CorrectedLatencyMs != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *ReportError) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovReport(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovReport(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *ReportOpType) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.OpType)
+	if l > 0 {
+		n += 1 + l + sovReport(uint64(l))
+	}
+	if m.Requests != 0 {
+		n += 1 + sovReport(uint64(m.Requests))
+	}
+	if m.Errors != 0 {
+		n += 1 + sovReport(uint64(m.Errors))
+	}
+	if m.RequestsPerSecond != 0 {
+		n += 9
+	}
+	if m.AverageLatencyMs != 0 {
+		n += 9
+	}
+	if len(m.Percentiles) > 0 {
+		for _, e := range m.Percentiles {
+			l = e.Size()
+			n += 1 + l + sovReport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReportSLO) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Metric)
+	if l > 0 {
+		n += 1 + l + sovReport(uint64(l))
+	}
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovReport(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 9
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.Pass {
+		n += 2
+	}
+	return n
+}
+
+func sovReport(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozReport(x uint64) (n int) {
+	return sovReport(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Report) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Report: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseDescription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BenchmarkType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BenchmarkType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TotalSeconds = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			m.Requests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Requests |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			m.Errors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Errors |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RequestsPerSecond = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FastestLatencyMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FastestLatencyMs = float64(math.Float64frombits(v))
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageLatencyMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AverageLatencyMs = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlowestLatencyMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SlowestLatencyMs = float64(math.Float64frombits(v))
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StddevLatencyMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.StddevLatencyMs = float64(math.Float64frombits(v))
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentiles = append(m.Percentiles, &ReportPercentile{})
+			if err := m.Percentiles[len(m.Percentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorDist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorDist = append(m.ErrorDist, &ReportError{})
+			if err := m.ErrorDist[len(m.ErrorDist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpTypes = append(m.OpTypes, &ReportOpType{})
+			if err := m.OpTypes[len(m.OpTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SLOs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SLOs = append(m.SLOs, &ReportSLO{})
+			if err := m.SLOs[len(m.SLOs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportPercentile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportPercentile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportPercentile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Percentile = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LatencyMs = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectedLatencyMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CorrectedLatencyMs = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportOpType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportOpType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportOpType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			m.Requests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Requests |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			m.Errors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Errors |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RequestsPerSecond = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageLatencyMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AverageLatencyMs = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentiles = append(m.Percentiles, &ReportPercentile{})
+			if err := m.Percentiles[len(m.Percentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportSLO) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportSLO: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportSLO: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metric = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Op = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Threshold = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pass", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pass = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReport(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReport
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthReport
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowReport
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipReport(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthReport = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReport   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("dbtesterpb/report.proto", fileDescriptorReport) }
+
+var fileDescriptorReport = []byte{
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdf, 0x6e, 0x12, 0x41,
+	0x14, 0xc6, 0xbb, 0x2c, 0xd0, 0x72, 0xa0, 0x2d, 0x8e, 0xd5, 0x4e, 0x9a, 0x66, 0x43, 0x36, 0x5e,
+	0xa0, 0x51, 0x30, 0x35, 0x5e, 0x78, 0x63, 0x62, 0x8b, 0x26, 0x26, 0x34, 0x90, 0x81, 0x78, 0xbf,
+	0x7f, 0x46, 0xd8, 0x48, 0x77, 0xd6, 0x99, 0xa1, 0xa6, 0xf1, 0xc6, 0xc7, 0xf0, 0x21, 0x7c, 0x90,
+	0x5e, 0xfa, 0x08, 0x8a, 0xaf, 0xe0, 0x03, 0x98, 0x3d, 0xbb, 0xb0, 0x0b, 0xdb, 0x6a, 0xbc, 0x9b,
+	0xef, 0x3b, 0xbf, 0x33, 0xec, 0xcc, 0xf9, 0x18, 0x38, 0xf4, 0x5d, 0xcd, 0x95, 0xe6, 0x32, 0x72,
+	0xbb, 0x92, 0x47, 0x42, 0xea, 0x4e, 0x24, 0x85, 0x16, 0x04, 0xb2, 0xc2, 0xd1, 0x93, 0x49, 0xa0,
+	0xa7, 0x73, 0xb7, 0xe3, 0x89, 0x8b, 0xee, 0x44, 0x4c, 0x44, 0x17, 0x11, 0x77, 0xfe, 0x1e, 0x15,
+	0x0a, 0x5c, 0x25, 0xad, 0xf6, 0xb7, 0x0a, 0x54, 0x19, 0xee, 0x45, 0x2c, 0x80, 0x9e, 0xa3, 0x1d,
+	0xd7, 0x51, 0xfc, 0x6d, 0x8f, 0x1a, 0x2d, 0xa3, 0x5d, 0x63, 0x39, 0x87, 0xb4, 0xa0, 0xbe, 0x54,
+	0x63, 0x67, 0x42, 0x4b, 0x08, 0xe4, 0x2d, 0xf2, 0x14, 0xee, 0x2e, 0x65, 0x8f, 0x2b, 0x4f, 0x06,
+	0x91, 0x0e, 0x44, 0x48, 0x4d, 0x24, 0x6f, 0x2a, 0x91, 0x07, 0xb0, 0x7b, 0xca, 0x43, 0x6f, 0x7a,
+	0xe1, 0xc8, 0x0f, 0xe3, 0xab, 0x88, 0xd3, 0x32, 0xb2, 0xeb, 0x26, 0xb1, 0xa1, 0x31, 0x16, 0xda,
+	0x99, 0x8d, 0xb8, 0x27, 0x42, 0x5f, 0xd1, 0x4a, 0xcb, 0x68, 0x1b, 0x6c, 0xcd, 0x23, 0x47, 0xb0,
+	0xc3, 0xf8, 0xc7, 0x39, 0x57, 0x5a, 0xd1, 0x6a, 0xcb, 0x68, 0x9b, 0x6c, 0xa5, 0xc9, 0x7d, 0xa8,
+	0xbe, 0x96, 0x52, 0x48, 0x45, 0xb7, 0xb1, 0x92, 0x2a, 0xf2, 0x18, 0xee, 0x2c, 0x99, 0x21, 0x97,
+	0xc9, 0x4e, 0x74, 0x07, 0x37, 0x2f, 0x16, 0xc8, 0x23, 0x68, 0xbe, 0x71, 0x54, 0x7c, 0xd1, 0x7d,
+	0x47, 0xf3, 0xd0, 0xbb, 0x3a, 0x57, 0xb4, 0x86, 0x70, 0xc1, 0x8f, 0xd9, 0x57, 0x97, 0x5c, 0x3a,
+	0x13, 0x9e, 0xb1, 0x90, 0xb0, 0x9b, 0x7e, 0xcc, 0x8e, 0x66, 0xe2, 0xd3, 0xda, 0xbe, 0xf5, 0x84,
+	0xdd, 0xf4, 0x49, 0x1b, 0xf6, 0x47, 0xda, 0xf7, 0xf9, 0x65, 0x86, 0x36, 0x10, 0xdd, 0xb4, 0xc9,
+	0x4b, 0xa8, 0x0f, 0xb9, 0xf4, 0x78, 0xa8, 0x83, 0x19, 0x57, 0x74, 0xb7, 0x65, 0xb6, 0xeb, 0x27,
+	0xc7, 0x9d, 0x2c, 0x29, 0x9d, 0x64, 0xec, 0x19, 0xc4, 0xf2, 0x0d, 0xe4, 0x39, 0xd4, 0xf0, 0x96,
+	0x7a, 0x81, 0xd2, 0x74, 0x0f, 0xbb, 0x0f, 0x8b, 0xdd, 0x88, 0xb0, 0x8c, 0x24, 0x27, 0xb0, 0x3d,
+	0x88, 0xe2, 0xa1, 0x29, 0xba, 0x8f, 0x4d, 0xb4, 0xd8, 0x94, 0x00, 0x6c, 0x09, 0x92, 0x87, 0x50,
+	0x1e, 0xf5, 0x07, 0x8a, 0x36, 0xb1, 0xe1, 0x5e, 0xb1, 0x61, 0xd4, 0x1f, 0x30, 0x44, 0xec, 0x2f,
+	0x06, 0x34, 0x37, 0xbf, 0x3b, 0x0e, 0x6e, 0xa6, 0x30, 0xb8, 0x06, 0xcb, 0x39, 0xe4, 0x18, 0x6a,
+	0xd9, 0x75, 0x95, 0xb0, 0x9c, 0x19, 0xa4, 0x03, 0xe4, 0x4c, 0x48, 0xc9, 0x3d, 0xcd, 0xfd, 0x0c,
+	0x33, 0x11, 0xbb, 0xa1, 0x62, 0xbf, 0x80, 0x7a, 0xee, 0xec, 0xe4, 0x00, 0x2a, 0xb8, 0x48, 0xff,
+	0x30, 0x95, 0x95, 0x7b, 0x26, 0xe6, 0xa1, 0xc6, 0x9f, 0x33, 0x59, 0x22, 0xec, 0xdf, 0x06, 0x34,
+	0xf2, 0x57, 0x10, 0x07, 0x33, 0x59, 0xa5, 0xdd, 0xa9, 0x5a, 0x0b, 0x73, 0xe9, 0xd6, 0x30, 0x9b,
+	0xff, 0x0e, 0x73, 0xf9, 0x2f, 0x61, 0x2e, 0x04, 0xb4, 0x72, 0x4b, 0x40, 0x37, 0xa2, 0x54, 0xfd,
+	0xcf, 0x28, 0xd9, 0x9f, 0xa1, 0xb6, 0x9a, 0x63, 0xfc, 0xf9, 0xe7, 0x5c, 0xcb, 0xc0, 0x5b, 0x1e,
+	0x39, 0x51, 0x64, 0x0f, 0x4a, 0x83, 0x28, 0x7d, 0x54, 0x4a, 0x83, 0x28, 0x1e, 0xda, 0x78, 0x2a,
+	0xb9, 0x9a, 0x8a, 0x99, 0x9f, 0x4e, 0x23, 0x33, 0xe2, 0xfb, 0x7d, 0xe7, 0xcc, 0xe6, 0x3c, 0x3d,
+	0x60, 0x22, 0x08, 0x81, 0xf2, 0xd0, 0x51, 0xc9, 0x41, 0x76, 0x18, 0xae, 0x4f, 0x0f, 0xae, 0x7f,
+	0x5a, 0x5b, 0xd7, 0x0b, 0xcb, 0xf8, 0xbe, 0xb0, 0x8c, 0x1f, 0x0b, 0xcb, 0xf8, 0xfa, 0xcb, 0xda,
+	0x72, 0xab, 0xf8, 0xfa, 0x3d, 0xfb, 0x33, 0x00, 0x04, 0xa6, 0x2f, 0xf3, 0x53, 0x05, 0x00, 0x00,
+}
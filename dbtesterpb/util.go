@@ -15,18 +15,94 @@
 package dbtesterpb
 
 import (
+	"fmt"
 	"image/color"
+	"path"
 	"sort"
+	"time"
 
 	"gonum.org/v1/plot/plotutil"
 )
 
+// Durability policies of the database write-ahead log.
+const (
+	DurabilityFsync   = "fsync"
+	DurabilityNoFsync = "no-fsync"
+)
+
+// Encodings of benchmark key numbers.
+const (
+	KeyEncodingDecimal = "decimal"
+	KeyEncodingBinary  = "binary"
+)
+
+// Log levels of databases.
+const (
+	LogLevelDebug = "debug"
+	LogLevelInfo  = "info"
+	LogLevelWarn  = "warn"
+	LogLevelError = "error"
+)
+
+// Orders of requests to agents.
+const (
+	BroadcastOrderParallel       = "parallel"
+	BroadcastOrderBootstrapFirst = "bootstrap-first"
+	BroadcastOrderSequential     = "sequential"
+)
+
+// Actions on differing hardware profiles of agents.
+const (
+	HardwareCheckWarn  = "warn"
+	HardwareCheckAbort = "abort"
+)
+
+// Targets of user hooks.
+const (
+	HookTargetLocal  = "local"
+	HookTargetAgents = "agents"
+)
+
+// HookPoints are the points around benchmark steps to run user hooks at.
+var HookPoints = []string{"pre-step1", "post-step1", "pre-step2", "post-step2", "pre-step3", "post-step3"}
+
+// Datacenter returns the datacenter name (e.g. 'dc2') of the server at
+// the peer index, the index of the first server in the datacenter, and
+// the number of servers in the datacenter. Without datacenter sizes,
+// all servers are in 'dc1', and size is zero.
+func (m *Flag_Consul_V1_0_2) Datacenter(idx int) (name string, first, size int) {
+	if m != nil {
+		for i, n := range m.DatacenterSizes {
+			if idx < first+int(n) {
+				return fmt.Sprintf("dc%d", i+1), first, int(n)
+			}
+			first += int(n)
+		}
+	}
+	return "dc1", 0, 0
+}
+
 // IsValidDatabaseID returns false if the database id is not supported.
 func IsValidDatabaseID(id string) bool {
 	_, ok := DatabaseID_value[id]
 	return ok
 }
 
+// PhaseAt returns the name of the phase at the unix second, or empty if none.
+// When phases change within the second, the last phase that began is returned.
+func PhaseAt(phases []*Phase, sec int64) string {
+	name := ""
+	for _, p := range phases {
+		if p.StartUnixNano/int64(time.Second) > sec {
+			break
+		}
+		if p.EndUnixNano == 0 || p.EndUnixNano/int64(time.Second) >= sec {
+			name = p.Name
+		}
+	}
+	return name
+}
+
 // GetAllDatabaseIDs returns all database ids.
 func GetAllDatabaseIDs() []string {
 	var ids []string
@@ -37,6 +113,52 @@ func GetAllDatabaseIDs() []string {
 	return ids
 }
 
+// Destinations returns the remote storage destinations. If none is configured,
+// it returns Google Cloud Storage destination of 'google_cloud_storage_*' options,
+// or nothing if 'google_cloud_storage_bucket_name' is not set either.
+func (m *ConfigClientMachineInitial) Destinations() []*RemoteStorageDestination {
+	if len(m.RemoteStorageDestinations) > 0 {
+		return m.RemoteStorageDestinations
+	}
+	if m.GoogleCloudStorageBucketName == "" {
+		return nil
+	}
+	return []*RemoteStorageDestination{{
+		Name:         "google-cloud-storage",
+		Type:         "google-cloud-storage",
+		Bucket:       m.GoogleCloudStorageBucketName,
+		SubDirectory: m.GoogleCloudStorageSubDirectory,
+	}}
+}
+
+// Offline returns true if no remote storage is configured, so that results
+// and logs are only kept on the local disks of control and agents.
+func (m *ConfigClientMachineInitial) Offline() bool {
+	return m == nil || len(m.Destinations()) == 0
+}
+
+// LabelPath returns the labels as 'key=value' path components,
+// sorted by key. It returns empty string if there is no label.
+func (m *ConfigClientMachineInitial) LabelPath() string {
+	keys := make([]string, 0, len(m.Labels))
+	for k := range m.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	ss := make([]string, 0, len(keys))
+	for _, k := range keys {
+		ss = append(ss, k+"="+m.Labels[k])
+	}
+	return path.Join(ss...)
+}
+
+// RunPath returns the directory of this run, 'test_name/run_timestamp',
+// under the label directories. Control uploads files to 'control' and
+// agents to 'member-N' under this directory.
+func (m *ConfigClientMachineInitial) RunPath() string {
+	return path.Join(m.LabelPath(), m.TestName, m.RunTimestamp)
+}
+
 func GetRGBI(databaseID string, i int) color.Color {
 	switch databaseID {
 	case "etcd__other":
@@ -0,0 +1,65 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import "github.com/etcd-io/dbtester/dbtesterpb"
+
+// zoneSlots is the number of endpoint slots handed out in round-robin
+// order, so that spillover is accurate to a percent.
+const zoneSlots = 100
+
+// zoneEndpoints returns the endpoints for read connections, preferring the
+// ones in the client zone. Connections are dialed in round-robin order over
+// the returned slots, of which 'zone_spillover_percent' go to endpoints in
+// other zones. The endpoints are returned as-is without 'client_zone'.
+func zoneEndpoints(gcfg dbtesterpb.ConfigClientMachineAgentControl, endpoints []string) []string {
+	if gcfg.ClientZone == "" || len(gcfg.EndpointZones) != len(gcfg.DatabaseEndpoints) {
+		return endpoints
+	}
+	zones := make(map[string]string, len(gcfg.DatabaseEndpoints))
+	for i, ep := range gcfg.DatabaseEndpoints {
+		zones[ep] = gcfg.EndpointZones[i]
+	}
+	var local, remote []string
+	for _, ep := range endpoints {
+		if zones[ep] == gcfg.ClientZone {
+			local = append(local, ep)
+		} else {
+			remote = append(remote, ep)
+		}
+	}
+	if len(local) == 0 {
+		return endpoints
+	}
+	spill := gcfg.ZoneSpilloverPercent
+	if spill <= 0 || len(remote) == 0 {
+		return local
+	}
+
+	// spread remote slots evenly, so that a few connections
+	// still spill over at the given percentage
+	slots := make([]string, zoneSlots)
+	var nl, nr int
+	for i := range slots {
+		if int(float64(i+1)*spill/100) > int(float64(i)*spill/100) {
+			slots[i] = remote[nr%len(remote)]
+			nr++
+		} else {
+			slots[i] = local[nl%len(local)]
+			nl++
+		}
+	}
+	return slots
+}
@@ -0,0 +1,161 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/pkg/remotestorage"
+)
+
+// ExperimentIndexPath is the path of the index of all experiment runs,
+// relative to the sub-directory of each remote storage destination.
+const ExperimentIndexPath = "index.json"
+
+// RunTimestampFormat is the format of run timestamps.
+const RunTimestampFormat = "20060102-150405"
+
+// ExperimentRun is a run of an experiment, uploaded to
+// 'test_name/run_timestamp/{control,member-1,member-2,...}'
+// under the label directories.
+type ExperimentRun struct {
+	TestName            string            `json:"test_name"`
+	RunTimestamp        string            `json:"run_timestamp"`
+	DatabaseID          string            `json:"database_id"`
+	DatabaseDescription string            `json:"database_description"`
+	Labels              map[string]string `json:"labels,omitempty"`
+	Path                string            `json:"path"`
+	Members             int               `json:"members"`
+	Success             bool              `json:"success"`
+}
+
+// ExperimentIndex lists all experiment runs in a bucket.
+type ExperimentIndex struct {
+	Runs []ExperimentRun `json:"runs"`
+}
+
+// Add adds or replaces the run of the same path and database.
+// Runs are sorted by test name and timestamp.
+func (idx *ExperimentIndex) Add(run ExperimentRun) {
+	replaced := false
+	for i := range idx.Runs {
+		if idx.Runs[i].Path == run.Path && idx.Runs[i].DatabaseID == run.DatabaseID {
+			idx.Runs[i], replaced = run, true
+			break
+		}
+	}
+	if !replaced {
+		idx.Runs = append(idx.Runs, run)
+	}
+	sort.Slice(idx.Runs, func(i, j int) bool {
+		if idx.Runs[i].TestName != idx.Runs[j].TestName {
+			return idx.Runs[i].TestName < idx.Runs[j].TestName
+		}
+		if idx.Runs[i].RunTimestamp != idx.Runs[j].RunTimestamp {
+			return idx.Runs[i].RunTimestamp < idx.Runs[j].RunTimestamp
+		}
+		return idx.Runs[i].DatabaseID < idx.Runs[j].DatabaseID
+	})
+}
+
+// controlUploadPath returns the destination path of control's file 'src'.
+func (cfg *Config) controlUploadPath(databaseTag, src string) string {
+	return path.Join(cfg.ConfigClientMachineInitial.RunPath(), "control", prefixDatabaseTag(databaseTag, filepath.Base(src)))
+}
+
+// prefixDatabaseTag prefixes the file name with the database tag,
+// since a run may test multiple databases.
+func prefixDatabaseTag(databaseTag, name string) string {
+	if strings.HasPrefix(name, databaseTag) {
+		return name
+	}
+	return fmt.Sprintf("%s-%s", databaseTag, name)
+}
+
+// SetRunTimestamp sets the run timestamp to now, if not configured.
+func (cfg *Config) SetRunTimestamp() {
+	if cfg.ConfigClientMachineInitial.RunTimestamp == "" {
+		cfg.ConfigClientMachineInitial.RunTimestamp = time.Now().UTC().Format(RunTimestampFormat)
+	}
+}
+
+// ReadExperimentIndex reads the experiment index from remote storage.
+// It returns an empty index if none exists yet.
+func (cfg *Config) ReadExperimentIndex() (*ExperimentIndex, error) {
+	if err := cfg.initUploader(); err != nil {
+		return nil, err
+	}
+	idx := &ExperimentIndex{}
+	b, err := cfg.uploader.ReadFile(ExperimentIndexPath)
+	if err == remotestorage.ErrNotExist {
+		return idx, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(b, idx); err != nil {
+		return nil, fmt.Errorf("failed to parse %q (%v)", ExperimentIndexPath, err)
+	}
+	return idx, nil
+}
+
+// UpdateExperimentIndex adds this run to the experiment index in remote storage.
+// Concurrent updates from different runs may overwrite each other.
+func (cfg *Config) UpdateExperimentIndex(databaseID string, success bool) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+	idx, err := cfg.ReadExperimentIndex()
+	if err != nil {
+		return err
+	}
+	idx.Add(ExperimentRun{
+		TestName:            cfg.ConfigClientMachineInitial.TestName,
+		RunTimestamp:        cfg.ConfigClientMachineInitial.RunTimestamp,
+		DatabaseID:          databaseID,
+		DatabaseDescription: gcfg.DatabaseDescription,
+		Labels:              cfg.ConfigClientMachineInitial.Labels,
+		Path:                cfg.ConfigClientMachineInitial.RunPath(),
+		Members:             len(gcfg.PeerIPs),
+		Success:             success,
+	})
+
+	b, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile("", "dbtester-index")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(f.Name())
+	if _, err = f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return cfg.uploader.UploadFile(f.Name(), ExperimentIndexPath, 30)
+}
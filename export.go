@@ -0,0 +1,204 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ipv4Regex matches IPv4 addresses, which are internal
+// unless they are of the database members.
+var ipv4Regex = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+
+// Anonymizer strips internal hostnames, IPs, and storage locations
+// from results and logs, to share them outside.
+type Anonymizer struct {
+	replacer *strings.Replacer
+	// hostRegex matches endpoint hostnames as whole words,
+	// nil if all endpoints are IPs
+	hostRegex *regexp.Regexp
+	// ips maps IPs and hostnames to their aliases, e.g. 'member-1' or 'host-1'
+	ips   map[string]string
+	hosts int
+}
+
+// NewAnonymizer returns an anonymizer for the results of the configuration.
+// IPs and endpoint hostnames of database members become 'member-N' in order
+// of 'peer_ips' and endpoints, other IPs become 'host-N', and storage
+// locations and secrets are redacted.
+func (cfg *Config) NewAnonymizer() *Anonymizer {
+	a := &Anonymizer{ips: make(map[string]string)}
+	member := func(host string) {
+		if _, ok := a.ips[host]; !ok && host != "" {
+			a.ips[host] = fmt.Sprintf("member-%d", len(a.ips)+1)
+		}
+	}
+
+	ids := make([]string, 0, len(cfg.DatabaseIDToConfigClientMachineAgentControl))
+	for id := range cfg.DatabaseIDToConfigClientMachineAgentControl {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		group := cfg.DatabaseIDToConfigClientMachineAgentControl[id]
+		for _, ip := range group.PeerIPs {
+			member(ip)
+		}
+		// endpoints are given as-is without peer IPs,
+		// and may name internal hosts
+		for _, ep := range append(group.AgentEndpoints, group.DatabaseEndpoints...) {
+			member(endpointHost(ep))
+		}
+	}
+
+	var pairs [][2]string
+	redact := func(old, alias string) {
+		if old != "" {
+			pairs = append(pairs, [2]string{old, alias})
+		}
+	}
+	var hosts []string
+	for host := range a.ips {
+		if !ipv4Regex.MatchString(host) {
+			hosts = append(hosts, regexp.QuoteMeta(host))
+		}
+	}
+	if len(hosts) > 0 {
+		// longer names first, as with the replacer below
+		sort.Slice(hosts, func(i, j int) bool { return len(hosts[i]) > len(hosts[j]) })
+		a.hostRegex = regexp.MustCompile(`\b(?:` + strings.Join(hosts, "|") + `)\b`)
+	}
+	ci := cfg.ConfigClientMachineInitial
+	redact(ci.GoogleCloudStorageKey, "<redacted-key>")
+	redact(ci.GoogleCloudStorageKeyPath, "<redacted-key-path>")
+	redact(ci.GoogleCloudProjectName, "<redacted-project>")
+	redact(ci.GoogleCloudStorageBucketName, "<redacted-bucket>")
+	redact(ci.GoogleCloudStorageSubDirectory, "<redacted-directory>")
+	for _, d := range ci.RemoteStorageDestinations {
+		redact(d.Bucket, "<redacted-bucket>")
+		redact(d.SubDirectory, "<redacted-directory>")
+		redact(d.LocalDirectory, "<redacted-directory>")
+	}
+	if host, err := os.Hostname(); err == nil {
+		redact(host, "<redacted-host>")
+	}
+	if home := os.Getenv("HOME"); home != "/" {
+		redact(home, "~")
+	}
+
+	// longer strings first, so that a bucket is not
+	// partially replaced by a shorter one it contains
+	sort.SliceStable(pairs, func(i, j int) bool { return len(pairs[i][0]) > len(pairs[j][0]) })
+	olds := make([]string, 0, 2*len(pairs))
+	for _, p := range pairs {
+		olds = append(olds, p[0], p[1])
+	}
+	a.replacer = strings.NewReplacer(olds...)
+	return a
+}
+
+// endpointHost returns the host of an endpoint, given
+// as 'host:port' or URL, or the endpoint without a port.
+func endpointHost(ep string) string {
+	if i := strings.Index(ep, "://"); i >= 0 {
+		ep = ep[i+3:]
+	}
+	ep = strings.SplitN(ep, "/", 2)[0]
+	if host, _, err := net.SplitHostPort(ep); err == nil {
+		return host
+	}
+	return ep
+}
+
+// Anonymize returns the anonymized text.
+func (a *Anonymizer) Anonymize(s string) string {
+	s = a.replacer.Replace(s)
+	if a.hostRegex != nil {
+		s = a.hostRegex.ReplaceAllStringFunc(s, func(host string) string { return a.ips[host] })
+	}
+	return ipv4Regex.ReplaceAllStringFunc(s, func(ip string) string {
+		alias, ok := a.ips[ip]
+		if !ok {
+			a.hosts++
+			alias = fmt.Sprintf("host-%d", a.hosts)
+			a.ips[ip] = alias
+		}
+		return alias
+	})
+}
+
+// ExportResults writes anonymized text files to a gzipped tarball at
+// 'outputPath', under the directory of the test name. Files are stored
+// by their base names, which are anonymized as well.
+func (cfg *Config) ExportResults(a *Anonymizer, paths []string, outputPath string) error {
+	f, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+
+	dir := a.Anonymize(cfg.ConfigClientMachineInitial.TestName)
+	if dir == "" {
+		dir = "dbtester-results"
+	}
+	now := time.Now()
+	seen := make(map[string]bool)
+	for _, p := range paths {
+		if strings.HasSuffix(p, ".gz") {
+			// rotated backups cannot be anonymized as text
+			cfg.lg.Sugar().Warnf("skipped exporting compressed file %q", p)
+			continue
+		}
+		b, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		name := filepath.Join(dir, a.Anonymize(filepath.Base(p)))
+		if seen[name] {
+			return fmt.Errorf("duplicate file name %q in export (%q)", name, p)
+		}
+		seen[name] = true
+
+		data := []byte(a.Anonymize(string(b)))
+		hdr := &tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), ModTime: now}
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err = tw.Write(data); err != nil {
+			return err
+		}
+		cfg.lg.Sugar().Infof("exported %q as %q", p, name)
+	}
+
+	if err = tw.Close(); err != nil {
+		return err
+	}
+	if err = gw.Close(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,64 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func TestAnonymizer(t *testing.T) {
+	cfg := &Config{
+		ConfigClientMachineInitial: dbtesterpb.ConfigClientMachineInitial{
+			GoogleCloudStorageBucketName:   "internal-bucket",
+			GoogleCloudStorageSubDirectory: "team-x/nightly",
+			RemoteStorageDestinations: []*dbtesterpb.RemoteStorageDestination{
+				{Bucket: "internal-bucket-backup", SubDirectory: "team-x/backup"},
+			},
+		},
+		DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+			"etcd__tip": {PeerIPs: []string{"10.240.0.7", "10.240.0.8"}},
+			"zookeeper__r3_5_3_beta": {
+				AgentEndpoints:    []string{"zk-1.corp.internal:3500"},
+				DatabaseEndpoints: []string{"zk-1.corp.internal:2181", "http://db:2181"},
+			},
+		},
+	}
+	a := cfg.NewAnonymizer()
+
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{
+			"10.240.0.8:2379 gs://internal-bucket-backup/a gs://internal-bucket/b 10.0.0.1 10.0.0.1",
+			"member-2:2379 gs://<redacted-bucket>/a gs://<redacted-bucket>/b host-1 host-1",
+		},
+		{
+			"connected to zk-1.corp.internal:2181 and db:2181 (dbtester)",
+			"connected to member-3:2181 and member-4:2181 (dbtester)",
+		},
+		{
+			"uploaded to team-x/nightly/a.csv and team-x/backup/a.csv",
+			"uploaded to <redacted-directory>/a.csv and <redacted-directory>/a.csv",
+		},
+	}
+	for i, tt := range tests {
+		if out := a.Anonymize(tt.in); out != tt.expected {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.expected, out)
+		}
+	}
+}
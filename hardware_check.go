@@ -0,0 +1,111 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// VerifyHardware compares the hardware profiles of all agents with the
+// first member, and logs or fails on differences per 'hardware_check'.
+func (cfg *Config) VerifyHardware(ctx context.Context, databaseID string) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("database id %q does not exist", databaseID)
+	}
+
+	idxs := make([]int, len(gcfg.AgentEndpoints))
+	for i := range idxs {
+		idxs[i] = i
+	}
+	idxToResp, err := cfg.sendRequests(ctx, databaseID, dbtesterpb.Operation_Profile, idxs, 0, false)
+	if err != nil {
+		return err
+	}
+	profiles := make([]*dbtesterpb.HardwareProfile, len(idxs))
+	for _, idx := range idxs {
+		p := idxToResp[idx].HardwareProfile
+		if p == nil {
+			return fmt.Errorf("agent %d returned no hardware profile", idx)
+		}
+		profiles[idx] = p
+		cfg.lg.Info("agent hardware",
+			zap.Int("index", idx),
+			zap.String("cpu-model", p.CPUModel),
+			zap.Int64("cpu-count", p.CPUCount),
+			zap.Int64("memory-bytes", p.MemoryBytes),
+			zap.String("disk-type", p.DiskType),
+			zap.String("kernel-version", p.KernelVersion),
+			zap.String("cpu-architecture", p.CPUArchitecture),
+		)
+	}
+
+	diffs := hardwareDiffs(profiles, gcfg.HardwareTolerancePercent)
+	if len(diffs) == 0 {
+		cfg.lg.Info("agents have homogeneous hardware", zap.Int("agents", len(profiles)))
+		return nil
+	}
+	for _, d := range diffs {
+		cfg.lg.Warn("agents have different hardware", zap.String("difference", d))
+	}
+	if gcfg.HardwareCheck == dbtesterpb.HardwareCheckAbort {
+		return fmt.Errorf("agents have different hardware (%s)", strings.Join(diffs, "; "))
+	}
+	return nil
+}
+
+// hardwareDiffs returns the differences of each profile from the first.
+// CPU count and memory may differ up to the tolerance in percent.
+func hardwareDiffs(profiles []*dbtesterpb.HardwareProfile, tolerancePercent float64) (diffs []string) {
+	if len(profiles) == 0 {
+		return nil
+	}
+	base := profiles[0]
+	within := func(v, b int64) bool {
+		if b == 0 {
+			return v == 0
+		}
+		return math.Abs(float64(v-b))/float64(b)*100 <= tolerancePercent
+	}
+	for i, p := range profiles[1:] {
+		idx := i + 1
+		if p.CPUArchitecture != base.CPUArchitecture {
+			diffs = append(diffs, fmt.Sprintf("agent %d CPU architecture %q, agent 0 %q", idx, p.CPUArchitecture, base.CPUArchitecture))
+		}
+		if p.CPUModel != base.CPUModel {
+			diffs = append(diffs, fmt.Sprintf("agent %d CPU model %q, agent 0 %q", idx, p.CPUModel, base.CPUModel))
+		}
+		if !within(p.CPUCount, base.CPUCount) {
+			diffs = append(diffs, fmt.Sprintf("agent %d CPU count %d, agent 0 %d", idx, p.CPUCount, base.CPUCount))
+		}
+		if !within(p.MemoryBytes, base.MemoryBytes) {
+			diffs = append(diffs, fmt.Sprintf("agent %d memory %d bytes, agent 0 %d bytes", idx, p.MemoryBytes, base.MemoryBytes))
+		}
+		if p.DiskType != base.DiskType {
+			diffs = append(diffs, fmt.Sprintf("agent %d disk type %q, agent 0 %q", idx, p.DiskType, base.DiskType))
+		}
+		if p.KernelVersion != base.KernelVersion {
+			diffs = append(diffs, fmt.Sprintf("agent %d kernel %q, agent 0 %q", idx, p.KernelVersion, base.KernelVersion))
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,287 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	// pure Go SQLite, so that dbtester builds without cgo
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+)
+
+// HistoryRun is the summary of a run in the local results history,
+// for trend tracking across runs without remote storage.
+type HistoryRun struct {
+	// RunID is 'test_name/run_timestamp'.
+	RunID               string            `json:"run_id"`
+	TestName            string            `json:"test_name"`
+	RunTimestamp        string            `json:"run_timestamp"`
+	DatabaseID          string            `json:"database_id"`
+	DatabaseDescription string            `json:"database_description"`
+	Labels              map[string]string `json:"labels,omitempty"`
+	Success             bool              `json:"success"`
+	// Metrics maps 'BenchmarkMetrics' to their values,
+	// without the metrics that were not measured.
+	Metrics map[string]float64 `json:"metrics,omitempty"`
+}
+
+// historySchema is the schema of the SQLite history file. Labels and
+// metrics are rows of their own tables, to filter runs by label in SQL.
+const historySchema = `
+CREATE TABLE IF NOT EXISTS runs (
+	id                   INTEGER PRIMARY KEY AUTOINCREMENT,
+	run_id               TEXT NOT NULL,
+	test_name            TEXT NOT NULL,
+	run_timestamp        TEXT NOT NULL,
+	database_id          TEXT NOT NULL,
+	database_description TEXT NOT NULL,
+	success              INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS runs_test_name ON runs (test_name, run_timestamp);
+CREATE TABLE IF NOT EXISTS labels (
+	run   INTEGER NOT NULL REFERENCES runs (id),
+	key   TEXT NOT NULL,
+	value TEXT NOT NULL,
+	PRIMARY KEY (run, key)
+);
+CREATE TABLE IF NOT EXISTS metrics (
+	run    INTEGER NOT NULL REFERENCES runs (id),
+	metric TEXT NOT NULL,
+	value  REAL NOT NULL,
+	PRIMARY KEY (run, metric)
+);
+`
+
+// openHistory opens the history file, creating it with the schema if not exists.
+func openHistory(fpath string) (*sql.DB, error) {
+	if err := os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+		return nil, err
+	}
+	// wait for concurrent writers (e.g. 'control' and 'serve')
+	dsn := "file:" + (&url.URL{Path: fpath}).EscapedPath() + "?_pragma=busy_timeout(10000)"
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = db.Exec(historySchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("%q: %v", fpath, err)
+	}
+	return db, nil
+}
+
+// AppendHistory appends the summary of this run to the history file.
+func (cfg *Config) AppendHistory(fpath, databaseID string, success bool) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+	run := HistoryRun{
+		RunID:               cfg.ConfigClientMachineInitial.TestName + "/" + cfg.ConfigClientMachineInitial.RunTimestamp,
+		TestName:            cfg.ConfigClientMachineInitial.TestName,
+		RunTimestamp:        cfg.ConfigClientMachineInitial.RunTimestamp,
+		DatabaseID:          databaseID,
+		DatabaseDescription: gcfg.DatabaseDescription,
+		Labels:              cfg.ConfigClientMachineInitial.Labels,
+		Success:             success,
+		Metrics:             make(map[string]float64),
+	}
+	for _, m := range BenchmarkMetrics {
+		// aborted runs may not have written all results
+		if v, err := cfg.ReadMetric(m); err == nil {
+			run.Metrics[m] = v
+		}
+	}
+	return AppendHistoryRuns(fpath, run)
+}
+
+// AppendHistoryRuns appends the runs to the history file in one transaction.
+func AppendHistoryRuns(fpath string, runs ...HistoryRun) error {
+	db, err := openHistory(fpath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, run := range runs {
+		if err = insertHistoryRun(tx, run); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func insertHistoryRun(tx *sql.Tx, run HistoryRun) error {
+	res, err := tx.Exec(
+		"INSERT INTO runs (run_id, test_name, run_timestamp, database_id, database_description, success) VALUES (?, ?, ?, ?, ?, ?)",
+		run.RunID, run.TestName, run.RunTimestamp, run.DatabaseID, run.DatabaseDescription, run.Success,
+	)
+	if err != nil {
+		return err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	for k, v := range run.Labels {
+		if _, err = tx.Exec("INSERT INTO labels (run, key, value) VALUES (?, ?, ?)", id, k, v); err != nil {
+			return err
+		}
+	}
+	for m, v := range run.Metrics {
+		if _, err = tx.Exec("INSERT INTO metrics (run, metric, value) VALUES (?, ?, ?)", id, m, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HistoryQuery filters runs of the history file.
+type HistoryQuery struct {
+	// TestName only matches runs of the test name, if not empty.
+	TestName string
+	// DatabaseID only matches runs of the database, if not empty.
+	DatabaseID string
+	// Labels only matches runs with all the labels.
+	Labels map[string]string
+	// Last only returns the last N matched runs, 0 for all.
+	Last int
+}
+
+// ReadHistory reads the runs of the history file, sorted by
+// run timestamp. It returns no run if the file does not exist.
+func ReadHistory(fpath string) ([]HistoryRun, error) {
+	return QueryHistory(fpath, HistoryQuery{})
+}
+
+// QueryHistory reads the runs of the history file that match the
+// query, sorted by run timestamp. It returns no run if the file
+// does not exist.
+func QueryHistory(fpath string, q HistoryQuery) ([]HistoryRun, error) {
+	if _, err := os.Stat(fpath); os.IsNotExist(err) {
+		return nil, nil
+	}
+	db, err := openHistory(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	var conds []string
+	var args []interface{}
+	if q.TestName != "" {
+		conds, args = append(conds, "test_name = ?"), append(args, q.TestName)
+	}
+	if q.DatabaseID != "" {
+		conds, args = append(conds, "database_id = ?"), append(args, q.DatabaseID)
+	}
+	for k, v := range q.Labels {
+		conds = append(conds, "EXISTS (SELECT 1 FROM labels WHERE labels.run = runs.id AND key = ? AND value = ?)")
+		args = append(args, k, v)
+	}
+	stmt := "SELECT id, run_id, test_name, run_timestamp, database_id, database_description, success FROM runs"
+	if len(conds) > 0 {
+		stmt += " WHERE " + strings.Join(conds, " AND ")
+	}
+	// select the last runs first, to limit to the last N
+	stmt += " ORDER BY run_timestamp DESC, id DESC"
+	if q.Last > 0 {
+		stmt, args = stmt+" LIMIT ?", append(args, q.Last)
+	}
+
+	rows, err := db.Query(stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	var ids []int64
+	var runs []HistoryRun
+	for rows.Next() {
+		var id int64
+		var run HistoryRun
+		if err = rows.Scan(&id, &run.RunID, &run.TestName, &run.RunTimestamp, &run.DatabaseID, &run.DatabaseDescription, &run.Success); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids, runs = append(ids, id), append(runs, run)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// reverse to the run timestamp order
+	for i, j := 0, len(runs)-1; i < j; i, j = i+1, j-1 {
+		ids[i], ids[j] = ids[j], ids[i]
+		runs[i], runs[j] = runs[j], runs[i]
+	}
+	for i := range runs {
+		if runs[i].Labels, err = readHistoryLabels(db, ids[i]); err != nil {
+			return nil, err
+		}
+		if runs[i].Metrics, err = readHistoryMetrics(db, ids[i]); err != nil {
+			return nil, err
+		}
+	}
+	return runs, nil
+}
+
+func readHistoryLabels(db *sql.DB, id int64) (map[string]string, error) {
+	rows, err := db.Query("SELECT key, value FROM labels WHERE run = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var labels map[string]string
+	for rows.Next() {
+		var k, v string
+		if err = rows.Scan(&k, &v); err != nil {
+			return nil, err
+		}
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[k] = v
+	}
+	return labels, rows.Err()
+}
+
+func readHistoryMetrics(db *sql.DB, id int64) (map[string]float64, error) {
+	rows, err := db.Query("SELECT metric, value FROM metrics WHERE run = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	metrics := make(map[string]float64)
+	for rows.Next() {
+		var m string
+		var v float64
+		if err = rows.Scan(&m, &v); err != nil {
+			return nil, err
+		}
+		metrics[m] = v
+	}
+	return metrics, rows.Err()
+}
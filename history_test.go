@@ -0,0 +1,111 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func TestHistory(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fpath := filepath.Join(dir, "history.db")
+
+	runs, err := ReadHistory(fpath)
+	if err != nil || len(runs) != 0 {
+		t.Fatalf("expected no run without file, got %v (%v)", runs, err)
+	}
+	if _, err = os.Stat(fpath); !os.IsNotExist(err) {
+		t.Fatalf("expected no file created on read, got %v", err)
+	}
+
+	cfg := &Config{DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+		"etcd__tip": {DatabaseDescription: "etcd tip"},
+	}}
+	cfg.ConfigClientMachineInitial.TestName = "write-1M"
+	cfg.ConfigClientMachineInitial.RunTimestamp = "2018010203"
+	cfg.ConfigClientMachineInitial.Labels = map[string]string{"durability": "fsync", "cache": "warm"}
+	// no result files, so no metric
+	if err = cfg.AppendHistory(fpath, "etcd__tip", false); err != nil {
+		t.Fatal(err)
+	}
+	if err = AppendHistoryRuns(fpath,
+		HistoryRun{RunID: "write-1M/2018010101", TestName: "write-1M", RunTimestamp: "2018010101", DatabaseID: "etcd__tip", Success: true, Labels: map[string]string{"durability": "fsync"}, Metrics: map[string]float64{"p99": 10.5, "throughput": 1000}},
+		HistoryRun{RunID: "read-1M/2018010102", TestName: "read-1M", RunTimestamp: "2018010102", DatabaseID: "zookeeper__r3_5_3_beta", Success: true, Metrics: map[string]float64{"p99": 5}},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	runs, err = ReadHistory(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, run := range runs {
+		ids = append(ids, run.RunID)
+	}
+	if exp := []string{"write-1M/2018010101", "read-1M/2018010102", "write-1M/2018010203"}; !reflect.DeepEqual(ids, exp) {
+		t.Fatalf("expected runs %q in run timestamp order, got %q", exp, ids)
+	}
+	if exp := (HistoryRun{
+		RunID:               "write-1M/2018010203",
+		TestName:            "write-1M",
+		RunTimestamp:        "2018010203",
+		DatabaseID:          "etcd__tip",
+		DatabaseDescription: "etcd tip",
+		Labels:              map[string]string{"durability": "fsync", "cache": "warm"},
+		Metrics:             map[string]float64{},
+	}); !reflect.DeepEqual(runs[2], exp) {
+		t.Fatalf("expected %+v, got %+v", exp, runs[2])
+	}
+	if exp := map[string]float64{"p99": 10.5, "throughput": 1000}; !reflect.DeepEqual(runs[0].Metrics, exp) {
+		t.Fatalf("expected metrics %v, got %v", exp, runs[0].Metrics)
+	}
+
+	tests := []struct {
+		q   HistoryQuery
+		ids []string
+	}{
+		{HistoryQuery{TestName: "write-1M"}, []string{"write-1M/2018010101", "write-1M/2018010203"}},
+		{HistoryQuery{DatabaseID: "zookeeper__r3_5_3_beta"}, []string{"read-1M/2018010102"}},
+		{HistoryQuery{Labels: map[string]string{"durability": "fsync"}}, []string{"write-1M/2018010101", "write-1M/2018010203"}},
+		{HistoryQuery{Labels: map[string]string{"durability": "fsync", "cache": "warm"}}, []string{"write-1M/2018010203"}},
+		{HistoryQuery{Labels: map[string]string{"durability": "none"}}, nil},
+		{HistoryQuery{Last: 2}, []string{"read-1M/2018010102", "write-1M/2018010203"}},
+		{HistoryQuery{TestName: "write-1M", Last: 1}, []string{"write-1M/2018010203"}},
+	}
+	for i, tt := range tests {
+		runs, err := QueryHistory(fpath, tt.q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, run := range runs {
+			ids = append(ids, run.RunID)
+		}
+		if !reflect.DeepEqual(ids, tt.ids) {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.ids, ids)
+		}
+	}
+}
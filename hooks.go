@@ -0,0 +1,129 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+// defaultHookTimeout is the timeout of hooks without 'timeout_seconds'.
+const defaultHookTimeout = time.Minute
+
+// RunHooks runs the hooks at the point (e.g. 'pre-step2') in order,
+// and returns the error of the first failed hook.
+func (cfg *Config) RunHooks(databaseID, point string) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("database id %q does not exist", databaseID)
+	}
+	for i, h := range gcfg.Hooks {
+		if h.Point != point {
+			continue
+		}
+		timeout := time.Duration(h.TimeoutSeconds) * time.Second
+		if timeout <= 0 {
+			timeout = defaultHookTimeout
+		}
+		cfg.lg.Info("running hook",
+			zap.Int("index", i),
+			zap.String("point", point),
+			zap.String("target", h.Target),
+			zap.Strings("command", h.Command),
+		)
+		var err error
+		switch h.Target {
+		case "", dbtesterpb.HookTargetLocal:
+			err = cfg.runLocalHook(gcfg, point, h.Command, timeout)
+		case dbtesterpb.HookTargetAgents:
+			err = cfg.runAgentHook(databaseID, h.Command, timeout)
+		default:
+			err = fmt.Errorf("unknown target %q", h.Target)
+		}
+		if err != nil {
+			return fmt.Errorf("hook %d at %q failed (%v)", i, point, err)
+		}
+	}
+	return nil
+}
+
+// runLocalHook runs the command on the control machine, with the
+// benchmark described in 'DBTESTER_*' environment variables.
+func (cfg *Config) runLocalHook(gcfg dbtesterpb.ConfigClientMachineAgentControl, point string, command []string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Env = append(os.Environ(),
+		"DBTESTER_HOOK_POINT="+point,
+		"DBTESTER_DATABASE_ID="+gcfg.DatabaseID,
+		"DBTESTER_DATABASE_ENDPOINTS="+strings.Join(gcfg.DatabaseEndpoints, ","),
+		"DBTESTER_AGENT_ENDPOINTS="+strings.Join(gcfg.AgentEndpoints, ","),
+		"DBTESTER_TEST_NAME="+cfg.ConfigClientMachineInitial.TestName,
+		"DBTESTER_RUN_TIMESTAMP="+cfg.ConfigClientMachineInitial.RunTimestamp,
+	)
+	o, err := cmd.CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("timed out after %v", timeout)
+	}
+	if err != nil {
+		return fmt.Errorf("%v (output %q)", err, o)
+	}
+	cfg.lg.Info("ran hook", zap.Strings("command", command), zap.String("output", string(o)))
+	return nil
+}
+
+// runAgentHook runs the command on all agents at once.
+func (cfg *Config) runAgentHook(databaseID string, command []string, timeout time.Duration) error {
+	gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+
+	type result struct {
+		idx    int
+		output string
+		err    error
+	}
+	resc := make(chan result, len(gcfg.AgentEndpoints))
+	for i, ep := range gcfg.AgentEndpoints {
+		go func(i int, ep string) {
+			req, err := cfg.ToRequest(databaseID, dbtesterpb.Operation_Exec, i)
+			if err != nil {
+				resc <- result{idx: i, err: err}
+				return
+			}
+			req.ExecCommand = command
+			req.ExecTimeoutSeconds = int64(timeout / time.Second)
+			resp, err := cfg.sendRequest(context.Background(), ep, i, req)
+			resc <- result{idx: i, output: resp.ExecOutput, err: err}
+		}(i, ep)
+	}
+
+	var errs []error
+	for range gcfg.AgentEndpoints {
+		rs := <-resc
+		if rs.err != nil {
+			errs = append(errs, rs.err)
+			continue
+		}
+		cfg.lg.Info("ran hook on agent", zap.Int("index", rs.idx), zap.Strings("command", command), zap.String("output", rs.output))
+	}
+	return joinErrors(errs)
+}
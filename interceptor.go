@@ -0,0 +1,157 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"plugin"
+	"sort"
+	"sync"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// ClientInterceptor intercepts the RPCs of etcd v3 benchmark clients,
+// e.g. for custom telemetry, header injection, or chaos.
+type ClientInterceptor interface {
+	// Unary returns the interceptor of unary RPCs, nil to skip.
+	Unary() grpc.UnaryClientInterceptor
+	// Stream returns the interceptor of streaming RPCs, nil to skip.
+	Stream() grpc.StreamClientInterceptor
+}
+
+// ClientInterceptorFunc creates a ClientInterceptor from the benchmark configuration.
+type ClientInterceptorFunc func(gcfg dbtesterpb.ConfigClientMachineAgentControl) (ClientInterceptor, error)
+
+var (
+	interceptorsMu sync.Mutex
+	interceptors   = make(map[string]ClientInterceptorFunc)
+)
+
+// RegisterClientInterceptor registers a client interceptor by name.
+// Go plugins should call this in their 'init' function.
+func RegisterClientInterceptor(name string, fn ClientInterceptorFunc) {
+	interceptorsMu.Lock()
+	defer interceptorsMu.Unlock()
+	if fn == nil {
+		panic("dbtester: RegisterClientInterceptor with nil ClientInterceptorFunc")
+	}
+	if _, ok := interceptors[name]; ok {
+		panic(fmt.Sprintf("dbtester: client interceptor %q is already registered", name))
+	}
+	interceptors[name] = fn
+}
+
+// RegisteredClientInterceptors returns the sorted names of registered client interceptors.
+func RegisteredClientInterceptors() []string {
+	interceptorsMu.Lock()
+	defer interceptorsMu.Unlock()
+	ns := make([]string, 0, len(interceptors))
+	for k := range interceptors {
+		ns = append(ns, k)
+	}
+	sort.Strings(ns)
+	return ns
+}
+
+// etcdv3DialOptions are the interceptors of etcd v3 clients
+// of the current benchmark.
+var etcdv3DialOptions = interceptorDialOptions(nil, nil)
+
+// newClientInterceptors loads the plugin, if any, and creates the
+// interceptors in the order of 'client_interceptors'.
+func newClientInterceptors(gcfg dbtesterpb.ConfigClientMachineAgentControl) ([]ClientInterceptor, error) {
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.ClientInterceptorPluginPath != "" {
+		// plugin 'init' registers the interceptors
+		if _, err := plugin.Open(opts.ClientInterceptorPluginPath); err != nil {
+			return nil, fmt.Errorf("failed to open client interceptor plugin %q (%v)", opts.ClientInterceptorPluginPath, err)
+		}
+	}
+
+	var ics []ClientInterceptor
+	for _, name := range opts.ClientInterceptors {
+		interceptorsMu.Lock()
+		fn, ok := interceptors[name]
+		interceptorsMu.Unlock()
+		if !ok {
+			return nil, fmt.Errorf("client interceptor %q is not registered (registered %q)", name, RegisteredClientInterceptors())
+		}
+		ic, err := fn(gcfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client interceptor %q (%v)", name, err)
+		}
+		ics = append(ics, ic)
+	}
+	return ics, nil
+}
+
+// interceptorDialOptions chains the interceptors after the priority
+// interceptors, since gRPC allows only one interceptor of each kind.
+// The first interceptor is the outermost.
+func interceptorDialOptions(unary []grpc.UnaryClientInterceptor, stream []grpc.StreamClientInterceptor) []grpc.DialOption {
+	unary = append([]grpc.UnaryClientInterceptor{priorityUnaryInterceptor}, unary...)
+	stream = append([]grpc.StreamClientInterceptor{priorityStreamInterceptor}, stream...)
+	return []grpc.DialOption{
+		grpc.WithUnaryInterceptor(chainUnary(unary)),
+		grpc.WithStreamInterceptor(chainStream(stream)),
+	}
+}
+
+// clientInterceptorDialOptions returns the dial options of the interceptors.
+func clientInterceptorDialOptions(ics []ClientInterceptor) []grpc.DialOption {
+	var (
+		unary  []grpc.UnaryClientInterceptor
+		stream []grpc.StreamClientInterceptor
+	)
+	for _, ic := range ics {
+		if u := ic.Unary(); u != nil {
+			unary = append(unary, u)
+		}
+		if s := ic.Stream(); s != nil {
+			stream = append(stream, s)
+		}
+	}
+	return interceptorDialOptions(unary, stream)
+}
+
+func chainUnary(ics []grpc.UnaryClientInterceptor) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		next := invoker
+		for i := len(ics) - 1; i >= 0; i-- {
+			ic, inner := ics[i], next
+			next = func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				return ic(ctx, method, req, reply, cc, inner, opts...)
+			}
+		}
+		return next(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func chainStream(ics []grpc.StreamClientInterceptor) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		next := streamer
+		for i := len(ics) - 1; i >= 0; i-- {
+			ic, inner := ics[i], next
+			next = func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+				return ic(ctx, desc, cc, method, inner, opts...)
+			}
+		}
+		return next(ctx, desc, cc, method, opts...)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/benchrunner"
+
+	"github.com/samuel/go-zookeeper/zk"
+	"go.uber.org/zap"
+)
+
+// maxKeyPathDirs limits the number of directories of hierarchical keys,
+// since Zookeeper parent znodes are created one by one.
+const maxKeyPathDirs = 1000000
+
+// benchmarkKey returns the key of the request number, encoded per
+// 'key_encoding' under 'key_prefix' and 'key_path_depth' directories.
+func benchmarkKey(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions, num int64) string {
+	var leaf string
+	switch opts.KeyEncoding {
+	case dbtesterpb.KeyEncodingBinary:
+		leaf = benchrunner.BinaryKey(opts.KeySizeBytes, num)
+	default:
+		leaf = benchrunner.SequentialKey(opts.KeySizeBytes, num)
+	}
+	elems := make([]string, 0, opts.KeyPathDepth+2)
+	if opts.KeyPrefix != "" {
+		elems = append(elems, opts.KeyPrefix)
+	}
+	for l, n := int64(0), num; l < opts.KeyPathDepth; l, n = l+1, n/opts.KeyPathFanout {
+		elems = append(elems, keyDir(opts.KeyPathFanout, n%opts.KeyPathFanout))
+	}
+	return strings.Join(append(elems, leaf), "/")
+}
+
+// keyNamespace returns the common prefix of benchmark keys,
+// empty if keys are not prefixed.
+func keyNamespace(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) string {
+	if opts.KeyPrefix == "" {
+		return ""
+	}
+	return opts.KeyPrefix + "/"
+}
+
+// keyDir returns the directory name of the index, zero-padded so that
+// directories of the same level sort in order.
+func keyDir(fanout, idx int64) string {
+	return fmt.Sprintf("%0*d", len(fmt.Sprint(fanout-1)), idx)
+}
+
+// keyDirs returns all directories of hierarchical keys, including
+// the directories of 'key_prefix', parents first.
+func keyDirs(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) []string {
+	var dirs []string
+	root := ""
+	if opts.KeyPrefix != "" {
+		for _, elem := range strings.Split(opts.KeyPrefix, "/") {
+			root += "/" + elem
+			dirs = append(dirs, root)
+		}
+	}
+	level := []string{root}
+	for l := int64(0); l < opts.KeyPathDepth; l++ {
+		next := make([]string, 0, len(level)*int(opts.KeyPathFanout))
+		for _, parent := range level {
+			for i := int64(0); i < opts.KeyPathFanout; i++ {
+				next = append(next, parent+"/"+keyDir(opts.KeyPathFanout, i))
+			}
+		}
+		dirs = append(dirs, next...)
+		level = next
+	}
+	return dirs
+}
+
+// numKeyDirs returns the number of directories of hierarchical keys,
+// or -1 if it exceeds 'maxKeyPathDirs'.
+func numKeyDirs(depth, fanout int64) int64 {
+	total, level := int64(0), int64(1)
+	for l := int64(0); l < depth; l++ {
+		level *= fanout
+		total += level
+		if level > maxKeyPathDirs || total > maxKeyPathDirs {
+			return -1
+		}
+	}
+	return total
+}
+
+// createKeyDirsZk creates the parent znodes of hierarchical keys,
+// skipping existing ones.
+func createKeyDirsZk(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) error {
+	dirs := keyDirs(gcfg.ConfigClientMachineBenchmarkOptions)
+	lg.Info("creating parent znodes", zap.Int("znodes", len(dirs)), zap.Int64("depth", gcfg.ConfigClientMachineBenchmarkOptions.KeyPathDepth))
+	conn := mustCreateConnsZk(gcfg.DatabaseEndpoints, 1)[0]
+	defer conn.Close()
+	for _, d := range dirs {
+		if _, err := conn.Create(d, nil, zkCreateFlags, zkCreateACL); err != nil && err != zk.ErrNodeExists {
+			return fmt.Errorf("%v (%q)", err, d)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/gyuho/dataframe"
+	"github.com/samuel/go-zookeeper/zk"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+const (
+	roleLeader   = "leader"
+	roleFollower = "follower"
+)
+
+// leaderTracker polls the role of each member every second during the
+// benchmark, so that per-member results can be labeled by role.
+type leaderTracker struct {
+	lg        *zap.Logger
+	endpoints []string
+	roles     func() []string
+	done      func()
+
+	mu      sync.Mutex
+	samples []leaderSample
+
+	stopc chan struct{}
+	donec chan struct{}
+}
+
+// leaderSample is the role of each member at a second,
+// empty if the role is unknown (e.g. member is down).
+type leaderSample struct {
+	unixSecond int64
+	roles      []string
+}
+
+// startLeaderTracker starts tracking member roles of the database.
+// It returns nil if the database does not expose its leader.
+func startLeaderTracker(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) *leaderTracker {
+	lt := &leaderTracker{
+		lg:        lg,
+		endpoints: gcfg.DatabaseEndpoints,
+		done:      func() {},
+		stopc:     make(chan struct{}),
+		donec:     make(chan struct{}),
+	}
+	switch gcfg.DatabaseID {
+	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		cli, err := clientv3.New(clientv3.Config{Endpoints: lt.endpoints, DialTimeout: 5 * time.Second})
+		if err != nil {
+			lg.Warn("failed to create etcd client to track leader", zap.Error(err))
+			return nil
+		}
+		lt.roles = func() []string { return rolesEtcdv3(cli, lt.endpoints) }
+		lt.done = func() { cli.Close() }
+
+	case "zookeeper__r3_5_3_beta":
+		lt.roles = func() []string { return rolesZk(lt.endpoints) }
+
+	case "consul__v1_0_2":
+		lt.roles = func() []string { return rolesConsul(lt.endpoints) }
+
+	default:
+		// zetcd and cetcd proxies do not have leaders
+		return nil
+	}
+
+	lg.Info("starting tracking leader", zap.Strings("endpoints", lt.endpoints))
+	go lt.run()
+	return lt
+}
+
+func (lt *leaderTracker) run() {
+	defer close(lt.donec)
+
+	var prev string
+	for {
+		select {
+		case <-lt.stopc:
+			return
+		case <-time.After(time.Second):
+		}
+
+		now := time.Now()
+		roles := lt.roles()
+		var leader string
+		for i, r := range roles {
+			if r == roleLeader {
+				leader = lt.endpoints[i]
+			}
+		}
+		if leader != prev {
+			lt.lg.Info("leader changed", zap.String("from", prev), zap.String("to", leader))
+			prev = leader
+		}
+
+		lt.mu.Lock()
+		lt.samples = append(lt.samples, leaderSample{unixSecond: now.Unix(), roles: roles})
+		lt.mu.Unlock()
+	}
+}
+
+// stop stops tracking, and returns all samples.
+func (lt *leaderTracker) stop() []leaderSample {
+	close(lt.stopc)
+	<-lt.donec
+	lt.done()
+
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	return lt.samples
+}
+
+func rolesEtcdv3(cli *clientv3.Client, endpoints []string) []string {
+	roles := make([]string, len(endpoints))
+	for i, ep := range endpoints {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		resp, err := cli.Status(ctx, ep)
+		cancel()
+		if err != nil {
+			continue
+		}
+		roles[i] = roleFollower
+		if resp.Header.MemberId == resp.Leader {
+			roles[i] = roleLeader
+		}
+	}
+	return roles
+}
+
+func rolesZk(endpoints []string) []string {
+	roles := make([]string, len(endpoints))
+	stats, _ := zk.FLWSrvr(endpoints, time.Second)
+	for i, st := range stats {
+		if i >= len(roles) || st.Error != nil {
+			continue
+		}
+		// observers and standalone servers are labeled as is
+		roles[i] = st.Mode.String()
+	}
+	return roles
+}
+
+func rolesConsul(endpoints []string) []string {
+	roles := make([]string, len(endpoints))
+	hc := &http.Client{Timeout: time.Second}
+	for i, ep := range endpoints {
+		leader, err := consulLeader(hc, ep)
+		if err != nil {
+			continue
+		}
+		roles[i] = roleFollower
+		if host, _, err := net.SplitHostPort(ep); err == nil && host == leader {
+			roles[i] = roleLeader
+		}
+	}
+	return roles
+}
+
+// consulLeader returns the host of the Raft leader
+// in the datacenter of the endpoint.
+func consulLeader(hc *http.Client, ep string) (string, error) {
+	resp, err := hc.Get("http://" + ep + "/v1/status/leader")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%q returned %q", ep, resp.Status)
+	}
+
+	// e.g. "10.240.0.7:8300", empty if no leader
+	var addr string
+	if err = json.NewDecoder(resp.Body).Decode(&addr); err != nil {
+		return "", err
+	}
+	host, _, err := net.SplitHostPort(addr)
+	return host, err
+}
+
+// saveLeaderTimeseries writes the role of each member by second.
+func (cfg *Config) saveLeaderTimeseries(gcfg dbtesterpb.ConfigClientMachineAgentControl, samples []leaderSample) error {
+	if cfg.ConfigClientMachineInitial.ClientLeaderTimeseriesPath == "" {
+		return nil
+	}
+
+	c1 := dataframe.NewColumn("UNIX-SECOND")
+	cols := make([]dataframe.Column, len(gcfg.DatabaseEndpoints))
+	for i := range cols {
+		cols[i] = dataframe.NewColumn(fmt.Sprintf("ROLE-%d", i+1))
+	}
+	for _, s := range samples {
+		c1.PushBack(dataframe.NewStringValue(s.unixSecond))
+		for i := range cols {
+			cols[i].PushBack(dataframe.NewStringValue(s.roles[i]))
+		}
+	}
+
+	fr := dataframe.New()
+	for _, col := range append([]dataframe.Column{c1}, cols...) {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientLeaderTimeseriesPath)
+}
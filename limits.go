@@ -0,0 +1,78 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/syslimit"
+
+	"go.uber.org/zap"
+)
+
+// openFilesMargin is added to the number of client connections,
+// for log, result, and data files.
+const openFilesMargin = 1024
+
+// maxConnectionNumber returns the largest number of client connections
+// that the benchmark opens at once.
+func maxConnectionNumber(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) int64 {
+	if opts == nil {
+		return 0
+	}
+	n := opts.ConnectionNumber
+	for _, cn := range opts.ConnectionClientNumbers {
+		if n < cn {
+			n = cn
+		}
+	}
+	return n
+}
+
+// CheckClientLimits tunes GOMAXPROCS and raises the open file limit
+// for the client connections of the benchmark, and verifies there are
+// enough ephemeral ports to dial each database endpoint. It returns an
+// error describing how to raise the limits that are too low.
+func (cfg *Config) CheckClientLimits(databaseID string) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q is not found", databaseID)
+	}
+	procs := syslimit.SetMaxProcs()
+
+	conns := maxConnectionNumber(gcfg.ConfigClientMachineBenchmarkOptions)
+	// connections are spread over the endpoints,
+	// and ephemeral ports are shared across destinations
+	perEndpoint := conns
+	if n := int64(len(gcfg.DatabaseEndpoints)); n > 1 {
+		perEndpoint = (conns + n - 1) / n
+	}
+	r := syslimit.Requirement{
+		OpenFiles:      uint64(conns) + openFilesMargin,
+		EphemeralPorts: perEndpoint,
+	}
+	cfg.lg.Info("checking client limits",
+		zap.String("database-id", databaseID),
+		zap.Int("gomaxprocs", procs),
+		zap.Int64("connections", conns),
+		zap.Uint64("open-files", r.OpenFiles),
+		zap.Int64("ephemeral-ports", r.EphemeralPorts),
+	)
+	if err := syslimit.Check(r); err != nil {
+		return fmt.Errorf("%d connections to %d endpoints: %v", conns, len(gcfg.DatabaseEndpoints), err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/coreos/etcd/pkg/report"
+	"github.com/gyuho/dataframe"
+)
+
+// Operation types of requests, to report mixed workloads per type.
+const (
+	OpTypeWrite  = "write"
+	OpTypeRead   = "read"
+	OpTypeRange  = "range"
+	OpTypeDelete = "delete"
+	OpTypeTxn    = "txn"
+
+	// Consul service catalog operations of 'consul-catalog'
+	OpTypeRegister    = "register"
+	OpTypeDeregister  = "deregister"
+	OpTypeCheckUpdate = "check-update"
+	OpTypeHealthQuery = "health-query"
+)
+
+// OpTypeSummaryColumns defines per-operation-type summary columns.
+var OpTypeSummaryColumns = []string{
+	"OP-TYPE",
+	"REQUESTS",
+	"ERRORS",
+	"REQUESTS-PER-SECOND",
+	"AVERAGE-LATENCY-MS",
+	"P50-LATENCY-MS",
+	"P90-LATENCY-MS",
+	"P99-LATENCY-MS",
+	"P99.9-LATENCY-MS",
+}
+
+// opType returns the operation type of the request.
+func (r *Request) opType() string {
+	switch {
+	case r.catalogOp.kind != "":
+		return r.catalogOp.kind
+	case r.zkOp.key != "":
+		if r.zkOp.value != nil {
+			return OpTypeWrite
+		}
+		return OpTypeRead
+	case r.consulOp.key != "":
+		if r.consulOp.value != nil {
+			return OpTypeWrite
+		}
+		return OpTypeRead
+	}
+	switch {
+	case r.etcdv3Op.IsPut():
+		return OpTypeWrite
+	case r.etcdv3Op.IsDelete():
+		return OpTypeDelete
+	case r.etcdv3Op.IsTxn():
+		return OpTypeTxn
+	case len(r.etcdv3Op.RangeBytes()) > 0:
+		return OpTypeRange
+	}
+	return OpTypeRead
+}
+
+// opTypePercentiles are the latency percentiles reported per operation type.
+var opTypePercentiles = []float64{50, 90, 99, 99.9}
+
+// opTypeRow returns the summary of the operation type. Throughput is over
+// the whole benchmark, since types may start and end at different times.
+func opTypeRow(opType string, st report.Stats, total report.Stats) []string {
+	errN := 0
+	for _, n := range st.ErrorDist {
+		errN += n
+	}
+	var rps float64
+	if total.Total > 0 {
+		rps = float64(len(st.Lats)) / total.Total.Seconds()
+	}
+	row := []string{
+		opType,
+		fmt.Sprint(len(st.Lats)),
+		fmt.Sprint(errN),
+		fmt.Sprintf("%4.4f", rps),
+		fmt.Sprintf("%4.4f", 1000*st.Average),
+	}
+	pctls, seconds := report.Percentiles(st.Lats)
+	for _, p := range opTypePercentiles {
+		for i := range pctls {
+			if pctls[i] == p {
+				row = append(row, fmt.Sprintf("%4.4f", 1000*seconds[i]))
+			}
+		}
+	}
+	return row
+}
+
+func sortedOpTypes(opStats map[string]report.Stats) []string {
+	types := make([]string, 0, len(opStats))
+	for t := range opStats {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// writeOpTypeStats writes the summary per operation type, if the
+// workload mixes more than one type.
+func writeOpTypeStats(w io.Writer, opStats map[string]report.Stats, total report.Stats) {
+	if len(opStats) < 2 {
+		return
+	}
+	fmt.Fprintln(w, "\nPer operation type (requests, errors, requests/sec, average, p50, p90, p99, p99.9 ms):")
+	for _, t := range sortedOpTypes(opStats) {
+		row := opTypeRow(t, opStats[t], total)
+		fmt.Fprintf(w, "  %s: %s\n", row[0], strings.Join(row[1:], ", "))
+	}
+}
+
+func (cfg *Config) saveOpTypeSummary(opStats map[string]report.Stats, total report.Stats) error {
+	cols := make([]dataframe.Column, len(OpTypeSummaryColumns))
+	for i := range cols {
+		cols[i] = dataframe.NewColumn(OpTypeSummaryColumns[i])
+	}
+	for _, t := range sortedOpTypes(opStats) {
+		for i, v := range opTypeRow(t, opStats[t], total) {
+			cols[i].PushBack(dataframe.NewStringValue(v))
+		}
+	}
+
+	fr := dataframe.New()
+	for _, col := range cols {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientOpTypeSummaryPath)
+}
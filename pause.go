@@ -0,0 +1,149 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Pauser pauses and resumes request generation of running benchmarks,
+// so that operators can take a manual action (e.g. defragmentation)
+// at a precise point in the workload. Paused intervals are annotated
+// in the latency/throughput time series.
+type Pauser struct {
+	mu sync.Mutex
+	// resumec is closed on resume, nil if not paused
+	resumec chan struct{}
+	gaps    []PauseGap
+}
+
+// PauseGap is an interval when request generation was paused.
+// End is zero while paused.
+type PauseGap struct {
+	Reason string    `json:"reason,omitempty"`
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end,omitempty"`
+}
+
+// NewPauser returns a new Pauser, which is not paused.
+func NewPauser() *Pauser {
+	return &Pauser{}
+}
+
+// Pause pauses request generation. In-flight requests complete,
+// but no request is sent until resumed.
+func (p *Pauser) Pause(reason string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.resumec != nil {
+		return fmt.Errorf("already paused since %s", p.gaps[len(p.gaps)-1].Start.Format(time.RFC3339))
+	}
+	p.resumec = make(chan struct{})
+	p.gaps = append(p.gaps, PauseGap{Reason: reason, Start: time.Now()})
+	return nil
+}
+
+// Resume resumes request generation.
+func (p *Pauser) Resume() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.resumec == nil {
+		return fmt.Errorf("not paused")
+	}
+	close(p.resumec)
+	p.resumec = nil
+	p.gaps[len(p.gaps)-1].End = time.Now()
+	return nil
+}
+
+// Paused returns true if request generation is paused.
+func (p *Pauser) Paused() bool {
+	if p == nil {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.resumec != nil
+}
+
+// Gaps returns all paused intervals, including the current one.
+func (p *Pauser) Gaps() []PauseGap {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]PauseGap(nil), p.gaps...)
+}
+
+// wait blocks while paused, or until stopc is closed.
+func (p *Pauser) wait(stopc <-chan struct{}) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	resumec := p.resumec
+	p.mu.Unlock()
+	if resumec == nil {
+		return
+	}
+	select {
+	case <-resumec:
+	case <-stopc:
+	}
+}
+
+// pausedBetween returns how long request generation was
+// paused between start and end.
+func (p *Pauser) pausedBetween(start, end time.Time) (d time.Duration) {
+	for _, g := range p.Gaps() {
+		gs, ge := g.Start, g.End
+		if ge.IsZero() {
+			ge = end
+		}
+		if gs.Before(start) {
+			gs = start
+		}
+		if ge.After(end) {
+			ge = end
+		}
+		if ge.After(gs) {
+			d += ge.Sub(gs)
+		}
+	}
+	return d
+}
+
+// pausedBySecond returns paused duration by unix second.
+func pausedBySecond(gaps []PauseGap, now time.Time) map[int64]time.Duration {
+	m := make(map[int64]time.Duration)
+	for _, g := range gaps {
+		end := g.End
+		if end.IsZero() {
+			end = now
+		}
+		for s := g.Start; s.Before(end); {
+			next := time.Unix(s.Unix()+1, 0)
+			if next.After(end) {
+				next = end
+			}
+			m[s.Unix()] += next.Sub(s)
+			s = next
+		}
+	}
+	return m
+}
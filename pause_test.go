@@ -0,0 +1,77 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPauser(t *testing.T) {
+	p := NewPauser()
+	if err := p.Resume(); err == nil {
+		t.Fatal("expected error on resume without pause")
+	}
+	if err := p.Pause("defrag"); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Pause("defrag"); err == nil {
+		t.Fatal("expected error on pause while paused")
+	}
+
+	donec := make(chan struct{})
+	go func() {
+		p.wait(nil)
+		close(donec)
+	}()
+	select {
+	case <-donec:
+		t.Fatal("wait returned while paused")
+	case <-time.After(10 * time.Millisecond):
+	}
+	if err := p.Resume(); err != nil {
+		t.Fatal(err)
+	}
+	<-donec
+
+	if gaps := p.Gaps(); len(gaps) != 1 || gaps[0].Reason != "defrag" || gaps[0].End.IsZero() {
+		t.Fatalf("unexpected gaps %+v", gaps)
+	}
+}
+
+func Test_pausedBySecond(t *testing.T) {
+	start := time.Unix(100, int64(500*time.Millisecond))
+	gaps := []PauseGap{{Start: start, End: start.Add(2 * time.Second)}}
+
+	m := pausedBySecond(gaps, time.Now())
+	expected := map[int64]time.Duration{
+		100: 500 * time.Millisecond,
+		101: time.Second,
+		102: 500 * time.Millisecond,
+	}
+	if len(m) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, m)
+	}
+	for k, v := range expected {
+		if m[k] != v {
+			t.Fatalf("second %d: expected %v, got %v", k, v, m[k])
+		}
+	}
+
+	p := &Pauser{gaps: gaps}
+	if d := p.pausedBetween(start.Add(-time.Second), start.Add(time.Second)); d != time.Second {
+		t.Fatalf("expected 1s paused, got %v", d)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// Workload phases. 'warmup', 'load', and 'drain' are marked by the
+// benchmark; 'fault-injection' and 'recovery' are marked from the admin
+// server when faults are injected out of band.
+const (
+	// PhaseWarmup is from benchmark start (e.g. dialing connections)
+	// to the first request.
+	PhaseWarmup = "warmup"
+	// PhaseLoad is while requests are generated.
+	PhaseLoad = "load"
+	// PhaseFaultInjection is while faults are injected.
+	PhaseFaultInjection = "fault-injection"
+	// PhaseRecovery is after faults are removed, until the cluster recovers.
+	PhaseRecovery = "recovery"
+	// PhaseDrain is after all requests are generated,
+	// until in-flight requests complete.
+	PhaseDrain = "drain"
+)
+
+// IsValidPhase returns true if the phase name is known.
+func IsValidPhase(name string) bool {
+	switch name {
+	case PhaseWarmup, PhaseLoad, PhaseFaultInjection, PhaseRecovery, PhaseDrain:
+		return true
+	}
+	return false
+}
+
+// Phase is a named interval of the workload. End is zero until
+// the next phase begins, or the benchmark ends.
+type Phase struct {
+	Name  string    `json:"name"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end,omitempty"`
+}
+
+// phaseLog records consecutive phases of the workload.
+// A nil phaseLog records nothing.
+type phaseLog struct {
+	mu     sync.Mutex
+	phases []Phase
+}
+
+// begin ends the current phase, and begins the named phase.
+func (l *phaseLog) begin(name string) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	if n := len(l.phases); n > 0 && l.phases[n-1].End.IsZero() {
+		if l.phases[n-1].Name == name {
+			return
+		}
+		l.phases[n-1].End = now
+	}
+	l.phases = append(l.phases, Phase{Name: name, Start: now})
+}
+
+// end ends the current phase.
+func (l *phaseLog) end() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if n := len(l.phases); n > 0 && l.phases[n-1].End.IsZero() {
+		l.phases[n-1].End = time.Now()
+	}
+}
+
+// Phases returns all phases, including the current one.
+func (l *phaseLog) Phases() []Phase {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Phase(nil), l.phases...)
+}
+
+// PhasesToProto converts phases to send to agents.
+func PhasesToProto(phases []Phase) []*dbtesterpb.Phase {
+	ps := make([]*dbtesterpb.Phase, len(phases))
+	for i, p := range phases {
+		ps[i] = &dbtesterpb.Phase{Name: p.Name, StartUnixNano: p.Start.UnixNano()}
+		if !p.End.IsZero() {
+			ps[i].EndUnixNano = p.End.UnixNano()
+		}
+	}
+	return ps
+}
+
+// PhasesFromProto converts phases received from control.
+func PhasesFromProto(ps []*dbtesterpb.Phase) []Phase {
+	phases := make([]Phase, len(ps))
+	for i, p := range ps {
+		phases[i] = Phase{Name: p.Name, Start: time.Unix(0, p.StartUnixNano)}
+		if p.EndUnixNano > 0 {
+			phases[i].End = time.Unix(0, p.EndUnixNano)
+		}
+	}
+	return phases
+}
+
+// phasesHeader is the header of 'client_phases_path'.
+var phasesHeader = []string{"PHASE", "START-UNIX-NANO", "END-UNIX-NANO", "DURATION-MS"}
+
+// savePhases writes the phases to 'client_phases_path'.
+func (cfg *Config) savePhases() error {
+	fpath := cfg.ConfigClientMachineInitial.ClientPhasesPath
+	if fpath == "" {
+		return nil
+	}
+	f, err := os.Create(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	if err = w.Write(phasesHeader); err != nil {
+		return err
+	}
+	for _, p := range cfg.phases.Phases() {
+		end, took := "", ""
+		if !p.End.IsZero() {
+			end = fmt.Sprintf("%d", p.End.UnixNano())
+			took = fmt.Sprintf("%f", toMillisecond(p.End.Sub(p.Start)))
+		}
+		if err = w.Write([]string{p.Name, fmt.Sprintf("%d", p.Start.UnixNano()), end, took}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// ReadPhases reads phases written to 'client_phases_path'.
+func ReadPhases(fpath string) ([]Phase, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%q has no header", fpath)
+	}
+	phases := make([]Phase, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		if len(row) < 3 {
+			return nil, fmt.Errorf("%q has unexpected row %q", fpath, row)
+		}
+		start, err := strconv.ParseInt(row[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		p := Phase{Name: row[0], Start: time.Unix(0, start)}
+		if row[2] != "" {
+			end, err := strconv.ParseInt(row[2], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			p.End = time.Unix(0, end)
+		}
+		phases = append(phases, p)
+	}
+	return phases, nil
+}
+
+// phasesForAgents returns the phases of the benchmark, read from
+// 'client_phases_path' when the benchmark ran in another invocation
+// (e.g. '--steps 2', then '--steps 3').
+func (cfg *Config) phasesForAgents() []*dbtesterpb.Phase {
+	phases := cfg.phases.Phases()
+	if len(phases) == 0 && cfg.ConfigClientMachineInitial.ClientPhasesPath != "" {
+		phases, _ = ReadPhases(cfg.ConfigClientMachineInitial.ClientPhasesPath)
+	}
+	return PhasesToProto(phases)
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func TestPhaseAt(t *testing.T) {
+	base := time.Unix(100, 0)
+	phases := PhasesToProto([]Phase{
+		{Name: PhaseWarmup, Start: base, End: base.Add(2 * time.Second)},
+		{Name: PhaseLoad, Start: base.Add(2 * time.Second), End: base.Add(5500 * time.Millisecond)},
+		{Name: PhaseDrain, Start: base.Add(5500 * time.Millisecond)},
+	})
+	tests := []struct {
+		sec   int64
+		phase string
+	}{
+		{99, ""},
+		{100, PhaseWarmup},
+		{102, PhaseLoad},
+		{104, PhaseLoad},
+		{105, PhaseDrain},
+		{200, PhaseDrain},
+	}
+	for i, tt := range tests {
+		if phase := dbtesterpb.PhaseAt(phases, tt.sec); phase != tt.phase {
+			t.Fatalf("#%d: expected %q at %d, got %q", i, tt.phase, tt.sec, phase)
+		}
+	}
+}
+
+func TestSavePhases(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "dbtester-phases")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cfg := &Config{ConfigClientMachineInitial: dbtesterpb.ConfigClientMachineInitial{
+		ClientPhasesPath: filepath.Join(dir, "phases.csv"),
+	}}
+	cfg.phases = &phaseLog{}
+	cfg.phases.begin(PhaseWarmup)
+	cfg.phases.begin(PhaseLoad)
+	cfg.phases.begin(PhaseLoad)
+	cfg.phases.end()
+	if err = cfg.savePhases(); err != nil {
+		t.Fatal(err)
+	}
+
+	phases, err := ReadPhases(cfg.ConfigClientMachineInitial.ClientPhasesPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := cfg.phases.Phases()
+	if len(expected) != 2 {
+		t.Fatalf("expected 2 phases, got %+v", expected)
+	}
+	for i := range expected {
+		// strip monotonic clock reading
+		expected[i].Start, expected[i].End = expected[i].Start.Round(0), expected[i].End.Round(0)
+	}
+	if !reflect.DeepEqual(phases, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, phases)
+	}
+}
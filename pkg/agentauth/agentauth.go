@@ -0,0 +1,304 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package agentauth signs and verifies agent RPCs with a shared secret,
+// so that only controllers holding the secret can operate agents.
+package agentauth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	timestampKey = "dbtester-auth-timestamp"
+	signatureKey = "dbtester-auth-signature"
+)
+
+// MaxSkew is the maximum difference between the signing time of the
+// controller and the clock of the agent, to limit replays of requests.
+const MaxSkew = 5 * time.Minute
+
+// ReadSecret reads the shared secret from the file, without surrounding
+// whitespaces.
+func ReadSecret(fpath string) ([]byte, error) {
+	bts, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	secret := []byte(strings.TrimSpace(string(bts)))
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("secret file %q is empty", fpath)
+	}
+	return secret, nil
+}
+
+// sign returns the HMAC-SHA256 of the method, the signing time, and the
+// request wire bytes, so that a signature cannot be reused for other
+// operations.
+func sign(secret []byte, method string, unixNano int64, body []byte) string {
+	h := hmac.New(sha256.New, secret)
+	fmt.Fprintf(h, "%s\n%d\n", method, unixNano)
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func signContext(ctx context.Context, secret []byte, method string, body []byte) context.Context {
+	now := time.Now().UnixNano()
+	sig := sign(secret, method, now, body)
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs(timestampKey, strconv.FormatInt(now, 10), signatureKey, sig))
+}
+
+func verify(ctx context.Context, secret []byte, method string, body []byte) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md[timestampKey]) != 1 || len(md[signatureKey]) != 1 {
+		return status.Errorf(codes.Unauthenticated, "%q is not signed", method)
+	}
+	unixNano, err := strconv.ParseInt(md[timestampKey][0], 10, 64)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid signing time %q", md[timestampKey][0])
+	}
+	if skew := time.Since(time.Unix(0, unixNano)); skew > MaxSkew || skew < -MaxSkew {
+		return status.Errorf(codes.Unauthenticated, "%q was signed %v apart from agent clock (max %v)", method, skew, MaxSkew)
+	}
+	expected := sign(secret, method, unixNano, body)
+	if !hmac.Equal([]byte(expected), []byte(md[signatureKey][0])) {
+		return status.Errorf(codes.PermissionDenied, "%q has invalid signature", method)
+	}
+	return nil
+}
+
+// rawMessage is a request marshaled and signed by the client,
+// which the codec sends as-is.
+type rawMessage []byte
+
+// codec marshals messages with gogo/protobuf. On agents, it keeps the
+// wire bytes of decoded requests until they are verified, so that
+// signatures are checked on the bytes the controller signed, rather than
+// on re-marshaled requests that drop fields unknown to older agents.
+type codec struct {
+	// raw maps decoded requests to their wire bytes, nil on controllers
+	raw *sync.Map
+}
+
+func (c codec) Marshal(v interface{}) ([]byte, error) {
+	if b, ok := v.(rawMessage); ok {
+		return b, nil
+	}
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a proto message", v)
+	}
+	return proto.Marshal(m)
+}
+
+func (c codec) Unmarshal(data []byte, v interface{}) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%T is not a proto message", v)
+	}
+	if err := proto.Unmarshal(data, m); err != nil {
+		return err
+	}
+	if c.raw != nil {
+		c.raw.Store(v, append([]byte(nil), data...))
+	}
+	return nil
+}
+
+// String returns the name of default gRPC codec, since
+// the wire format is the same.
+func (codec) String() string {
+	return "proto"
+}
+
+// wireBytes returns the wire bytes of the decoded request,
+// and forgets them.
+func (c codec) wireBytes(v interface{}) ([]byte, error) {
+	b, ok := c.raw.Load(v)
+	c.raw.Delete(v)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "no wire bytes of %T", v)
+	}
+	return b.([]byte), nil
+}
+
+// signedClientStream opens the stream on its request message, so that
+// the request is signed with the method. Agent streams take one request.
+type signedClientStream struct {
+	grpc.ClientStream // nil until the request is sent
+
+	ctx      context.Context
+	secret   []byte
+	desc     *grpc.StreamDesc
+	cc       *grpc.ClientConn
+	method   string
+	streamer grpc.Streamer
+	opts     []grpc.CallOption
+}
+
+func (s *signedClientStream) SendMsg(m interface{}) error {
+	if s.ClientStream != nil {
+		return status.Errorf(codes.Unimplemented, "%q takes one signed request", s.method)
+	}
+	body, err := codec{}.Marshal(m)
+	if err != nil {
+		return err
+	}
+	cs, err := s.streamer(signContext(s.ctx, s.secret, s.method, body), s.desc, s.cc, s.method, s.opts...)
+	if err != nil {
+		return err
+	}
+	s.ClientStream = cs
+	return cs.SendMsg(rawMessage(body))
+}
+
+func (s *signedClientStream) Context() context.Context {
+	if s.ClientStream == nil {
+		return s.ctx
+	}
+	return s.ClientStream.Context()
+}
+
+func (s *signedClientStream) Header() (metadata.MD, error) {
+	if s.ClientStream == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%q request is not sent", s.method)
+	}
+	return s.ClientStream.Header()
+}
+
+func (s *signedClientStream) Trailer() metadata.MD {
+	if s.ClientStream == nil {
+		return nil
+	}
+	return s.ClientStream.Trailer()
+}
+
+func (s *signedClientStream) CloseSend() error {
+	if s.ClientStream == nil {
+		return status.Errorf(codes.FailedPrecondition, "%q request is not sent", s.method)
+	}
+	return s.ClientStream.CloseSend()
+}
+
+func (s *signedClientStream) RecvMsg(m interface{}) error {
+	if s.ClientStream == nil {
+		return status.Errorf(codes.FailedPrecondition, "%q request is not sent", s.method)
+	}
+	return s.ClientStream.RecvMsg(m)
+}
+
+// verifiedServerStream verifies the request message of the stream,
+// and sends nothing before it is verified.
+type verifiedServerStream struct {
+	grpc.ServerStream
+
+	c        codec
+	secret   []byte
+	method   string
+	verified bool
+}
+
+func (s *verifiedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	body, err := s.c.wireBytes(m)
+	if err != nil {
+		return err
+	}
+	if s.verified {
+		return status.Errorf(codes.PermissionDenied, "%q takes one signed request", s.method)
+	}
+	if err = verify(s.Context(), s.secret, s.method, body); err != nil {
+		return err
+	}
+	s.verified = true
+	return nil
+}
+
+func (s *verifiedServerStream) SendMsg(m interface{}) error {
+	if !s.verified {
+		return status.Errorf(codes.PermissionDenied, "%q request is not verified", s.method)
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// DialOptions returns the options to sign all RPCs with the secret.
+// It returns nil if the secret is empty.
+func DialOptions(secret []byte) []grpc.DialOption {
+	if len(secret) == 0 {
+		return nil
+	}
+	return []grpc.DialOption{
+		grpc.WithCodec(codec{}),
+		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			body, err := codec{}.Marshal(req)
+			if err != nil {
+				return err
+			}
+			return invoker(signContext(ctx, secret, method, body), method, rawMessage(body), reply, cc, opts...)
+		}),
+		grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return &signedClientStream{
+				ctx:      ctx,
+				secret:   secret,
+				desc:     desc,
+				cc:       cc,
+				method:   method,
+				streamer: streamer,
+				opts:     opts,
+			}, nil
+		}),
+	}
+}
+
+// ServerOptions returns the options to reject RPCs not signed with the
+// secret. It returns nil if the secret is empty.
+func ServerOptions(secret []byte) []grpc.ServerOption {
+	if len(secret) == 0 {
+		return nil
+	}
+	c := codec{raw: new(sync.Map)}
+	return []grpc.ServerOption{
+		grpc.CustomCodec(c),
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			body, err := c.wireBytes(req)
+			if err != nil {
+				return nil, err
+			}
+			if err = verify(ctx, secret, info.FullMethod, body); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, &verifiedServerStream{ServerStream: ss, c: c, secret: secret, method: info.FullMethod})
+		}),
+	}
+}
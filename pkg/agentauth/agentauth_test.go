@@ -0,0 +1,180 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agentauth
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestVerify(t *testing.T) {
+	const method = "/dbtesterpb.Transporter/Transfer"
+	body, err := (&dbtesterpb.Request{Operation: dbtesterpb.Operation_Stop, IPIndex: 1}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := (&dbtesterpb.Request{Operation: dbtesterpb.Operation_Start, IPIndex: 1}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	md, _ := metadata.FromOutgoingContext(signContext(context.Background(), []byte("secret"), method, body))
+	in := metadata.NewIncomingContext(context.Background(), md)
+
+	tests := []struct {
+		ctx    context.Context
+		secret string
+		method string
+		body   []byte
+		code   codes.Code
+	}{
+		{in, "secret", method, body, codes.OK},
+		{in, "other", method, body, codes.PermissionDenied},
+		{in, "secret", "/dbtesterpb.Transporter/WaitUpload", body, codes.PermissionDenied},
+		{in, "secret", method, other, codes.PermissionDenied},
+		{context.Background(), "secret", method, body, codes.Unauthenticated},
+	}
+	for i, tt := range tests {
+		err := verify(tt.ctx, []byte(tt.secret), tt.method, tt.body)
+		s, ok := status.FromError(err)
+		if !ok || s.Code() != tt.code {
+			t.Fatalf("#%d: expected %v, got %v", i, tt.code, err)
+		}
+	}
+}
+
+// echoServer returns the operation of requests, and streams the test ID.
+type echoServer struct{}
+
+func (echoServer) Transfer(ctx context.Context, req *dbtesterpb.Request) (*dbtesterpb.Response, error) {
+	var labels int
+	if req.ConfigClientMachineInitial != nil {
+		labels = len(req.ConfigClientMachineInitial.Labels)
+	}
+	return &dbtesterpb.Response{Success: true, ExecOutput: fmt.Sprintf("%v %d", req.Operation, labels)}, nil
+}
+
+func (echoServer) WaitUpload(ctx context.Context, req *dbtesterpb.UploadRequest) (*dbtesterpb.UploadResponse, error) {
+	return &dbtesterpb.UploadResponse{Success: true}, nil
+}
+
+func (echoServer) StreamLog(req *dbtesterpb.LogRequest, stream dbtesterpb.Transporter_StreamLogServer) error {
+	return stream.Send(&dbtesterpb.LogLine{Line: req.TestID})
+}
+
+func errCode(err error) codes.Code {
+	s, ok := status.FromError(err)
+	if !ok {
+		return codes.Unknown
+	}
+	return s.Code()
+}
+
+func TestSignedRPC(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(ServerOptions([]byte("secret"))...)
+	dbtesterpb.RegisterTransporterServer(srv, echoServer{})
+	go srv.Serve(ln)
+	defer srv.Stop()
+
+	dial := func(secret string) *grpc.ClientConn {
+		conn, err := grpc.Dial(ln.Addr().String(), append([]grpc.DialOption{grpc.WithInsecure()}, DialOptions([]byte(secret))...)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return conn
+	}
+	conn := dial("secret")
+	defer conn.Close()
+	cli := dbtesterpb.NewTransporterClient(conn)
+
+	// labels are marshaled in a random map order each time
+	req := &dbtesterpb.Request{
+		Operation: dbtesterpb.Operation_Stop,
+		ConfigClientMachineInitial: &dbtesterpb.ConfigClientMachineInitial{Labels: map[string]string{
+			"durability":       "fsync",
+			"cache":            "cold",
+			"etcd-git-sha":     "abc123",
+			"cpu-architecture": "arm64",
+		}},
+	}
+	for i := 0; i < 20; i++ {
+		resp, err := cli.Transfer(context.Background(), req)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if resp.ExecOutput != "Stop 4" {
+			t.Fatalf("#%d: unexpected response %q", i, resp.ExecOutput)
+		}
+	}
+
+	// fields unknown to the agent (e.g. from newer controllers) are
+	// dropped when decoded, but signed and verified as sent
+	body, err := req.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body = append(body, 0xc2, 0x3e, 0x01, 'x') // field 1000, "x"
+	var resp dbtesterpb.Response
+	if err = grpc.Invoke(context.Background(), "/dbtesterpb.Transporter/Transfer", rawMessage(body), &resp, conn); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		secret string
+		code   codes.Code
+	}{
+		{"secret", codes.OK},
+		{"other", codes.PermissionDenied},
+		{"", codes.Unauthenticated},
+	} {
+		conn := dial(tt.secret)
+		defer conn.Close()
+		cli := dbtesterpb.NewTransporterClient(conn)
+
+		if _, err = cli.Transfer(context.Background(), req); errCode(err) != tt.code {
+			t.Fatalf("%q: expected %v, got %v", tt.secret, tt.code, err)
+		}
+		stream, err := cli.StreamLog(context.Background(), &dbtesterpb.LogRequest{TestID: "test-1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		line, err := stream.Recv()
+		if errCode(err) != tt.code {
+			t.Fatalf("%q: expected %v, got %v", tt.secret, tt.code, err)
+		}
+		if tt.code != codes.OK {
+			continue
+		}
+		if line.Line != "test-1" {
+			t.Fatalf("unexpected line %q", line.Line)
+		}
+		if _, err = stream.Recv(); err != io.EOF {
+			t.Fatalf("expected EOF, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package antagonist consumes CPU, memory, and disk bandwidth on the host,
+// to simulate noisy neighbors of the database.
+package antagonist
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Config defines how much resource to consume.
+// Zero values disable each antagonist.
+type Config struct {
+	// CPUCores is the number of busy-looping threads.
+	CPUCores int
+	// MemoryBytes is the size of memory to allocate and keep resident.
+	MemoryBytes int64
+	// DiskWriteBytesPerSecond is the rate of synced writes to 'DiskDir'.
+	DiskWriteBytesPerSecond int64
+	// DiskDir is the directory to write files to.
+	DiskDir string
+}
+
+const (
+	pageSize      = 4096
+	diskChunkSize = 1024 * 1024
+	// maxDiskFileSize is the size after which the disk antagonist
+	// overwrites the file from the beginning, to bound disk usage.
+	maxDiskFileSize = 1024 * 1024 * 1024
+)
+
+// Antagonist is a set of running antagonists.
+type Antagonist struct {
+	cancel func()
+	wg     sync.WaitGroup
+
+	mu  sync.Mutex
+	err error
+}
+
+// Start starts antagonists in the background.
+func Start(cfg Config) (*Antagonist, error) {
+	var f *os.File
+	if cfg.DiskWriteBytesPerSecond > 0 {
+		if err := os.MkdirAll(cfg.DiskDir, 0777); err != nil {
+			return nil, err
+		}
+		var err error
+		f, err = os.Create(filepath.Join(cfg.DiskDir, "antagonist.data"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a := &Antagonist{cancel: cancel}
+	for i := 0; i < cfg.CPUCores; i++ {
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			burnCPU(ctx)
+		}()
+	}
+	if cfg.MemoryBytes > 0 {
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			holdMemory(ctx, cfg.MemoryBytes)
+		}()
+	}
+	if f != nil {
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			if err := writeDisk(ctx, f, cfg.DiskWriteBytesPerSecond); err != nil {
+				a.mu.Lock()
+				a.err = err
+				a.mu.Unlock()
+			}
+		}()
+	}
+	return a, nil
+}
+
+// Stop stops all antagonists, and returns the first error of disk writes.
+func (a *Antagonist) Stop() error {
+	a.cancel()
+	a.wg.Wait()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+func burnCPU(ctx context.Context) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	x := uint64(1)
+	for {
+		select {
+		case <-ctx.Done():
+			atomic.StoreUint64(&cpuSink, x)
+			return
+		default:
+		}
+		for i := uint64(0); i < 1000000; i++ {
+			x = x*31 + i
+		}
+	}
+}
+
+// cpuSink keeps busy loops from being optimized away.
+var cpuSink uint64
+
+// holdMemory allocates memory, and touches every page
+// every second so that it stays resident.
+func holdMemory(ctx context.Context, n int64) {
+	bs := make([]byte, n)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for j := byte(1); ; j++ {
+		for i := int64(0); i < n; i += pageSize {
+			bs[i] = j
+		}
+		select {
+		case <-ctx.Done():
+			runtime.KeepAlive(bs)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// writeDisk writes and syncs chunks to the file at the rate,
+// and removes the file when done.
+func writeDisk(ctx context.Context, f *os.File, bytesPerSecond int64) (err error) {
+	defer func() {
+		f.Close()
+		if rerr := os.Remove(f.Name()); err == nil {
+			err = rerr
+		}
+	}()
+
+	chunk := int64(diskChunkSize)
+	if bytesPerSecond < chunk {
+		chunk = bytesPerSecond
+	}
+	buf := make([]byte, chunk)
+	for i := range buf {
+		buf[i] = byte(i)
+	}
+	limiter := rate.NewLimiter(rate.Limit(bytesPerSecond), int(chunk))
+
+	var offset int64
+	for {
+		if err = limiter.WaitN(ctx, int(chunk)); err != nil {
+			// canceled
+			return nil
+		}
+		if _, err = f.WriteAt(buf, offset); err != nil {
+			return err
+		}
+		if err = f.Sync(); err != nil {
+			return err
+		}
+		offset += chunk
+		if offset >= maxDiskFileSize {
+			offset = 0
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package antagonist
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestAntagonist(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "antagonist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	a, err := Start(Config{
+		CPUCores:                1,
+		MemoryBytes:             1024 * 1024,
+		DiskWriteBytesPerSecond: 64 * 1024,
+		DiskDir:                 dir,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if _, err = os.Stat(filepath.Join(dir, "antagonist.data")); err != nil {
+		t.Fatal(err)
+	}
+	if err = a.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(filepath.Join(dir, "antagonist.data")); !os.IsNotExist(err) {
+		t.Fatalf("expected file removed, got %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package benchrunner is the load engine of dbtester. It sends requests
+// from concurrent clients at a target rate, and aggregates their latencies
+// overall, per operation type, and per second, corrected for coordinated
+// omission. Other tools can import it to generate load without running
+// dbtester.
+package benchrunner
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Config defines the load to generate.
+type Config struct {
+	// Clients is the number of concurrent clients.
+	Clients int
+	// Requests is the total number of requests.
+	Requests int64
+	// RequestsPerSecond is the target rate, zero for no limit.
+	RequestsPerSecond int64
+	// ErrorBudget aborts the run when the database is clearly unhealthy.
+	ErrorBudget ErrorBudget
+}
+
+// Handler sends the i-th request from the client, and returns its
+// operation type (e.g. "put") to report latencies by.
+type Handler func(ctx context.Context, client int, i int64) (op string, err error)
+
+// Run sends 'Requests' requests with the handler, and returns the results.
+// It returns an error if the error budget is exceeded or the context is
+// canceled, with the results of the requests sent by then.
+func Run(ctx context.Context, cfg Config, h Handler) (Result, error) {
+	if cfg.Clients <= 0 {
+		return Result{}, fmt.Errorf("got %d clients", cfg.Clients)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type job struct {
+		i        int64
+		intended time.Time
+	}
+	jobs := make(chan job, cfg.Clients)
+	go func() {
+		defer close(jobs)
+		pacer := NewPacer(cfg.RequestsPerSecond)
+		for i := int64(0); i < cfg.Requests; i++ {
+			intended := pacer.Wait(ctx, i)
+			if ctx.Err() != nil {
+				return
+			}
+			jobs <- job{i: i, intended: intended}
+		}
+	}()
+
+	var (
+		rec      = NewRecorder(cfg.ErrorBudget)
+		wg       sync.WaitGroup
+		abortMu  sync.Mutex
+		abortErr error
+	)
+	for c := 0; c < cfg.Clients; c++ {
+		wg.Add(1)
+		go func(c int) {
+			defer wg.Done()
+			for j := range jobs {
+				if ctx.Err() != nil {
+					continue // drain, so that the generator can finish
+				}
+				st := time.Now()
+				op, err := h(ctx, c, j.i)
+				if berr := rec.Observe(op, j.intended, st, time.Now(), err); berr != nil {
+					abortMu.Lock()
+					if abortErr == nil {
+						abortErr = berr
+					}
+					abortMu.Unlock()
+					cancel()
+				}
+			}
+		}(c)
+	}
+	wg.Wait()
+
+	res := rec.Close()
+	if abortErr != nil {
+		return res, abortErr
+	}
+	return res, ctx.Err()
+}
@@ -0,0 +1,69 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchrunner
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	h := func(ctx context.Context, client int, i int64) (string, error) {
+		if i%2 == 0 {
+			return "put", nil
+		}
+		return "get", nil
+	}
+	res, err := Run(context.Background(), Config{Clients: 4, Requests: 100}, h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Stats.Lats) != 100 {
+		t.Fatalf("expected 100 latencies, got %d", len(res.Stats.Lats))
+	}
+	if len(res.OpStats["put"].Lats) != 50 || len(res.OpStats["get"].Lats) != 50 {
+		t.Fatalf("expected 50 latencies per operation, got %+v", res.OpStats)
+	}
+	if len(res.Heatmap) == 0 {
+		t.Fatal("expected heatmap")
+	}
+}
+
+func TestRunErrorBudget(t *testing.T) {
+	h := func(ctx context.Context, client int, i int64) (string, error) {
+		return "put", errors.New("unavailable")
+	}
+	cfg := Config{Clients: 1, Requests: 1000, ErrorBudget: ErrorBudget{MaxConsecutiveErrors: 10}}
+	res, err := Run(context.Background(), cfg, h)
+	if err == nil {
+		t.Fatal("expected error budget exceeded")
+	}
+	if n := len(res.Stats.ErrorDist); n == 0 {
+		t.Fatal("expected errors")
+	}
+}
+
+func TestKeys(t *testing.T) {
+	if k := SequentialKey(5, 12); k != "00012" {
+		t.Fatalf("expected 00012, got %q", k)
+	}
+	if k := BinaryKey(10, 1); len(k) != 10 || k[9] != 1 {
+		t.Fatalf("unexpected binary key %q", k)
+	}
+	if b := RandomBytes(32); len(b) != 32 {
+		t.Fatalf("expected 32 bytes, got %d", len(b))
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchrunner
+
+import (
+	"encoding/binary"
+	"fmt"
+	mrand "math/rand"
+	"strings"
+	"time"
+)
+
+// SequentialKey returns '00012' when size is 5 and num is 12.
+func SequentialKey(size, num int64) string {
+	txt := fmt.Sprintf("%d", num)
+	if len(txt) > int(size) {
+		return txt
+	}
+	delta := int(size) - len(txt)
+	return strings.Repeat("0", delta) + txt
+}
+
+// BinaryKey returns the big-endian bytes of the number, zero-padded to size.
+// Size must be at least 8.
+func BinaryKey(size, num int64) string {
+	b := make([]byte, size)
+	binary.BigEndian.PutUint64(b[size-8:], uint64(num))
+	return string(b)
+}
+
+// RandomBytes returns random letters of the size.
+func RandomBytes(size int64) []byte {
+	const (
+		letterBytes   = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+		letterIdxBits = 6                    // 6 bits to represent a letter index
+		letterIdxMask = 1<<letterIdxBits - 1 // All 1-bits, as many as letterIdxBits
+		letterIdxMax  = 63 / letterIdxBits   // # of letter indices fitting in 63 bits
+	)
+	src := mrand.NewSource(time.Now().UnixNano())
+	b := make([]byte, size)
+	for i, cache, remain := size-1, src.Int63(), letterIdxMax; i >= 0; {
+		if remain == 0 {
+			cache, remain = src.Int63(), letterIdxMax
+		}
+		if idx := int(cache & letterIdxMask); idx < len(letterBytes) {
+			b[i] = letterBytes[idx]
+			i--
+		}
+		cache >>= letterIdxBits
+		remain--
+	}
+	return b
+}
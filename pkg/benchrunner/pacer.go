@@ -0,0 +1,63 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchrunner
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Pacer limits requests to the target rate, and computes when each
+// request is intended to be sent at the rate, regardless of when it
+// actually is. Measuring latency from the intended start time corrects
+// coordinated omission, which hides queueing delay when the target rate
+// cannot be sustained. A nil Pacer does not limit.
+type Pacer struct {
+	limiter  *rate.Limiter
+	start    time.Time
+	interval time.Duration
+}
+
+// NewPacer returns nil if rps is not positive.
+func NewPacer(rps int64) *Pacer {
+	if rps <= 0 {
+		return nil
+	}
+	return &Pacer{
+		limiter:  rate.NewLimiter(rate.Limit(rps), int(rps)),
+		start:    time.Now(),
+		interval: time.Second / time.Duration(rps),
+	}
+}
+
+// Wait blocks until the i-th request may be sent, or the context is
+// canceled, and returns its intended start time (zero if not limited).
+func (p *Pacer) Wait(ctx context.Context, i int64) time.Time {
+	if p == nil {
+		return time.Time{}
+	}
+	p.limiter.Wait(ctx)
+	return p.At(i)
+}
+
+// At returns the intended start time of the i-th request.
+func (p *Pacer) At(i int64) time.Time {
+	if p == nil {
+		return time.Time{}
+	}
+	return p.start.Add(time.Duration(i) * p.interval)
+}
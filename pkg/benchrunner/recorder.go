@@ -0,0 +1,192 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchrunner
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/coreos/etcd/pkg/report"
+)
+
+// LatencyBucketsMs is the upper bounds of per-second latency histogram
+// buckets, in milliseconds. Latencies beyond the last bound go to an
+// overflow bucket.
+var LatencyBucketsMs = []float64{
+	0.5, 1, 2, 4, 8, 16, 32, 64, 128, 256, 512, 1024, 2048, 4096, 8192, 16384,
+}
+
+// LatencyBucket returns the bucket index of the latency.
+func LatencyBucket(lat time.Duration) int {
+	ms := float64(lat) / float64(time.Millisecond)
+	return sort.SearchFloat64s(LatencyBucketsMs, ms)
+}
+
+// ErrorBudget defines when to abort. Zero values disable each check.
+type ErrorBudget struct {
+	// MaxErrorRate is the ratio of failed requests to abort at,
+	// checked after 'MinRequestsForErrorRate' requests.
+	MaxErrorRate float64
+	// MaxConsecutiveErrors is the number of consecutive failed requests
+	// to abort at.
+	MaxConsecutiveErrors int64
+}
+
+// MinRequestsForErrorRate is the number of requests to complete
+// before checking error rate, so that a few early errors do not abort.
+const MinRequestsForErrorRate = 100
+
+// Result is the aggregated latencies of all requests.
+type Result struct {
+	// Stats is latencies from when requests were actually sent,
+	// with sampled time series.
+	Stats report.Stats
+	// CorrectedStats is latencies from when requests were intended
+	// to be sent at the target rate.
+	CorrectedStats report.Stats
+	// OpStats is latencies by operation type.
+	OpStats map[string]report.Stats
+	// Heatmap is the latency histogram of each unix second,
+	// by 'LatencyBucketsMs'.
+	Heatmap map[int64][]int64
+}
+
+// Recorder aggregates results of requests. It is safe for concurrent use.
+type Recorder struct {
+	budget ErrorBudget
+
+	report        report.Report
+	reportDone    <-chan report.Stats
+	corrected     report.Report
+	correctedDone <-chan report.Stats
+	requestsN     int64
+	errorsN       int64
+	consecutiveN  int64
+	opMu          sync.Mutex
+	ops           map[string]*opReport
+	heatmapMu     sync.Mutex
+	heatmap       map[int64][]int64
+}
+
+// opReport is the latency report of one operation type.
+type opReport struct {
+	report report.Report
+	done   <-chan report.Stats
+}
+
+// NewRecorder returns a new Recorder.
+func NewRecorder(budget ErrorBudget) *Recorder {
+	r := &Recorder{
+		budget:    budget,
+		report:    report.NewReportSample("%4.4f"),
+		corrected: report.NewReport("%4.4f"),
+		ops:       make(map[string]*opReport),
+		heatmap:   make(map[int64][]int64),
+	}
+	r.reportDone = r.report.Stats()
+	r.correctedDone = r.corrected.Stats()
+	return r
+}
+
+// Observe records the request of the operation type (empty to skip per-type
+// latencies), sent at 'start' and completed at 'end'. Requests sent ahead of
+// their intended start (e.g. rate limiter burst), or not rate-limited with
+// zero intended start, are measured from when they were actually sent. It
+// returns an error once the error budget is exceeded.
+func (r *Recorder) Observe(op string, intended, start, end time.Time, err error) error {
+	res := report.Result{Err: err, Start: start, End: end}
+	r.report.Results() <- res
+	if op != "" {
+		r.observeOp(op, res)
+	}
+	r.observeHeatmap(end, end.Sub(start))
+
+	if !intended.IsZero() && intended.Before(start) {
+		res.Start = intended
+	}
+	r.corrected.Results() <- res
+	return r.countError(err)
+}
+
+func (r *Recorder) observeOp(op string, res report.Result) {
+	r.opMu.Lock()
+	o, ok := r.ops[op]
+	if !ok {
+		o = &opReport{report: report.NewReport("%4.4f")}
+		o.done = o.report.Stats()
+		r.ops[op] = o
+	}
+	r.opMu.Unlock()
+	o.report.Results() <- res
+}
+
+func (r *Recorder) observeHeatmap(end time.Time, lat time.Duration) {
+	sec := end.Unix()
+	r.heatmapMu.Lock()
+	counts, ok := r.heatmap[sec]
+	if !ok {
+		counts = make([]int64, len(LatencyBucketsMs)+1)
+		r.heatmap[sec] = counts
+	}
+	counts[LatencyBucket(lat)]++
+	r.heatmapMu.Unlock()
+}
+
+func (r *Recorder) countError(err error) error {
+	n := atomic.AddInt64(&r.requestsN, 1)
+	if err == nil {
+		atomic.StoreInt64(&r.consecutiveN, 0)
+		return nil
+	}
+	errN := atomic.AddInt64(&r.errorsN, 1)
+	consecutive := atomic.AddInt64(&r.consecutiveN, 1)
+
+	if r.budget.MaxConsecutiveErrors > 0 && consecutive >= r.budget.MaxConsecutiveErrors {
+		return fmt.Errorf("aborted after %d consecutive errors (last error %v)", consecutive, err)
+	}
+	if r.budget.MaxErrorRate > 0 && n >= MinRequestsForErrorRate {
+		if rate := float64(errN) / float64(n); rate > r.budget.MaxErrorRate {
+			return fmt.Errorf("aborted with error rate %.4f > %.4f (%d errors out of %d requests, last error %v)", rate, r.budget.MaxErrorRate, errN, n, err)
+		}
+	}
+	return nil
+}
+
+// Close stops recording, and returns the results. Observe must not be
+// called after Close.
+func (r *Recorder) Close() Result {
+	close(r.report.Results())
+	close(r.corrected.Results())
+	res := Result{
+		Stats:          <-r.reportDone,
+		CorrectedStats: <-r.correctedDone,
+		OpStats:        make(map[string]report.Stats),
+	}
+
+	r.opMu.Lock()
+	for op, o := range r.ops {
+		close(o.report.Results())
+		res.OpStats[op] = <-o.done
+	}
+	r.opMu.Unlock()
+
+	r.heatmapMu.Lock()
+	res.Heatmap = r.heatmap
+	r.heatmapMu.Unlock()
+	return res
+}
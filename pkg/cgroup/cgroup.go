@@ -0,0 +1,227 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cgroup reads CPU, memory, and I/O accounting of processes
+// from the cgroup v2 unified hierarchy.
+package cgroup
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultRoot is the mount point of the cgroup v2 unified hierarchy.
+const DefaultRoot = "/sys/fs/cgroup"
+
+// Unified returns true if 'root' is mounted as the cgroup v2 unified hierarchy.
+func Unified(root string) bool {
+	_, err := os.Stat(filepath.Join(root, "cgroup.controllers"))
+	return err == nil
+}
+
+// Find returns the cgroup directory of the process under 'root'.
+func Find(root string, pid int64) (string, error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/cgroup", pid))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	p, err := parseProcCgroup(f)
+	if err != nil {
+		return "", fmt.Errorf("pid %d: %v", pid, err)
+	}
+	return filepath.Join(root, p), nil
+}
+
+// parseProcCgroup returns the path of the unified hierarchy entry
+// ('0::<path>') in '/proc/<pid>/cgroup'.
+func parseProcCgroup(r io.Reader) (string, error) {
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		if p := strings.TrimPrefix(sc.Text(), "0::"); p != sc.Text() {
+			return p, nil
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no cgroup v2 entry")
+}
+
+// Stats is the cumulative accounting of a cgroup.
+type Stats struct {
+	// CPUUsage is 'usage_usec' of 'cpu.stat'.
+	CPUUsage time.Duration
+	// MemoryBytes is 'memory.current'.
+	MemoryBytes uint64
+	// PeakMemoryBytes is 'memory.peak', zero on kernels older than 5.19.
+	PeakMemoryBytes uint64
+	// OOMKills is 'oom_kill' of 'memory.events'.
+	OOMKills uint64
+	// ReadBytes and WriteBytes are 'rbytes' and 'wbytes' of 'io.stat',
+	// summed over all devices.
+	ReadBytes  uint64
+	WriteBytes uint64
+}
+
+// Read reads the stats of the cgroup directory. It returns error
+// if the cpu, memory, or io controller is not enabled for the cgroup.
+func Read(dir string) (Stats, error) {
+	var st Stats
+	kv, err := readKeyValues(filepath.Join(dir, "cpu.stat"))
+	if err != nil {
+		return st, err
+	}
+	usec, ok := kv["usage_usec"]
+	if !ok {
+		return st, fmt.Errorf("%q has no 'usage_usec'", filepath.Join(dir, "cpu.stat"))
+	}
+	st.CPUUsage = time.Duration(usec) * time.Microsecond
+
+	bts, err := ioutil.ReadFile(filepath.Join(dir, "memory.current"))
+	if err != nil {
+		return st, err
+	}
+	if st.MemoryBytes, err = strconv.ParseUint(strings.TrimSpace(string(bts)), 10, 64); err != nil {
+		return st, fmt.Errorf("%q: %v", filepath.Join(dir, "memory.current"), err)
+	}
+	if bts, err = ioutil.ReadFile(filepath.Join(dir, "memory.peak")); err == nil {
+		if st.PeakMemoryBytes, err = strconv.ParseUint(strings.TrimSpace(string(bts)), 10, 64); err != nil {
+			return st, fmt.Errorf("%q: %v", filepath.Join(dir, "memory.peak"), err)
+		}
+	}
+	if kv, err = readKeyValues(filepath.Join(dir, "memory.events")); err != nil {
+		return st, err
+	}
+	st.OOMKills = kv["oom_kill"]
+
+	if st.ReadBytes, st.WriteBytes, err = readIOStat(filepath.Join(dir, "io.stat")); err != nil {
+		return st, err
+	}
+	return st, nil
+}
+
+// readKeyValues reads 'key value' lines of flat keyed files (e.g. 'cpu.stat').
+func readKeyValues(fpath string) (map[string]uint64, error) {
+	bts, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	kv := make(map[string]uint64)
+	for _, line := range strings.Split(string(bts), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %v", fpath, err)
+		}
+		kv[fields[0]] = v
+	}
+	return kv, nil
+}
+
+// readIOStat sums 'rbytes' and 'wbytes' of nested keyed 'io.stat' lines
+// (e.g. '8:0 rbytes=1459200 wbytes=314773504 rios=192 wios=353 ...').
+func readIOStat(fpath string) (rbytes, wbytes uint64, err error) {
+	bts, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, line := range strings.Split(string(bts), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		for _, f := range fields[1:] {
+			kv := strings.SplitN(f, "=", 2)
+			if len(kv) != 2 || (kv[0] != "rbytes" && kv[0] != "wbytes") {
+				continue
+			}
+			v, err := strconv.ParseUint(kv[1], 10, 64)
+			if err != nil {
+				return 0, 0, fmt.Errorf("%q: %v", fpath, err)
+			}
+			if kv[0] == "rbytes" {
+				rbytes += v
+			} else {
+				wbytes += v
+			}
+		}
+	}
+	return rbytes, wbytes, nil
+}
+
+// Usage is the usage of a cgroup since the previous sample.
+type Usage struct {
+	// CPUPercent is the percent of one CPU, as 'top' reports.
+	CPUPercent      float64
+	MemoryBytes     uint64
+	PeakMemoryBytes uint64
+	ReadBytesDelta  uint64
+	WriteBytesDelta uint64
+}
+
+// Reader samples the usage of a cgroup.
+type Reader struct {
+	Dir string
+
+	first  Stats
+	prev   Stats
+	prevAt time.Time
+}
+
+// Sample reads the stats, and returns the usage since the previous
+// sample. CPU and I/O deltas of the first sample are zero.
+func (r *Reader) Sample() (Usage, error) {
+	st, err := Read(r.Dir)
+	if err != nil {
+		return Usage{}, err
+	}
+	now := time.Now()
+	u := Usage{MemoryBytes: st.MemoryBytes, PeakMemoryBytes: st.PeakMemoryBytes}
+	if r.prevAt.IsZero() {
+		r.first = st
+	} else {
+		if d := now.Sub(r.prevAt); d > 0 && st.CPUUsage >= r.prev.CPUUsage {
+			u.CPUPercent = 100 * float64(st.CPUUsage-r.prev.CPUUsage) / float64(d)
+		}
+		if st.ReadBytes >= r.prev.ReadBytes {
+			u.ReadBytesDelta = st.ReadBytes - r.prev.ReadBytes
+		}
+		if st.WriteBytes >= r.prev.WriteBytes {
+			u.WriteBytesDelta = st.WriteBytes - r.prev.WriteBytes
+		}
+	}
+	r.prev, r.prevAt = st, now
+	return u, nil
+}
+
+// OOMKilled returns true if the OOM killer has killed any process
+// of the cgroup since the first sample.
+func (r *Reader) OOMKilled() (bool, error) {
+	kv, err := readKeyValues(filepath.Join(r.Dir, "memory.events"))
+	if err != nil {
+		return false, err
+	}
+	return kv["oom_kill"] > r.first.OOMKills, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseProcCgroup(t *testing.T) {
+	p, err := parseProcCgroup(strings.NewReader("0::/system.slice/etcd.service\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != "/system.slice/etcd.service" {
+		t.Fatalf("unexpected path %q", p)
+	}
+	if _, err = parseProcCgroup(strings.NewReader("12:memory:/user.slice\n1:name=systemd:/user.slice\n")); err == nil {
+		t.Fatal("expected error of cgroup v1 only entries")
+	}
+}
+
+func TestRead(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "cgroup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	write := func(cpuUsec, mem, rbytes, wbytes string) {
+		for name, s := range map[string]string{
+			"cpu.stat":       "usage_usec " + cpuUsec + "\nuser_usec 100\nsystem_usec 50\n",
+			"memory.current": mem + "\n",
+			"memory.events":  "low 0\nhigh 0\nmax 3\noom 1\noom_kill 1\n",
+			"io.stat":        "8:0 rbytes=" + rbytes + " wbytes=" + wbytes + " rios=1 wios=2\n259:0 rbytes=10 wbytes=20 rios=1 wios=2\n",
+		} {
+			if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(s), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	write("150", "4096", "100", "200")
+
+	st, err := Read(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Stats{CPUUsage: 150 * time.Microsecond, MemoryBytes: 4096, OOMKills: 1, ReadBytes: 110, WriteBytes: 220}
+	if st != expected {
+		t.Fatalf("expected %+v, got %+v", expected, st)
+	}
+
+	r := &Reader{Dir: dir}
+	u, err := r.Sample()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.CPUPercent != 0 || u.ReadBytesDelta != 0 || u.MemoryBytes != 4096 {
+		t.Fatalf("unexpected first sample %+v", u)
+	}
+	write("1000150", "8192", "1100", "2200")
+	u, err = r.Sample()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.CPUPercent <= 0 || u.MemoryBytes != 8192 || u.ReadBytesDelta != 1000 || u.WriteBytesDelta != 2000 {
+		t.Fatalf("unexpected sample %+v", u)
+	}
+
+	if killed, err := r.OOMKilled(); err != nil || killed {
+		t.Fatalf("unexpected OOM kill (%v)", err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "memory.events"), []byte("oom 2\noom_kill 2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if killed, err := r.OOMKilled(); err != nil || !killed {
+		t.Fatalf("expected OOM kill (%v)", err)
+	}
+
+	if err = os.Remove(filepath.Join(dir, "io.stat")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = Read(dir); err == nil {
+		t.Fatal("expected error without io controller")
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diskdelay simulates a slow disk with a device-mapper 'delay' target
+// on a loop device, so that writes and flushes (fsync) take longer.
+//
+// LD_PRELOAD shims do not work for Go databases (etcd, Consul),
+// which call fsync without libc, so the delay is added in the block layer.
+package diskdelay
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Config is the delayed disk configuration.
+type Config struct {
+	// Name is the device-mapper device name (e.g. "dbtester-delay").
+	Name string
+	// BackingFile is the sparse file backing the loop device.
+	BackingFile string
+	// SizeBytes is the size of the disk.
+	SizeBytes int64
+	// MountDir is where the file system on the delayed disk is mounted.
+	MountDir string
+
+	// ReadDelay is added to every read.
+	ReadDelay time.Duration
+	// WriteDelay is added to every write and flush.
+	WriteDelay time.Duration
+}
+
+const sectorSize = 512
+
+// Table returns the device-mapper table of the delay target
+// on the device with the given number of 512-byte sectors.
+//
+//	0 <sectors> delay <device> 0 <read-delay-ms> <device> 0 <write-delay-ms>
+func Table(device string, sectors int64, readDelay, writeDelay time.Duration) string {
+	return fmt.Sprintf("0 %d delay %s 0 %d %s 0 %d",
+		sectors,
+		device, readDelay/time.Millisecond,
+		device, writeDelay/time.Millisecond,
+	)
+}
+
+// Setup creates the delayed disk with an ext4 file system, and mounts it.
+// Any existing disk of the same configuration is torn down first.
+//
+//	truncate -s 32G /home/gyuho/disk-delay.img
+//	sudo losetup --find --show /home/gyuho/disk-delay.img
+//	sudo dmsetup create dbtester-delay --table "0 67108864 delay /dev/loop0 0 0 /dev/loop0 0 10"
+//	sudo mkfs.ext4 -q /dev/mapper/dbtester-delay
+//	sudo mount /dev/mapper/dbtester-delay /home/gyuho/disk-delay
+//	sudo chown $(id -u):$(id -g) /home/gyuho/disk-delay
+func Setup(cfg Config) (string, error) {
+	if cfg.SizeBytes < sectorSize {
+		return "", fmt.Errorf("disk size %d is too small", cfg.SizeBytes)
+	}
+
+	// ignore errors when there is nothing to tear down
+	Teardown(cfg)
+
+	f, err := os.Create(cfg.BackingFile)
+	if err != nil {
+		return "", err
+	}
+	err = f.Truncate(cfg.SizeBytes)
+	f.Close()
+	if err != nil {
+		return "", err
+	}
+	if err = os.MkdirAll(cfg.MountDir, 0777); err != nil {
+		return "", err
+	}
+
+	var outs []string
+	loop, err := sudo("losetup", "--find", "--show", cfg.BackingFile)
+	if err != nil {
+		return loop, err
+	}
+	dev := devicePath(cfg.Name)
+	for _, args := range [][]string{
+		{"dmsetup", "create", cfg.Name, "--table", Table(loop, cfg.SizeBytes/sectorSize, cfg.ReadDelay, cfg.WriteDelay)},
+		{"mkfs.ext4", "-q", dev},
+		{"mount", dev, cfg.MountDir},
+		{"chown", fmt.Sprintf("%d:%d", os.Getuid(), os.Getgid()), cfg.MountDir},
+	} {
+		o, err := sudo(args...)
+		if o != "" {
+			outs = append(outs, o)
+		}
+		if err != nil {
+			return strings.Join(outs, ";"), err
+		}
+	}
+	return strings.Join(outs, ";"), nil
+}
+
+// Teardown unmounts and removes the delayed disk, and its backing file.
+//
+//	sudo umount /home/gyuho/disk-delay
+//	sudo dmsetup remove dbtester-delay
+//	sudo losetup --detach-all /home/gyuho/disk-delay.img
+func Teardown(cfg Config) (string, error) {
+	var (
+		outs []string
+		rerr error
+	)
+	for _, args := range [][]string{
+		{"umount", cfg.MountDir},
+		{"dmsetup", "remove", cfg.Name},
+	} {
+		o, err := sudo(args...)
+		if o != "" {
+			outs = append(outs, o)
+		}
+		if err != nil && rerr == nil {
+			rerr = err
+		}
+	}
+
+	// detach all loop devices of the backing file
+	o, err := sudo("losetup", "--associated", cfg.BackingFile, "--noheadings", "--output", "NAME")
+	if err == nil {
+		for _, loop := range strings.Fields(o) {
+			if o, err = sudo("losetup", "--detach", loop); o != "" {
+				outs = append(outs, o)
+			}
+			if err != nil && rerr == nil {
+				rerr = err
+			}
+		}
+	}
+	if err = os.RemoveAll(cfg.BackingFile); err != nil && rerr == nil {
+		rerr = err
+	}
+	return strings.Join(outs, ";"), rerr
+}
+
+func devicePath(name string) string {
+	return "/dev/mapper/" + name
+}
+
+func sudo(args ...string) (string, error) {
+	buf := new(bytes.Buffer)
+	cmd := exec.Command("sudo", args...)
+	cmd.Stdout = buf
+	cmd.Stderr = buf
+	err := cmd.Run()
+	return strings.TrimSpace(buf.String()), err
+}
@@ -0,0 +1,28 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diskdelay
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTable(t *testing.T) {
+	tb := Table("/dev/loop0", 2048, 0, 10*time.Millisecond)
+	exp := "0 2048 delay /dev/loop0 0 0 /dev/loop0 0 10"
+	if tb != exp {
+		t.Fatalf("expected %q, got %q", exp, tb)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flamediff compares CPU profiles in folded stack format
+// (e.g. 'perf script | stackcollapse-perf.pl'), to show where CPU
+// time shifted between two workloads or versions.
+package flamediff
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Profile is the number of samples by folded stack,
+// with frames separated by ';' from root to leaf.
+type Profile map[string]int64
+
+// ReadFolded reads the profile of "stack count" lines.
+func ReadFolded(r io.Reader) (Profile, error) {
+	p := make(Profile)
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for ln := 1; sc.Scan(); ln++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		i := strings.LastIndexByte(line, ' ')
+		if i < 0 {
+			return nil, fmt.Errorf("line %d: no sample count in %q", ln, line)
+		}
+		n, err := strconv.ParseInt(line[i+1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid sample count (%v)", ln, err)
+		}
+		p[strings.TrimSpace(line[:i])] += n
+	}
+	return p, sc.Err()
+}
+
+// Total returns the total number of samples.
+func (p Profile) Total() (n int64) {
+	for _, c := range p {
+		n += c
+	}
+	return n
+}
+
+// WriteDiff writes "stack baseline target" lines of all stacks, the
+// input format of differential flame graphs (e.g. 'flamegraph.pl').
+// If normalize is true, target counts are scaled to the baseline total,
+// so that captures of different durations are comparable.
+func WriteDiff(w io.Writer, baseline, target Profile, normalize bool) error {
+	scale := 1.0
+	if bt, tt := baseline.Total(), target.Total(); normalize && tt > 0 {
+		scale = float64(bt) / float64(tt)
+	}
+
+	stacks := make([]string, 0, len(baseline)+len(target))
+	for s := range baseline {
+		stacks = append(stacks, s)
+	}
+	for s := range target {
+		if _, ok := baseline[s]; !ok {
+			stacks = append(stacks, s)
+		}
+	}
+	sort.Strings(stacks)
+
+	bw := bufio.NewWriter(w)
+	for _, s := range stacks {
+		tc := int64(math.Round(float64(target[s]) * scale))
+		if _, err := fmt.Fprintf(bw, "%s %d %d\n", s, baseline[s], tc); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// Shift is the CPU share of a function in two profiles, in percent
+// of total samples. Self is when the function is on top of the stack,
+// and total is when it is anywhere on the stack.
+type Shift struct {
+	Function string
+
+	BaselineSelf, TargetSelf   float64
+	BaselineTotal, TargetTotal float64
+}
+
+// Delta returns the change of total share in percentage points.
+func (s Shift) Delta() float64 { return s.TargetTotal - s.BaselineTotal }
+
+// Shifts returns the shift of all functions in either profile,
+// ordered by the largest change of total share first.
+func Shifts(baseline, target Profile) []Shift {
+	bs, bt := shares(baseline)
+	ts, tt := shares(target)
+
+	byFn := make(map[string]*Shift)
+	get := func(fn string) *Shift {
+		s, ok := byFn[fn]
+		if !ok {
+			s = &Shift{Function: fn}
+			byFn[fn] = s
+		}
+		return s
+	}
+	for fn, v := range bs {
+		get(fn).BaselineSelf = v
+	}
+	for fn, v := range bt {
+		get(fn).BaselineTotal = v
+	}
+	for fn, v := range ts {
+		get(fn).TargetSelf = v
+	}
+	for fn, v := range tt {
+		get(fn).TargetTotal = v
+	}
+
+	ss := make([]Shift, 0, len(byFn))
+	for _, s := range byFn {
+		ss = append(ss, *s)
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		di, dj := math.Abs(ss[i].Delta()), math.Abs(ss[j].Delta())
+		if di != dj {
+			return di > dj
+		}
+		return ss[i].Function < ss[j].Function
+	})
+	return ss
+}
+
+// shares returns the self and total share of each function in percent.
+func shares(p Profile) (self, total map[string]float64) {
+	self, total = make(map[string]float64), make(map[string]float64)
+	sum := p.Total()
+	if sum == 0 {
+		return self, total
+	}
+	for stack, n := range p {
+		frames := strings.Split(stack, ";")
+		pct := 100 * float64(n) / float64(sum)
+		self[frames[len(frames)-1]] += pct
+
+		// recursive functions are counted once per stack
+		seen := make(map[string]bool, len(frames))
+		for _, fn := range frames {
+			if !seen[fn] {
+				seen[fn] = true
+				total[fn] += pct
+			}
+		}
+	}
+	return self, total
+}
@@ -0,0 +1,72 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flamediff
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestReadFolded(t *testing.T) {
+	p, err := ReadFolded(strings.NewReader("main;put;fsync 30\nmain;get 10\n\nmain;put;fsync 20\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p["main;put;fsync"] != 50 || p["main;get"] != 10 || p.Total() != 60 {
+		t.Fatalf("unexpected profile %v", p)
+	}
+
+	if _, err = ReadFolded(strings.NewReader("main;get\n")); err == nil {
+		t.Fatal("expected error on missing count")
+	}
+}
+
+func TestWriteDiff(t *testing.T) {
+	baseline := Profile{"main;put": 10, "main;get": 10}
+	target := Profile{"main;put": 30, "main;range": 10}
+
+	var buf bytes.Buffer
+	if err := WriteDiff(&buf, baseline, target, true); err != nil {
+		t.Fatal(err)
+	}
+	exp := "main;get 10 0\nmain;put 10 15\nmain;range 0 5\n"
+	if buf.String() != exp {
+		t.Fatalf("expected %q, got %q", exp, buf.String())
+	}
+}
+
+func TestShifts(t *testing.T) {
+	baseline := Profile{"main;put;fsync": 50, "main;get": 50}
+	target := Profile{"main;put;fsync": 75, "main;get": 25}
+
+	ss := Shifts(baseline, target)
+	// ties are ordered by name, and 'main' is always 100%
+	if len(ss) != 4 || ss[0].Function != "fsync" || ss[1].Function != "get" || ss[2].Function != "put" || ss[3].Function != "main" {
+		t.Fatalf("unexpected shifts %+v", ss)
+	}
+	if math.Abs(ss[0].TargetSelf-75) > 1e-9 || math.Abs(ss[1].Delta()+25) > 1e-9 || ss[2].TargetSelf != 0 || ss[3].Delta() != 0 {
+		t.Fatalf("unexpected shifts %+v", ss)
+	}
+
+	// recursive frames are counted once
+	ss = Shifts(Profile{"a;b;a": 1}, Profile{"a;b;a": 1})
+	for _, s := range ss {
+		if s.Function == "a" && s.BaselineTotal != 100 {
+			t.Fatalf("expected 100%%, got %f", s.BaselineTotal)
+		}
+	}
+}
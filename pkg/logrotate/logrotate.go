@@ -0,0 +1,197 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logrotate rotates files by size and age, with optional
+// compression and retention of rotated files.
+package logrotate
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Config defines when to rotate files and how many to keep.
+// Zero values disable each option.
+type Config struct {
+	// MaxBytes is the maximum size of the file before rotation.
+	MaxBytes int64
+	// MaxAge is the maximum duration the file is written before rotation.
+	MaxAge time.Duration
+	// MaxBackups is the maximum number of rotated files to keep.
+	MaxBackups int
+	// Compress is true to gzip rotated files.
+	Compress bool
+}
+
+// timeFormat is the suffix format of rotated files, sorted by time.
+const timeFormat = "20060102T150405.000000000"
+
+// Writer writes to a file, rotating it when it exceeds the size or age.
+type Writer struct {
+	fpath string
+	cfg   Config
+
+	mu       sync.Mutex
+	f        *os.File
+	size     int64
+	openedAt time.Time
+}
+
+// NewWriter opens 'fpath' to append, rotating with the given config.
+func NewWriter(fpath string, cfg Config) (*Writer, error) {
+	w := &Writer{fpath: fpath, cfg: cfg}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *Writer) open() error {
+	f, err := os.OpenFile(w.fpath, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0777)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	w.f, w.size, w.openedAt = f, fi.Size(), time.Now()
+	return nil
+}
+
+// Write writes to the file, rotating it first if the write would
+// exceed 'MaxBytes' or the file is older than 'MaxAge'.
+func (w *Writer) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.size > 0 && ((w.cfg.MaxBytes > 0 && w.size+int64(len(p)) > w.cfg.MaxBytes) ||
+		(w.cfg.MaxAge > 0 && time.Since(w.openedAt) > w.cfg.MaxAge)) {
+		if err := w.f.Close(); err != nil {
+			return 0, err
+		}
+		if _, err := Rotate(w.fpath, w.cfg); err != nil {
+			return 0, err
+		}
+		if err := w.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.f.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// Sync commits the current contents of the file to disk.
+func (w *Writer) Sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.f.Sync()
+}
+
+// Close closes the file.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.f.Close()
+}
+
+// Rotate renames 'fpath' with the current time suffix, compressing it
+// if configured, and removes the oldest rotated files beyond 'MaxBackups'.
+// It returns the path of the rotated file.
+func Rotate(fpath string, cfg Config) (string, error) {
+	rpath := fpath + "." + time.Now().Format(timeFormat)
+	if err := os.Rename(fpath, rpath); err != nil {
+		return "", err
+	}
+	if cfg.Compress {
+		gpath, err := compress(rpath)
+		if err != nil {
+			return "", err
+		}
+		rpath = gpath
+	}
+
+	if cfg.MaxBackups > 0 {
+		bs, err := Backups(fpath)
+		if err != nil {
+			return "", err
+		}
+		for len(bs) > cfg.MaxBackups {
+			if err = os.Remove(bs[0]); err != nil {
+				return "", err
+			}
+			bs = bs[1:]
+		}
+	}
+	return rpath, nil
+}
+
+// Backups returns the rotated files of 'fpath', from oldest to newest.
+func Backups(fpath string) ([]string, error) {
+	bs, err := filepath.Glob(fpath + ".*")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(bs)
+	return bs, nil
+}
+
+// RemoveBackups removes all rotated files of 'fpath'.
+func RemoveBackups(fpath string) error {
+	bs, err := Backups(fpath)
+	if err != nil {
+		return err
+	}
+	for _, b := range bs {
+		if err = os.Remove(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func compress(fpath string) (string, error) {
+	src, err := os.Open(fpath)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	gpath := fpath + ".gz"
+	dst, err := os.OpenFile(gpath, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0777)
+	if err != nil {
+		return "", err
+	}
+	gw := gzip.NewWriter(dst)
+	if _, err = io.Copy(gw, src); err != nil {
+		dst.Close()
+		return "", err
+	}
+	if err = gw.Close(); err != nil {
+		dst.Close()
+		return "", err
+	}
+	if err = dst.Close(); err != nil {
+		return "", err
+	}
+	return gpath, os.Remove(fpath)
+}
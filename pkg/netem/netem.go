@@ -0,0 +1,150 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package netem simulates network latency between peers with 'tc netem'.
+package netem
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+)
+
+// zoneDelays is the one-way delay matrix between zones,
+// where zoneDelays[i][j] is the delay from zone i to zone j.
+// Delays are asymmetric to mimic real routes.
+type zoneDelays [][]time.Duration
+
+// profiles maps profile name to its zone delay matrix.
+// Peers are assigned to zones in round-robin order.
+var profiles = map[string]zoneDelays{
+	// all peers in one zone
+	"same-zone": {
+		{250 * time.Microsecond},
+	},
+
+	// three zones in one region, ~2ms RTT
+	"cross-zone": {
+		{250 * time.Microsecond, 900 * time.Microsecond, 1100 * time.Microsecond},
+		{1000 * time.Microsecond, 250 * time.Microsecond, 950 * time.Microsecond},
+		{1200 * time.Microsecond, 1050 * time.Microsecond, 250 * time.Microsecond},
+	},
+
+	// three regions, ~70ms RTT
+	"cross-region": {
+		{250 * time.Microsecond, 33 * time.Millisecond, 36 * time.Millisecond},
+		{37 * time.Millisecond, 250 * time.Microsecond, 34 * time.Millisecond},
+		{35 * time.Millisecond, 38 * time.Millisecond, 250 * time.Microsecond},
+	},
+}
+
+// Profiles returns all available profile names.
+func Profiles() []string {
+	ns := make([]string, 0, len(profiles))
+	for k := range profiles {
+		ns = append(ns, k)
+	}
+	sort.Strings(ns)
+	return ns
+}
+
+// IsValidProfile returns true if the profile is available.
+func IsValidProfile(name string) bool {
+	_, ok := profiles[name]
+	return ok
+}
+
+// Matrix returns the one-way delay matrix between n peers, where
+// matrix[i][j] is the delay from peer i to peer j.
+func Matrix(name string, n int) ([][]time.Duration, error) {
+	zs, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown latency profile %q (available %q)", name, Profiles())
+	}
+	m := make([][]time.Duration, n)
+	for i := range m {
+		m[i] = make([]time.Duration, n)
+		for j := range m[i] {
+			if i == j {
+				continue
+			}
+			m[i][j] = zs[i%len(zs)][j%len(zs)]
+		}
+	}
+	return m, nil
+}
+
+// Apply delays outgoing packets to each peer IP on the network interface.
+// Zero delay is skipped. It replaces any existing root qdisc.
+//
+//	sudo tc qdisc add dev eth0 root handle 1: htb
+//	sudo tc class add dev eth0 parent 1: classid 1:1 htb rate 10gbit
+//	sudo tc qdisc add dev eth0 parent 1:1 handle 10: netem delay 33ms
+//	sudo tc filter add dev eth0 protocol ip parent 1: prio 1 u32 match ip dst 10.240.0.8/32 flowid 1:1
+func Apply(iface string, peerIPs []string, delays []time.Duration) (string, error) {
+	if len(peerIPs) != len(delays) {
+		return "", fmt.Errorf("len(peerIPs) %d != len(delays) %d", len(peerIPs), len(delays))
+	}
+
+	// ignore error when there is no root qdisc
+	Reset(iface)
+
+	var outs []string
+	o, err := tc("qdisc", "add", "dev", iface, "root", "handle", "1:", "htb")
+	if o != "" {
+		outs = append(outs, o)
+	}
+	if err != nil {
+		return strings.Join(outs, ";"), err
+	}
+	for i, ip := range peerIPs {
+		if delays[i] <= 0 {
+			continue
+		}
+		classID := fmt.Sprintf("1:%d", i+1)
+		for _, args := range [][]string{
+			{"class", "add", "dev", iface, "parent", "1:", "classid", classID, "htb", "rate", "10gbit"},
+			{"qdisc", "add", "dev", iface, "parent", classID, "handle", fmt.Sprintf("%d:", i+10), "netem", "delay", fmt.Sprintf("%dus", delays[i]/time.Microsecond)},
+			{"filter", "add", "dev", iface, "protocol", "ip", "parent", "1:", "prio", "1", "u32", "match", "ip", "dst", ip + "/32", "flowid", classID},
+		} {
+			o, err = tc(args...)
+			if o != "" {
+				outs = append(outs, o)
+			}
+			if err != nil {
+				return strings.Join(outs, ";"), err
+			}
+		}
+	}
+	return strings.Join(outs, ";"), nil
+}
+
+// Reset removes the root qdisc from the network interface.
+//
+//	sudo tc qdisc del dev eth0 root
+func Reset(iface string) (string, error) {
+	return tc("qdisc", "del", "dev", iface, "root")
+}
+
+func tc(args ...string) (string, error) {
+	buf := new(bytes.Buffer)
+	cmd := exec.Command("sudo", append([]string{"tc"}, args...)...)
+	cmd.Stdout = buf
+	cmd.Stderr = buf
+	err := cmd.Run()
+	return strings.TrimSpace(buf.String()), err
+}
@@ -0,0 +1,51 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netem
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMatrix(t *testing.T) {
+	for _, name := range Profiles() {
+		m, err := Matrix(name, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range m {
+			if m[i][i] != 0 {
+				t.Fatalf("%s: expected no delay to self, got %v", name, m[i][i])
+			}
+			for j := range m[i] {
+				if i != j && m[i][j] <= 0 {
+					t.Fatalf("%s: expected delay from %d to %d, got %v", name, i, j, m[i][j])
+				}
+			}
+		}
+	}
+
+	m, err := Matrix("cross-region", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m[0][1] != 33*time.Millisecond || m[1][0] != 37*time.Millisecond {
+		t.Fatalf("unexpected cross-region delays %v, %v", m[0][1], m[1][0])
+	}
+
+	if _, err = Matrix("unknown", 3); err == nil {
+		t.Fatal("expected error on unknown profile")
+	}
+}
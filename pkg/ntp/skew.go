@@ -0,0 +1,59 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ntp
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Skew steps the system time by the offset, after stopping
+// NTP service so that the skew is not corrected.
+//
+//	sudo service ntp stop
+//	sudo date --set @1500000000.500000000
+func Skew(offset time.Duration) (string, error) {
+	// ignore error when NTP service is not installed
+	so, _ := serviceNTP("stop")
+
+	now := time.Now().Add(offset)
+	buf := new(bytes.Buffer)
+	cmd := exec.Command("sudo", "date", "--set", fmt.Sprintf("@%d.%09d", now.Unix(), now.Nanosecond()))
+	cmd.Stdout = buf
+	cmd.Stderr = buf
+	err := cmd.Run()
+	o := strings.TrimSpace(buf.String())
+	if so != "" {
+		o = so + ";" + o
+	}
+	return o, err
+}
+
+// Unskew syncs the system time with NTP server,
+// and restarts NTP service stopped by 'Skew'.
+func Unskew() (string, error) {
+	o, err := DefaultSync()
+	if err != nil {
+		return o, err
+	}
+	so, err := serviceNTP("start")
+	if so != "" {
+		o += ";" + so
+	}
+	return o, err
+}
@@ -0,0 +1,109 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package oomkill finds kills of processes by the kernel OOM killer
+// in the kernel log.
+package oomkill
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// MaxExcerptLines limits the kernel log excerpt of a kill, since the
+// OOM killer dumps the memory of every task.
+const MaxExcerptLines = 200
+
+// timestampLayout is the wall clock timestamp of 'dmesg --time-format iso'.
+const timestampLayout = "2006-01-02T15:04:05,000000-07:00"
+
+// ReadKernelLog returns the kernel log lines with wall clock timestamps.
+// It requires permissions to read the kernel log (root, or
+// 'kernel.dmesg_restrict=0').
+func ReadKernelLog() ([]string, error) {
+	out, err := exec.Command("dmesg", "--time-format", "iso").CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("dmesg failed %q (%v)", strings.TrimSpace(string(out)), err)
+	}
+	return strings.Split(strings.TrimRight(string(out), "\n"), "\n"), nil
+}
+
+// Event is a kill of a process by the OOM killer.
+type Event struct {
+	// At is the time of the kill, zero if the kernel log has
+	// no wall clock timestamps.
+	At time.Time
+	// Excerpt is the kernel log lines from the OOM killer invocation
+	// to the kill, truncated to MaxExcerptLines.
+	Excerpt []string
+}
+
+// Find returns the last kill of the process in the kernel log lines.
+func Find(lines []string, pid int64) (Event, bool) {
+	killed := -1
+	for i := len(lines) - 1; i >= 0 && killed < 0; i-- {
+		if killedLine(lines[i], pid) {
+			killed = i
+		}
+	}
+	if killed < 0 {
+		return Event{}, false
+	}
+
+	start := killed
+	for i := killed; i >= 0; i-- {
+		if strings.Contains(lines[i], "invoked oom-killer") {
+			start = i
+			break
+		}
+	}
+	end := killed + 1
+	for end < len(lines) && killedLine(lines[end], pid) {
+		end++
+	}
+	// e.g. 'oom_reaper: reaped process 1234 (etcd), now anon-rss:0kB'
+	if end < len(lines) && strings.Contains(lines[end], fmt.Sprintf("reaped process %d ", pid)) {
+		end++
+	}
+
+	ev := Event{Excerpt: truncate(lines[start:end], MaxExcerptLines)}
+	if fields := strings.Fields(lines[killed]); len(fields) > 0 {
+		if at, err := time.Parse(timestampLayout, fields[0]); err == nil {
+			ev.At = at
+		}
+	}
+	return ev, true
+}
+
+// killedLine returns true if the line reports the kill of the process, as
+// 'Out of memory: Killed process 1234 (etcd) ...' (or 'Memory cgroup out
+// of memory: ...'), or 'oom-kill:constraint=...,task=etcd,pid=1234,uid=0'.
+func killedLine(line string, pid int64) bool {
+	return strings.Contains(line, fmt.Sprintf("Killed process %d ", pid)) ||
+		(strings.Contains(line, "oom-kill:") && strings.Contains(line, fmt.Sprintf(",pid=%d,", pid)))
+}
+
+// truncate keeps the first and last lines, omitting the middle
+// (e.g. task dumps) of long excerpts.
+func truncate(lines []string, max int) []string {
+	if len(lines) <= max {
+		return append([]string{}, lines...)
+	}
+	half := max / 2
+	ls := append([]string{}, lines[:half]...)
+	ls = append(ls, fmt.Sprintf("... (%d lines omitted)", len(lines)-2*half))
+	return append(ls, lines[len(lines)-half:]...)
+}
@@ -0,0 +1,69 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oomkill
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testKernelLog = strings.Split(`2018-02-01T10:00:00,000000+00:00 EXT4-fs (sda1): mounted filesystem
+2018-02-01T10:00:05,100000+00:00 etcd invoked oom-killer: gfp_mask=0x14200ca(GFP_HIGHUSER_MOVABLE), order=0, oom_score_adj=0
+2018-02-01T10:00:05,100100+00:00 CPU: 3 PID: 1234 Comm: etcd Not tainted 4.15.0
+2018-02-01T10:00:05,100200+00:00 [ pid ]   uid  tgid total_vm      rss pgtables_bytes swapents oom_score_adj name
+2018-02-01T10:00:05,100300+00:00 [   1234]     0  1234  4194304  3932160 32000000        0             0 etcd
+2018-02-01T10:00:05,100400+00:00 oom-kill:constraint=CONSTRAINT_NONE,nodemask=(null),cpuset=/,mems_allowed=0,global_oom,task_memcg=/,task=etcd,pid=1234,uid=0
+2018-02-01T10:00:05,100500+00:00 Out of memory: Killed process 1234 (etcd) total-vm:16777216kB, anon-rss:15728640kB, file-rss:0kB, shmem-rss:0kB
+2018-02-01T10:00:05,200000+00:00 oom_reaper: reaped process 1234 (etcd), now anon-rss:0kB, file-rss:0kB, shmem-rss:0kB
+2018-02-01T10:00:06,000000+00:00 IPv6: ADDRCONF(NETDEV_UP): eth0: link is not ready`, "\n")
+
+func TestFind(t *testing.T) {
+	ev, ok := Find(testKernelLog, 1234)
+	if !ok {
+		t.Fatal("expected OOM kill of 1234")
+	}
+	if len(ev.Excerpt) != 7 || !strings.Contains(ev.Excerpt[0], "invoked oom-killer") || !strings.Contains(ev.Excerpt[6], "reaped process 1234") {
+		t.Fatalf("unexpected excerpt %q", ev.Excerpt)
+	}
+	expected := time.Date(2018, 2, 1, 10, 0, 5, 100500000, time.UTC)
+	if !ev.At.Equal(expected) {
+		t.Fatalf("expected %v, got %v", expected, ev.At)
+	}
+
+	if _, ok = Find(testKernelLog, 123); ok {
+		t.Fatal("unexpected OOM kill of 123")
+	}
+}
+
+func TestFindTruncate(t *testing.T) {
+	lines := []string{"[ 1.0] java invoked oom-killer: gfp_mask=0x14200ca"}
+	for i := 0; i < 1000; i++ {
+		lines = append(lines, fmt.Sprintf("[ 1.0] [ %d] 0 %d 100 100 0 0 0 java", i, i))
+	}
+	lines = append(lines, "[ 1.1] Memory cgroup out of memory: Killed process 77 (java) total-vm:1kB")
+
+	ev, ok := Find(lines, 77)
+	if !ok {
+		t.Fatal("expected OOM kill of 77")
+	}
+	if len(ev.Excerpt) != MaxExcerptLines+1 || !strings.Contains(ev.Excerpt[MaxExcerptLines/2], "lines omitted") {
+		t.Fatalf("unexpected excerpt of %d lines", len(ev.Excerpt))
+	}
+	if !ev.At.IsZero() {
+		t.Fatalf("unexpected time %v of monotonic timestamps", ev.At)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2016 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotestorage
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// LocalStorage copies files to a local directory,
+// to be pulled for immediate analysis.
+type LocalStorage struct {
+	lg  *zap.Logger
+	Dir string
+}
+
+// NewLocalStorage creates a new uploader to the local directory.
+func NewLocalStorage(lg *zap.Logger, dir string) (Uploader, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("empty local directory")
+	}
+	return &LocalStorage{lg: lg, Dir: dir}, nil
+}
+
+// UploadFile copies a file to the local directory.
+func (l *LocalStorage) UploadFile(bucket, src, dst string, opts ...OpOption) error {
+	if l == nil {
+		return fmt.Errorf("LocalStorage is nil")
+	}
+	target := filepath.Join(l.Dir, bucket, dst)
+	l.lg.Info("copying", zap.String("source", src), zap.String("destination", target))
+	if err := copyFile(src, target); err != nil {
+		return err
+	}
+	l.lg.Info("copied", zap.String("source", src), zap.String("destination", target))
+	return nil
+}
+
+// UploadDir copies a directory to the local directory.
+func (l *LocalStorage) UploadDir(bucket, src, dst string, opts ...OpOption) error {
+	if l == nil {
+		return fmt.Errorf("LocalStorage is nil")
+	}
+	fmap, err := walkRecursive(src)
+	if err != nil {
+		return err
+	}
+	for fpath := range fmap {
+		if err = l.UploadFile(bucket, fpath, filepath.Join(dst, strings.Replace(fpath, src, "", -1)), opts...); err != nil {
+			return err
+		}
+	}
+	l.lg.Info("finished copying", zap.String("source", src))
+	return nil
+}
+
+// ReadFile reads a file from the local directory.
+func (l *LocalStorage) ReadFile(bucket, src string) ([]byte, error) {
+	if l == nil {
+		return nil, fmt.Errorf("LocalStorage is nil")
+	}
+	b, err := ioutil.ReadFile(filepath.Join(l.Dir, bucket, src))
+	if os.IsNotExist(err) {
+		return nil, ErrNotExist
+	}
+	return b, err
+}
+
+func copyFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0777); err != nil {
+		return err
+	}
+	r, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	if _, err = io.Copy(w, r); err != nil {
+		return err
+	}
+	return w.Sync()
+}
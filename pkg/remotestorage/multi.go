@@ -0,0 +1,167 @@
+// Copyright 2016 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotestorage
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"go.uber.org/zap"
+)
+
+// Destination is an uploader with its bucket and sub-directory.
+type Destination struct {
+	Name         string
+	Bucket       string
+	SubDirectory string
+	Uploader     Uploader
+}
+
+// Status is the upload status of a destination.
+type Status struct {
+	Uploaded  int
+	Failed    int
+	LastError string
+}
+
+// Multi uploads to multiple destinations,
+// tracking success of each destination.
+type Multi struct {
+	lg    *zap.Logger
+	dests []Destination
+
+	mu     sync.Mutex
+	status map[string]Status
+}
+
+// NewMulti creates a new uploader to all remote storage destinations
+// of the configuration.
+func NewMulti(lg *zap.Logger, c *dbtesterpb.ConfigClientMachineInitial) (*Multi, error) {
+	if c == nil {
+		return nil, fmt.Errorf("nil ConfigClientMachineInitial")
+	}
+	var dests []Destination
+	for _, d := range c.Destinations() {
+		u, err := New(lg, d.Type, []byte(c.GoogleCloudStorageKey), c.GoogleCloudProjectName, d.LocalDirectory)
+		if err != nil {
+			return nil, fmt.Errorf("%v (destination %q)", err, d.Name)
+		}
+		dests = append(dests, Destination{
+			Name:         d.Name,
+			Bucket:       d.Bucket,
+			SubDirectory: d.SubDirectory,
+			Uploader:     u,
+		})
+	}
+	return newMulti(lg, dests...), nil
+}
+
+func newMulti(lg *zap.Logger, dests ...Destination) *Multi {
+	status := make(map[string]Status, len(dests))
+	for _, d := range dests {
+		status[d.Name] = Status{}
+	}
+	return &Multi{lg: lg, dests: dests, status: status}
+}
+
+// UploadFile uploads a file to all destinations in parallel, trying
+// up to 'retry' times (at least once) on each with backoff. It returns
+// error if any destination fails.
+func (m *Multi) UploadFile(src, dst string, retry int, opts ...OpOption) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(m.dests))
+	for i := range m.dests {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			d := m.dests[i]
+			target := filepath.Join(d.SubDirectory, dst)
+			var err error
+			for k := 0; k == 0 || k < retry; k++ {
+				if err = d.Uploader.UploadFile(d.Bucket, src, target, opts...); err == nil {
+					break
+				}
+				if k+1 >= retry {
+					break
+				}
+				m.lg.Warn("upload error; retrying...", zap.String("destination", d.Name), zap.Int("retry", k), zap.Error(err))
+				time.Sleep(backoff(k))
+			}
+			m.record(d.Name, err)
+			errs[i] = err
+		}(i)
+	}
+	wg.Wait()
+
+	var ss []string
+	for i, err := range errs {
+		if err != nil {
+			ss = append(ss, fmt.Sprintf("%s: %v", m.dests[i].Name, err))
+		}
+	}
+	if len(ss) > 0 {
+		return fmt.Errorf("failed to upload %q (%s)", src, strings.Join(ss, ", "))
+	}
+	return nil
+}
+
+// ReadFile reads 'src' from the first destination that supports reading.
+func (m *Multi) ReadFile(src string) ([]byte, error) {
+	for _, d := range m.dests {
+		r, ok := d.Uploader.(Reader)
+		if !ok {
+			continue
+		}
+		return r.ReadFile(d.Bucket, filepath.Join(d.SubDirectory, src))
+	}
+	return nil, fmt.Errorf("no destination supports reading")
+}
+
+// Objects returns the object locations of 'dst' in each destination.
+func (m *Multi) Objects(dst string) []string {
+	ss := make([]string, 0, len(m.dests))
+	for _, d := range m.dests {
+		ss = append(ss, fmt.Sprintf("%s:%s", d.Name, filepath.Join(d.Bucket, d.SubDirectory, dst)))
+	}
+	return ss
+}
+
+func (m *Multi) record(name string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	st := m.status[name]
+	if err != nil {
+		st.Failed++
+		st.LastError = err.Error()
+	} else {
+		st.Uploaded++
+	}
+	m.status[name] = st
+}
+
+// Status returns the upload status of each destination.
+func (m *Multi) Status() map[string]Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	status := make(map[string]Status, len(m.status))
+	for k, v := range m.status {
+		status[k] = v
+	}
+	return status
+}
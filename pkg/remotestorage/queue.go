@@ -0,0 +1,140 @@
+// Copyright 2016 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotestorage
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// File states in the upload queue.
+const (
+	FileStatePending  = "pending"
+	FileStateUploaded = "uploaded"
+	FileStateFailed   = "failed"
+)
+
+// FileStatus is the upload status of a file.
+type FileStatus struct {
+	Source      string   `json:"source"`
+	Destination string   `json:"destination"`
+	State       string   `json:"state"`
+	Error       string   `json:"error,omitempty"`
+	Objects     []string `json:"objects,omitempty"`
+}
+
+// Queue uploads files in order, tracking the status of each file.
+type Queue struct {
+	lg    *zap.Logger
+	m     *Multi
+	retry int
+
+	mu    sync.Mutex
+	files []FileStatus
+}
+
+// NewQueue creates a new upload queue, retrying each file
+// up to 'retry' times on each destination.
+func NewQueue(lg *zap.Logger, m *Multi, retry int) *Queue {
+	return &Queue{lg: lg, m: m, retry: retry}
+}
+
+// Add adds a file to the queue. A file already uploaded to the same
+// destination is skipped, and a failed one is retried on next Run.
+func (q *Queue) Add(src, dst string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, fs := range q.files {
+		if fs.Source != src || fs.Destination != dst {
+			continue
+		}
+		if fs.State == FileStateFailed {
+			q.files[i].State, q.files[i].Error = FileStatePending, ""
+		}
+		return
+	}
+	q.files = append(q.files, FileStatus{Source: src, Destination: dst, State: FileStatePending})
+}
+
+// Run uploads all pending files. It returns error if any file fails.
+func (q *Queue) Run() error {
+	var failed int
+	for i := range q.Files() {
+		q.mu.Lock()
+		fs := q.files[i]
+		q.mu.Unlock()
+		if fs.State != FileStatePending {
+			continue
+		}
+
+		q.lg.Info("uploading", zap.String("source", fs.Source), zap.String("destination", fs.Destination))
+		err := q.m.UploadFile(fs.Source, fs.Destination, q.retry)
+
+		q.mu.Lock()
+		if err != nil {
+			failed++
+			q.files[i].State = FileStateFailed
+			q.files[i].Error = err.Error()
+		} else {
+			q.files[i].State = FileStateUploaded
+			q.files[i].Objects = q.m.Objects(fs.Destination)
+		}
+		q.mu.Unlock()
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to upload %d file(s)", failed)
+	}
+	return nil
+}
+
+// Files returns the status of all files in the queue.
+func (q *Queue) Files() []FileStatus {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	files := make([]FileStatus, len(q.files))
+	copy(files, q.files)
+	return files
+}
+
+// Manifest is the list of uploaded files with the run labels.
+type Manifest struct {
+	Labels map[string]string `json:"labels,omitempty"`
+	Files  []FileStatus      `json:"files"`
+}
+
+// WriteManifest writes the status of all files with labels to 'fpath' in JSON.
+func (q *Queue) WriteManifest(fpath string, labels map[string]string) error {
+	b, err := json.MarshalIndent(Manifest{Labels: labels, Files: q.Files()}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fpath, b, 0644)
+}
+
+// backoff returns the duration to wait before the next retry,
+// doubling from 500ms up to 30s with jitter.
+func backoff(attempt int) time.Duration {
+	d := 30 * time.Second
+	if attempt < 6 {
+		d = 500 * time.Millisecond << uint(attempt)
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
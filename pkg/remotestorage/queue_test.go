@@ -0,0 +1,191 @@
+// Copyright 2016 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotestorage
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"go.uber.org/zap"
+)
+
+// flakyUploader fails the first 'fails' uploads.
+type flakyUploader struct {
+	Uploader
+	fails    int
+	attempts int
+}
+
+func (f *flakyUploader) UploadFile(bucket, src, dst string, opts ...OpOption) error {
+	f.attempts++
+	if f.attempts <= f.fails {
+		return fmt.Errorf("upload attempt %d failed", f.attempts)
+	}
+	return f.Uploader.UploadFile(bucket, src, dst, opts...)
+}
+
+func TestQueue(t *testing.T) {
+	dir, err := ioutil.TempDir("", "remotestorage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	lg := zap.NewNop()
+	m, err := NewMulti(lg, &dbtesterpb.ConfigClientMachineInitial{
+		RemoteStorageDestinations: []*dbtesterpb.RemoteStorageDestination{
+			{Name: "local", Type: "local", Bucket: "bucket", SubDirectory: "run", LocalDirectory: filepath.Join(dir, "remote")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := NewQueue(lg, m, 1)
+
+	src := filepath.Join(dir, "a.csv")
+	q.Add(src, "control/a.csv")
+	if err = q.Run(); err == nil {
+		t.Fatal("expected error on missing source")
+	}
+	if fs := q.Files(); len(fs) != 1 || fs[0].State != FileStateFailed || fs[0].Error == "" {
+		t.Fatalf("unexpected files %+v", fs)
+	}
+	if st := m.Status()["local"]; st.Failed != 1 || st.Uploaded != 0 {
+		t.Fatalf("unexpected status %+v", st)
+	}
+
+	// failed file is retried on next run
+	if err = ioutil.WriteFile(src, []byte("a"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	q.Add(src, "control/a.csv")
+	if err = q.Run(); err != nil {
+		t.Fatal(err)
+	}
+	fs := q.Files()
+	expected := []FileStatus{{
+		Source:      src,
+		Destination: "control/a.csv",
+		State:       FileStateUploaded,
+		Objects:     []string{"local:bucket/run/control/a.csv"},
+	}}
+	if !reflect.DeepEqual(fs, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, fs)
+	}
+	if b, err := ioutil.ReadFile(filepath.Join(dir, "remote", "bucket", "run", "control", "a.csv")); err != nil || string(b) != "a" {
+		t.Fatalf("unexpected upload %q (%v)", b, err)
+	}
+
+	// uploaded file is not uploaded again
+	q.Add(src, "control/a.csv")
+	if err = q.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if st := m.Status()["local"]; st.Failed != 1 || st.Uploaded != 1 {
+		t.Fatalf("unexpected status %+v", st)
+	}
+
+	fpath := filepath.Join(dir, "manifest.json")
+	labels := map[string]string{"branch": "master"}
+	if err = q.WriteManifest(fpath, labels); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mf Manifest
+	if err = json.Unmarshal(b, &mf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(mf, Manifest{Labels: labels, Files: expected}) {
+		t.Fatalf("unexpected manifest %+v", mf)
+	}
+}
+
+func TestMultiRetry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "remotestorage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "a.csv")
+	if err = ioutil.WriteFile(src, []byte("a"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	lg := zap.NewNop()
+	local, err := NewLocalStorage(lg, filepath.Join(dir, "remote"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &flakyUploader{Uploader: local, fails: 1}
+	m := newMulti(lg, Destination{Name: "flaky", Bucket: "bucket", Uploader: f})
+
+	if err = m.UploadFile(src, "a.csv", 2); err != nil {
+		t.Fatal(err)
+	}
+	if f.attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", f.attempts)
+	}
+	if st := m.Status()["flaky"]; st.Failed != 0 || st.Uploaded != 1 {
+		t.Fatalf("unexpected status %+v", st)
+	}
+
+	// no retry still tries once, and fails without backoff
+	f = &flakyUploader{Uploader: local, fails: 1}
+	m = newMulti(lg, Destination{Name: "flaky", Bucket: "bucket", Uploader: f})
+	now := time.Now()
+	if err = m.UploadFile(src, "a.csv", 0); err == nil {
+		t.Fatal("expected error")
+	}
+	if took := time.Since(now); took >= backoff(0)/2 {
+		t.Fatalf("expected no backoff after the last attempt, took %v", took)
+	}
+	if f.attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", f.attempts)
+	}
+	if st := m.Status()["flaky"]; st.Failed != 1 || st.Uploaded != 0 {
+		t.Fatalf("unexpected status %+v", st)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt := 0; attempt < 10; attempt++ {
+		d := 30 * time.Second
+		if attempt < 6 {
+			d = 500 * time.Millisecond << uint(attempt)
+		}
+		seen := make(map[time.Duration]bool)
+		for i := 0; i < 100; i++ {
+			b := backoff(attempt)
+			if b < d/2 || b >= d {
+				t.Fatalf("attempt %d: backoff %v out of [%v, %v)", attempt, b, d/2, d)
+			}
+			seen[b] = true
+		}
+		// jitter spreads retries of agents uploading at once
+		if len(seen) < 2 {
+			t.Fatalf("attempt %d: no jitter in backoff", attempt)
+		}
+	}
+}
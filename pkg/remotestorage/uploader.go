@@ -16,6 +16,7 @@ package remotestorage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
@@ -37,6 +38,28 @@ type Uploader interface {
 	UploadDir(bucket, src, dst string, opts ...OpOption) error
 }
 
+// ErrNotExist is returned when the object to read does not exist.
+var ErrNotExist = errors.New("object does not exist")
+
+// Reader defines storage reader.
+type Reader interface {
+	// ReadFile reads an object. It returns ErrNotExist if the object does not exist.
+	ReadFile(bucket, src string) ([]byte, error)
+}
+
+// New creates a new uploader of the storage type,
+// "google-cloud-storage" or "local".
+func New(lg *zap.Logger, typ string, key []byte, project, localDir string) (Uploader, error) {
+	switch typ {
+	case "google-cloud-storage", "":
+		return NewGoogleCloudStorage(lg, key, project)
+	case "local":
+		return NewLocalStorage(lg, localDir)
+	default:
+		return nil, fmt.Errorf("unknown storage type %q", typ)
+	}
+}
+
 // GoogleCloudStorage wraps Google Cloud Storage API.
 type GoogleCloudStorage struct {
 	lg      *zap.Logger
@@ -176,3 +199,27 @@ func (g *GoogleCloudStorage) UploadDir(bucket, src, dst string, opts ...OpOption
 	g.lg.Info("finished uploading", zap.String("source", src))
 	return nil
 }
+
+// ReadFile reads an object from Google Cloud Storage.
+func (g *GoogleCloudStorage) ReadFile(bucket, src string) ([]byte, error) {
+	if g == nil {
+		return nil, fmt.Errorf("GoogleCloudStorage is nil")
+	}
+	ctx := context.Background()
+
+	client, err := storage.NewClient(ctx, option.WithTokenSource(g.Config.TokenSource(ctx)))
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	rc, err := client.Bucket(bucket).Object(src).NewReader(ctx)
+	if err == storage.ErrObjectNotExist {
+		return nil, ErrNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return ioutil.ReadAll(rc)
+}
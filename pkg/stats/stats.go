@@ -0,0 +1,188 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stats computes confidence intervals and significance tests
+// of benchmark results from repeated runs.
+package stats
+
+import (
+	"errors"
+	"math"
+)
+
+// Mean returns the arithmetic mean.
+func Mean(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
+
+// Variance returns the unbiased sample variance.
+func Variance(xs []float64) float64 {
+	if len(xs) < 2 {
+		return 0
+	}
+	m := Mean(xs)
+	sum := 0.0
+	for _, x := range xs {
+		sum += (x - m) * (x - m)
+	}
+	return sum / float64(len(xs)-1)
+}
+
+// StdDev returns the sample standard deviation.
+func StdDev(xs []float64) float64 {
+	return math.Sqrt(Variance(xs))
+}
+
+// ConfidenceInterval returns the mean and its confidence interval
+// at the level (e.g. 0.95), using Student's t-distribution.
+func ConfidenceInterval(xs []float64, level float64) (mean, low, high float64) {
+	mean = Mean(xs)
+	if len(xs) < 2 {
+		return mean, mean, mean
+	}
+	half := StudentTQuantile(1-(1-level)/2, float64(len(xs)-1)) * StdDev(xs) / math.Sqrt(float64(len(xs)))
+	return mean, mean - half, mean + half
+}
+
+// ErrNotEnoughSamples is returned when a test needs more samples.
+var ErrNotEnoughSamples = errors.New("stats: at least 2 samples are required in each group")
+
+// WelchTTest tests whether the means of two groups differ, without
+// assuming equal variances. It returns the t statistic, the degrees of
+// freedom, and the two-sided p-value.
+func WelchTTest(a, b []float64) (t, df, p float64, err error) {
+	if len(a) < 2 || len(b) < 2 {
+		return 0, 0, 0, ErrNotEnoughSamples
+	}
+	na, nb := float64(len(a)), float64(len(b))
+	va, vb := Variance(a)/na, Variance(b)/nb
+	diff := Mean(b) - Mean(a)
+	if va+vb == 0 {
+		// no variance; any difference is significant
+		if diff == 0 {
+			return 0, na + nb - 2, 1, nil
+		}
+		return math.Copysign(math.Inf(1), diff), na + nb - 2, 0, nil
+	}
+	t = diff / math.Sqrt(va+vb)
+	df = (va + vb) * (va + vb) / (va*va/(na-1) + vb*vb/(nb-1))
+	p = 2 * (1 - StudentTCDF(math.Abs(t), df))
+	return t, df, p, nil
+}
+
+// StudentTCDF returns the cumulative distribution function
+// of Student's t-distribution with 'df' degrees of freedom.
+func StudentTCDF(t, df float64) float64 {
+	x := df / (df + t*t)
+	tail := 0.5 * regularizedIncompleteBeta(df/2, 0.5, x)
+	if t > 0 {
+		return 1 - tail
+	}
+	return tail
+}
+
+// StudentTQuantile returns the inverse of 'StudentTCDF' for 0 < p < 1.
+func StudentTQuantile(p, df float64) float64 {
+	if p == 0.5 {
+		return 0
+	}
+	if p < 0.5 {
+		return -StudentTQuantile(1-p, df)
+	}
+	lo, hi := 0.0, 1.0
+	for StudentTCDF(hi, df) < p {
+		hi *= 2
+	}
+	for i := 0; i < 200 && hi-lo > 1e-12; i++ {
+		mid := (lo + hi) / 2
+		if StudentTCDF(mid, df) < p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// regularizedIncompleteBeta returns I_x(a, b), evaluated with the
+// continued fraction in Numerical Recipes (6.4).
+func regularizedIncompleteBeta(a, b, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+	la, _ := math.Lgamma(a)
+	lb, _ := math.Lgamma(b)
+	lab, _ := math.Lgamma(a + b)
+	front := math.Exp(lab - la - lb + a*math.Log(x) + b*math.Log(1-x))
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(a, b, x) / a
+	}
+	return 1 - front*betaContinuedFraction(b, a, 1-x)/b
+}
+
+func betaContinuedFraction(a, b, x float64) float64 {
+	const (
+		maxIterations = 300
+		epsilon       = 1e-14
+		tiny          = 1e-300
+	)
+	qab, qap, qam := a+b, a+1, a-1
+	c, d := 1.0, 1-qab*x/qap
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+	for m := 1; m <= maxIterations; m++ {
+		fm := float64(m)
+		m2 := 2 * fm
+		aa := fm * (b - fm) * x / ((qam + m2) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+		aa = -(a + fm) * (qab + fm) * x / ((a + m2) * (qap + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < epsilon {
+			break
+		}
+	}
+	return h
+}
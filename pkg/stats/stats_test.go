@@ -0,0 +1,66 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStudentTQuantile(t *testing.T) {
+	tests := []struct {
+		p, df, exp float64
+	}{
+		{0.975, 1, 12.7062},
+		{0.975, 4, 2.7764},
+		{0.975, 9, 2.2622},
+		{0.975, 30, 2.0423},
+		{0.95, 10, 1.8125},
+	}
+	for i, tt := range tests {
+		v := StudentTQuantile(tt.p, tt.df)
+		if math.Abs(v-tt.exp) > 1e-3 {
+			t.Fatalf("#%d: expected %f, got %f", i, tt.exp, v)
+		}
+	}
+}
+
+func TestConfidenceInterval(t *testing.T) {
+	mean, low, high := ConfidenceInterval([]float64{10, 12, 11, 13, 9}, 0.95)
+	if mean != 11 {
+		t.Fatalf("expected mean 11, got %f", mean)
+	}
+	// 2.7764 * sqrt(2.5) / sqrt(5)
+	if math.Abs((high-low)/2-1.9632) > 1e-3 {
+		t.Fatalf("unexpected interval [%f, %f]", low, high)
+	}
+}
+
+func TestWelchTTest(t *testing.T) {
+	a := []float64{19.8, 20.4, 19.6, 17.8, 18.5, 18.9, 18.3, 18.9, 19.5, 22.0}
+	b := []float64{28.2, 26.6, 20.1, 23.3, 25.2, 22.1, 17.7, 27.6, 20.6, 13.7}
+	tv, df, p, err := WelchTTest(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// p-value by numerical integration of t-distribution density
+	if math.Abs(tv-2.0740) > 1e-3 || math.Abs(df-10.2092) > 1e-3 || math.Abs(p-0.06428) > 1e-4 {
+		t.Fatalf("unexpected t %f, df %f, p %f", tv, df, p)
+	}
+
+	if _, _, _, err = WelchTTest(a[:1], b); err != ErrNotEnoughSamples {
+		t.Fatalf("expected %v, got %v", ErrNotEnoughSamples, err)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+// +build !windows
+
+package syslimit
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// RaiseOpenFiles raises the soft RLIMIT_NOFILE of the current process
+// to at least n, and the hard limit when n is above it (which needs root).
+// Child processes inherit the limit. It returns the new soft limit.
+func RaiseOpenFiles(n uint64) (uint64, error) {
+	var rlim syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rlim); err != nil {
+		return 0, err
+	}
+	if rlim.Cur >= n {
+		return rlim.Cur, nil
+	}
+	if rlim.Max < n {
+		raised := syscall.Rlimit{Cur: n, Max: n}
+		if err := syscall.Setrlimit(syscall.RLIMIT_NOFILE, &raised); err == nil {
+			return n, nil
+		}
+	}
+	// as high as the hard limit allows
+	max := rlim.Max
+	if max > n {
+		max = n
+	}
+	rlim.Cur = max
+	if err := syscall.Setrlimit(syscall.RLIMIT_NOFILE, &rlim); err != nil {
+		return 0, err
+	}
+	if max < n {
+		return max, fmt.Errorf("open file limit is %d, need %d (run 'ulimit -n %d' as root, or raise 'nofile' in /etc/security/limits.conf)", max, n, n)
+	}
+	return max, nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package syslimit raises and verifies the process and kernel limits
+// that high-connection benchmarks depend on, so that they fail at start
+// with an actionable message instead of with dial errors in the middle.
+package syslimit
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Requirement is the limits that a process needs.
+type Requirement struct {
+	// OpenFiles is the minimum RLIMIT_NOFILE.
+	OpenFiles uint64
+	// ListenBacklog is the minimum 'net.core.somaxconn',
+	// the number of connections that can be accepted at once.
+	ListenBacklog int64
+	// EphemeralPorts is the minimum size of 'net.ipv4.ip_local_port_range',
+	// the number of outgoing connections to one endpoint.
+	EphemeralPorts int64
+}
+
+// Check raises RLIMIT_NOFILE to the required number of open files,
+// and verifies the kernel settings. It returns one error describing
+// every limit that is too low, and how to raise it.
+func Check(r Requirement) error {
+	var msgs []string
+	if r.OpenFiles > 0 {
+		if _, err := RaiseOpenFiles(r.OpenFiles); err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	if r.ListenBacklog > 0 {
+		n, err := Somaxconn()
+		switch {
+		case err != nil:
+			msgs = append(msgs, err.Error())
+		case n < r.ListenBacklog:
+			msgs = append(msgs, fmt.Sprintf("net.core.somaxconn is %d, need %d (run 'sudo sysctl -w net.core.somaxconn=%d')", n, r.ListenBacklog, r.ListenBacklog))
+		}
+	}
+	if r.EphemeralPorts > 0 {
+		lo, hi, err := EphemeralPortRange()
+		switch {
+		case err != nil:
+			msgs = append(msgs, err.Error())
+		case hi-lo+1 < r.EphemeralPorts:
+			msgs = append(msgs, fmt.Sprintf("net.ipv4.ip_local_port_range %d-%d has %d ports, need %d (run 'sudo sysctl -w net.ipv4.ip_local_port_range=\"1024 65535\"')", lo, hi, hi-lo+1, r.EphemeralPorts))
+		}
+	}
+	if len(msgs) > 0 {
+		return fmt.Errorf("system limits are too low: %s", strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
+// Somaxconn returns 'net.core.somaxconn'.
+func Somaxconn() (int64, error) {
+	bts, err := ioutil.ReadFile("/proc/sys/net/core/somaxconn")
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(bts)), 10, 64)
+}
+
+// EphemeralPortRange returns 'net.ipv4.ip_local_port_range'.
+func EphemeralPortRange() (lo, hi int64, err error) {
+	bts, err := ioutil.ReadFile("/proc/sys/net/ipv4/ip_local_port_range")
+	if err != nil {
+		return 0, 0, err
+	}
+	return parsePortRange(string(bts))
+}
+
+func parsePortRange(s string) (lo, hi int64, err error) {
+	fs := strings.Fields(s)
+	if len(fs) != 2 {
+		return 0, 0, fmt.Errorf("unexpected port range %q", s)
+	}
+	if lo, err = strconv.ParseInt(fs[0], 10, 64); err != nil {
+		return 0, 0, err
+	}
+	if hi, err = strconv.ParseInt(fs[1], 10, 64); err != nil {
+		return 0, 0, err
+	}
+	if hi < lo {
+		return 0, 0, fmt.Errorf("unexpected port range %q", s)
+	}
+	return lo, hi, nil
+}
+
+// SetMaxProcs sets GOMAXPROCS to the CPU quota of the cgroup, when it is
+// lower than the number of CPUs, so that a container limited to 2 CPUs on
+// a 64-CPU host is not throttled by 64 busy threads. GOMAXPROCS in the
+// environment takes precedence. It returns the resulting GOMAXPROCS.
+func SetMaxProcs() int {
+	if os.Getenv("GOMAXPROCS") != "" {
+		return runtime.GOMAXPROCS(0)
+	}
+	quota, ok := cpuQuota()
+	if !ok {
+		return runtime.GOMAXPROCS(0)
+	}
+	n := int(math.Ceil(quota))
+	if n < 1 {
+		n = 1
+	}
+	if n < runtime.NumCPU() {
+		runtime.GOMAXPROCS(n)
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// cpuQuota returns the CPU quota in number of CPUs, from cgroup v2
+// 'cpu.max' or cgroup v1 'cpu.cfs_quota_us'. It returns false if unlimited.
+func cpuQuota() (float64, bool) {
+	if bts, err := ioutil.ReadFile("/sys/fs/cgroup/cpu.max"); err == nil {
+		return parseCPUMax(string(bts))
+	}
+	qb, err := ioutil.ReadFile("/sys/fs/cgroup/cpu/cpu.cfs_quota_us")
+	if err != nil {
+		return 0, false
+	}
+	pb, err := ioutil.ReadFile("/sys/fs/cgroup/cpu/cpu.cfs_period_us")
+	if err != nil {
+		return 0, false
+	}
+	return parseCPUMax(strings.TrimSpace(string(qb)) + " " + strings.TrimSpace(string(pb)))
+}
+
+// parseCPUMax parses "<quota> <period>", where quota is "max" or -1 if unlimited.
+func parseCPUMax(s string) (float64, bool) {
+	fs := strings.Fields(s)
+	if len(fs) != 2 || fs[0] == "max" {
+		return 0, false
+	}
+	quota, err := strconv.ParseFloat(fs[0], 64)
+	if err != nil || quota <= 0 {
+		return 0, false
+	}
+	period, err := strconv.ParseFloat(fs[1], 64)
+	if err != nil || period <= 0 {
+		return 0, false
+	}
+	return quota / period, true
+}
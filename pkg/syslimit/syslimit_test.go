@@ -0,0 +1,50 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslimit
+
+import "testing"
+
+func TestParsePortRange(t *testing.T) {
+	lo, hi, err := parsePortRange("32768\t60999\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lo != 32768 || hi != 60999 {
+		t.Fatalf("expected 32768-60999, got %d-%d", lo, hi)
+	}
+	if _, _, err = parsePortRange("60999 32768"); err == nil {
+		t.Fatal("expected error on reversed range")
+	}
+}
+
+func TestParseCPUMax(t *testing.T) {
+	tests := []struct {
+		s     string
+		quota float64
+		ok    bool
+	}{
+		{"max 100000\n", 0, false},
+		{"-1 100000", 0, false},
+		{"200000 100000\n", 2, true},
+		{"150000 100000", 1.5, true},
+		{"", 0, false},
+	}
+	for i, tt := range tests {
+		quota, ok := parseCPUMax(tt.s)
+		if quota != tt.quota || ok != tt.ok {
+			t.Fatalf("#%d: expected %v %v, got %v %v", i, tt.quota, tt.ok, quota, ok)
+		}
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing samples benchmark requests as client spans, and exports
+// them to an OpenTelemetry collector with OTLP/HTTP JSON encoding.
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	mrand "math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+// Config defines which requests to trace and where to export spans.
+type Config struct {
+	// Endpoint is the OTLP/HTTP endpoint of the collector
+	// (e.g. 'http://localhost:4318'). '/v1/traces' is appended
+	// if the endpoint has no path.
+	Endpoint string
+	// SampleRatio is the fraction of requests to trace, in (0, 1].
+	SampleRatio float64
+	// ServiceName is the 'service.name' resource attribute.
+	ServiceName string
+	// Attributes are added to all spans.
+	Attributes map[string]string
+}
+
+const (
+	// TraceparentHeader is the W3C trace context header,
+	// propagated to the database as gRPC metadata.
+	TraceparentHeader = "traceparent"
+
+	batchSize     = 512
+	queueSize     = 8192
+	flushInterval = 5 * time.Second
+	exportTimeout = 10 * time.Second
+)
+
+// OTLP span kind and status codes.
+const (
+	spanKindClient  = 3
+	statusCodeOK    = 1
+	statusCodeError = 2
+)
+
+// Tracer samples requests and exports spans in the background.
+// A nil *Tracer traces nothing.
+type Tracer struct {
+	lg  *zap.Logger
+	cfg Config
+	url string
+	cli *http.Client
+
+	rmu sync.Mutex
+	rnd *mrand.Rand
+
+	spanc chan *Span
+	donec chan struct{}
+
+	mu      sync.Mutex
+	dropped int64
+}
+
+// New creates a tracer, and starts exporting spans.
+func New(lg *zap.Logger, cfg Config) *Tracer {
+	if cfg.ServiceName == "" {
+		cfg.ServiceName = "dbtester"
+	}
+	t := &Tracer{
+		lg:    lg,
+		cfg:   cfg,
+		url:   tracesURL(cfg.Endpoint),
+		cli:   &http.Client{Timeout: exportTimeout},
+		rnd:   mrand.New(mrand.NewSource(time.Now().UnixNano())),
+		spanc: make(chan *Span, queueSize),
+		donec: make(chan struct{}),
+	}
+	go t.run()
+	return t
+}
+
+func tracesURL(ep string) string {
+	ep = strings.TrimSuffix(ep, "/")
+	if !strings.Contains(ep, "://") {
+		ep = "http://" + ep
+	}
+	if strings.Count(ep, "/") == 2 {
+		ep += "/v1/traces"
+	}
+	return ep
+}
+
+// Span is a sampled client request.
+type Span struct {
+	t       *Tracer
+	traceID [16]byte
+	spanID  [8]byte
+	name    string
+	start   time.Time
+	end     time.Time
+	err     error
+	attrs   map[string]string
+}
+
+// Start samples the request. If sampled, it returns the context with
+// the trace context in outgoing gRPC metadata, and the span to end
+// when the request finishes. Otherwise, it returns the context and nil.
+func (t *Tracer) Start(ctx context.Context, name string) (context.Context, *Span) {
+	if t == nil || !t.sample() {
+		return ctx, nil
+	}
+	s := &Span{t: t, name: name, start: time.Now()}
+	if _, err := rand.Read(s.traceID[:]); err != nil {
+		return ctx, nil
+	}
+	if _, err := rand.Read(s.spanID[:]); err != nil {
+		return ctx, nil
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = metadata.Join(md, metadata.Pairs(TraceparentHeader, s.Traceparent()))
+	return metadata.NewOutgoingContext(ctx, md), s
+}
+
+func (t *Tracer) sample() bool {
+	if t.cfg.SampleRatio >= 1 {
+		return true
+	}
+	t.rmu.Lock()
+	v := t.rnd.Float64()
+	t.rmu.Unlock()
+	return v < t.cfg.SampleRatio
+}
+
+// Traceparent returns the W3C trace context of the span.
+func (s *Span) Traceparent() string {
+	return fmt.Sprintf("00-%s-%s-01", hex.EncodeToString(s.traceID[:]), hex.EncodeToString(s.spanID[:]))
+}
+
+// SetAttribute sets an attribute of the span. Safe to call on nil.
+func (s *Span) SetAttribute(k, v string) {
+	if s == nil {
+		return
+	}
+	if s.attrs == nil {
+		s.attrs = make(map[string]string)
+	}
+	s.attrs[k] = v
+}
+
+// End finishes the span with the result of the request,
+// and queues it for export. Safe to call on nil.
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+	s.end, s.err = time.Now(), err
+	select {
+	case s.t.spanc <- s:
+	default:
+		// never block benchmark requests on exports
+		s.t.mu.Lock()
+		s.t.dropped++
+		s.t.mu.Unlock()
+	}
+}
+
+// Close exports all queued spans, and stops the tracer.
+// All spans must be ended before Close.
+func (t *Tracer) Close() {
+	if t == nil {
+		return
+	}
+	close(t.spanc)
+	<-t.donec
+
+	t.mu.Lock()
+	dropped := t.dropped
+	t.mu.Unlock()
+	if dropped > 0 {
+		t.lg.Warn("dropped spans with full export queue", zap.Int64("dropped", dropped))
+	}
+}
+
+func (t *Tracer) run() {
+	defer close(t.donec)
+	batch := make([]*Span, 0, batchSize)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case s, ok := <-t.spanc:
+			if !ok {
+				t.export(batch)
+				return
+			}
+			if batch = append(batch, s); len(batch) >= batchSize {
+				t.export(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			t.export(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+func (t *Tracer) export(spans []*Span) {
+	if len(spans) == 0 {
+		return
+	}
+	body, err := json.Marshal(t.encode(spans))
+	if err != nil {
+		t.lg.Warn("failed to encode spans", zap.Error(err))
+		return
+	}
+	resp, err := t.cli.Post(t.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.lg.Warn("failed to export spans", zap.String("url", t.url), zap.Int("spans", len(spans)), zap.Error(err))
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		t.lg.Warn("failed to export spans", zap.String("url", t.url), zap.Int("status", resp.StatusCode), zap.String("body", string(b)))
+		return
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+}
+
+// OTLP JSON encoding of 'ExportTraceServiceRequest'.
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string        `json:"key"`
+	Value otlpAnyString `json:"value"`
+}
+
+type otlpAnyString struct {
+	StringValue string `json:"stringValue"`
+}
+
+func otlpAttributes(m map[string]string) []otlpKeyValue {
+	kvs := make([]otlpKeyValue, 0, len(m))
+	for k, v := range m {
+		kvs = append(kvs, otlpKeyValue{Key: k, Value: otlpAnyString{StringValue: v}})
+	}
+	return kvs
+}
+
+func (t *Tracer) encode(spans []*Span) otlpRequest {
+	res := map[string]string{"service.name": t.cfg.ServiceName}
+	ss := make([]otlpSpan, len(spans))
+	for i, s := range spans {
+		attrs := make(map[string]string, len(t.cfg.Attributes)+len(s.attrs))
+		for k, v := range t.cfg.Attributes {
+			attrs[k] = v
+		}
+		for k, v := range s.attrs {
+			attrs[k] = v
+		}
+		status := otlpStatus{Code: statusCodeOK}
+		if s.err != nil {
+			status = otlpStatus{Code: statusCodeError, Message: s.err.Error()}
+		}
+		ss[i] = otlpSpan{
+			TraceID:           hex.EncodeToString(s.traceID[:]),
+			SpanID:            hex.EncodeToString(s.spanID[:]),
+			Name:              s.name,
+			Kind:              spanKindClient,
+			StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+			Attributes:        otlpAttributes(attrs),
+			Status:            status,
+		}
+	}
+	return otlpRequest{ResourceSpans: []otlpResourceSpans{{
+		Resource:   otlpResource{Attributes: otlpAttributes(res)},
+		ScopeSpans: []otlpScopeSpans{{Scope: otlpScope{Name: "github.com/etcd-io/dbtester"}, Spans: ss}},
+	}}}
+}
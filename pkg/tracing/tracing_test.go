@@ -0,0 +1,103 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTracer(t *testing.T) {
+	var mu sync.Mutex
+	var reqs []otlpRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		var req otlpRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		mu.Lock()
+		reqs = append(reqs, req)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	tr := New(zap.NewExample(), Config{Endpoint: srv.URL, SampleRatio: 1, Attributes: map[string]string{"db.system": "etcd"}})
+	ctx, s1 := tr.Start(context.Background(), "put")
+	if s1 == nil {
+		t.Fatal("expected sampled span")
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if v := md[TraceparentHeader]; len(v) != 1 || v[0] != s1.Traceparent() || !strings.HasPrefix(v[0], "00-") || len(v[0]) != 55 {
+		t.Fatalf("unexpected traceparent %v", v)
+	}
+	s1.End(nil)
+	_, s2 := tr.Start(context.Background(), "get")
+	s2.SetAttribute("key", "foo")
+	s2.End(errors.New("timeout"))
+	tr.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(reqs) != 1 || len(reqs[0].ResourceSpans) != 1 || len(reqs[0].ResourceSpans[0].ScopeSpans) != 1 {
+		t.Fatalf("unexpected export %+v", reqs)
+	}
+	spans := reqs[0].ResourceSpans[0].ScopeSpans[0].Spans
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+	if spans[0].Name != "put" || spans[0].Status.Code != statusCodeOK || spans[0].Kind != spanKindClient {
+		t.Fatalf("unexpected span %+v", spans[0])
+	}
+	if spans[1].Name != "get" || spans[1].Status.Code != statusCodeError || spans[1].Status.Message != "timeout" || len(spans[1].Attributes) != 2 {
+		t.Fatalf("unexpected span %+v", spans[1])
+	}
+}
+
+func TestTracerNil(t *testing.T) {
+	var tr *Tracer
+	ctx := context.Background()
+	nctx, s := tr.Start(ctx, "put")
+	if nctx != ctx || s != nil {
+		t.Fatal("expected nil tracer not to sample")
+	}
+	s.SetAttribute("key", "foo")
+	s.End(nil)
+	tr.Close()
+}
+
+func Test_tracesURL(t *testing.T) {
+	tests := []struct{ ep, url string }{
+		{"http://localhost:4318", "http://localhost:4318/v1/traces"},
+		{"localhost:4318/", "http://localhost:4318/v1/traces"},
+		{"https://collector/otlp/v1/traces", "https://collector/otlp/v1/traces"},
+	}
+	for i, tt := range tests {
+		if u := tracesURL(tt.ep); u != tt.url {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.url, u)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// defaultPriorityMetadataKey is the gRPC metadata key of request priorities.
+const defaultPriorityMetadataKey = "x-dbtester-priority"
+
+// clientPriorities returns the priority classes of clients, assigning
+// 'priority_classes' in round-robin order. It returns nil if no class
+// is configured.
+func clientPriorities(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions, clientsN int) []string {
+	if len(opts.PriorityClasses) == 0 {
+		return nil
+	}
+	ps := make([]string, clientsN)
+	for i := range ps {
+		ps[i] = opts.PriorityClasses[i%len(opts.PriorityClasses)]
+	}
+	return ps
+}
+
+// priorityMetadataKey returns the metadata key of 'priority_metadata_key'.
+func priorityMetadataKey(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) string {
+	if opts.PriorityMetadataKey == "" {
+		return defaultPriorityMetadataKey
+	}
+	return opts.PriorityMetadataKey
+}
+
+type priorityCtxKey struct{}
+
+// requestPriority is the priority metadata of a request.
+type requestPriority struct {
+	key   string
+	class string
+}
+
+// withPriority tags the request context with the priority class,
+// to be sent as gRPC metadata by the client interceptors.
+func withPriority(ctx context.Context, key, class string) context.Context {
+	return context.WithValue(ctx, priorityCtxKey{}, requestPriority{key: key, class: class})
+}
+
+// outgoingPriority attaches the priority of the context to outgoing metadata.
+func outgoingPriority(ctx context.Context) context.Context {
+	p, ok := ctx.Value(priorityCtxKey{}).(requestPriority)
+	if !ok {
+		return ctx
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = metadata.Join(md, metadata.Pairs(p.key, p.class))
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// priorityUnaryInterceptor and priorityStreamInterceptor send request
+// priorities, so that experimental server-side QoS can tell the traffic
+// apart. Requests without priority are sent as is.
+func priorityUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(outgoingPriority(ctx), method, req, reply, cc, opts...)
+}
+
+func priorityStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(outgoingPriority(ctx), desc, cc, method, opts...)
+}
@@ -0,0 +1,48 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestClientPriorities(t *testing.T) {
+	if ps := clientPriorities(&dbtesterpb.ConfigClientMachineBenchmarkOptions{}, 3); ps != nil {
+		t.Fatalf("unexpected priorities %v", ps)
+	}
+	ps := clientPriorities(&dbtesterpb.ConfigClientMachineBenchmarkOptions{PriorityClasses: []string{"high", "low"}}, 3)
+	if exp := []string{"high", "low", "high"}; !reflect.DeepEqual(ps, exp) {
+		t.Fatalf("expected %v, got %v", exp, ps)
+	}
+}
+
+func TestOutgoingPriority(t *testing.T) {
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("hasleader", "true"))
+	if md, _ := metadata.FromOutgoingContext(outgoingPriority(ctx)); len(md) != 1 {
+		t.Fatalf("unexpected metadata %v", md)
+	}
+
+	md, _ := metadata.FromOutgoingContext(outgoingPriority(withPriority(ctx, defaultPriorityMetadataKey, "high")))
+	exp := metadata.Pairs("hasleader", "true", defaultPriorityMetadataKey, "high")
+	if !reflect.DeepEqual(md, exp) {
+		t.Fatalf("expected %v, got %v", exp, md)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var htmlReportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: right; }
+th:first-child, td:first-child { text-align: left; }
+pre { background: #f6f6f6; padding: 1em; overflow-x: auto; }
+figure { margin: 2em 0; }
+figure svg, figure img { max-width: 100%; height: auto; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>{{.Description}}</p>
+<h2>Summary</h2>
+{{if .Rows}}<table>
+<tr>{{range index .Rows 0}}<th>{{.}}</th>{{end}}</tr>
+{{range slice .Rows 1}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</table>
+{{else}}<pre>{{.Summary}}</pre>
+{{end}}{{if .Images}}<h2>Charts</h2>
+{{range .Images}}<figure>
+{{.Body}}
+<figcaption>{{.Title}}</figcaption>
+</figure>
+{{end}}{{end}}{{if .Config}}<h2>Configuration</h2>
+<pre>{{.Config}}</pre>
+{{end}}</body>
+</html>
+`))
+
+type htmlImage struct {
+	Title string
+	Body  template.HTML
+}
+
+// WriteHTML writes a self-contained HTML report, if configured. Local images
+// are inlined, so the file can be shared without other outputs.
+func (cfg *Config) WriteHTML(summary string) error {
+	if cfg.ConfigAnalyzeMachineREADME.HTMLOutputPath == "" {
+		return nil
+	}
+	cfg.lg.Sugar().Infof("writing HTML report at %q", cfg.ConfigAnalyzeMachineREADME.HTMLOutputPath)
+
+	data := struct {
+		Title       string
+		Description string
+		Summary     string
+		Rows        [][]string
+		Images      []htmlImage
+		Config      string
+	}{
+		Title:       cfg.TestTitle,
+		Description: cfg.TestDescription,
+		Summary:     summary,
+		Config:      string(cfg.rawConfig),
+	}
+
+	// summary text has errors appended, so fall back to it
+	// only when the aggregated rows are not available
+	if f, err := os.Open(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV); err == nil {
+		rows, rerr := csv.NewReader(f).ReadAll()
+		f.Close()
+		if rerr == nil && len(rows) > 0 {
+			data.Rows = rows
+		}
+	}
+
+	for _, img := range cfg.Images {
+		body, err := htmlImageBody(img.Title, img.Path, img.Type)
+		if err != nil {
+			return err
+		}
+		data.Images = append(data.Images, htmlImage{Title: img.Title, Body: body})
+	}
+
+	buf := new(bytes.Buffer)
+	if err := htmlReportTemplate.Execute(buf, data); err != nil {
+		return err
+	}
+	return toFile(buf.String(), cfg.ConfigAnalyzeMachineREADME.HTMLOutputPath)
+}
+
+// htmlImageBody returns the markup of the image. SVG is embedded as-is,
+// and other local images as data URIs. Remote images are linked.
+func htmlImageBody(title, fpath, tp string) (template.HTML, error) {
+	switch tp {
+	case "local":
+	case "remote":
+		return template.HTML(fmt.Sprintf(`<img src="%s" alt="%s">`, template.HTMLEscapeString(fpath), template.HTMLEscapeString(title))), nil
+	default:
+		return "", fmt.Errorf("%s is not supported", tp)
+	}
+
+	bts, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return "", err
+	}
+	ext := strings.ToLower(filepath.Ext(fpath))
+	if ext == ".svg" {
+		// drop XML prolog, not allowed inside HTML
+		s := string(bts)
+		if i := strings.Index(s, "<svg"); i > 0 {
+			s = s[i:]
+		}
+		return template.HTML(s), nil
+	}
+	mime := "image/png"
+	switch ext {
+	case ".jpg", ".jpeg":
+		mime = "image/jpeg"
+	case ".gif":
+		mime = "image/gif"
+	}
+	return template.HTML(fmt.Sprintf(`<img src="data:%s;base64,%s" alt="%s">`, mime, base64.StdEncoding.EncodeToString(bts), template.HTMLEscapeString(title))), nil
+}
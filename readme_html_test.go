@@ -0,0 +1,71 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+func TestWriteHTML(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "dbtester-html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	svgPath := filepath.Join(dir, "plot.svg")
+	if err = ioutil.WriteFile(svgPath, []byte(`<?xml version="1.0"?><svg id="plot"></svg>`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	csvPath := filepath.Join(dir, "all-aggregated.csv")
+	if err = ioutil.WriteFile(csvPath, []byte("INDEX,etcd-v3.3\nTOTAL-SECONDS,10.0000 sec\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &Config{
+		lg:                                      zap.NewExample(),
+		rawConfig:                               []byte("test_title: <test>\n"),
+		TestTitle:                               "<test>",
+		ConfigAnalyzeMachineAllAggregatedOutput: dbtesterpb.ConfigAnalyzeMachineAllAggregatedOutput{AllAggregatedOutputPathCSV: csvPath},
+		ConfigAnalyzeMachineREADME: dbtesterpb.ConfigAnalyzeMachineREADME{
+			Images:         []*dbtesterpb.ConfigAnalyzeMachineImage{{Title: "plot", Path: svgPath, Type: "local"}},
+			HTMLOutputPath: filepath.Join(dir, "report.html"),
+		},
+	}
+	if err = cfg.WriteHTML("summary"); err != nil {
+		t.Fatal(err)
+	}
+	bts, err := ioutil.ReadFile(cfg.ConfigAnalyzeMachineREADME.HTMLOutputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	html := string(bts)
+	for _, s := range []string{`<svg id="plot"></svg>`, "<th>etcd-v3.3</th>", "<td>10.0000 sec</td>", "test_title: &lt;test&gt;"} {
+		if !strings.Contains(html, s) {
+			t.Errorf("expected %q in report, got %s", s, html)
+		}
+	}
+	if strings.Contains(html, "<?xml") {
+		t.Errorf("expected XML prolog to be dropped, got %s", html)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/pkg/stats"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// BenchmarkMetrics is the list of metrics read from benchmark results.
+var BenchmarkMetrics = []string{"p50", "p90", "p99", "p99.9", "avg", "throughput"}
+
+// IsValidBenchmarkMetric returns true if the metric is in 'BenchmarkMetrics'.
+func IsValidBenchmarkMetric(m string) bool {
+	for _, v := range BenchmarkMetrics {
+		if v == m {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHigherBetter returns true if higher values of the metric are better.
+func IsHigherBetter(m string) bool {
+	return m == "throughput"
+}
+
+// ReadMetric reads the metric from the latency distribution
+// percentile or summary CSV of the last benchmark.
+func (cfg *Config) ReadMetric(m string) (float64, error) {
+	switch m {
+	case "avg":
+		return readCSVValue(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath, "AVERAGE-LATENCY-MS")
+	case "throughput":
+		return readCSVValue(cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath, "REQUESTS-PER-SECOND")
+	default:
+		return readCSVValue(cfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath, m)
+	}
+}
+
+// readCSVValue returns the value of the row whose first column is 'key'.
+func readCSVValue(fpath, key string) (float64, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return 0, err
+	}
+	for _, row := range rows {
+		if len(row) > 1 && row[0] == key {
+			return strconv.ParseFloat(row[1], 64)
+		}
+	}
+	return 0, fmt.Errorf("%q is not found in %q", key, fpath)
+}
+
+// RepeatConfidenceLevel is the confidence level of intervals
+// in the repeat summary.
+const RepeatConfidenceLevel = 0.95
+
+// RepeatSamples maps each metric in 'BenchmarkMetrics'
+// to its values of repeated benchmarks.
+type RepeatSamples map[string][]float64
+
+// StressRepeat runs 'Stress' for 'repeat' times, and saves the metrics of
+// each run with confidence intervals to 'client_repeat_summary_path'.
+// Result files other than the summary are of the last run.
+func (cfg *Config) StressRepeat(ctx context.Context, databaseID string) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+	repeat := gcfg.ConfigClientMachineBenchmarkOptions.Repeat
+	if repeat <= 1 {
+		return cfg.Stress(ctx, databaseID)
+	}
+
+	samples := make(RepeatSamples)
+	for i := int64(1); i <= repeat; i++ {
+		if i > 1 {
+			time.Sleep(5 * time.Second)
+		}
+		cfg.lg.Info("starting repeated benchmark", zap.Int64("repeat", i), zap.Int64("total", repeat))
+		if err := cfg.Stress(ctx, databaseID); err != nil {
+			if len(samples[BenchmarkMetrics[0]]) > 1 {
+				// still save completed runs
+				if serr := cfg.saveRepeatSummary(samples); serr != nil {
+					cfg.lg.Warn("failed to save repeat summary", zap.Error(serr))
+				}
+			}
+			return err
+		}
+		for _, m := range BenchmarkMetrics {
+			v, err := cfg.ReadMetric(m)
+			if err != nil {
+				return err
+			}
+			samples[m] = append(samples[m], v)
+		}
+	}
+	return cfg.saveRepeatSummary(samples)
+}
+
+// repeatColumn returns the column header of the i-th (0-based) repeated run.
+func repeatColumn(i int) string {
+	return fmt.Sprintf("REPEAT-%d", i+1)
+}
+
+func (cfg *Config) saveRepeatSummary(samples RepeatSamples) error {
+	n := len(samples[BenchmarkMetrics[0]])
+	c1 := dataframe.NewColumn("METRIC")
+	c2 := dataframe.NewColumn("MEAN")
+	c3 := dataframe.NewColumn("STDDEV")
+	c4 := dataframe.NewColumn("CI95-LOW")
+	c5 := dataframe.NewColumn("CI95-HIGH")
+	cols := []dataframe.Column{c1, c2, c3, c4, c5}
+	for i := 0; i < n; i++ {
+		cols = append(cols, dataframe.NewColumn(repeatColumn(i)))
+	}
+	for _, m := range BenchmarkMetrics {
+		xs := samples[m]
+		mean, low, high := stats.ConfidenceInterval(xs, RepeatConfidenceLevel)
+		c1.PushBack(dataframe.NewStringValue(m))
+		c2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", mean)))
+		c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", stats.StdDev(xs))))
+		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", low)))
+		c5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", high)))
+		for i, x := range xs {
+			cols[5+i].PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", x)))
+		}
+
+		cfg.lg.Info("repeated benchmark metric",
+			zap.String("metric", m),
+			zap.Float64("mean", mean),
+			zap.Float64("ci95-low", low),
+			zap.Float64("ci95-high", high),
+			zap.Int("samples", len(xs)),
+		)
+	}
+
+	fr := dataframe.New()
+	for _, col := range cols {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientRepeatSummaryPath)
+}
+
+// ReadRepeatSummary reads the metric samples of repeated runs
+// from the file saved by 'StressRepeat'.
+func ReadRepeatSummary(fpath string) (RepeatSamples, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%q is empty", fpath)
+	}
+	var idxs []int
+	for i, h := range rows[0] {
+		if strings.HasPrefix(h, "REPEAT-") {
+			idxs = append(idxs, i)
+		}
+	}
+	samples := make(RepeatSamples)
+	for _, row := range rows[1:] {
+		if len(row) != len(rows[0]) {
+			return nil, fmt.Errorf("%q has %d columns, expected %d", fpath, len(row), len(rows[0]))
+		}
+		for _, i := range idxs {
+			v, err := strconv.ParseFloat(row[i], 64)
+			if err != nil {
+				return nil, err
+			}
+			samples[row[0]] = append(samples[row[0]], v)
+		}
+	}
+	return samples, nil
+}
@@ -16,49 +16,177 @@ package dbtester
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cheggaaa/pb"
 	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/benchrunner"
+	"github.com/etcd-io/dbtester/pkg/tracing"
 	"github.com/coreos/etcd/pkg/report"
+	"go.uber.org/zap"
 	"golang.org/x/net/context"
 )
 
 type benchmark struct {
-	bar        *pb.ProgressBar
-	report     report.Report
-	reportDone <-chan report.Stats
-	stats      report.Stats
+	bar *pb.ProgressBar
+
+	// rec aggregates latencies into stats, correctedStats measured from
+	// intended start times to correct coordinated omission under rate
+	// limiting, opStats per operation type, and heatmap by unix second.
+	rec            *benchrunner.Recorder
+	stats          report.Stats
+	correctedStats report.Stats
+	opStats        map[string]report.Stats
+	heatmap        map[int64][]int64
 
 	reqHandlers []ReqHandler
-	reqGen      func(chan<- request)
+	reqGen      func(chan<- Request)
 	reqDone     func()
 	wg          sync.WaitGroup
 
 	mu           sync.RWMutex
-	inflightReqs chan request
+	inflightReqs chan Request
+
+	// inflight is the number of requests being handled by clients
+	inflight int64
+	// concurrency is sampled queue depth and in-flight requests by unix second
+	concurrency map[int64]concurrencySample
+	sampleStopc chan struct{}
+	sampleDonec chan struct{}
+
+	// budget aborts the benchmark, zero to disable
+	budget benchrunner.ErrorBudget
+
+	// tracer samples requests as spans, nil to disable
+	tracer   *tracing.Tracer
+	spanName string
+
+	// delays is the artificial delay of each request handler, nil to disable
+	delays []clientDelay
+
+	// priorities is the priority class of each request handler,
+	// nil to disable
+	priorities  []string
+	priorityKey string
+
+	// pauser pauses sending requests, nil to disable
+	pauser *Pauser
+
+	// recorder records generated requests, nil to disable
+	recorder *traceRecorder
+
+	// phases marks load and drain phases, nil to disable
+	phases *phaseLog
+
+	// clientWrites and clientReads count bytes written and read
+	// by clients, nil to disable
+	clientWrites *byteCounter
+	clientReads  *byteCounter
+
+	// ctx aborts the benchmark when done, nil to disable
+	ctx context.Context
+
+	abortOnce sync.Once
+	abortc    chan struct{}
+	// abortErr is the reason of abort, nil if not aborted
+	abortErr error
+}
+
+// concurrencySample is the sum of sampled queue depths and in-flight
+// requests within a second.
+type concurrencySample struct {
+	queueDepthSum float64
+	inflightSum   float64
+	n             int64
+}
+
+func (s concurrencySample) avgQueueDepth() float64 {
+	if s.n == 0 {
+		return 0
+	}
+	return s.queueDepthSum / float64(s.n)
+}
+
+func (s concurrencySample) avgInflight() float64 {
+	if s.n == 0 {
+		return 0
+	}
+	return s.inflightSum / float64(s.n)
+}
+
+// mergeConcurrency merges samples of the same unix second.
+func mergeConcurrency(dst, src map[int64]concurrencySample) {
+	for k, v := range src {
+		d := dst[k]
+		d.queueDepthSum += v.queueDepthSum
+		d.inflightSum += v.inflightSum
+		d.n += v.n
+		dst[k] = d
+	}
 }
 
 // pass totalN in case that 'cfg' is manipulated
-func newBenchmark(totalN int64, clientsN int64, reqHandlers []ReqHandler, reqDone func(), reqGen func(chan<- request)) (b *benchmark) {
+func newBenchmark(totalN int64, clientsN int64, reqHandlers []ReqHandler, reqDone func(), reqGen func(chan<- Request)) (b *benchmark) {
 	b = &benchmark{
 		bar:         pb.New(int(totalN)),
 		reqHandlers: reqHandlers,
 		reqGen:      reqGen,
 		reqDone:     reqDone,
 		wg:          sync.WaitGroup{},
+		abortc:      make(chan struct{}),
 	}
-	b.inflightReqs = make(chan request, clientsN)
+	b.inflightReqs = make(chan Request, clientsN)
 
 	b.bar.Format("Bom !")
 	b.bar.Start()
-	b.report = report.NewReportSample("%4.4f")
 	return
 }
 
+// setErrorBudget sets the error budget, to abort when the cluster is clearly unhealthy.
+func (b *benchmark) setErrorBudget(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) {
+	b.budget = benchrunner.ErrorBudget{
+		MaxErrorRate:         opts.MaxErrorRate,
+		MaxConsecutiveErrors: opts.MaxConsecutiveErrors,
+	}
+}
+
+// setClientDelays delays the requests of each client, if 'client_delays_ms'
+// or 'client_delay_jitter_ms' is set.
+func (b *benchmark) setClientDelays(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) {
+	b.delays = clientDelays(opts, len(b.reqHandlers))
+}
+
+// setPriorities tags the requests of each client with its priority class,
+// if 'priority_classes' is set.
+func (b *benchmark) setPriorities(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) {
+	b.priorities = clientPriorities(opts, len(b.reqHandlers))
+	b.priorityKey = priorityMetadataKey(opts)
+}
+
+// setTracer traces sampled requests, if 'trace_sample_ratio' is set.
+func (b *benchmark) setTracer(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) {
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.TraceSampleRatio <= 0 {
+		return
+	}
+	b.tracer = tracing.New(lg, tracing.Config{
+		Endpoint:    opts.TraceOTLPEndpoint,
+		SampleRatio: opts.TraceSampleRatio,
+		Attributes: map[string]string{
+			"dbtester.database_id":    gcfg.DatabaseID,
+			"dbtester.database_tag":   gcfg.DatabaseTag,
+			"dbtester.benchmark_type": opts.Type,
+		},
+	})
+	b.spanName = "dbtester/" + opts.Type
+}
+
 // only useful when multiple ranges of requests are run with one report
-func (b *benchmark) reset(clientsN int64, reqHandlers []ReqHandler, reqDone func(), reqGen func(chan<- request)) {
+func (b *benchmark) reset(clientsN int64, reqHandlers []ReqHandler, reqDone func(), reqGen func(chan<- Request)) {
 	if len(reqHandlers) == 0 {
 		panic(fmt.Errorf("got 0 reqHandlers"))
 	}
@@ -68,11 +196,11 @@ func (b *benchmark) reset(clientsN int64, reqHandlers []ReqHandler, reqDone func
 
 	// inflight requests will be dropped!
 	b.mu.Lock()
-	b.inflightReqs = make(chan request, clientsN)
+	b.inflightReqs = make(chan Request, clientsN)
 	b.mu.Unlock()
 }
 
-func (b *benchmark) getInflightsReqs() (ch chan request) {
+func (b *benchmark) getInflightsReqs() (ch chan Request) {
 	b.mu.RLock()
 	ch = b.inflightReqs
 	b.mu.RUnlock()
@@ -80,23 +208,122 @@ func (b *benchmark) getInflightsReqs() (ch chan request) {
 }
 
 func (b *benchmark) startRequests() {
+	b.rec = benchrunner.NewRecorder(b.budget)
+	b.phases.begin(PhaseLoad)
 	for i := range b.reqHandlers {
 		b.wg.Add(1)
-		go func(rh ReqHandler) {
+		var delay clientDelay
+		if i < len(b.delays) {
+			delay = b.delays[i]
+		}
+		var priority string
+		if i < len(b.priorities) {
+			priority = b.priorities[i]
+		}
+		go func(rh ReqHandler, delay clientDelay, priority string) {
 			defer b.wg.Done()
 			for req := range b.getInflightsReqs() {
+				if b.aborted() {
+					return
+				}
 				if rh == nil {
 					panic(fmt.Errorf("got nil rh"))
 				}
+				b.pauser.wait(b.abortc)
+				atomic.AddInt64(&b.inflight, 1)
+				ctx, span := b.tracer.Start(context.Background(), b.spanName)
+				if span != nil {
+					span.SetAttribute("db.key", req.key())
+					if !req.intendedStart.IsZero() {
+						span.SetAttribute("dbtester.schedule_lag", time.Since(req.intendedStart).String())
+					}
+				}
+				opType := req.opType()
+				if priority != "" {
+					ctx = withPriority(ctx, b.priorityKey, priority)
+					opType += "/" + priority
+				}
 				st := time.Now()
-				err := rh(context.Background(), &req)
-				b.report.Results() <- report.Result{Err: err, Start: st, End: time.Now()}
+				delay.wait(b.abortc)
+				err := rh(ctx, &req)
+				end := time.Now()
+				span.End(err)
+				if err == nil {
+					b.clientWrites.add(req.writeBytes())
+					b.clientReads.add(req.readBytes())
+				}
+
+				// requests are not late for the paused time
+				intended := req.intendedStart
+				if b.pauser != nil && !intended.IsZero() && intended.Before(st) {
+					intended = intended.Add(b.pauser.pausedBetween(intended, st))
+				}
+				if berr := b.rec.Observe(opType, intended, st, end, err); berr != nil {
+					b.abort(berr)
+				}
+				atomic.AddInt64(&b.inflight, -1)
 				b.bar.Increment()
 			}
-		}(b.reqHandlers[i])
+		}(b.reqHandlers[i], delay, priority)
+	}
+	go func(ch chan Request) {
+		b.recorder.wrap(b.reqGen)(ch)
+		// all requests are generated, in-flight requests remain
+		b.phases.begin(PhaseDrain)
+	}(b.getInflightsReqs())
+	b.concurrency = make(map[int64]concurrencySample)
+	b.sampleStopc, b.sampleDonec = make(chan struct{}), make(chan struct{})
+	go b.sampleConcurrency()
+	if b.ctx != nil {
+		go func(stopc chan struct{}) {
+			select {
+			case <-b.ctx.Done():
+				b.abort(b.ctx.Err())
+			case <-stopc:
+			}
+		}(b.sampleStopc)
+	}
+}
+
+func (b *benchmark) aborted() bool {
+	select {
+	case <-b.abortc:
+		return true
+	default:
+		return false
+	}
+}
+
+// abort stops clients from sending requests. Queued requests are
+// drained in the background, so that the request generator can finish.
+func (b *benchmark) abort(err error) {
+	b.abortOnce.Do(func() {
+		b.abortErr = err
+		close(b.abortc)
+		go func(ch chan Request) {
+			for range ch {
+			}
+		}(b.getInflightsReqs())
+	})
+}
+
+// sampleConcurrency samples the number of queued and in-flight requests
+// every 100ms, to tell whether the client or the server is the bottleneck.
+func (b *benchmark) sampleConcurrency() {
+	defer close(b.sampleDonec)
+	for {
+		select {
+		case <-b.sampleStopc:
+			return
+		case now := <-time.After(100 * time.Millisecond):
+			sec := now.Unix()
+			s := b.concurrency[sec]
+			s.queueDepthSum += float64(len(b.getInflightsReqs()))
+			s.inflightSum += float64(atomic.LoadInt64(&b.inflight))
+			s.n++
+			b.concurrency[sec] = s
+		}
 	}
-	go b.reqGen(b.getInflightsReqs())
-	b.reportDone = b.report.Stats()
 }
 
 func (b *benchmark) waitRequestsEnd() {
@@ -107,10 +334,15 @@ func (b *benchmark) waitRequestsEnd() {
 }
 
 func (b *benchmark) finishReports() {
-	close(b.report.Results())
+	close(b.sampleStopc)
+	<-b.sampleDonec
+	res := b.rec.Close()
+	b.tracer.Close()
 	b.bar.Finish()
-	st := <-b.reportDone
-	b.stats = st
+	b.stats = res.Stats
+	b.correctedStats = res.CorrectedStats
+	b.opStats = res.OpStats
+	b.heatmap = res.Heatmap
 }
 
 func (b *benchmark) waitAll() {
@@ -120,27 +352,45 @@ func (b *benchmark) waitAll() {
 
 func printStats(st report.Stats) {
 	// to be piped to cfg.Log via stdout when dbtester executed
+	writeStats(os.Stdout, st)
+}
+
+func writeStats(w io.Writer, st report.Stats) {
 	if len(st.Lats) > 0 {
-		fmt.Printf("Total: %v\n", st.Total)
-		fmt.Printf("Slowest: %f secs\n", st.Slowest)
-		fmt.Printf("Fastest: %f secs\n", st.Fastest)
-		fmt.Printf("Average: %f secs\n", st.Average)
-		fmt.Printf("Requests/sec: %4.4f\n", st.RPS)
+		fmt.Fprintf(w, "Total: %v\n", st.Total)
+		fmt.Fprintf(w, "Slowest: %f secs\n", st.Slowest)
+		fmt.Fprintf(w, "Fastest: %f secs\n", st.Fastest)
+		fmt.Fprintf(w, "Average: %f secs\n", st.Average)
+		fmt.Fprintf(w, "Requests/sec: %4.4f\n", st.RPS)
 	}
 	if len(st.ErrorDist) > 0 {
 		for k, v := range st.ErrorDist {
-			fmt.Printf("ERROR %q : %d\n", k, v)
+			fmt.Fprintf(w, "ERROR %q : %d\n", k, v)
 		}
 	} else {
-		fmt.Println("ERRRO: 0")
+		fmt.Fprintln(w, "ERRRO: 0")
 	}
 }
 
-func (cfg *Config) generateReport(gcfg dbtesterpb.ConfigClientMachineAgentControl, h []ReqHandler, reqDone func(), reqGen func(chan<- request)) {
+// generateReport returns an error if the benchmark was aborted
+// by the error budget. Partial results are still saved.
+func (cfg *Config) generateReport(gcfg dbtesterpb.ConfigClientMachineAgentControl, h []ReqHandler, reqDone func(), reqGen func(chan<- Request)) error {
 	b := newBenchmark(gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber, h, reqDone, reqGen)
+	b.setErrorBudget(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.setTracer(cfg.lg, gcfg)
+	b.setClientDelays(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.setPriorities(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.pauser = cfg.pauser
+	b.ctx = cfg.stressCtx
+	b.recorder = cfg.recorder
+	b.phases = cfg.phases
+	b.clientWrites = cfg.clientWrites
+	b.clientReads = cfg.clientReads
 	b.startRequests()
 	b.waitAll()
 
 	printStats(b.stats)
-	cfg.saveAllStats(gcfg, b.stats, nil)
+	cfg.opStats = b.opStats
+	cfg.saveAllStats(gcfg, b.stats, b.correctedStats, nil, b.concurrency, b.heatmap)
+	return b.abortErr
 }
@@ -0,0 +1,268 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/pkg/report"
+)
+
+// ReportEncoder encodes the machine-readable report of a benchmark.
+type ReportEncoder interface {
+	// Extension returns the file extension of the encoded report (e.g. ".json").
+	Extension() string
+	// Encode writes the report.
+	Encode(w io.Writer, r *dbtesterpb.Report) error
+}
+
+var (
+	reportEncodersMu sync.Mutex
+	reportEncoders   = map[string]ReportEncoder{
+		"csv":      csvReportEncoder{},
+		"json":     jsonReportEncoder{},
+		"protobuf": protobufReportEncoder{},
+	}
+)
+
+// RegisterReportEncoder registers a report encoder by format name.
+func RegisterReportEncoder(format string, enc ReportEncoder) {
+	reportEncodersMu.Lock()
+	defer reportEncodersMu.Unlock()
+	if enc == nil {
+		panic("dbtester: RegisterReportEncoder with nil ReportEncoder")
+	}
+	if _, ok := reportEncoders[format]; ok {
+		panic(fmt.Sprintf("dbtester: report format %q is already registered", format))
+	}
+	reportEncoders[format] = enc
+}
+
+// RegisteredReportFormats returns the sorted names of registered report formats.
+func RegisteredReportFormats() []string {
+	reportEncodersMu.Lock()
+	defer reportEncodersMu.Unlock()
+	ns := make([]string, 0, len(reportEncoders))
+	for k := range reportEncoders {
+		ns = append(ns, k)
+	}
+	sort.Strings(ns)
+	return ns
+}
+
+func reportEncoder(format string) (ReportEncoder, bool) {
+	reportEncodersMu.Lock()
+	defer reportEncodersMu.Unlock()
+	enc, ok := reportEncoders[format]
+	return enc, ok
+}
+
+// csvReportEncoder writes one 'FIELD,VALUE' row per metric.
+type csvReportEncoder struct{}
+
+func (csvReportEncoder) Extension() string { return ".csv" }
+
+func (csvReportEncoder) Encode(w io.Writer, r *dbtesterpb.Report) error {
+	rows := [][]string{
+		{"FIELD", "VALUE"},
+		{"DATABASE-ID", r.DatabaseID},
+		{"DATABASE-TAG", r.DatabaseTag},
+		{"DATABASE-DESCRIPTION", r.DatabaseDescription},
+		{"BENCHMARK-TYPE", r.BenchmarkType},
+		{"TOTAL-SECONDS", fmt.Sprintf("%4.4f", r.TotalSeconds)},
+		{"REQUESTS", fmt.Sprint(r.Requests)},
+		{"ERRORS", fmt.Sprint(r.Errors)},
+		{"REQUESTS-PER-SECOND", fmt.Sprintf("%4.4f", r.RequestsPerSecond)},
+		{"FASTEST-LATENCY-MS", fmt.Sprintf("%4.4f", r.FastestLatencyMs)},
+		{"AVERAGE-LATENCY-MS", fmt.Sprintf("%4.4f", r.AverageLatencyMs)},
+		{"SLOWEST-LATENCY-MS", fmt.Sprintf("%4.4f", r.SlowestLatencyMs)},
+		{"STDDEV-LATENCY-MS", fmt.Sprintf("%4.4f", r.StddevLatencyMs)},
+	}
+	pctRows := func(prefix string, ps []*dbtesterpb.ReportPercentile, corrected bool) {
+		for _, p := range ps {
+			rows = append(rows, []string{fmt.Sprintf("%sP%g-LATENCY-MS", prefix, p.Percentile), fmt.Sprintf("%4.4f", p.LatencyMs)})
+			if corrected {
+				rows = append(rows, []string{fmt.Sprintf("%sP%g-CORRECTED-LATENCY-MS", prefix, p.Percentile), fmt.Sprintf("%4.4f", p.CorrectedLatencyMs)})
+			}
+		}
+	}
+	pctRows("", r.Percentiles, true)
+	for _, e := range r.ErrorDist {
+		rows = append(rows, []string{fmt.Sprintf("ERROR: %q", e.Error), fmt.Sprint(e.Count)})
+	}
+	for _, op := range r.OpTypes {
+		prefix := "OP-TYPE-" + strings.ToUpper(op.OpType) + "-"
+		rows = append(rows,
+			[]string{prefix + "REQUESTS", fmt.Sprint(op.Requests)},
+			[]string{prefix + "ERRORS", fmt.Sprint(op.Errors)},
+			[]string{prefix + "REQUESTS-PER-SECOND", fmt.Sprintf("%4.4f", op.RequestsPerSecond)},
+			[]string{prefix + "AVERAGE-LATENCY-MS", fmt.Sprintf("%4.4f", op.AverageLatencyMs)},
+		)
+		pctRows(prefix, op.Percentiles, false)
+	}
+	for _, s := range r.SLOs {
+		rows = append(rows, []string{fmt.Sprintf("SLO: %s %s %g", s.Metric, s.Op, s.Threshold), fmt.Sprintf("%t (got %.4f)", s.Pass, s.Value)})
+	}
+
+	wr := csv.NewWriter(w)
+	if err := wr.WriteAll(rows); err != nil {
+		return err
+	}
+	wr.Flush()
+	return wr.Error()
+}
+
+type jsonReportEncoder struct{}
+
+func (jsonReportEncoder) Extension() string { return ".json" }
+
+func (jsonReportEncoder) Encode(w io.Writer, r *dbtesterpb.Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+type protobufReportEncoder struct{}
+
+func (protobufReportEncoder) Extension() string { return ".pb" }
+
+func (protobufReportEncoder) Encode(w io.Writer, r *dbtesterpb.Report) error {
+	bts, err := r.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bts)
+	return err
+}
+
+// reportPercentiles returns the latency percentiles, with corrected
+// latencies if any.
+func reportPercentiles(measured, corrected report.Stats) []*dbtesterpb.ReportPercentile {
+	pctls, seconds := report.Percentiles(measured.Lats)
+	var correctedSeconds []float64
+	if len(corrected.Lats) > 0 {
+		_, correctedSeconds = report.Percentiles(corrected.Lats)
+	}
+	ps := make([]*dbtesterpb.ReportPercentile, len(pctls))
+	for i := range pctls {
+		ps[i] = &dbtesterpb.ReportPercentile{Percentile: pctls[i], LatencyMs: 1000 * seconds[i]}
+		if i < len(correctedSeconds) {
+			ps[i].CorrectedLatencyMs = 1000 * correctedSeconds[i]
+		}
+	}
+	return ps
+}
+
+func countErrors(st report.Stats) (n int64) {
+	for _, v := range st.ErrorDist {
+		n += int64(v)
+	}
+	return n
+}
+
+// newReport returns the machine-readable report of the benchmark.
+func (cfg *Config) newReport(gcfg dbtesterpb.ConfigClientMachineAgentControl, stats, corrected report.Stats) *dbtesterpb.Report {
+	r := &dbtesterpb.Report{
+		DatabaseID:          gcfg.DatabaseID,
+		DatabaseTag:         gcfg.DatabaseTag,
+		DatabaseDescription: gcfg.DatabaseDescription,
+		BenchmarkType:       gcfg.ConfigClientMachineBenchmarkOptions.Type,
+		TotalSeconds:        stats.Total.Seconds(),
+		Requests:            int64(len(stats.Lats)),
+		Errors:              countErrors(stats),
+		RequestsPerSecond:   stats.RPS,
+		FastestLatencyMs:    1000 * stats.Fastest,
+		AverageLatencyMs:    1000 * stats.Average,
+		SlowestLatencyMs:    1000 * stats.Slowest,
+		StddevLatencyMs:     1000 * stats.Stddev,
+		Percentiles:         reportPercentiles(stats, corrected),
+	}
+	for e, n := range stats.ErrorDist {
+		r.ErrorDist = append(r.ErrorDist, &dbtesterpb.ReportError{Error: e, Count: int64(n)})
+	}
+	sort.Slice(r.ErrorDist, func(i, j int) bool { return r.ErrorDist[i].Error < r.ErrorDist[j].Error })
+
+	if len(cfg.opStats) > 1 {
+		for _, t := range sortedOpTypes(cfg.opStats) {
+			st := cfg.opStats[t]
+			op := &dbtesterpb.ReportOpType{
+				OpType:           t,
+				Requests:         int64(len(st.Lats)),
+				Errors:           countErrors(st),
+				AverageLatencyMs: 1000 * st.Average,
+				Percentiles:      reportPercentiles(st, report.Stats{}),
+			}
+			if stats.Total > 0 {
+				op.RequestsPerSecond = float64(len(st.Lats)) / stats.Total.Seconds()
+			}
+			r.OpTypes = append(r.OpTypes, op)
+		}
+	}
+	for _, s := range cfg.sloResults {
+		r.SLOs = append(r.SLOs, &dbtesterpb.ReportSLO{Metric: s.Metric, Op: s.Op, Threshold: s.Threshold, Value: s.Value, Pass: s.Pass})
+	}
+	return r
+}
+
+// reportDataPath returns the path of the report in the format,
+// replacing the extension of 'client_report_data_path'.
+func reportDataPath(fpath string, enc ReportEncoder) string {
+	return strings.TrimSuffix(fpath, filepath.Ext(fpath)) + enc.Extension()
+}
+
+// saveReportData writes the machine-readable report in each format
+// of 'client_report_formats'.
+func (cfg *Config) saveReportData(gcfg dbtesterpb.ConfigClientMachineAgentControl, stats, corrected report.Stats) error {
+	r := cfg.newReport(gcfg, stats, corrected)
+	for _, format := range cfg.ConfigClientMachineInitial.ClientReportFormats {
+		enc, ok := reportEncoder(format)
+		if !ok {
+			return fmt.Errorf("report format %q is not registered (registered %q)", format, RegisteredReportFormats())
+		}
+		f, err := os.Create(reportDataPath(cfg.ConfigClientMachineInitial.ClientReportDataPath, enc))
+		if err != nil {
+			return err
+		}
+		err = enc.Encode(f, r)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to encode %q report (%v)", format, err)
+		}
+	}
+	return nil
+}
+
+// reportDataPaths returns the paths of the machine-readable reports.
+func (cfg *Config) reportDataPaths() []string {
+	var paths []string
+	for _, format := range cfg.ConfigClientMachineInitial.ClientReportFormats {
+		if enc, ok := reportEncoder(format); ok {
+			paths = append(paths, reportDataPath(cfg.ConfigClientMachineInitial.ClientReportDataPath, enc))
+		}
+	}
+	return paths
+}
@@ -0,0 +1,74 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func TestReportEncoders(t *testing.T) {
+	r := &dbtesterpb.Report{
+		DatabaseID:        "etcd__tip",
+		BenchmarkType:     "write",
+		Requests:          100,
+		RequestsPerSecond: 50,
+		Percentiles:       []*dbtesterpb.ReportPercentile{{Percentile: 99, LatencyMs: 3, CorrectedLatencyMs: 4}},
+		ErrorDist:         []*dbtesterpb.ReportError{{Error: "timeout", Count: 1}},
+	}
+
+	var buf bytes.Buffer
+	if err := (jsonReportEncoder{}).Encode(&buf, r); err != nil {
+		t.Fatal(err)
+	}
+	var fromJSON dbtesterpb.Report
+	if err := json.Unmarshal(buf.Bytes(), &fromJSON); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&fromJSON, r) {
+		t.Fatalf("expected %+v, got %+v", r, fromJSON)
+	}
+
+	buf.Reset()
+	if err := (protobufReportEncoder{}).Encode(&buf, r); err != nil {
+		t.Fatal(err)
+	}
+	var fromPB dbtesterpb.Report
+	if err := fromPB.Unmarshal(buf.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&fromPB, r) {
+		t.Fatalf("expected %+v, got %+v", r, fromPB)
+	}
+
+	buf.Reset()
+	if err := (csvReportEncoder{}).Encode(&buf, r); err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range []string{"FIELD,VALUE\n", "REQUESTS,100\n", "P99-LATENCY-MS,3.0000\n", "P99-CORRECTED-LATENCY-MS,4.0000\n", "\"ERROR: \"\"timeout\"\"\",1\n"} {
+		if !strings.Contains(buf.String(), row) {
+			t.Fatalf("expected %q in %q", row, buf.String())
+		}
+	}
+
+	if p := reportDataPath("/tmp/client-report-data.txt", protobufReportEncoder{}); p != "/tmp/client-report-data.pb" {
+		t.Fatalf("unexpected path %q", p)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/etcd-io/dbtester/pkg/benchrunner"
+	"github.com/gyuho/dataframe"
+)
+
+// LatencyHeatmapBucketsMs is the upper bounds of latency heatmap buckets,
+// in milliseconds. Latencies beyond the last bound go to an overflow bucket.
+var LatencyHeatmapBucketsMs = benchrunner.LatencyBucketsMs
+
+// LatencyHeatmapColumns returns the headers of latency heatmap data,
+// the unix second followed by the request count of each bucket.
+func LatencyHeatmapColumns() []string {
+	cols := []string{"UNIX-SECOND"}
+	for _, b := range LatencyHeatmapBucketsMs {
+		cols = append(cols, fmt.Sprintf("LE-%gMS", b))
+	}
+	return append(cols, fmt.Sprintf("GT-%gMS", LatencyHeatmapBucketsMs[len(LatencyHeatmapBucketsMs)-1]))
+}
+
+// mergeHeatmap merges histograms of the same unix second.
+func mergeHeatmap(dst, src map[int64][]int64) {
+	for sec, counts := range src {
+		d, ok := dst[sec]
+		if !ok {
+			d = make([]int64, len(counts))
+			dst[sec] = d
+		}
+		for i := range counts {
+			d[i] += counts[i]
+		}
+	}
+}
+
+// saveDataLatencyHeatmap saves per-second latency histograms, so that
+// periodic stalls (e.g. snapshot, GC) are not smeared out by averages.
+func (cfg *Config) saveDataLatencyHeatmap(heatmap map[int64][]int64) {
+	secs := make([]int64, 0, len(heatmap))
+	for sec := range heatmap {
+		secs = append(secs, sec)
+	}
+	sort.Slice(secs, func(i, j int) bool { return secs[i] < secs[j] })
+
+	headers := LatencyHeatmapColumns()
+	cols := make([]dataframe.Column, len(headers))
+	for i := range headers {
+		cols[i] = dataframe.NewColumn(headers[i])
+	}
+	for _, sec := range secs {
+		cols[0].PushBack(dataframe.NewStringValue(sec))
+		for i, n := range heatmap[sec] {
+			cols[i+1].PushBack(dataframe.NewStringValue(n))
+		}
+	}
+
+	fr := dataframe.New()
+	for _, col := range cols {
+		if err := fr.AddColumn(col); err != nil {
+			panic(err)
+		}
+	}
+	if err := fr.CSV(cfg.ConfigClientMachineInitial.ClientLatencyHeatmapPath); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/pkg/report"
+)
+
+// openMetricsLabel is a label name and value of OpenMetrics samples.
+type openMetricsLabel struct {
+	name  string
+	value string
+}
+
+var openMetricsEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatOpenMetricsLabels(labels []openMetricsLabel) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	ss := make([]string, len(labels))
+	for i, l := range labels {
+		ss[i] = l.name + `="` + openMetricsEscaper.Replace(l.value) + `"`
+	}
+	return "{" + strings.Join(ss, ",") + "}"
+}
+
+func formatOpenMetricsFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// writeOpenMetrics writes the latency histogram of all successful requests,
+// error counters and throughput in OpenMetrics text format. Histogram buckets
+// share the bounds of latency heatmaps, in seconds.
+func writeOpenMetrics(w io.Writer, labels []openMetricsLabel, st report.Stats) {
+	withLabels := func(extra ...openMetricsLabel) string {
+		ls := make([]openMetricsLabel, 0, len(labels)+len(extra))
+		ls = append(ls, labels...)
+		return formatOpenMetricsLabels(append(ls, extra...))
+	}
+
+	counts := make([]int64, len(LatencyHeatmapBucketsMs)+1)
+	for _, lat := range st.Lats {
+		counts[sort.SearchFloat64s(LatencyHeatmapBucketsMs, lat*1000)]++
+	}
+	fmt.Fprintln(w, "# TYPE dbtester_request_duration_seconds histogram")
+	fmt.Fprintln(w, "# HELP dbtester_request_duration_seconds Latency of successful benchmark requests.")
+	var cumulative int64
+	for i, b := range LatencyHeatmapBucketsMs {
+		cumulative += counts[i]
+		le := openMetricsLabel{name: "le", value: formatOpenMetricsFloat(b / 1000)}
+		fmt.Fprintf(w, "dbtester_request_duration_seconds_bucket%s %d\n", withLabels(le), cumulative)
+	}
+	fmt.Fprintf(w, "dbtester_request_duration_seconds_bucket%s %d\n", withLabels(openMetricsLabel{name: "le", value: "+Inf"}), len(st.Lats))
+	fmt.Fprintf(w, "dbtester_request_duration_seconds_sum%s %s\n", withLabels(), formatOpenMetricsFloat(st.AvgTotal))
+	fmt.Fprintf(w, "dbtester_request_duration_seconds_count%s %d\n", withLabels(), len(st.Lats))
+
+	errs := make([]string, 0, len(st.ErrorDist))
+	for e := range st.ErrorDist {
+		errs = append(errs, e)
+	}
+	sort.Strings(errs)
+	fmt.Fprintln(w, "# TYPE dbtester_request_errors counter")
+	fmt.Fprintln(w, "# HELP dbtester_request_errors Number of failed benchmark requests by error.")
+	for _, e := range errs {
+		fmt.Fprintf(w, "dbtester_request_errors_total%s %d\n", withLabels(openMetricsLabel{name: "error", value: e}), st.ErrorDist[e])
+	}
+
+	fmt.Fprintln(w, "# TYPE dbtester_requests_per_second gauge")
+	fmt.Fprintln(w, "# HELP dbtester_requests_per_second Throughput of successful benchmark requests.")
+	fmt.Fprintf(w, "dbtester_requests_per_second%s %s\n", withLabels(), formatOpenMetricsFloat(st.RPS))
+
+	fmt.Fprintln(w, "# TYPE dbtester_benchmark_duration_seconds gauge")
+	fmt.Fprintln(w, "# HELP dbtester_benchmark_duration_seconds Duration of the benchmark.")
+	fmt.Fprintf(w, "dbtester_benchmark_duration_seconds%s %s\n", withLabels(), formatOpenMetricsFloat(st.Total.Seconds()))
+
+	fmt.Fprintln(w, "# EOF")
+}
+
+// saveOpenMetrics saves the final latency distribution and counters
+// in OpenMetrics text format, labeled by test and database.
+func (cfg *Config) saveOpenMetrics(gcfg dbtesterpb.ConfigClientMachineAgentControl, st report.Stats) {
+	labels := []openMetricsLabel{
+		{name: "test_name", value: cfg.ConfigClientMachineInitial.TestName},
+		{name: "database_id", value: gcfg.DatabaseID},
+		{name: "database_tag", value: gcfg.DatabaseTag},
+		{name: "benchmark_type", value: gcfg.ConfigClientMachineBenchmarkOptions.Type},
+	}
+	var buf bytes.Buffer
+	writeOpenMetrics(&buf, labels, st)
+	if err := ioutil.WriteFile(cfg.ConfigClientMachineInitial.ClientOpenMetricsPath, buf.Bytes(), 0644); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/pkg/report"
+)
+
+func Test_writeOpenMetrics(t *testing.T) {
+	st := report.Stats{
+		AvgTotal:  0.0115,
+		RPS:       1.5,
+		Total:     2 * time.Second,
+		ErrorDist: map[string]int{`rpc error: "timeout"`: 2},
+		Lats:      []float64{0.0003, 0.001, 0.0102},
+	}
+	var buf bytes.Buffer
+	writeOpenMetrics(&buf, []openMetricsLabel{{name: "database_id", value: "etcd__tip"}}, st)
+	out := buf.String()
+
+	for _, line := range []string{
+		`dbtester_request_duration_seconds_bucket{database_id="etcd__tip",le="0.0005"} 1`,
+		`dbtester_request_duration_seconds_bucket{database_id="etcd__tip",le="0.001"} 2`,
+		`dbtester_request_duration_seconds_bucket{database_id="etcd__tip",le="0.008"} 2`,
+		`dbtester_request_duration_seconds_bucket{database_id="etcd__tip",le="0.016"} 3`,
+		`dbtester_request_duration_seconds_bucket{database_id="etcd__tip",le="+Inf"} 3`,
+		`dbtester_request_duration_seconds_sum{database_id="etcd__tip"} 0.0115`,
+		`dbtester_request_duration_seconds_count{database_id="etcd__tip"} 3`,
+		`dbtester_request_errors_total{database_id="etcd__tip",error="rpc error: \"timeout\""} 2`,
+		`dbtester_requests_per_second{database_id="etcd__tip"} 1.5`,
+		`dbtester_benchmark_duration_seconds{database_id="etcd__tip"} 2`,
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Fatalf("expected %q in output:\n%s", line, out)
+		}
+	}
+	if !strings.HasSuffix(out, "# EOF\n") {
+		t.Fatalf("expected '# EOF' at the end, got:\n%s", out)
+	}
+}
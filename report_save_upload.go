@@ -15,9 +15,10 @@
 package dbtester
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"math"
-	"path/filepath"
 	"strings"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/coreos/etcd/pkg/report"
 	humanize "github.com/dustin/go-humanize"
 	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
 )
 
 // DiskSpaceUsageSummaryColumns defines summary columns.
@@ -35,6 +37,17 @@ var DiskSpaceUsageSummaryColumns = []string{
 	"DATABASE-ENDPOINT",
 	"DISK-SPACE-USAGE",
 	"DISK-SPACE-USAGE-BYTES-NUM",
+	"ROLE",
+	"DISK-WRITE-BYTES-NUM",
+	"CLIENT-WRITE-BYTES-NUM",
+	"WRITE-AMPLIFICATION",
+	"SPACE-AMPLIFICATION",
+	"DISK-READ-BYTES-NUM",
+	"CLIENT-READ-BYTES-NUM",
+	"READ-AMPLIFICATION",
+	"CACHE-HIT-RATIO",
+	"PEAK-MEMORY-BYTES-NUM",
+	"OOM-KILLED",
 }
 
 // SaveDiskSpaceUsageSummary saves data size summary.
@@ -48,11 +61,43 @@ func (cfg *Config) SaveDiskSpaceUsageSummary(databaseID string, idxToResponse ma
 	c2 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[1])
 	c3 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[2])
 	c4 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[3])
+	c5 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[4])
+	c6 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[5])
+	c7 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[6])
+	c8 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[7])
+	c9 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[8])
+	c10 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[9])
+	c11 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[10])
+	c12 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[11])
+	c13 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[12])
+	c14 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[13])
+	c15 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[14])
+	clientBytes, clientReadBytes := cfg.clientWrites.bytes(), cfg.clientReads.bytes()
 	for i := range gcfg.DatabaseEndpoints {
 		c1.PushBack(dataframe.NewStringValue(i))
 		c2.PushBack(dataframe.NewStringValue(gcfg.DatabaseEndpoints[i]))
 		c3.PushBack(dataframe.NewStringValue(humanize.Bytes(uint64(idxToResponse[i].DiskSpaceUsageBytes))))
 		c4.PushBack(dataframe.NewStringValue(idxToResponse[i].DiskSpaceUsageBytes))
+		var role string
+		if i < len(cfg.memberRoles) {
+			role = cfg.memberRoles[i]
+		}
+		c5.PushBack(dataframe.NewStringValue(role))
+
+		// data directory starts empty, so its size is the growth
+		c6.PushBack(dataframe.NewStringValue(idxToResponse[i].DiskWriteBytes))
+		c7.PushBack(dataframe.NewStringValue(clientBytes))
+		c8.PushBack(dataframe.NewStringValue(amplification(idxToResponse[i].DiskWriteBytes, clientBytes)))
+		c9.PushBack(dataframe.NewStringValue(amplification(idxToResponse[i].DiskSpaceUsageBytes, clientBytes)))
+
+		// reads from page cache do not hit the disk
+		c10.PushBack(dataframe.NewStringValue(idxToResponse[i].DiskReadBytes))
+		c11.PushBack(dataframe.NewStringValue(clientReadBytes))
+		c12.PushBack(dataframe.NewStringValue(amplification(idxToResponse[i].DiskReadBytes, clientReadBytes)))
+		c13.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.4f", cacheHitRatio(idxToResponse[i].DiskReadBytes, idxToResponse[i].LogicalReadBytes))))
+
+		c14.PushBack(dataframe.NewStringValue(idxToResponse[i].PeakMemoryBytes))
+		c15.PushBack(dataframe.NewStringValue(idxToResponse[i].OOMKilled))
 	}
 
 	fr := dataframe.New()
@@ -68,10 +113,45 @@ func (cfg *Config) SaveDiskSpaceUsageSummary(databaseID string, idxToResponse ma
 	if err := fr.AddColumn(c4); err != nil {
 		return err
 	}
+	if err := fr.AddColumn(c5); err != nil {
+		return err
+	}
+	for _, col := range []dataframe.Column{c6, c7, c8, c9, c10, c11, c12, c13, c14, c15} {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
 
 	return fr.CSV(cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath)
 }
 
+// saveReport saves the report printed to stdout, with latency percentiles.
+func (cfg *Config) saveReport(gcfg dbtesterpb.ConfigClientMachineAgentControl, stats, corrected report.Stats) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Database: %s (%s)\n", gcfg.DatabaseDescription, gcfg.DatabaseID)
+	fmt.Fprintf(&buf, "Type: %s\n", gcfg.ConfigClientMachineBenchmarkOptions.Type)
+	if c := gcfg.ConfigClientMachineBenchmarkOptions.EtcdCompression; c != "" {
+		fmt.Fprintf(&buf, "Compression: %s\n", c)
+	}
+	if cfg.valueCodec != nil {
+		fmt.Fprintf(&buf, "Value compression: %s\n", cfg.valueCodec)
+	}
+	writeStats(&buf, stats)
+
+	pctls, seconds := report.Percentiles(stats.Lats)
+	_, correctedSeconds := report.Percentiles(corrected.Lats)
+	fmt.Fprintln(&buf, "\nLatency distribution (measured, corrected):")
+	for i := range pctls {
+		fmt.Fprintf(&buf, "  %v%% in %4.4f secs, %4.4f secs\n", pctls[i], seconds[i], correctedSeconds[i])
+	}
+	writeOpTypeStats(&buf, cfg.opStats, stats)
+	writeSLOResults(&buf, cfg.sloResults)
+
+	if err := ioutil.WriteFile(cfg.ConfigClientMachineInitial.ClientReportPath, buf.Bytes(), 0644); err != nil {
+		panic(err)
+	}
+}
+
 func (cfg *Config) saveDataLatencyDistributionSummary(st report.Stats) {
 	fr := dataframe.New()
 
@@ -158,6 +238,46 @@ func (cfg *Config) saveDataLatencyDistributionPercentile(st report.Stats) {
 	}
 }
 
+// saveDataLatencyDistributionCorrected saves measured and
+// coordinated-omission-corrected latency percentiles side by side.
+func (cfg *Config) saveDataLatencyDistributionCorrected(measured, corrected report.Stats) {
+	pctls, measuredSeconds := report.Percentiles(measured.Lats)
+	_, correctedSeconds := report.Percentiles(corrected.Lats)
+	c1 := dataframe.NewColumn("LATENCY-PERCENTILE")
+	c2 := dataframe.NewColumn("MEASURED-LATENCY-MS")
+	c3 := dataframe.NewColumn("CORRECTED-LATENCY-MS")
+	for i := range pctls {
+		pct := fmt.Sprintf("p%.1f", pctls[i])
+		if strings.HasSuffix(pct, ".0") {
+			pct = strings.Replace(pct, ".0", "", -1)
+		}
+
+		c1.PushBack(dataframe.NewStringValue(pct))
+		c2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", 1000*measuredSeconds[i])))
+		c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", 1000*correctedSeconds[i])))
+	}
+	c1.PushBack(dataframe.NewStringValue("average"))
+	c2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", 1000*measured.Average)))
+	c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", 1000*corrected.Average)))
+	c1.PushBack(dataframe.NewStringValue("slowest"))
+	c2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", 1000*measured.Slowest)))
+	c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", 1000*corrected.Slowest)))
+
+	fr := dataframe.New()
+	if err := fr.AddColumn(c1); err != nil {
+		panic(err)
+	}
+	if err := fr.AddColumn(c2); err != nil {
+		panic(err)
+	}
+	if err := fr.AddColumn(c3); err != nil {
+		panic(err)
+	}
+	if err := fr.CSV(cfg.ConfigClientMachineInitial.ClientLatencyDistributionCorrectedPath); err != nil {
+		panic(err)
+	}
+}
+
 func (cfg *Config) saveDataLatencyDistributionAll(st report.Stats) {
 	min := int64(math.MaxInt64)
 	max := int64(-100000)
@@ -211,7 +331,7 @@ func (cfg *Config) saveDataLatencyDistributionAll(st report.Stats) {
 	}
 }
 
-func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigClientMachineAgentControl, st report.Stats, clientNs []int64) {
+func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigClientMachineAgentControl, st report.Stats, clientNs []int64, concurrency map[int64]concurrencySample) {
 	if len(clientNs) == 0 && len(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers) == 0 {
 		clientNs = make([]int64, len(st.TimeSeries))
 		for i := range clientNs {
@@ -224,6 +344,8 @@ func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigCli
 	c4 := dataframe.NewColumn("AVG-LATENCY-MS")
 	c5 := dataframe.NewColumn("MAX-LATENCY-MS")
 	c6 := dataframe.NewColumn("AVG-THROUGHPUT")
+	c7 := dataframe.NewColumn("AVG-QUEUE-DEPTH")
+	c8 := dataframe.NewColumn("AVG-INFLIGHT-REQUESTS")
 	for i := range st.TimeSeries {
 		// this Timestamp is unix seconds
 		c1.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", st.TimeSeries[i].Timestamp)))
@@ -232,6 +354,9 @@ func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigCli
 		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(st.TimeSeries[i].AvgLatency))))
 		c5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(st.TimeSeries[i].MaxLatency))))
 		c6.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", st.TimeSeries[i].ThroughPut)))
+		cs := concurrency[st.TimeSeries[i].Timestamp]
+		c7.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", cs.avgQueueDepth())))
+		c8.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", cs.avgInflight())))
 	}
 
 	fr := dataframe.New()
@@ -253,6 +378,58 @@ func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigCli
 	if err := fr.AddColumn(c6); err != nil {
 		panic(err)
 	}
+	if err := fr.AddColumn(c7); err != nil {
+		panic(err)
+	}
+	if err := fr.AddColumn(c8); err != nil {
+		panic(err)
+	}
+	if cfg.compactor != nil {
+		// annotate seconds with compactions
+		compactions := compactionsBySecond(cfg.compactor.eventsSnapshot())
+		cc1 := dataframe.NewColumn("COMPACTIONS")
+		cc2 := dataframe.NewColumn("COMPACTION-REVISION")
+		cc3 := dataframe.NewColumn("COMPACTION-MS")
+		for i := range st.TimeSeries {
+			var rev int64
+			var took time.Duration
+			evs := compactions[st.TimeSeries[i].Timestamp]
+			for _, ev := range evs {
+				rev = ev.revision
+				took += ev.took
+			}
+			cc1.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", len(evs))))
+			cc2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", rev)))
+			cc3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(took))))
+		}
+		for _, col := range []dataframe.Column{cc1, cc2, cc3} {
+			if err := fr.AddColumn(col); err != nil {
+				panic(err)
+			}
+		}
+	}
+	if gaps := cfg.pauser.Gaps(); len(gaps) > 0 {
+		// annotate seconds with paused request generation
+		paused := pausedBySecond(gaps, time.Now())
+		pc := dataframe.NewColumn("PAUSED-MS")
+		for i := range st.TimeSeries {
+			pc.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", toMillisecond(paused[st.TimeSeries[i].Timestamp]))))
+		}
+		if err := fr.AddColumn(pc); err != nil {
+			panic(err)
+		}
+	}
+
+	if phases := PhasesToProto(cfg.phases.Phases()); len(phases) > 0 {
+		// annotate seconds with workload phases
+		pc := dataframe.NewColumn("PHASE")
+		for i := range st.TimeSeries {
+			pc.PushBack(dataframe.NewStringValue(dbtesterpb.PhaseAt(phases, st.TimeSeries[i].Timestamp)))
+		}
+		if err := fr.AddColumn(pc); err != nil {
+			panic(err)
+		}
+	}
 
 	if err := fr.CSV(cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath); err != nil {
 		panic(err)
@@ -290,42 +467,169 @@ func (cfg *Config) saveDataLatencyThroughputTimeseries(gcfg dbtesterpb.ConfigCli
 	}
 }
 
-func (cfg *Config) saveAllStats(gcfg dbtesterpb.ConfigClientMachineAgentControl, stats report.Stats, clientNs []int64) {
+func (cfg *Config) saveAllStats(gcfg dbtesterpb.ConfigClientMachineAgentControl, stats, corrected report.Stats, clientNs []int64, concurrency map[int64]concurrencySample, heatmap map[int64][]int64) {
+	cfg.sloResults = evaluateSLOs(gcfg.ConfigClientMachineBenchmarkOptions.SLOs, stats)
+	if cfg.ConfigClientMachineInitial.ClientReportPath != "" {
+		cfg.saveReport(gcfg, stats, corrected)
+	}
+	if len(cfg.ConfigClientMachineInitial.ClientReportFormats) > 0 {
+		if err := cfg.saveReportData(gcfg, stats, corrected); err != nil {
+			cfg.lg.Warn("failed to save report data", zap.Error(err))
+		}
+	}
 	cfg.saveDataLatencyDistributionSummary(stats)
 	cfg.saveDataLatencyDistributionPercentile(stats)
+	if cfg.ConfigClientMachineInitial.ClientLatencyDistributionCorrectedPath != "" {
+		cfg.saveDataLatencyDistributionCorrected(stats, corrected)
+	}
+	if cfg.ConfigClientMachineInitial.ClientLatencyHeatmapPath != "" {
+		cfg.saveDataLatencyHeatmap(heatmap)
+	}
+	if cfg.ConfigClientMachineInitial.ClientOpenMetricsPath != "" {
+		cfg.saveOpenMetrics(gcfg, stats)
+	}
+	if cfg.ConfigClientMachineInitial.ClientOpTypeSummaryPath != "" && len(cfg.opStats) > 0 {
+		if err := cfg.saveOpTypeSummary(cfg.opStats, stats); err != nil {
+			cfg.lg.Warn("failed to save operation type summary", zap.Error(err))
+		}
+	}
 	cfg.saveDataLatencyDistributionAll(stats)
-	cfg.saveDataLatencyThroughputTimeseries(gcfg, stats, clientNs)
+	cfg.saveDataLatencyThroughputTimeseries(gcfg, stats, clientNs, concurrency)
+}
+
+// ClientResultPaths returns the paths of benchmark results written by control.
+func (cfg *Config) ClientResultPaths(databaseID string) []string {
+	gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	paths := []string{
+		cfg.ConfigClientMachineInitial.ClientLatencyThroughputTimeseriesPath,
+		cfg.ConfigClientMachineInitial.ClientLatencyDistributionAllPath,
+		cfg.ConfigClientMachineInitial.ClientLatencyDistributionPercentilePath,
+		cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath,
+		cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath,
+	}
+	for _, p := range []string{
+		cfg.ConfigClientMachineInitial.ClientReportPath,
+		cfg.ConfigClientMachineInitial.ClientLatencyDistributionCorrectedPath,
+		cfg.ConfigClientMachineInitial.ClientLatencyHeatmapPath,
+		cfg.ConfigClientMachineInitial.ClientOpenMetricsPath,
+		cfg.ConfigClientMachineInitial.ClientLeaderTimeseriesPath,
+		cfg.ConfigClientMachineInitial.ClientPhasesPath,
+	} {
+		if p != "" {
+			paths = append(paths, p)
+		}
+	}
+	for _, p := range cfg.reportDataPaths() {
+		if exist(p) {
+			paths = append(paths, p)
+		}
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && gcfg.ConfigClientMachineBenchmarkOptions.Type == "read-your-writes" {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientReadYourWritesPath)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && gcfg.ConfigClientMachineBenchmarkOptions.Type == "read-consistency" {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientReadConsistencyPath)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && gcfg.ConfigClientMachineBenchmarkOptions.Type == "auth" {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientAuthPenaltyPath)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && gcfg.ConfigClientMachineBenchmarkOptions.Type == "watch" {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientWatchSummaryPath)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && gcfg.ConfigClientMachineBenchmarkOptions.Type == "watch-compaction" {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientWatchCompactionSummaryPath)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && gcfg.ConfigClientMachineBenchmarkOptions.Type == "delete-range" {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientDeleteRangeSummaryPath)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && gcfg.ConfigClientMachineBenchmarkOptions.Type == "tenancy" {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientTenancySummaryPath)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && gcfg.ConfigClientMachineBenchmarkOptions.Repeat > 1 {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientRepeatSummaryPath)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && gcfg.ConfigClientMachineBenchmarkOptions.Type == "backup-restore" {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientBackupRestoreSummaryPath)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && gcfg.ConfigClientMachineBenchmarkOptions.Type == "snapshot-transfer" {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientSnapshotTransferSummaryPath)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && len(gcfg.ConfigClientMachineBenchmarkOptions.ScalingMemberNumbers) > 0 && cfg.ConfigClientMachineInitial.ClientMembershipChangesPath != "" {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientMembershipChangesPath)
+	}
+	if gcfg.ConfigClientMachineBenchmarkOptions != nil && gcfg.ConfigClientMachineBenchmarkOptions.UpgradeEtcdGitRef != "" && cfg.ConfigClientMachineInitial.ClientUpgradesPath != "" {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientUpgradesPath)
+	}
+	if cfg.ConfigClientMachineInitial.ClientOpTypeSummaryPath != "" && exist(cfg.ConfigClientMachineInitial.ClientOpTypeSummaryPath) {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientOpTypeSummaryPath)
+	}
+	if gcfg.ConfigClientMachineBenchmarkSteps != nil && gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase && cfg.ConfigClientMachineInitial.ClientClockOffsetsPath != "" {
+		paths = append(paths, cfg.ConfigClientMachineInitial.ClientClockOffsetsPath)
+	}
+	return paths
 }
 
-// UploadToGoogle uploads target file to Google Cloud Storage.
-func (cfg *Config) UploadToGoogle(databaseID string, targetPath string) error {
+// UploadFiles uploads target files to all remote storage destinations,
+// and then the manifest of uploaded files if 'client_upload_manifest_path' is set.
+// Files are uploaded to 'control' directory of the run. It can be called multiple times
+// as results become available; files already uploaded are skipped, and the manifest
+// lists all files uploaded so far.
+func (cfg *Config) UploadFiles(databaseID string, targetPaths ...string) error {
 	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 	if !ok {
 		return fmt.Errorf("%q does not exist", databaseID)
 	}
-	if !exist(targetPath) {
-		return fmt.Errorf("%q does not exist", targetPath)
+	for _, p := range targetPaths {
+		if !exist(p) {
+			return fmt.Errorf("%q does not exist", p)
+		}
 	}
-	u, err := remotestorage.NewGoogleCloudStorage(cfg.lg, []byte(cfg.ConfigClientMachineInitial.GoogleCloudStorageKey), cfg.ConfigClientMachineInitial.GoogleCloudProjectName)
-	if err != nil {
+	if err := cfg.initUploader(); err != nil {
 		return err
 	}
 
-	srcPath := targetPath
-	dstPath := filepath.Base(targetPath)
-	if !strings.HasPrefix(dstPath, gcfg.DatabaseTag) {
-		dstPath = fmt.Sprintf("%s-%s", gcfg.DatabaseTag, dstPath)
+	dstPath := func(srcPath string) string {
+		return cfg.controlUploadPath(gcfg.DatabaseTag, srcPath)
 	}
-	dstPath = filepath.Join(cfg.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstPath)
+	if cfg.uploadQueue == nil {
+		cfg.uploadQueue = remotestorage.NewQueue(cfg.lg, cfg.uploader, 30)
+	}
+	q := cfg.uploadQueue
+	for _, p := range targetPaths {
+		q.Add(p, dstPath(p))
+	}
+	err := q.Run()
 
-	var uerr error
-	for k := 0; k < 30; k++ {
-		if uerr = u.UploadFile(cfg.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcPath, dstPath); uerr != nil {
-			cfg.lg.Sugar().Infof("#%d: error %v while uploading %q", k, uerr, targetPath)
-			time.Sleep(2 * time.Second)
-			continue
+	if mp := cfg.ConfigClientMachineInitial.ClientUploadManifestPath; mp != "" {
+		if merr := q.WriteManifest(mp, cfg.ConfigClientMachineInitial.Labels); merr != nil {
+			return merr
 		}
-		break
+		if merr := cfg.uploader.UploadFile(mp, dstPath(mp), 30); merr != nil {
+			return merr
+		}
+	}
+	return err
+}
+
+func (cfg *Config) initUploader() error {
+	if cfg.uploader != nil {
+		return nil
+	}
+	if cfg.ConfigClientMachineInitial.Offline() {
+		return fmt.Errorf("no remote storage configured (set 'google_cloud_storage_bucket_name' or 'remote_storage_destinations')")
+	}
+	u, err := remotestorage.NewMulti(cfg.lg, &cfg.ConfigClientMachineInitial)
+	if err != nil {
+		return err
+	}
+	cfg.uploader = u
+	return nil
+}
+
+// UploadStatus returns the upload status of each remote storage destination.
+func (cfg *Config) UploadStatus() map[string]remotestorage.Status {
+	if cfg.uploader == nil {
+		return nil
 	}
-	return uerr
+	return cfg.uploader.Status()
 }
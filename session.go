@@ -0,0 +1,101 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Session is the state of a running cluster, written after step 1,
+// so that later invocations reuse the cluster for different workloads
+// (e.g. 'control --steps 2 --session <path>'), until it is stopped.
+type Session struct {
+	DatabaseID string `json:"database_id"`
+	// TestID and PortOffset identify the state of the
+	// cluster on agents, which serve concurrent tests.
+	TestID            string            `json:"test_id,omitempty"`
+	PortOffset        int64             `json:"port_offset,omitempty"`
+	DatabaseEndpoints []string          `json:"database_endpoints"`
+	AgentEndpoints    []string          `json:"agent_endpoints,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"`
+	StartedAt         time.Time         `json:"started_at"`
+}
+
+// SaveSession writes the session of the started cluster.
+func (cfg *Config) SaveSession(fpath, databaseID string) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+	b, err := json.MarshalIndent(Session{
+		DatabaseID:        databaseID,
+		TestID:            cfg.ConfigClientMachineInitial.TestID,
+		PortOffset:        cfg.ConfigClientMachineInitial.PortOffset,
+		DatabaseEndpoints: gcfg.DatabaseEndpoints,
+		AgentEndpoints:    gcfg.AgentEndpoints,
+		Labels:            cfg.ConfigClientMachineInitial.Labels,
+		StartedAt:         time.Now().UTC(),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(fpath), 0777); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fpath, b, 0644)
+}
+
+// ReadSession reads the session file.
+func ReadSession(fpath string) (*Session, error) {
+	b, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return nil, err
+	}
+	s := &Session{}
+	if err = json.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("failed to parse session %q (%v)", fpath, err)
+	}
+	return s, nil
+}
+
+// UseSession overwrites the endpoints of the database, and the test ID and
+// port offset of the configuration with the session. Labels of the session
+// (e.g. 'etcd-git-sha') are added unless configured.
+func (cfg *Config) UseSession(s *Session, databaseID string) error {
+	if s.DatabaseID != databaseID {
+		return fmt.Errorf("session is for %q, not %q", s.DatabaseID, databaseID)
+	}
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+	gcfg.DatabaseEndpoints = s.DatabaseEndpoints
+	gcfg.AgentEndpoints = s.AgentEndpoints
+	cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = gcfg
+
+	cfg.ConfigClientMachineInitial.TestID = s.TestID
+	cfg.ConfigClientMachineInitial.PortOffset = s.PortOffset
+	for k, v := range s.Labels {
+		if _, ok := cfg.ConfigClientMachineInitial.Labels[k]; !ok {
+			cfg.SetLabel(k, v)
+		}
+	}
+	return nil
+}
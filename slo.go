@@ -0,0 +1,137 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/pkg/report"
+	"go.uber.org/zap"
+)
+
+// SLOMetrics is the list of metrics that SLOs can be defined on.
+var SLOMetrics = append(append([]string{}, BenchmarkMetrics...), "availability")
+
+// SLOResult is the evaluation of an SLO.
+type SLOResult struct {
+	Metric string
+	// Op is "<" for upper bounds, ">" for lower bounds.
+	Op        string
+	Threshold float64
+	Value     float64
+	// Margin is the distance from the threshold,
+	// negative if the SLO is violated.
+	Margin float64
+	Pass   bool
+}
+
+func (r SLOResult) String() string {
+	st := "PASS"
+	if !r.Pass {
+		st = "FAIL"
+	}
+	return fmt.Sprintf("%s: %s %s %g (got %.4f, margin %+.4f)", st, r.Metric, r.Op, r.Threshold, r.Value, r.Margin)
+}
+
+func validateSLO(slo *dbtesterpb.ConfigSLO) error {
+	valid := false
+	for _, m := range SLOMetrics {
+		if m == slo.Metric {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return fmt.Errorf("unknown SLO metric %q (available %q)", slo.Metric, SLOMetrics)
+	}
+	if (slo.Max == 0) == (slo.Min == 0) {
+		return fmt.Errorf("SLO %q must set exactly one of 'max' or 'min'", slo.Metric)
+	}
+	return nil
+}
+
+// sloValue returns the metric of the benchmark stats.
+func sloValue(st report.Stats, m string) float64 {
+	switch m {
+	case "avg":
+		return 1000 * st.Average
+	case "throughput":
+		return st.RPS
+	case "availability":
+		var errN int
+		for _, n := range st.ErrorDist {
+			errN += n
+		}
+		if total := len(st.Lats) + errN; total > 0 {
+			return 100 * float64(len(st.Lats)) / float64(total)
+		}
+		return 0
+	}
+	pctls, seconds := report.Percentiles(st.Lats)
+	for i, p := range pctls {
+		if fmt.Sprintf("p%g", p) == m {
+			return 1000 * seconds[i]
+		}
+	}
+	return 0
+}
+
+// evaluateSLOs evaluates the SLOs on the benchmark stats.
+func evaluateSLOs(slos []*dbtesterpb.ConfigSLO, st report.Stats) []SLOResult {
+	rs := make([]SLOResult, 0, len(slos))
+	for _, slo := range slos {
+		r := SLOResult{Metric: slo.Metric, Value: sloValue(st, slo.Metric)}
+		if slo.Max != 0 {
+			r.Op, r.Threshold, r.Margin = "<", slo.Max, slo.Max-r.Value
+			r.Pass = r.Value < slo.Max
+		} else {
+			r.Op, r.Threshold, r.Margin = ">", slo.Min, r.Value-slo.Min
+			r.Pass = r.Value > slo.Min
+		}
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+func writeSLOResults(w io.Writer, rs []SLOResult) {
+	if len(rs) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "\nSLOs:")
+	for _, r := range rs {
+		fmt.Fprintf(w, "  %s\n", r)
+	}
+}
+
+// CheckSLOs returns error if any SLO of the last benchmark failed.
+func (cfg *Config) CheckSLOs() error {
+	var failed []string
+	for _, r := range cfg.sloResults {
+		if r.Pass {
+			cfg.lg.Info("SLO passed", zap.String("slo", r.String()))
+			continue
+		}
+		cfg.lg.Warn("SLO failed", zap.String("slo", r.String()))
+		failed = append(failed, fmt.Sprintf("%s %s %g (got %.4f)", r.Metric, r.Op, r.Threshold, r.Value))
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%d of %d SLOs failed: %s", len(failed), len(cfg.sloResults), strings.Join(failed, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/pkg/report"
+)
+
+func Test_evaluateSLOs(t *testing.T) {
+	lats := make([]float64, 100)
+	for i := range lats {
+		lats[i] = float64(i+1) / 1000
+	}
+	st := report.Stats{
+		Average:   0.0505,
+		RPS:       1000,
+		Lats:      lats,
+		ErrorDist: map[string]int{"timeout": 1},
+	}
+	rs := evaluateSLOs([]*dbtesterpb.ConfigSLO{
+		{Metric: "p99", Max: 50},
+		{Metric: "avg", Max: 100},
+		{Metric: "throughput", Min: 500},
+		{Metric: "availability", Min: 99.9},
+	}, st)
+
+	expected := []struct {
+		value float64
+		pass  bool
+	}{
+		{100, false},
+		{50.5, true},
+		{1000, true},
+		{100 * 100.0 / 101.0, false},
+	}
+	if len(rs) != len(expected) {
+		t.Fatalf("expected %d results, got %d", len(expected), len(rs))
+	}
+	for i, r := range rs {
+		if diff := r.Value - expected[i].value; diff > 1e-9 || diff < -1e-9 || r.Pass != expected[i].pass {
+			t.Fatalf("#%d: expected %v (pass %v), got %+v", i, expected[i].value, expected[i].pass, r)
+		}
+	}
+	if rs[0].Margin != -50 {
+		t.Fatalf("expected margin -50, got %v", rs[0].Margin)
+	}
+}
+
+func Test_validateSLO(t *testing.T) {
+	tests := []struct {
+		slo   dbtesterpb.ConfigSLO
+		valid bool
+	}{
+		{dbtesterpb.ConfigSLO{Metric: "p99", Max: 50}, true},
+		{dbtesterpb.ConfigSLO{Metric: "availability", Min: 99.9}, true},
+		{dbtesterpb.ConfigSLO{Metric: "p98", Max: 50}, false},
+		{dbtesterpb.ConfigSLO{Metric: "p99"}, false},
+		{dbtesterpb.ConfigSLO{Metric: "p99", Max: 50, Min: 1}, false},
+	}
+	for i, tt := range tests {
+		if err := validateSLO(&tt.slo); (err == nil) != tt.valid {
+			t.Fatalf("#%d: expected valid %v, got %v", i, tt.valid, err)
+		}
+	}
+}
@@ -23,13 +23,13 @@ import (
 	"time"
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/benchrunner"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/pkg/report"
 	consulapi "github.com/hashicorp/consul/api"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
-	"golang.org/x/time/rate"
 )
 
 type values struct {
@@ -39,40 +39,207 @@ type values struct {
 }
 
 func newValues(gcfg dbtesterpb.ConfigClientMachineAgentControl) (v values, rerr error) {
-	v.bytes = [][]byte{randBytes(gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)}
+	switch gcfg.ConfigClientMachineBenchmarkOptions.ValueEncoding {
+	case "json", "protobuf":
+		return newStructuredValues(gcfg.ConfigClientMachineBenchmarkOptions), nil
+	}
+	v.bytes = [][]byte{benchrunner.RandomBytes(gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)}
 	v.strings = []string{string(v.bytes[0])}
 	v.sampleSize = 1
 	return
 }
 
-// Stress stresses the database.
-func (cfg *Config) Stress(databaseID string) error {
+// stressContext returns the context of the running 'Stress'.
+func (cfg *Config) stressContext() context.Context {
+	if cfg.stressCtx == nil {
+		return context.Background()
+	}
+	return cfg.stressCtx
+}
+
+// Stress stresses the database. Benchmarks and agent requests
+// are aborted when the context is done, keeping partial results.
+func (cfg *Config) Stress(ctx context.Context, databaseID string) error {
 	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 	if !ok {
 		return fmt.Errorf("%q does not exist", databaseID)
 	}
+	cfg.stressCtx = ctx
+	defer func() { cfg.stressCtx = nil }()
+
+	if cfg.phases == nil {
+		cfg.phases = &phaseLog{}
+	}
+	cfg.phases.begin(PhaseWarmup)
+	cfg.clientWrites, cfg.clientReads = &byteCounter{}, &byteCounter{}
+	cfg.opStats = nil
+	defer func() {
+		cfg.phases.end()
+		if err := cfg.savePhases(); err != nil {
+			cfg.lg.Warn("failed to save phases", zap.Error(err))
+		}
+	}()
+
+	ramp = newDialRamp(gcfg.ConfigClientMachineBenchmarkOptions)
+
+	ics, err := newClientInterceptors(gcfg)
+	if err != nil {
+		return err
+	}
+	etcdv3DialOptions = clientInterceptorDialOptions(ics)
+
+	// track roles of the members, before endpoints
+	// are rewritten to proxies or client agents
+	if lt := startLeaderTracker(cfg.lg, gcfg); lt != nil {
+		defer func(gcfg dbtesterpb.ConfigClientMachineAgentControl) {
+			samples := lt.stop()
+			if len(samples) > 0 {
+				cfg.memberRoles = samples[len(samples)-1].roles
+			}
+			if err := cfg.saveLeaderTimeseries(gcfg, samples); err != nil {
+				cfg.lg.Warn("failed to save leader timeseries", zap.Error(err))
+			}
+		}(gcfg)
+	}
+
+	// route Consul requests through client agents on loader machines
+	if gcfg.Flag_Consul_V1_0_2 != nil && len(gcfg.Flag_Consul_V1_0_2.ClientAgentIPs) > 0 {
+		gcfg.DatabaseEndpoints = make([]string, len(gcfg.Flag_Consul_V1_0_2.ClientAgentIPs))
+		for i, ip := range gcfg.Flag_Consul_V1_0_2.ClientAgentIPs {
+			gcfg.DatabaseEndpoints[i] = fmt.Sprintf("%s:%d", ip, gcfg.DatabasePortToConnect+cfg.ConfigClientMachineInitial.PortOffset)
+		}
+		cfg.lg.Info("sending requests to Consul client agents", zap.Strings("endpoints", gcfg.DatabaseEndpoints))
+	}
+
+	// send requests to the first Consul datacenter, which
+	// forwards them to the target datacenter over WAN
+	if gcfg.Flag_Consul_V1_0_2 != nil && len(gcfg.Flag_Consul_V1_0_2.ClientAgentIPs) == 0 && len(gcfg.Flag_Consul_V1_0_2.DatacenterSizes) > 1 {
+		gcfg.DatabaseEndpoints = gcfg.DatabaseEndpoints[:gcfg.Flag_Consul_V1_0_2.DatacenterSizes[0]]
+		cfg.lg.Info("sending requests to first Consul datacenter",
+			zap.Strings("endpoints", gcfg.DatabaseEndpoints),
+			zap.String("target-datacenter", gcfg.Flag_Consul_V1_0_2.TargetDatacenter),
+		)
+	}
+
+	// learner does not serve client requests until promoted
+	resetBenchConnsEtcdv3()
+	if learner, promoteSeconds := etcdLearnerOptions(gcfg); learner && len(gcfg.DatabaseEndpoints) > 1 {
+		learnerEp := gcfg.DatabaseEndpoints[len(gcfg.DatabaseEndpoints)-1]
+		gcfg.DatabaseEndpoints = gcfg.DatabaseEndpoints[:len(gcfg.DatabaseEndpoints)-1]
+		if promoteSeconds > 0 {
+			// clients through proxies reach the promoted member already
+			addBack := len(etcdGRPCProxyIPs(gcfg)) == 0
+			ctx, cancel := context.WithCancel(cfg.stressContext())
+			donec := make(chan struct{})
+			go func(ep string) {
+				defer close(donec)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(time.Duration(promoteSeconds) * time.Second):
+				}
+				cfg.lg.Info("promoting etcd learner", zap.Int64("after-seconds", promoteSeconds))
+				if err := promoteLearnerEtcdv3(ctx, cfg.lg, ep); err != nil {
+					cfg.lg.Warn("failed to promote etcd learner", zap.Error(err))
+					return
+				}
+				if addBack {
+					addBenchEndpointEtcdv3(learnerEp)
+					cfg.lg.Info("sending requests to promoted etcd learner", zap.String("endpoint", learnerEp))
+				}
+			}(gcfg.DatabaseEndpoints[0])
+			defer func() {
+				cancel()
+				<-donec
+			}()
+		}
+	}
+
+	// route etcd requests through gRPC proxies
+	if proxyIPs := etcdGRPCProxyIPs(gcfg); len(proxyIPs) > 0 {
+		gcfg.DatabaseEndpoints = make([]string, len(proxyIPs))
+		for i, ip := range proxyIPs {
+			gcfg.DatabaseEndpoints[i] = fmt.Sprintf("%s:%d", ip, gcfg.DatabasePortToConnect+cfg.ConfigClientMachineInitial.PortOffset)
+		}
+		cfg.lg.Info("sending requests to etcd gRPC proxies", zap.Strings("endpoints", gcfg.DatabaseEndpoints))
+	}
+
+	// reconfigure cluster during the benchmark, while clients
+	// only connect to the members that are never removed
+	if nums := gcfg.ConfigClientMachineBenchmarkOptions.ScalingMemberNumbers; len(nums) > 0 {
+		minN := nums[0]
+		for _, n := range nums {
+			if minN > n {
+				minN = n
+			}
+		}
+		stopc, donec := make(chan struct{}), make(chan []membershipChange, 1)
+		go func(gcfg dbtesterpb.ConfigClientMachineAgentControl) {
+			donec <- cfg.scaleMembers(gcfg, stopc)
+		}(gcfg)
+		defer func() {
+			close(stopc)
+			changes := <-donec
+			cfg.lg.Info("finished scaling members", zap.Int("changes", len(changes)))
+			if err := cfg.saveMembershipChanges(changes); err != nil {
+				cfg.lg.Warn("failed to save membership changes", zap.Error(err))
+			}
+		}()
+		gcfg.DatabaseEndpoints = gcfg.DatabaseEndpoints[:minN]
+	}
+
+	// restart members one by one with the new etcd binary,
+	// while clients keep sending requests to the mixed-version cluster
+	if gcfg.ConfigClientMachineBenchmarkOptions.UpgradeEtcdGitRef != "" {
+		stopc, donec := make(chan struct{}), make(chan []memberUpgrade, 1)
+		go func(gcfg dbtesterpb.ConfigClientMachineAgentControl) {
+			donec <- cfg.upgradeMembers(gcfg, stopc)
+		}(gcfg)
+		defer func() {
+			close(stopc)
+			upgrades := <-donec
+			cfg.lg.Info("finished upgrading members", zap.Int("upgrades", len(upgrades)))
+			if err := cfg.saveMemberUpgrades(upgrades); err != nil {
+				cfg.lg.Warn("failed to save member upgrades", zap.Error(err))
+			}
+		}()
+	}
 
 	vals, err := newValues(gcfg)
 	if err != nil {
 		return err
 	}
+	cfg.valueCodec = newValueCodec(gcfg.ConfigClientMachineBenchmarkOptions.ValueCompression)
 
 	switch gcfg.ConfigClientMachineBenchmarkOptions.Type {
 	case "write":
 		cfg.lg.Info("write generateReport is started...")
+		if cfg.compactor = startCompactor(cfg.lg, gcfg); cfg.compactor != nil {
+			defer func() {
+				cfg.compactor.stop()
+				cfg.compactor = nil
+			}()
+		}
 
 		// fixed number of client numbers
 		if len(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers) == 0 {
 			h, done := newWriteHandlers(cfg.lg, gcfg)
-			reqGen := func(inflightReqs chan<- request) { generateWrites(gcfg, 0, vals, inflightReqs) }
-			cfg.generateReport(gcfg, h, done, reqGen)
+			h = cfg.valueCodec.wrapWrites(h)
+			reqGen := func(inflightReqs chan<- Request) { generateWrites(gcfg, 0, vals, inflightReqs) }
+			if err = cfg.generateReport(gcfg, h, done, reqGen); err != nil {
+				return err
+			}
 
 		} else {
 			// variable client numbers
 			rs := assignRequest(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers, gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber)
 
 			var stats []report.Stats
+			var correctedLats []float64
+			concurrency := make(map[int64]concurrencySample)
+			heatmap := make(map[int64][]int64)
 			reqCompleted := int64(0)
+			var abortErr error
 			for i := 0; i < len(rs); i++ {
 				copied := gcfg
 				copied.ConfigClientMachineBenchmarkOptions.ConnectionNumber = gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers[i]
@@ -83,14 +250,25 @@ func (cfg *Config) Stress(databaseID string) error {
 
 				go func() {
 					cfg.lg.Sugar().Infof("signaling agent with client number %d", copied.ConfigClientMachineBenchmarkOptions.ClientNumber)
-					if _, err := (&ncfg).BroadcaseRequest(databaseID, dbtesterpb.Operation_Heartbeat); err != nil {
+					if _, err := (&ncfg).BroadcaseRequest(cfg.stressContext(), databaseID, dbtesterpb.Operation_Heartbeat); err != nil {
 						panic(err)
 					}
 				}()
 
 				h, done := newWriteHandlers(cfg.lg, copied)
-				reqGen := func(inflightReqs chan<- request) { generateWrites(copied, reqCompleted, vals, inflightReqs) }
+				h = cfg.valueCodec.wrapWrites(h)
+				reqGen := func(inflightReqs chan<- Request) { generateWrites(copied, reqCompleted, vals, inflightReqs) }
 				b := newBenchmark(copied.ConfigClientMachineBenchmarkOptions.RequestNumber, copied.ConfigClientMachineBenchmarkOptions.ClientNumber, h, done, reqGen)
+				b.setErrorBudget(copied.ConfigClientMachineBenchmarkOptions)
+				b.setTracer(cfg.lg, copied)
+				b.setClientDelays(copied.ConfigClientMachineBenchmarkOptions)
+				b.setPriorities(copied.ConfigClientMachineBenchmarkOptions)
+				b.pauser = cfg.pauser
+				b.ctx = cfg.stressCtx
+				b.recorder = cfg.recorder
+				b.phases = cfg.phases
+				b.clientWrites = cfg.clientWrites
+				b.clientReads = cfg.clientReads
 
 				// wait until rs[i] requests are finished
 				// do not end reports yet
@@ -104,6 +282,13 @@ func (cfg *Config) Stress(databaseID string) error {
 
 				reqCompleted += rs[i]
 				stats = append(stats, b.stats)
+				correctedLats = append(correctedLats, b.correctedStats.Lats...)
+				mergeConcurrency(concurrency, b.concurrency)
+				mergeHeatmap(heatmap, b.heatmap)
+				if b.abortErr != nil {
+					abortErr = b.abortErr
+					break
+				}
 			}
 			cfg.lg.Info("combining all reports")
 
@@ -165,31 +350,36 @@ func (cfg *Config) Stress(databaseID string) error {
 				combined.Slowest = combined.Lats[len(combined.Lats)-1]
 			}
 
+			corrected := report.Stats{Lats: correctedLats}
+			sort.Float64s(corrected.Lats)
+			for _, lat := range corrected.Lats {
+				corrected.AvgTotal += lat
+			}
+			if len(corrected.Lats) > 0 {
+				corrected.Average = corrected.AvgTotal / float64(len(corrected.Lats))
+				corrected.Fastest = corrected.Lats[0]
+				corrected.Slowest = corrected.Lats[len(corrected.Lats)-1]
+			}
+
 			cfg.lg.Info("combined all reports")
 			printStats(combined)
-			cfg.saveAllStats(gcfg, combined, combinedClientNumber)
+			cfg.saveAllStats(gcfg, combined, corrected, combinedClientNumber, concurrency, heatmap)
+			if abortErr != nil {
+				return abortErr
+			}
 		}
 
 		cfg.lg.Info("write generateReport is finished...")
 
-		cfg.lg.Info("checking total keys on", zap.Strings("endpoints", gcfg.DatabaseEndpoints))
-		var totalKeysFunc func(*zap.Logger, []string) map[string]int64
-		switch gcfg.DatabaseID {
-		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			totalKeysFunc = getTotalKeysEtcdv3
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			totalKeysFunc = getTotalKeysZk
-		case "consul__v1_0_2", "cetcd__beta":
-			totalKeysFunc = getTotalKeysConsul
-		default:
-			cfg.lg.Fatal("unknown database ID", zap.String("database", gcfg.DatabaseID))
-		}
-		for k, v := range totalKeysFunc(cfg.lg, gcfg.DatabaseEndpoints) {
-			cfg.lg.Sugar().Infof("expected write total results [expected_total: %d | database: %q | endpoint: %q | number_of_keys: %d]",
-				gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.DatabaseID, k, v)
+		if err = cfg.checkTotalKeys(gcfg); err != nil {
+			return err
 		}
 
 	case "read":
+		// the key is written compressed, to decompress on reads
+		if vals, err = cfg.valueCodec.compressValues(vals); err != nil {
+			return err
+		}
 		key, value := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes), vals.strings[0]
 
 		switch gcfg.DatabaseID {
@@ -254,9 +444,15 @@ func (cfg *Config) Stress(databaseID string) error {
 			panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
 		}
 
+		if err = cfg.dropPageCaches(gcfg); err != nil {
+			return err
+		}
 		h, done := newReadHandlers(gcfg)
-		reqGen := func(inflightReqs chan<- request) { generateReads(gcfg, key, inflightReqs) }
-		cfg.generateReport(gcfg, h, done, reqGen)
+		h = cfg.valueCodec.wrapReads(h)
+		reqGen := func(inflightReqs chan<- Request) { generateReads(gcfg, key, inflightReqs) }
+		if err = cfg.generateReport(gcfg, h, done, reqGen); err != nil {
+			return err
+		}
 		cfg.lg.Info("read generateReport is finished...")
 
 	case "read-oneshot":
@@ -289,10 +485,154 @@ func (cfg *Config) Stress(databaseID string) error {
 			os.Exit(1)
 		}
 
+		if err = cfg.dropPageCaches(gcfg); err != nil {
+			return err
+		}
 		h := newReadOneshotHandlers(cfg.lg, gcfg)
-		reqGen := func(inflightReqs chan<- request) { generateReads(gcfg, key, inflightReqs) }
-		cfg.generateReport(gcfg, h, nil, reqGen)
+		reqGen := func(inflightReqs chan<- Request) { generateReads(gcfg, key, inflightReqs) }
+		if err = cfg.generateReport(gcfg, h, nil, reqGen); err != nil {
+			return err
+		}
 		cfg.lg.Info("read-oneshot generateReport is finished...")
+
+	case "read-consistency":
+		key, value := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes), vals.strings[0]
+		cfg.lg.Sugar().Infof("writing key for read-consistency [key: %q | database: %q]", key, gcfg.DatabaseID)
+		clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+			totalConns:   1,
+			totalClients: 1,
+		})
+		_, err = clients[0].Do(context.Background(), clientv3.OpPut(key, value))
+		clients[0].Close()
+		if err != nil {
+			return fmt.Errorf("write error on read-consistency (%v)", err)
+		}
+
+		h, done, rec := newReadConsistencyHandlers(gcfg)
+		reqGen := func(inflightReqs chan<- Request) { generateReadConsistency(gcfg, key, inflightReqs) }
+		err = cfg.generateReport(gcfg, h, done, reqGen)
+		if serr := cfg.saveReadConsistency(rec); serr != nil {
+			return serr
+		}
+		if err != nil {
+			return err
+		}
+		cfg.lg.Info("read-consistency generateReport is finished...")
+
+	case "watch":
+		cfg.lg.Info("watch is started...")
+		if err = cfg.benchmarkWatch(gcfg, vals); err != nil {
+			return err
+		}
+		cfg.lg.Info("watch is finished...")
+
+	case "watch-compaction":
+		cfg.lg.Info("watch-compaction is started...")
+		if err = cfg.benchmarkWatchCompaction(gcfg, vals); err != nil {
+			return err
+		}
+		cfg.lg.Info("watch-compaction is finished...")
+
+	case "auth":
+		cfg.lg.Info("auth is started...")
+		if err = cfg.benchmarkAuth(gcfg, vals); err != nil {
+			return err
+		}
+		cfg.lg.Info("auth is finished...")
+
+	case "read-your-writes":
+		cfg.lg.Info("read-your-writes generateReport is started...")
+		h, done, rec := newReadYourWritesHandlers(gcfg)
+		reqGen := func(inflightReqs chan<- Request) { generateWrites(gcfg, 0, vals, inflightReqs) }
+		err = cfg.generateReport(gcfg, h, done, reqGen)
+		if serr := cfg.saveReadYourWrites(rec); serr != nil {
+			return serr
+		}
+		if err != nil {
+			return err
+		}
+		cfg.lg.Info("read-your-writes generateReport is finished...")
+
+	case "delete-range":
+		cfg.lg.Info("delete-range is started...")
+		if err = cfg.benchmarkDeleteRange(gcfg, vals); err != nil {
+			return err
+		}
+		cfg.lg.Info("delete-range is finished...")
+
+	case "tenancy":
+		cfg.lg.Info("tenancy is started...")
+		if err = cfg.benchmarkTenancy(gcfg, vals); err != nil {
+			return err
+		}
+		cfg.lg.Info("tenancy is finished...")
+
+	case "list":
+		cfg.lg.Info("list is started...")
+		if err = cfg.listKeyspace(gcfg, vals); err != nil {
+			return err
+		}
+		cfg.lg.Info("list is finished...")
+
+	case "consul-catalog":
+		cfg.lg.Info("consul-catalog is started...")
+		if err = cfg.benchmarkConsulCatalog(gcfg, vals); err != nil {
+			return err
+		}
+		cfg.lg.Info("consul-catalog is finished...")
+
+	case "kubernetes-apiserver":
+		cfg.lg.Info("kubernetes-apiserver is started...")
+		if err = cfg.benchmarkKubernetes(gcfg, vals); err != nil {
+			return err
+		}
+		cfg.lg.Info("kubernetes-apiserver is finished...")
+
+	case "backup-restore":
+		cfg.lg.Info("backup-restore is started...")
+		if err := cfg.backupRestore(gcfg, vals); err != nil {
+			return err
+		}
+		cfg.lg.Info("backup-restore is finished...")
+
+	case "snapshot-transfer":
+		cfg.lg.Info("snapshot-transfer is started...")
+		if err := cfg.snapshotTransfer(gcfg, vals); err != nil {
+			return err
+		}
+		cfg.lg.Info("snapshot-transfer is finished...")
+
+	case "trace":
+		recs, err := readTraceFile(gcfg.ConfigClientMachineBenchmarkOptions.TraceFile)
+		if err != nil {
+			return err
+		}
+		if n := gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; n > 0 && n < int64(len(recs)) {
+			recs = recs[:n]
+		}
+		gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber = int64(len(recs))
+		cfg.lg.Info("trace generateReport is started...", zap.String("trace-file", gcfg.ConfigClientMachineBenchmarkOptions.TraceFile), zap.Int("operations", len(recs)))
+
+		h, done := newCustomHandlers(gcfg)
+		reqGen := func(inflightReqs chan<- Request) { generateTrace(gcfg, recs, inflightReqs) }
+		if err = cfg.generateReport(gcfg, h, done, reqGen); err != nil {
+			return err
+		}
+		cfg.lg.Info("trace generateReport is finished...")
+
+	case "custom":
+		wl, err := newWorkload(gcfg)
+		if err != nil {
+			return err
+		}
+		cfg.lg.Info("custom generateReport is started...", zap.String("workload", gcfg.ConfigClientMachineBenchmarkOptions.WorkloadName))
+
+		h, done := newCustomHandlers(gcfg)
+		reqGen := func(inflightReqs chan<- Request) { generateCustom(gcfg, wl, inflightReqs) }
+		if err = cfg.generateReport(gcfg, h, done, reqGen); err != nil {
+			return err
+		}
+		cfg.lg.Info("custom generateReport is finished...")
 	}
 
 	return nil
@@ -302,9 +642,11 @@ func newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []Req
 	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
 	switch gcfg.DatabaseID {
 	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-		clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
-			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
-			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+		clients := mustCreateClientsEtcdv3(zoneEndpoints(gcfg, gcfg.DatabaseEndpoints), etcdv3ClientCfg{
+			totalConns:      gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+			totalClients:    gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+			compression:     gcfg.ConfigClientMachineBenchmarkOptions.EtcdCompression,
+			endpointPerConn: gcfg.ClientZone != "",
 		})
 		for i := range clients {
 			rhs[i] = newGetEtcd3(clients[i].KV)
@@ -316,7 +658,7 @@ func newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []Req
 		}
 
 	case "zookeeper__r3_5_3_beta", "zetcd__beta":
-		conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		conns := mustCreateConnsZk(zoneEndpoints(gcfg, zkReadEndpoints(gcfg)), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
 		for i := range conns {
 			rhs[i] = newGetZK(conns[i])
 		}
@@ -327,7 +669,7 @@ func newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []Req
 		}
 
 	case "consul__v1_0_2", "cetcd__beta":
-		conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		conns := mustCreateConnsConsul(zoneEndpoints(gcfg, gcfg.DatabaseEndpoints), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
 		for i := range conns {
 			rhs[i] = newGetConsul(conns[i])
 		}
@@ -345,6 +687,7 @@ func newWriteHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentCo
 		etcdClients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
 			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
 			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+			compression:  gcfg.ConfigClientMachineBenchmarkOptions.EtcdCompression,
 		})
 		for i := range etcdClients {
 			rhs[i] = newPutEtcd3(etcdClients[i])
@@ -362,7 +705,7 @@ func newWriteHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentCo
 	case "zookeeper__r3_5_3_beta", "zetcd__beta":
 		if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
 			key := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
-			valueBts := randBytes(gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)
+			valueBts := benchrunner.RandomBytes(gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)
 			lg.Sugar().Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
 			var err error
 			for i := 0; i < 7; i++ {
@@ -383,6 +726,12 @@ func newWriteHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentCo
 			}
 		}
 
+		if !gcfg.ConfigClientMachineBenchmarkOptions.SameKey && (gcfg.ConfigClientMachineBenchmarkOptions.KeyPathDepth > 0 || gcfg.ConfigClientMachineBenchmarkOptions.KeyPrefix != "") {
+			if err := createKeyDirsZk(lg, gcfg); err != nil {
+				lg.Sugar().Fatalf("failed to create parent znodes (%v)", err)
+			}
+		}
+
 		conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
 		for i := range conns {
 			if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
@@ -422,10 +771,11 @@ func newReadOneshotHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineA
 	switch gcfg.DatabaseID {
 	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
 		for i := range rhs {
-			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
-					totalConns:   1,
-					totalClients: 1,
+			rhs[i] = func(ctx context.Context, req *Request) error {
+				conns := mustCreateClientsEtcdv3(zoneEndpoints(gcfg, gcfg.DatabaseEndpoints), etcdv3ClientCfg{
+					totalConns:      1,
+					totalClients:    1,
+					endpointPerConn: gcfg.ClientZone != "",
 				})
 				defer conns[0].Close()
 				return newGetEtcd3(conns[0])(ctx, req)
@@ -434,8 +784,8 @@ func newReadOneshotHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineA
 
 	case "zookeeper__r3_5_3_beta", "zetcd__beta":
 		for i := range rhs {
-			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+			rhs[i] = func(ctx context.Context, req *Request) error {
+				conns := mustCreateConnsZk(zoneEndpoints(gcfg, zkReadEndpoints(gcfg)), gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
 				defer conns[0].Close()
 				return newGetZK(conns[0])(ctx, req)
 			}
@@ -443,8 +793,8 @@ func newReadOneshotHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineA
 
 	case "consul__v1_0_2", "cetcd__beta":
 		for i := range rhs {
-			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, 1)
+			rhs[i] = func(ctx context.Context, req *Request) error {
+				conns := mustCreateConnsConsul(zoneEndpoints(gcfg, gcfg.DatabaseEndpoints), 1)
 				return newGetConsul(conns[0])(ctx, req)
 			}
 		}
@@ -454,21 +804,13 @@ func newReadOneshotHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineA
 	return rhs
 }
 
-func generateReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, inflightReqs chan<- request) {
+func generateReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, inflightReqs chan<- Request) {
 	defer close(inflightReqs)
 
-	var rateLimiter *rate.Limiter
-	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
-		rateLimiter = rate.NewLimiter(
-			rate.Limit(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
-			int(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
-		)
-	}
+	pacer := benchrunner.NewPacer(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond)
 
 	for i := int64(0); i < gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; i++ {
-		if rateLimiter != nil {
-			rateLimiter.Wait(context.TODO())
-		}
+		intended := pacer.Wait(context.TODO(), i)
 
 		switch gcfg.DatabaseID {
 		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
@@ -476,44 +818,38 @@ func generateReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string,
 			if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
 				opts = append(opts, clientv3.WithSerializable())
 			}
-			inflightReqs <- request{etcdv3Op: clientv3.OpGet(key, opts...)}
+			inflightReqs <- Request{etcdv3Op: clientv3.OpGet(key, opts...), intendedStart: intended}
 
 		case "zookeeper__r3_5_3_beta", "zetcd__beta":
 			op := zkOp{key: key}
 			if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
 				op.staleRead = true
 			}
-			inflightReqs <- request{zkOp: op}
+			inflightReqs <- Request{zkOp: op, intendedStart: intended}
 
 		case "consul__v1_0_2", "cetcd__beta":
-			op := consulOp{key: key}
+			op := consulOp{key: key, datacenter: consulTargetDatacenter(gcfg)}
 			if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
 				op.staleRead = true
 			}
-			inflightReqs <- request{consulOp: op}
+			inflightReqs <- Request{consulOp: op, intendedStart: intended}
 		default:
 			panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
 		}
 	}
 }
 
-func generateWrites(gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx int64, vals values, inflightReqs chan<- request) {
-	var rateLimiter *rate.Limiter
-	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
-		rateLimiter = rate.NewLimiter(
-			rate.Limit(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
-			int(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
-		)
-	}
-
+func generateWrites(gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx int64, vals values, inflightReqs chan<- Request) {
 	var wg sync.WaitGroup
 	defer func() {
 		close(inflightReqs)
 		wg.Wait()
 	}()
 
+	pacer := benchrunner.NewPacer(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond)
+
 	for i := int64(0); i < gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; i++ {
-		k := sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, i+startIdx)
+		k := benchmarkKey(gcfg.ConfigClientMachineBenchmarkOptions, i+startIdx)
 		if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
 			k = sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
 		}
@@ -521,19 +857,17 @@ func generateWrites(gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx in
 		v := vals.bytes[i%int64(vals.sampleSize)]
 		vs := vals.strings[i%int64(vals.sampleSize)]
 
-		if rateLimiter != nil {
-			rateLimiter.Wait(context.TODO())
-		}
+		intended := pacer.Wait(context.TODO(), i)
 
 		switch gcfg.DatabaseID {
 		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			inflightReqs <- request{etcdv3Op: clientv3.OpPut(k, vs)}
+			inflightReqs <- Request{etcdv3Op: clientv3.OpPut(k, vs), intendedStart: intended}
 
 		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			inflightReqs <- request{zkOp: zkOp{key: "/" + k, value: v}}
+			inflightReqs <- Request{zkOp: zkOp{key: "/" + k, value: v}, intendedStart: intended}
 
 		case "consul__v1_0_2", "cetcd__beta":
-			inflightReqs <- request{consulOp: consulOp{key: k, value: v}}
+			inflightReqs <- Request{consulOp: consulOp{key: k, value: v, datacenter: consulTargetDatacenter(gcfg)}, intendedStart: intended}
 
 		default:
 			panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
@@ -0,0 +1,325 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	"github.com/coreos/etcd/pkg/report"
+	"github.com/gyuho/dataframe"
+	consulapi "github.com/hashicorp/consul/api"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+const (
+	// authUser is the etcd user, and the Consul token name of clients,
+	// authorized to read and write all keys.
+	authUser     = "dbtester"
+	authPassword = "dbtester"
+	// authRootPassword is the password of etcd 'root' user,
+	// required to enable and disable authentication.
+	authRootPassword = "dbtester-root"
+)
+
+// authCredentials authenticates benchmark clients,
+// empty for anonymous clients.
+type authCredentials struct {
+	username string
+	password string
+	token    string
+}
+
+// authRecorder records latencies of anonymous and authenticated
+// writes, and the duration of each phase.
+type authRecorder struct {
+	mu sync.Mutex
+	// latencies in seconds
+	anonymous     []float64
+	authenticated []float64
+
+	anonymousErrs     int64
+	authenticatedErrs int64
+
+	anonymousTook     time.Duration
+	authenticatedTook time.Duration
+}
+
+func (r *authRecorder) observe(authenticated bool, took time.Duration, err error) {
+	r.mu.Lock()
+	switch {
+	case authenticated && err != nil:
+		r.authenticatedErrs++
+	case authenticated:
+		r.authenticated = append(r.authenticated, took.Seconds())
+	case err != nil:
+		r.anonymousErrs++
+	default:
+		r.anonymous = append(r.anonymous, took.Seconds())
+	}
+	r.mu.Unlock()
+}
+
+// benchmarkAuth writes 'request_number' keys with anonymous clients,
+// and then enables etcd authentication (or creates a Consul ACL token)
+// to write another 'request_number' keys with authenticated clients.
+// Only the authenticated writes are reported, and both are compared
+// in 'client_auth_penalty_path'. Phases run in order, since etcd
+// rejects anonymous requests once authentication is enabled.
+func (cfg *Config) benchmarkAuth(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	rec := &authRecorder{}
+	n := gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber
+
+	cfg.lg.Info("writing keys with anonymous clients", zap.Int64("keys", n))
+	h, done := newAuthWriteHandlers(gcfg, authCredentials{}, rec)
+	reqGen := func(inflightReqs chan<- Request) { generateWrites(gcfg, 0, vals, inflightReqs) }
+	b := newBenchmark(n, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber, h, done, reqGen)
+	b.setErrorBudget(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.setTracer(cfg.lg, gcfg)
+	b.setClientDelays(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.setPriorities(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.pauser = cfg.pauser
+	b.ctx = cfg.stressCtx
+	b.recorder = cfg.recorder
+	b.phases = cfg.phases
+	b.clientWrites = cfg.clientWrites
+	b.clientReads = cfg.clientReads
+	now := time.Now()
+	b.startRequests()
+	b.waitAll()
+	rec.anonymousTook = time.Since(now)
+	if b.abortErr != nil {
+		return b.abortErr
+	}
+
+	creds, disable, err := enableAuth(gcfg)
+	if err != nil {
+		return fmt.Errorf("failed to enable auth (%v)", err)
+	}
+	defer func() {
+		if derr := disable(); derr != nil {
+			cfg.lg.Warn("failed to disable auth", zap.Error(derr))
+		}
+	}()
+
+	cfg.lg.Info("writing keys with authenticated clients", zap.Int64("keys", n))
+	h, done = newAuthWriteHandlers(gcfg, creds, rec)
+	reqGen = func(inflightReqs chan<- Request) { generateWrites(gcfg, n, vals, inflightReqs) }
+	now = time.Now()
+	err = cfg.generateReport(gcfg, h, done, reqGen)
+	rec.authenticatedTook = time.Since(now)
+	if serr := cfg.saveAuthPenalty(rec); serr != nil {
+		return serr
+	}
+	return err
+}
+
+// newAuthWriteHandlers creates write handlers with the credentials,
+// that record latencies by whether the client is authenticated.
+func newAuthWriteHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl, creds authCredentials, rec *authRecorder) (rhs []ReqHandler, done func()) {
+	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	switch gcfg.DatabaseID {
+	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+			compression:  gcfg.ConfigClientMachineBenchmarkOptions.EtcdCompression,
+			username:     creds.username,
+			password:     creds.password,
+		})
+		for i := range clients {
+			rhs[i] = newPutEtcd3(clients[i])
+		}
+		done = func() {
+			for i := range clients {
+				clients[i].Close()
+			}
+		}
+
+	case "consul__v1_0_2":
+		conns := mustCreateConnsConsulToken(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber, creds.token)
+		for i := range rhs {
+			rhs[i] = newPutConsul(conns[i%len(conns)])
+		}
+
+	default:
+		panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
+	}
+
+	authenticated := creds != authCredentials{}
+	for i := range rhs {
+		h := rhs[i]
+		rhs[i] = func(ctx context.Context, req *Request) error {
+			now := time.Now()
+			err := h(ctx, req)
+			rec.observe(authenticated, time.Since(now), err)
+			return err
+		}
+	}
+	return rhs, done
+}
+
+// enableAuth enables etcd authentication with a user that can read and
+// write all keys, or creates a Consul ACL token that can write all keys.
+// It returns the credentials of clients, and the function to disable it.
+func enableAuth(gcfg dbtesterpb.ConfigClientMachineAgentControl) (creds authCredentials, disable func() error, err error) {
+	switch gcfg.DatabaseID {
+	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		return enableAuthEtcdv3(gcfg.DatabaseEndpoints)
+	case "consul__v1_0_2":
+		return createTokenConsul(gcfg.DatabaseEndpoints[0], gcfg.Flag_Consul_V1_0_2.ACLMasterToken)
+	}
+	return creds, nil, fmt.Errorf("%q does not support auth", gcfg.DatabaseID)
+}
+
+func enableAuthEtcdv3(endpoints []string) (creds authCredentials, disable func() error, err error) {
+	cli := mustCreateConnEtcdv3(endpoints)
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// users and roles remain from previous repeats
+	// of the benchmark, only authentication is disabled
+	ignoreExist := func(err error) error {
+		if err == rpctypes.ErrUserAlreadyExist || err == rpctypes.ErrRoleAlreadyExist {
+			return nil
+		}
+		return err
+	}
+	steps := []func() error{
+		func() error { _, err := cli.RoleAdd(ctx, "root"); return ignoreExist(err) },
+		func() error { _, err := cli.UserAdd(ctx, "root", authRootPassword); return ignoreExist(err) },
+		func() error { _, err := cli.UserGrantRole(ctx, "root", "root"); return err },
+		func() error { _, err := cli.RoleAdd(ctx, authUser); return ignoreExist(err) },
+		func() error {
+			_, err := cli.RoleGrantPermission(ctx, authUser, "\x00", "\x00", clientv3.PermissionType(clientv3.PermReadWrite))
+			return err
+		},
+		func() error { _, err := cli.UserAdd(ctx, authUser, authPassword); return ignoreExist(err) },
+		func() error { _, err := cli.UserGrantRole(ctx, authUser, authUser); return err },
+		func() error { _, err := cli.AuthEnable(ctx); return err },
+	}
+	for _, step := range steps {
+		if err = step(); err != nil {
+			return creds, nil, err
+		}
+	}
+
+	disable = func() error {
+		root := mustCreateAuthConnEtcdv3(endpoints, "root", authRootPassword)
+		defer root.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		_, err := root.AuthDisable(ctx)
+		cancel()
+		return err
+	}
+	return authCredentials{username: authUser, password: authPassword}, disable, nil
+}
+
+func createTokenConsul(endpoint, masterToken string) (creds authCredentials, disable func() error, err error) {
+	dcfg := consulapi.DefaultConfig()
+	dcfg.Address = endpoint
+	dcfg.Token = masterToken
+	cli, err := consulapi.NewClient(dcfg)
+	if err != nil {
+		return creds, nil, err
+	}
+
+	id, _, err := cli.ACL().Create(&consulapi.ACLEntry{
+		Name:  authUser,
+		Type:  consulapi.ACLClientType,
+		Rules: `key "" { policy = "write" }`,
+	}, nil)
+	if err != nil {
+		return creds, nil, err
+	}
+
+	disable = func() error {
+		_, err := cli.ACL().Destroy(id, nil)
+		return err
+	}
+	return authCredentials{token: id}, disable, nil
+}
+
+// saveAuthPenalty saves anonymous and authenticated write latency
+// percentiles and throughput side by side, with the penalty of auth.
+func (cfg *Config) saveAuthPenalty(rec *authRecorder) error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	cfg.lg.Sugar().Infof("auth results [anonymous: %d (errors %d) | authenticated: %d (errors %d)]",
+		len(rec.anonymous), rec.anonymousErrs, len(rec.authenticated), rec.authenticatedErrs)
+
+	sort.Float64s(rec.anonymous)
+	sort.Float64s(rec.authenticated)
+	pctls, anons := report.Percentiles(rec.anonymous)
+	_, auths := report.Percentiles(rec.authenticated)
+
+	c1 := dataframe.NewColumn("METRIC")
+	c2 := dataframe.NewColumn("ANONYMOUS")
+	c3 := dataframe.NewColumn("AUTHENTICATED")
+	c4 := dataframe.NewColumn("PENALTY-PERCENT")
+	push := func(name string, anon, auth, penalty float64) {
+		c1.PushBack(dataframe.NewStringValue(name))
+		c2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", anon)))
+		c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", auth)))
+		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", penalty)))
+	}
+	pushLatency := func(name string, anon, auth float64) {
+		var penalty float64
+		if anon > 0 {
+			penalty = 100 * (auth - anon) / anon
+		}
+		push(name+"-latency-ms", 1000*anon, 1000*auth, penalty)
+	}
+	for i := range pctls {
+		pct := fmt.Sprintf("p%.1f", pctls[i])
+		if strings.HasSuffix(pct, ".0") {
+			pct = strings.Replace(pct, ".0", "", -1)
+		}
+		pushLatency(pct, anons[i], auths[i])
+	}
+	pushLatency("avg", average(rec.anonymous), average(rec.authenticated))
+
+	var anonRPS, authRPS, penalty float64
+	if rec.anonymousTook > 0 {
+		anonRPS = float64(len(rec.anonymous)) / rec.anonymousTook.Seconds()
+	}
+	if rec.authenticatedTook > 0 {
+		authRPS = float64(len(rec.authenticated)) / rec.authenticatedTook.Seconds()
+	}
+	if anonRPS > 0 {
+		penalty = 100 * (anonRPS - authRPS) / anonRPS
+	}
+	push("requests-per-second", anonRPS, authRPS, penalty)
+	push("errors", float64(rec.anonymousErrs), float64(rec.authenticatedErrs), 0)
+
+	fr := dataframe.New()
+	for _, col := range []dataframe.Column{c1, c2, c3, c4} {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientAuthPenaltyPath)
+}
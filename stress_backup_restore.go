@@ -0,0 +1,107 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+)
+
+// BackupRestoreSummaryColumns defines backup and restore summary columns.
+var BackupRestoreSummaryColumns = []string{
+	"KEY-NUMBER",
+	"BACKUP-SIZE",
+	"BACKUP-SIZE-BYTES-NUM",
+	"BACKUP-SECONDS",
+	"RESTORE-SECONDS",
+}
+
+// backupRestore writes keys up to each of 'backup_restore_key_numbers',
+// and measures backup and restore-to-usable time on the first member.
+func (cfg *Config) backupRestore(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	c1 := dataframe.NewColumn(BackupRestoreSummaryColumns[0])
+	c2 := dataframe.NewColumn(BackupRestoreSummaryColumns[1])
+	c3 := dataframe.NewColumn(BackupRestoreSummaryColumns[2])
+	c4 := dataframe.NewColumn(BackupRestoreSummaryColumns[3])
+	c5 := dataframe.NewColumn(BackupRestoreSummaryColumns[4])
+
+	written := int64(0)
+	for _, n := range gcfg.ConfigClientMachineBenchmarkOptions.BackupRestoreKeyNumbers {
+		if n > written {
+			copied := gcfg
+			copied.ConfigClientMachineBenchmarkOptions.RequestNumber = n - written
+			startIdx := written
+
+			cfg.lg.Info("writing keys before backup", zap.Int64("from", written), zap.Int64("to", n))
+			h, done := newWriteHandlers(cfg.lg, copied)
+			reqGen := func(inflightReqs chan<- Request) { generateWrites(copied, startIdx, vals, inflightReqs) }
+			b := newBenchmark(copied.ConfigClientMachineBenchmarkOptions.RequestNumber, copied.ConfigClientMachineBenchmarkOptions.ClientNumber, h, done, reqGen)
+			b.setErrorBudget(copied.ConfigClientMachineBenchmarkOptions)
+			b.setTracer(cfg.lg, copied)
+			b.setClientDelays(copied.ConfigClientMachineBenchmarkOptions)
+			b.setPriorities(copied.ConfigClientMachineBenchmarkOptions)
+			b.pauser = cfg.pauser
+			b.ctx = cfg.stressCtx
+			b.recorder = cfg.recorder
+			b.phases = cfg.phases
+			b.clientWrites = cfg.clientWrites
+			b.clientReads = cfg.clientReads
+			b.startRequests()
+			b.waitAll()
+			if b.abortErr != nil {
+				return b.abortErr
+			}
+			written = n
+		}
+
+		cfg.lg.Info("requesting backup", zap.Int64("key-number", written))
+		bresp, err := cfg.sendRequests(cfg.stressContext(), gcfg.DatabaseID, dbtesterpb.Operation_Backup, []int{0}, 0, false)
+		if err != nil {
+			return err
+		}
+		cfg.lg.Info("requesting restore", zap.Int64("key-number", written))
+		rresp, err := cfg.sendRequests(cfg.stressContext(), gcfg.DatabaseID, dbtesterpb.Operation_Restore, []int{0}, 0, false)
+		if err != nil {
+			return err
+		}
+
+		backupTook, restoreTook := time.Duration(bresp[0].TookNanoseconds), time.Duration(rresp[0].TookNanoseconds)
+		cfg.lg.Info("backup and restore done",
+			zap.Int64("key-number", written),
+			zap.String("backup-size", humanize.Bytes(uint64(bresp[0].BackupSizeBytes))),
+			zap.Duration("backup-took", backupTook),
+			zap.Duration("restore-took", restoreTook),
+		)
+		c1.PushBack(dataframe.NewStringValue(written))
+		c2.PushBack(dataframe.NewStringValue(humanize.Bytes(uint64(bresp[0].BackupSizeBytes))))
+		c3.PushBack(dataframe.NewStringValue(bresp[0].BackupSizeBytes))
+		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", backupTook.Seconds())))
+		c5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", restoreTook.Seconds())))
+	}
+
+	fr := dataframe.New()
+	for _, col := range []dataframe.Column{c1, c2, c3, c4, c5} {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientBackupRestoreSummaryPath)
+}
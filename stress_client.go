@@ -15,15 +15,77 @@
 package dbtester
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/coreos/etcd/clientv3"
 	"golang.org/x/net/context"
 )
 
-type request struct {
+// Request wraps a database request, which is to be run by ReqHandler.
+// Use NewPutRequest or NewGetRequest to create one.
+type Request struct {
 	etcdv3Op clientv3.Op
 	zkOp     zkOp
 	consulOp consulOp
+
+	// catalogOp is set on 'consul-catalog' requests
+	catalogOp consulCatalogOp
+
+	// intendedStart is when the request should have been sent
+	// at the target rate, zero if not rate-limited.
+	intendedStart time.Time
+
+	// readValue is the value read by get handlers
+	readValue []byte
+}
+
+// key returns the key of the request, to annotate traces.
+func (r *Request) key() string {
+	switch {
+	case r.zkOp.key != "":
+		return r.zkOp.key
+	case r.consulOp.key != "":
+		return r.consulOp.key
+	}
+	return string(r.etcdv3Op.KeyBytes())
 }
 
 // ReqHandler wraps request handler.
-type ReqHandler func(ctx context.Context, req *request) error
+type ReqHandler func(ctx context.Context, req *Request) error
+
+// NewPutRequest creates a write request for the database.
+func NewPutRequest(databaseID, key string, value []byte) (Request, error) {
+	if value == nil {
+		// nil value is read in 'custom' handlers
+		value = []byte{}
+	}
+	switch databaseID {
+	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		return Request{etcdv3Op: clientv3.OpPut(key, string(value))}, nil
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		return Request{zkOp: zkOp{key: "/" + key, value: value}}, nil
+	case "consul__v1_0_2", "cetcd__beta":
+		return Request{consulOp: consulOp{key: key, value: value}}, nil
+	default:
+		return Request{}, fmt.Errorf("%q is unknown database ID", databaseID)
+	}
+}
+
+// NewGetRequest creates a read request for the database.
+func NewGetRequest(databaseID, key string, staleRead bool) (Request, error) {
+	switch databaseID {
+	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		opts := []clientv3.OpOption{clientv3.WithRange("")}
+		if staleRead {
+			opts = append(opts, clientv3.WithSerializable())
+		}
+		return Request{etcdv3Op: clientv3.OpGet(key, opts...)}, nil
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		return Request{zkOp: zkOp{key: key, staleRead: staleRead}}, nil
+	case "consul__v1_0_2", "cetcd__beta":
+		return Request{consulOp: consulOp{key: key, staleRead: staleRead}}, nil
+	default:
+		return Request{}, fmt.Errorf("%q is unknown database ID", databaseID)
+	}
+}
@@ -15,6 +15,10 @@
 package dbtester
 
 import (
+	"fmt"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
 	consulapi "github.com/hashicorp/consul/api"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
@@ -24,37 +28,82 @@ type consulOp struct {
 	key       string
 	value     []byte
 	staleRead bool
+
+	// datacenter to forward the request to, empty for
+	// the datacenter of the agent it is sent to
+	datacenter string
+}
+
+// consulTargetDatacenter returns the datacenter to send requests to.
+func consulTargetDatacenter(gcfg dbtesterpb.ConfigClientMachineAgentControl) string {
+	if gcfg.Flag_Consul_V1_0_2 == nil {
+		return ""
+	}
+	return gcfg.Flag_Consul_V1_0_2.TargetDatacenter
 }
 
 func mustCreateConnsConsul(endpoints []string, total int64) []*consulapi.KV {
-	css := make([]*consulapi.KV, total)
-	for i := range css {
-		endpoint := endpoints[dialTotal%len(endpoints)]
-		dialTotal++
+	return mustCreateConnsConsulToken(endpoints, total, "")
+}
+
+// mustCreateConnsConsulToken creates clients with the ACL token,
+// or with the anonymous token if empty.
+func mustCreateConnsConsulToken(endpoints []string, total int64, token string) []*consulapi.KV {
+	clients := mustCreateClientsConsul(endpoints, total, token)
+	css := make([]*consulapi.KV, len(clients))
+	for i := range clients {
+		css[i] = clients[i].KV()
+	}
+	return css
+}
+
+// mustCreateClientsConsul creates clients of all Consul APIs,
+// to endpoints in round-robin order.
+func mustCreateClientsConsul(endpoints []string, total int64, token string) []*consulapi.Client {
+	css := make([]*consulapi.Client, total)
+	base := dialTotal
+	dialTotal += len(css)
+	err := ramp.dial(len(css), func(i int) error {
+		endpoint := endpoints[(base+i)%len(endpoints)]
 
 		dcfg := consulapi.DefaultConfig()
 		dcfg.Address = endpoint // x.x.x.x:8500
+		dcfg.Token = token
 		cli, err := consulapi.NewClient(dcfg)
 		if err != nil {
-			panic(err)
+			return err
 		}
 
-		css[i] = cli.KV()
+		css[i] = cli
+		if !ramp.verify {
+			return nil
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), dialVerifyTimeout)
+		_, _, err = css[i].KV().Get("dbtester-dial", (&consulapi.QueryOptions{AllowStale: true}).WithContext(ctx))
+		cancel()
+		return err
+	})
+	if err != nil {
+		panic(err)
 	}
 	return css
 }
 
 func newPutConsul(conn *consulapi.KV) ReqHandler {
-	return func(ctx context.Context, req *request) error {
+	return func(ctx context.Context, req *Request) error {
 		op := req.consulOp
-		_, err := conn.Put(&consulapi.KVPair{Key: op.key, Value: op.value}, nil)
+		var opt *consulapi.WriteOptions
+		if op.datacenter != "" {
+			opt = &consulapi.WriteOptions{Datacenter: op.datacenter}
+		}
+		_, err := conn.Put(&consulapi.KVPair{Key: op.key, Value: op.value}, opt)
 		return err
 	}
 }
 
 func newGetConsul(conn *consulapi.KV) ReqHandler {
-	return func(ctx context.Context, req *request) error {
-		opt := &consulapi.QueryOptions{}
+	return func(ctx context.Context, req *Request) error {
+		opt := &consulapi.QueryOptions{Datacenter: req.consulOp.datacenter}
 		if req.consulOp.staleRead {
 			opt.AllowStale = true
 			opt.RequireConsistent = false
@@ -63,15 +112,29 @@ func newGetConsul(conn *consulapi.KV) ReqHandler {
 			opt.AllowStale = false
 			opt.RequireConsistent = true
 		}
-		_, _, err := conn.Get(req.consulOp.key, opt)
+		kv, _, err := conn.Get(req.consulOp.key, opt)
+		if kv != nil {
+			req.readValue = kv.Value
+		}
 		return err
 	}
 }
 
-func getTotalKeysConsul(lg *zap.Logger, endpoints []string) map[string]int64 {
+// getTotalKeysConsul counts the keys under the prefix on each agent,
+// with stale reads to count each server's local state.
+func getTotalKeysConsul(lg *zap.Logger, endpoints []string, prefix string) (map[string]int64, error) {
 	rs := make(map[string]int64)
 	for _, ep := range endpoints {
-		rs[ep] = 0 // not supported in consul
+		kv := mustCreateConnsConsul([]string{ep}, 1)[0]
+		ctx, cancel := context.WithTimeout(context.Background(), totalKeysTimeout)
+		keys, _, err := kv.Keys(prefix, "", (&consulapi.QueryOptions{AllowStale: true}).WithContext(ctx))
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to count keys on %q (%v)", ep, err)
+		}
+		rs[ep] = int64(len(keys))
 	}
-	return rs
+
+	lg.Info("getTotalKeysConsul", zap.String("prefix", prefix), zap.String("response", fmt.Sprintf("%+v", rs)))
+	return rs, nil
 }
@@ -15,20 +15,27 @@
 package dbtester
 
 import (
-	"bufio"
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"math"
 	"net/http"
 	"os"
-	"strconv"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
 
 	"github.com/coreos/etcd/clientv3"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 )
 
 func newPutEtcd3(conn clientv3.KV) ReqHandler {
-	return func(ctx context.Context, req *request) error {
+	return func(ctx context.Context, req *Request) error {
 		_, err := conn.Do(ctx, req.etcdv3Op)
 		return err
 	}
@@ -38,7 +45,13 @@ func newPutEtcd3(conn clientv3.KV) ReqHandler {
 // connections can be handed out in round-robin order
 var dialTotal int
 
-func mustCreateConnEtcdv3(endpoints []string) *clientv3.Client {
+func mustCreateConnEtcdv3(endpoints []string, dopts ...grpc.DialOption) *clientv3.Client {
+	return mustCreateAuthConnEtcdv3(endpoints, "", "", dopts...)
+}
+
+// mustCreateAuthConnEtcdv3 creates a client authenticated as the user,
+// or an anonymous client if the username is empty.
+func mustCreateAuthConnEtcdv3(endpoints []string, username, password string, dopts ...grpc.DialOption) *clientv3.Client {
 	// For parity with consul:
 	// endpoint := endpoints[dialTotal%len(endpoints)]
 	// dialTotal++
@@ -49,6 +62,12 @@ func mustCreateConnEtcdv3(endpoints []string) *clientv3.Client {
 	// let etcd client v3 balancer handle round robin
 	cfg := clientv3.Config{
 		Endpoints: endpoints,
+		// request size is limited by server '--max-request-bytes',
+		// validated in 'ReadConfig'
+		MaxCallSendMsgSize: math.MaxInt32,
+		DialOptions:        append(etcdv3DialOptions, dopts...),
+		Username:           username,
+		Password:           password,
 	}
 
 	client, err := clientv3.New(cfg)
@@ -62,13 +81,58 @@ func mustCreateConnEtcdv3(endpoints []string) *clientv3.Client {
 type etcdv3ClientCfg struct {
 	totalConns   int64
 	totalClients int64
+	// compression is the gRPC compressor, 'gzip' or empty for none
+	compression string
+	// username and password authenticate clients, empty for anonymous
+	username string
+	password string
+	// endpointPerConn dials each connection to a single endpoint in
+	// round-robin order, instead of the client balancer over all
+	endpointPerConn bool
+}
+
+// etcdv3CompressionDialOptions returns dial options for the compressor.
+func etcdv3CompressionDialOptions(compression string) []grpc.DialOption {
+	switch compression {
+	case "gzip":
+		return []grpc.DialOption{
+			grpc.WithCompressor(grpc.NewGZIPCompressor()),
+			grpc.WithDecompressor(grpc.NewGZIPDecompressor()),
+		}
+	}
+	return nil
 }
 
 func mustCreateClientsEtcdv3(endpoints []string, cfg etcdv3ClientCfg) []*clientv3.Client {
 	conns := make([]*clientv3.Client, cfg.totalConns)
-	for i := range conns {
-		conns[i] = mustCreateConnEtcdv3(endpoints)
+	base := dialTotal
+	if cfg.endpointPerConn {
+		dialTotal += len(conns)
+	}
+	err := ramp.dial(len(conns), func(i int) error {
+		eps := endpoints
+		if cfg.endpointPerConn {
+			eps = []string{endpoints[(base+i)%len(endpoints)]}
+		}
+		conns[i] = mustCreateAuthConnEtcdv3(eps, cfg.username, cfg.password, etcdv3CompressionDialOptions(cfg.compression)...)
+		if !ramp.verify {
+			return nil
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), dialVerifyTimeout)
+		_, err := conns[i].Get(ctx, "dbtester-dial", clientv3.WithSerializable(), clientv3.WithCountOnly())
+		cancel()
+		return err
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "dial error: %v\n", err)
+		os.Exit(1)
+	}
+
+	benchConnsEtcdv3.mu.Lock()
+	for _, c := range conns {
+		benchConnsEtcdv3.conns = append(benchConnsEtcdv3.conns, benchConnEtcdv3{c: c, pinned: cfg.endpointPerConn})
 	}
+	benchConnsEtcdv3.mu.Unlock()
 
 	clients := make([]*clientv3.Client, cfg.totalClients)
 	for i := range clients {
@@ -77,48 +141,195 @@ func mustCreateClientsEtcdv3(endpoints []string, cfg etcdv3ClientCfg) []*clientv
 	return clients
 }
 
+type benchConnEtcdv3 struct {
+	c *clientv3.Client
+	// pinned is true if dialed to a single endpoint
+	pinned bool
+}
+
+// benchConnsEtcdv3 are the benchmark connections of the current run, to
+// add endpoints of members that start serving mid-run (e.g. promoted
+// learners). It is reset at the start of each run.
+var benchConnsEtcdv3 struct {
+	mu    sync.Mutex
+	conns []benchConnEtcdv3
+}
+
+func resetBenchConnsEtcdv3() {
+	benchConnsEtcdv3.mu.Lock()
+	benchConnsEtcdv3.conns = nil
+	benchConnsEtcdv3.mu.Unlock()
+}
+
+// addBenchEndpointEtcdv3 adds the endpoint to benchmark connections. Balanced
+// connections add it to their endpoints, and every n-th pinned connection is
+// re-pinned to it, where n is the number of endpoints with the new one.
+func addBenchEndpointEtcdv3(ep string) {
+	benchConnsEtcdv3.mu.Lock()
+	defer benchConnsEtcdv3.mu.Unlock()
+
+	pinnedEps := make(map[string]struct{})
+	for _, bc := range benchConnsEtcdv3.conns {
+		if bc.pinned {
+			for _, e := range bc.c.Endpoints() {
+				pinnedEps[e] = struct{}{}
+			}
+		}
+	}
+	n := len(pinnedEps) + 1
+
+	var pinned int
+	for _, bc := range benchConnsEtcdv3.conns {
+		if !bc.pinned {
+			bc.c.SetEndpoints(append(bc.c.Endpoints(), ep)...)
+			continue
+		}
+		if pinned%n == n-1 {
+			bc.c.SetEndpoints(ep)
+		}
+		pinned++
+	}
+}
+
 func newGetEtcd3(conn clientv3.KV) ReqHandler {
-	return func(ctx context.Context, req *request) error {
-		_, err := conn.Do(ctx, req.etcdv3Op)
-		return err
+	return func(ctx context.Context, req *Request) error {
+		resp, err := conn.Do(ctx, req.etcdv3Op)
+		if err != nil {
+			return err
+		}
+		if kvs := resp.Get().Kvs; len(kvs) > 0 {
+			req.readValue = kvs[0].Value
+		}
+		return nil
 	}
 }
 
-func getTotalKeysEtcdv3(lg *zap.Logger, endpoints []string) map[string]int64 {
+// getTotalKeysEtcdv3 counts the keys under the prefix on each member
+// with serializable count-only ranges, or all keys if the prefix is empty.
+func getTotalKeysEtcdv3(lg *zap.Logger, endpoints []string, prefix string) (map[string]int64, error) {
 	rs := make(map[string]int64)
 	for _, ep := range endpoints {
-		if !strings.HasPrefix(ep, "http://") {
-			ep = "http://" + ep
+		key, opts := prefix, []clientv3.OpOption{clientv3.WithCountOnly(), clientv3.WithSerializable(), clientv3.WithPrefix()}
+		if prefix == "" {
+			key, opts = "\x00", []clientv3.OpOption{clientv3.WithCountOnly(), clientv3.WithSerializable(), clientv3.WithFromKey()}
 		}
-
-		lg.Info("GET", zap.String("path", ep+"/metrics"))
-		resp, err := http.Get(ep + "/metrics")
+		cli := mustCreateConnEtcdv3([]string{ep})
+		ctx, cancel := context.WithTimeout(context.Background(), totalKeysTimeout)
+		resp, err := cli.Get(ctx, key, opts...)
+		cancel()
+		cli.Close()
 		if err != nil {
-			lg.Warn("failed to get /metrics", zap.Error(err))
-			rs[ep] = 0
-		}
-		scanner := bufio.NewScanner(resp.Body)
-		for scanner.Scan() {
-			txt := scanner.Text()
-			if strings.HasPrefix(txt, "#") {
-				continue
-			}
-			ts := strings.SplitN(txt, " ", 2)
-			fv := 0.0
-			if len(ts) == 2 {
-				v, err := strconv.ParseFloat(ts[1], 64)
-				if err == nil {
-					fv = v
-				}
-			}
-			if ts[0] == "etcd_debugging_mvcc_keys_total" {
-				rs[ep] = int64(fv)
-				break
-			}
+			return nil, fmt.Errorf("failed to count keys on %q (%v)", ep, err)
+		}
+		rs[ep] = resp.Count
+	}
+
+	lg.Info("getTotalKeysEtcdv3", zap.String("prefix", prefix), zap.String("response", fmt.Sprintf("%+v", rs)))
+	return rs, nil
+}
+
+// etcdLearnerOptions returns true if the last member is a learner,
+// and the seconds to wait before promoting it.
+func etcdLearnerOptions(gcfg dbtesterpb.ConfigClientMachineAgentControl) (learner bool, promoteSeconds int64) {
+	switch gcfg.DatabaseID {
+	case "etcd__other":
+		if gcfg.Flag_Etcd_Other != nil {
+			return gcfg.Flag_Etcd_Other.Learner, gcfg.Flag_Etcd_Other.LearnerPromoteSeconds
+		}
+	case "etcd__tip":
+		if gcfg.Flag_Etcd_Tip != nil {
+			return gcfg.Flag_Etcd_Tip.Learner, gcfg.Flag_Etcd_Tip.LearnerPromoteSeconds
+		}
+	}
+	return false, 0
+}
+
+// etcdGRPCProxyIPs returns the IPs of gRPC proxies in front of the cluster.
+func etcdGRPCProxyIPs(gcfg dbtesterpb.ConfigClientMachineAgentControl) []string {
+	switch gcfg.DatabaseID {
+	case "etcd__other":
+		if gcfg.Flag_Etcd_Other != nil {
+			return gcfg.Flag_Etcd_Other.GRPCProxyIPs
+		}
+	case "etcd__tip":
+		if gcfg.Flag_Etcd_Tip != nil {
+			return gcfg.Flag_Etcd_Tip.GRPCProxyIPs
+		}
+	}
+	return nil
+}
+
+// postEtcdGateway sends a JSON request to etcd gRPC gateway.
+// Vendored etcd client does not support learner APIs.
+func postEtcdGateway(ctx context.Context, ep, path string, req, resp interface{}) error {
+	if !strings.HasPrefix(ep, "http://") {
+		ep = "http://" + ep
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	hreq, err := http.NewRequest(http.MethodPost, ep+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	hreq.Header.Set("Content-Type", "application/json")
+	rs, err := http.DefaultClient.Do(hreq.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer rs.Body.Close()
+	b, err := ioutil.ReadAll(rs.Body)
+	if err != nil {
+		return err
+	}
+	if rs.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s (%q)", rs.Status, string(b))
+	}
+	if resp == nil {
+		return nil
+	}
+	return json.Unmarshal(b, resp)
+}
+
+// promoteLearnerEtcdv3 promotes the learner member to a voting member,
+// retrying until the learner catches up with the leader or the context
+// is done.
+func promoteLearnerEtcdv3(ctx context.Context, lg *zap.Logger, ep string) error {
+	var members struct {
+		Members []struct {
+			ID        string `json:"ID"`
+			Name      string `json:"name"`
+			IsLearner bool   `json:"isLearner"`
+		} `json:"members"`
+	}
+	if err := postEtcdGateway(ctx, ep, "/v3/cluster/member/list", struct{}{}, &members); err != nil {
+		return err
+	}
+	var id, name string
+	for _, m := range members.Members {
+		if m.IsLearner {
+			id, name = m.ID, m.Name
+			break
 		}
-		gracefulClose(resp)
+	}
+	if id == "" {
+		return fmt.Errorf("no learner found from %q", ep)
 	}
 
-	lg.Info("getTotalKeysEtcdv3", zap.String("response", fmt.Sprintf("%+v", rs)))
-	return rs
+	var err error
+	for i := 0; i < 30; i++ {
+		now := time.Now()
+		if err = postEtcdGateway(ctx, ep, "/v3/cluster/member/promote", map[string]string{"ID": id}, nil); err == nil {
+			lg.Info("promoted etcd learner", zap.String("name", name), zap.String("id", id), zap.Duration("took", time.Since(now)))
+			return nil
+		}
+		lg.Warn("failed to promote etcd learner; retrying", zap.String("name", name), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+	return err
 }
@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
 	"github.com/samuel/go-zookeeper/zk"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
@@ -37,20 +39,57 @@ type zkOp struct {
 
 func mustCreateConnsZk(endpoints []string, total int64) []*zk.Conn {
 	zks := make([]*zk.Conn, total)
-	for i := range zks {
-		endpoint := endpoints[dialTotal%len(endpoints)]
-		dialTotal++
-		conn, _, err := zk.Connect([]string{endpoint}, time.Second)
+	base := dialTotal
+	dialTotal += len(zks)
+	err := ramp.dial(len(zks), func(i int) error {
+		endpoint := endpoints[(base+i)%len(endpoints)]
+		conn, evc, err := zk.Connect([]string{endpoint}, time.Second)
 		if err != nil {
-			panic(err)
+			return err
 		}
 		zks[i] = conn
+		if !ramp.verify {
+			return nil
+		}
+		return waitSessionZk(evc, dialVerifyTimeout)
+	})
+	if err != nil {
+		panic(err)
 	}
 	return zks
 }
 
+// waitSessionZk waits until the connection has a session.
+func waitSessionZk(evc <-chan zk.Event, timeout time.Duration) error {
+	tm := time.NewTimer(timeout)
+	defer tm.Stop()
+	for {
+		select {
+		case ev, ok := <-evc:
+			if !ok {
+				return errors.New("zk: connection closed before session")
+			}
+			if ev.State == zk.StateHasSession {
+				return nil
+			}
+		case <-tm.C:
+			return fmt.Errorf("zk: no session in %v", timeout)
+		}
+	}
+}
+
+// zkReadEndpoints returns the endpoints to send read requests to.
+// If configured, only observers serve reads.
+func zkReadEndpoints(gcfg dbtesterpb.ConfigClientMachineAgentControl) []string {
+	fz := gcfg.Flag_Zookeeper_R3_5_3Beta
+	if fz == nil || !fz.ReadFromObservers || fz.ObserverNumber <= 0 || int(fz.ObserverNumber) > len(gcfg.DatabaseEndpoints) {
+		return gcfg.DatabaseEndpoints
+	}
+	return gcfg.DatabaseEndpoints[len(gcfg.DatabaseEndpoints)-int(fz.ObserverNumber):]
+}
+
 func newPutCreateZK(conn *zk.Conn) ReqHandler {
-	return func(ctx context.Context, req *request) error {
+	return func(ctx context.Context, req *Request) error {
 		op := req.zkOp
 		_, err := conn.Create(op.key, op.value, zkCreateFlags, zkCreateACL)
 		return err
@@ -59,7 +98,7 @@ func newPutCreateZK(conn *zk.Conn) ReqHandler {
 
 func newPutOverwriteZK(conn *zk.Conn) ReqHandler {
 	// samekey
-	return func(ctx context.Context, req *request) error {
+	return func(ctx context.Context, req *Request) error {
 		op := req.zkOp
 		_, err := conn.Set(op.key, op.value, int32(-1))
 		return err
@@ -67,7 +106,7 @@ func newPutOverwriteZK(conn *zk.Conn) ReqHandler {
 }
 
 func newGetZK(conn *zk.Conn) ReqHandler {
-	return func(ctx context.Context, req *request) error {
+	return func(ctx context.Context, req *Request) error {
 		errt := ""
 		if !req.zkOp.staleRead {
 			_, err := conn.Sync("/" + req.zkOp.key)
@@ -75,7 +114,8 @@ func newGetZK(conn *zk.Conn) ReqHandler {
 				errt += err.Error()
 			}
 		}
-		_, _, err := conn.Get("/" + req.zkOp.key)
+		data, _, err := conn.Get("/" + req.zkOp.key)
+		req.readValue = data
 		if err != nil {
 			if errt != "" {
 				errt += "; "
@@ -89,18 +129,56 @@ func newGetZK(conn *zk.Conn) ReqHandler {
 	}
 }
 
-func getTotalKeysZk(lg *zap.Logger, endpoints []string) map[string]int64 {
+// getTotalKeysZk counts the znodes of keys on each server, walking
+// 'key_path_depth' directories under 'key_prefix'. Zookeeper system
+// znodes are not counted.
+func getTotalKeysZk(lg *zap.Logger, endpoints []string, opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) (map[string]int64, error) {
+	root := "/" + opts.KeyPrefix
 	rs := make(map[string]int64)
-	stats, ok := zk.FLWSrvr(endpoints, 5*time.Second)
-	if !ok {
-		lg.Sugar().Infof("getTotalKeysZk failed with %+v", stats)
-		for _, ep := range endpoints {
-			rs[ep] = 0
+	for _, ep := range endpoints {
+		conn := mustCreateConnsZk([]string{ep}, 1)[0]
+		// read the latest znodes from followers
+		_, err := conn.Sync(root)
+		if err == nil || err == zk.ErrNoNode {
+			rs[ep], err = countZk(conn, root, opts.KeyPathDepth)
 		}
-		return rs
+		conn.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to count znodes on %q (%v)", ep, err)
+		}
+	}
+
+	lg.Info("getTotalKeysZk", zap.String("root", root), zap.String("response", fmt.Sprintf("%+v", rs)))
+	return rs, nil
+}
+
+// countZk counts the znodes 'depth' levels below the path.
+func countZk(conn *zk.Conn, path string, depth int64) (int64, error) {
+	children, _, err := conn.Children(path)
+	if err == zk.ErrNoNode {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
 	}
-	for i, s := range stats {
-		rs[endpoints[i]] = s.NodeCount
+	var total int64
+	for _, c := range children {
+		if path == "/" && c == "zookeeper" {
+			continue
+		}
+		if depth == 0 {
+			total++
+			continue
+		}
+		child := path + "/" + c
+		if path == "/" {
+			child = path + c
+		}
+		n, err := countZk(conn, child, depth-1)
+		if err != nil {
+			return 0, err
+		}
+		total += n
 	}
-	return rs
+	return total, nil
 }
@@ -0,0 +1,189 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// compactionEvent is a compaction during benchmark.
+type compactionEvent struct {
+	unixSecond int64
+	revision   int64
+	took       time.Duration
+}
+
+// revisionSample is the current revision at a time.
+type revisionSample struct {
+	at       time.Time
+	revision int64
+}
+
+// compactor compacts etcd by the compaction policy,
+// and records compaction events to annotate time series.
+type compactor struct {
+	lg     *zap.Logger
+	policy dbtesterpb.ConfigCompactionPolicy
+	cli    *clientv3.Client
+	eps    []string
+
+	cancel func()
+	donec  chan struct{}
+
+	mu     sync.Mutex
+	events []compactionEvent
+}
+
+// startCompactor starts compacting in the background.
+// It returns nil if no compaction policy is configured.
+func startCompactor(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) *compactor {
+	policy := gcfg.ConfigClientMachineBenchmarkOptions.CompactionPolicy
+	if policy == nil || policy.Mode == "" {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &compactor{
+		lg:     lg,
+		policy: *policy,
+		cli:    mustCreateConnEtcdv3(gcfg.DatabaseEndpoints),
+		eps:    gcfg.DatabaseEndpoints,
+		cancel: cancel,
+		donec:  make(chan struct{}),
+	}
+	lg.Info("starting compactor", zap.String("policy", fmt.Sprintf("%+v", c.policy)))
+	go c.run(ctx)
+	return c
+}
+
+// stop stops compacting, and returns all compaction events.
+func (c *compactor) stop() []compactionEvent {
+	c.cancel()
+	<-c.donec
+	c.cli.Close()
+	c.lg.Info("stopped compactor", zap.Int("compactions", len(c.events)))
+	return c.eventsSnapshot()
+}
+
+func (c *compactor) eventsSnapshot() []compactionEvent {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	evs := make([]compactionEvent, len(c.events))
+	copy(evs, c.events)
+	return evs
+}
+
+func (c *compactor) run(ctx context.Context) {
+	defer close(c.donec)
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var (
+		samples      []revisionSample
+		compacted    int64
+		lastCompact  = time.Now()
+		intervalTime = time.Duration(c.policy.IntervalSeconds) * time.Second
+		retention    = time.Duration(c.policy.RetentionSeconds) * time.Second
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		resp, err := c.cli.Get(ctx, "compaction-probe", clientv3.WithCountOnly())
+		if err != nil {
+			if ctx.Err() == nil {
+				c.lg.Warn("failed to get revision", zap.Error(err))
+			}
+			continue
+		}
+		now, rev := time.Now(), resp.Header.Revision
+
+		target := int64(0)
+		switch c.policy.Mode {
+		case "revision":
+			if rev-compacted >= c.policy.IntervalRevisions {
+				target = rev - c.policy.RetentionRevisions
+			}
+		case "time":
+			// keep the latest revision written before the retention window
+			samples = append(samples, revisionSample{at: now, revision: rev})
+			for len(samples) > 1 && now.Sub(samples[1].at) >= retention {
+				samples = samples[1:]
+			}
+			if now.Sub(lastCompact) < intervalTime {
+				continue
+			}
+			lastCompact = now
+			if now.Sub(samples[0].at) >= retention {
+				target = samples[0].revision
+			}
+		}
+		if target <= compacted {
+			continue
+		}
+		if err = c.compact(ctx, target); err != nil {
+			if ctx.Err() == nil {
+				c.lg.Warn("failed to compact", zap.Int64("revision", target), zap.Error(err))
+			}
+			continue
+		}
+		compacted = target
+	}
+}
+
+func (c *compactor) compact(ctx context.Context, rev int64) error {
+	var opts []clientv3.CompactOption
+	if c.policy.Physical {
+		opts = append(opts, clientv3.WithCompactPhysical())
+	}
+	start := time.Now()
+	if _, err := c.cli.Compact(ctx, rev, opts...); err != nil {
+		return err
+	}
+	if c.policy.Defragment {
+		for _, ep := range c.eps {
+			if _, err := c.cli.Defragment(ctx, ep); err != nil {
+				return err
+			}
+		}
+	}
+	took := time.Since(start)
+
+	c.lg.Info("compacted", zap.Int64("revision", rev), zap.Duration("took", took))
+	c.mu.Lock()
+	c.events = append(c.events, compactionEvent{unixSecond: start.Unix(), revision: rev, took: took})
+	c.mu.Unlock()
+	return nil
+}
+
+// compactionsBySecond groups compaction events by unix second.
+func compactionsBySecond(evs []compactionEvent) map[int64][]compactionEvent {
+	m := make(map[int64][]compactionEvent)
+	for _, ev := range evs {
+		m[ev.unixSecond] = append(m[ev.unixSecond], ev)
+	}
+	return m
+}
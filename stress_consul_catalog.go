@@ -0,0 +1,198 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	mrand "math/rand"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/benchrunner"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+const (
+	defaultConsulCatalogServices   = 100
+	defaultConsulCatalogInstances  = 10
+	defaultConsulCatalogCheckRatio = 0.6
+	defaultConsulCatalogQueryRatio = 0.3
+)
+
+// consulCatalogOp is a request to the Consul service catalog.
+type consulCatalogOp struct {
+	// kind is one of 'OpTypeRegister', 'OpTypeDeregister',
+	// 'OpTypeCheckUpdate', or 'OpTypeHealthQuery'
+	kind     string
+	service  int64
+	instance int64
+	// status is the health check status to register
+	status string
+	output string
+}
+
+// consulCatalogOptions returns the benchmark options with defaults.
+func consulCatalogOptions(gcfg dbtesterpb.ConfigClientMachineAgentControl) dbtesterpb.ConfigClientMachineBenchmarkOptions {
+	opts := *gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.ConsulCatalogServices == 0 {
+		opts.ConsulCatalogServices = defaultConsulCatalogServices
+	}
+	if opts.ConsulCatalogInstances == 0 {
+		opts.ConsulCatalogInstances = defaultConsulCatalogInstances
+	}
+	if opts.ConsulCatalogCheckRatio == 0 {
+		opts.ConsulCatalogCheckRatio = defaultConsulCatalogCheckRatio
+	}
+	if opts.ConsulCatalogQueryRatio == 0 {
+		opts.ConsulCatalogQueryRatio = defaultConsulCatalogQueryRatio
+	}
+	return opts
+}
+
+func consulCatalogService(i int64) string {
+	return fmt.Sprintf("dbtester-service-%04d", i)
+}
+
+// consulCatalogNode returns the external node of the instance,
+// each instance of a service on a different node.
+func consulCatalogNode(instance int64) string {
+	return fmt.Sprintf("dbtester-node-%04d", instance)
+}
+
+func consulCatalogServiceID(service, instance int64) string {
+	return fmt.Sprintf("%s-%04d", consulCatalogService(service), instance)
+}
+
+// consulCatalogRegistration registers the instance with its health check,
+// as agents do on anti-entropy sync.
+func consulCatalogRegistration(op consulCatalogOp) *consulapi.CatalogRegistration {
+	id := consulCatalogServiceID(op.service, op.instance)
+	return &consulapi.CatalogRegistration{
+		Node:           consulCatalogNode(op.instance),
+		Address:        fmt.Sprintf("10.0.%d.%d", op.instance/256, op.instance%256),
+		SkipNodeUpdate: true,
+		Service: &consulapi.AgentService{
+			ID:      id,
+			Service: consulCatalogService(op.service),
+			Port:    8080,
+		},
+		Check: &consulapi.AgentCheck{
+			Node:      consulCatalogNode(op.instance),
+			CheckID:   "service:" + id,
+			Name:      "service check",
+			Status:    op.status,
+			Output:    op.output,
+			ServiceID: id,
+		},
+	}
+}
+
+// benchmarkConsulCatalog models Consul service discovery: services are
+// registered with health checks, and then clients update health checks,
+// query healthy instances of services, or register and deregister
+// instances, with latencies reported per operation type.
+func (cfg *Config) benchmarkConsulCatalog(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	opts := consulCatalogOptions(gcfg)
+
+	clients := mustCreateClientsConsul(gcfg.DatabaseEndpoints, 1, "")
+	cfg.lg.Info("registering services",
+		zap.Int64("services", opts.ConsulCatalogServices),
+		zap.Int64("instances", opts.ConsulCatalogInstances),
+	)
+	for s := int64(0); s < opts.ConsulCatalogServices; s++ {
+		for i := int64(0); i < opts.ConsulCatalogInstances; i++ {
+			op := consulCatalogOp{service: s, instance: i, status: consulapi.HealthPassing}
+			if _, err := clients[0].Catalog().Register(consulCatalogRegistration(op), nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	h := newConsulCatalogHandlers(gcfg, opts)
+	reqGen := func(inflightReqs chan<- Request) { generateConsulCatalog(opts, vals, inflightReqs) }
+	return cfg.generateReport(gcfg, h, nil, reqGen)
+}
+
+// generateConsulCatalog generates health check updates, health queries,
+// and registrations of instances that are not registered or deregistrations
+// of ones that are.
+func generateConsulCatalog(opts dbtesterpb.ConfigClientMachineBenchmarkOptions, vals values, inflightReqs chan<- Request) {
+	defer close(inflightReqs)
+
+	pacer := benchrunner.NewPacer(opts.RateLimitRequestsPerSecond)
+	rnd := mrand.New(mrand.NewSource(time.Now().UnixNano()))
+
+	total := opts.ConsulCatalogServices * opts.ConsulCatalogInstances
+	deregistered := make(map[int64]bool)
+	statuses := []string{consulapi.HealthPassing, consulapi.HealthWarning, consulapi.HealthCritical}
+	for i := int64(0); i < opts.RequestNumber; i++ {
+		intended := pacer.Wait(context.TODO(), i)
+		n := rnd.Int63n(total)
+		op := consulCatalogOp{
+			service:  n / opts.ConsulCatalogInstances,
+			instance: n % opts.ConsulCatalogInstances,
+			status:   consulapi.HealthPassing,
+			output:   vals.strings[i%int64(vals.sampleSize)],
+		}
+		switch r := rnd.Float64(); {
+		case r < opts.ConsulCatalogCheckRatio && !deregistered[n]:
+			op.kind = OpTypeCheckUpdate
+			op.status = statuses[rnd.Intn(len(statuses))]
+		case r < opts.ConsulCatalogCheckRatio+opts.ConsulCatalogQueryRatio:
+			op.kind = OpTypeHealthQuery
+		case deregistered[n]:
+			op.kind = OpTypeRegister
+			delete(deregistered, n)
+		default:
+			op.kind = OpTypeDeregister
+			deregistered[n] = true
+		}
+		inflightReqs <- Request{catalogOp: op, intendedStart: intended}
+	}
+}
+
+func newConsulCatalogHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl, opts dbtesterpb.ConfigClientMachineBenchmarkOptions) []ReqHandler {
+	clients := mustCreateClientsConsul(gcfg.DatabaseEndpoints, opts.ConnectionNumber, "")
+	rhs := make([]ReqHandler, opts.ClientNumber)
+	for i := range rhs {
+		rhs[i] = newConsulCatalogHandler(clients[i%len(clients)], opts.StaleRead)
+	}
+	return rhs
+}
+
+func newConsulCatalogHandler(cli *consulapi.Client, staleRead bool) ReqHandler {
+	return func(ctx context.Context, req *Request) error {
+		op := req.catalogOp
+		switch op.kind {
+		case OpTypeRegister, OpTypeCheckUpdate:
+			_, err := cli.Catalog().Register(consulCatalogRegistration(op), (&consulapi.WriteOptions{}).WithContext(ctx))
+			return err
+		case OpTypeDeregister:
+			_, err := cli.Catalog().Deregister(&consulapi.CatalogDeregistration{
+				Node:      consulCatalogNode(op.instance),
+				ServiceID: consulCatalogServiceID(op.service, op.instance),
+			}, (&consulapi.WriteOptions{}).WithContext(ctx))
+			return err
+		case OpTypeHealthQuery:
+			qopts := &consulapi.QueryOptions{AllowStale: staleRead, RequireConsistent: !staleRead}
+			_, _, err := cli.Health().Service(consulCatalogService(op.service), "", true, qopts.WithContext(ctx))
+			return err
+		}
+		return fmt.Errorf("unknown catalog operation %q", op.kind)
+	}
+}
@@ -0,0 +1,303 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/benchrunner"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+const (
+	defaultDeleteRangeKeys         = 10000
+	defaultDeleteRangeProbeSeconds = 5
+
+	// deleteRangePrefix is the prefix of keys that range deletes delete
+	deleteRangePrefix = "delete-range/"
+	// deleteRangeProbeKey is the key of probe reads and writes
+	deleteRangeProbeKey = "delete-range-probe"
+)
+
+// Phases of 'delete-range' probes.
+const (
+	deleteRangePhaseBefore = iota
+	deleteRangePhaseDelete
+	deleteRangePhaseCompaction
+)
+
+var deleteRangePhaseNames = []string{"before-delete", "delete", "compaction"}
+
+// deleteRangeOptions returns the benchmark options with defaults.
+func deleteRangeOptions(gcfg dbtesterpb.ConfigClientMachineAgentControl) dbtesterpb.ConfigClientMachineBenchmarkOptions {
+	opts := *gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.DeleteRangeKeys == 0 {
+		opts.DeleteRangeKeys = defaultDeleteRangeKeys
+	}
+	if opts.DeleteRangeProbeSeconds == 0 {
+		opts.DeleteRangeProbeSeconds = defaultDeleteRangeProbeSeconds
+	}
+	return opts
+}
+
+// deleteRangePrefixOf returns the prefix of the keys that i-th request deletes.
+func deleteRangePrefixOf(i int64) string {
+	return fmt.Sprintf("%s%08d/", deleteRangePrefix, i)
+}
+
+// deleteRangeProbe alternates writes and reads of one key,
+// recording their latencies by phase.
+type deleteRangeProbe struct {
+	mu        sync.Mutex
+	phase     int
+	started   []time.Time
+	latencies [][]float64
+	errors    []int64
+}
+
+func (p *deleteRangeProbe) begin(phase int) {
+	p.mu.Lock()
+	p.phase = phase
+	p.started[phase] = time.Now()
+	p.mu.Unlock()
+}
+
+func (p *deleteRangeProbe) observe(took time.Duration, err error) {
+	p.mu.Lock()
+	if err != nil {
+		p.errors[p.phase]++
+	} else {
+		p.latencies[p.phase] = append(p.latencies[p.phase], took.Seconds())
+	}
+	p.mu.Unlock()
+}
+
+func (p *deleteRangeProbe) run(ctx context.Context, cli *clientv3.Client, value string) {
+	for i := 0; ctx.Err() == nil; i++ {
+		st := time.Now()
+		var err error
+		if i%2 == 0 {
+			_, err = cli.Put(ctx, deleteRangeProbeKey, value)
+		} else {
+			_, err = cli.Get(ctx, deleteRangeProbeKey)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		p.observe(time.Since(st), err)
+	}
+}
+
+// benchmarkDeleteRange loads 'request_number' prefixes of 'delete_range_keys'
+// keys, and deletes each prefix with one request. Probe reads and writes run
+// before and during the range deletes, and while the deleted revisions are
+// compacted, to measure the impact of large deletions on other requests. Only
+// the range deletes are reported, and probes are summarized in
+// 'client_delete_range_summary_path'.
+func (cfg *Config) benchmarkDeleteRange(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	opts := deleteRangeOptions(gcfg)
+	gcfg.ConfigClientMachineBenchmarkOptions = &opts
+
+	loaded := opts.RequestNumber * opts.DeleteRangeKeys
+	cfg.lg.Info("loading keys before range deletes", zap.Int64("prefixes", opts.RequestNumber), zap.Int64("keys", loaded))
+	h, done := newWriteHandlers(cfg.lg, gcfg)
+	reqGen := func(inflightReqs chan<- Request) {
+		defer close(inflightReqs)
+		for i := int64(0); i < loaded; i++ {
+			k := fmt.Sprintf("%s%08d", deleteRangePrefixOf(i/opts.DeleteRangeKeys), i%opts.DeleteRangeKeys)
+			inflightReqs <- Request{etcdv3Op: clientv3.OpPut(k, vals.strings[i%int64(vals.sampleSize)])}
+		}
+	}
+	b := newBenchmark(loaded, opts.ClientNumber, h, done, reqGen)
+	b.setErrorBudget(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.setTracer(cfg.lg, gcfg)
+	b.setClientDelays(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.setPriorities(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.pauser = cfg.pauser
+	b.ctx = cfg.stressCtx
+	b.recorder = cfg.recorder
+	b.phases = cfg.phases
+	b.clientWrites = cfg.clientWrites
+	b.clientReads = cfg.clientReads
+	b.startRequests()
+	b.waitAll()
+	if b.abortErr != nil {
+		return b.abortErr
+	}
+
+	cli := mustCreateConnEtcdv3(gcfg.DatabaseEndpoints)
+	defer cli.Close()
+	probe := &deleteRangeProbe{
+		started:   make([]time.Time, len(deleteRangePhaseNames)),
+		latencies: make([][]float64, len(deleteRangePhaseNames)),
+		errors:    make([]int64, len(deleteRangePhaseNames)),
+	}
+	probe.begin(deleteRangePhaseBefore)
+	ctx, cancel := context.WithCancel(context.Background())
+	probeDonec := make(chan struct{})
+	go func() {
+		probe.run(ctx, cli, vals.strings[0])
+		close(probeDonec)
+	}()
+	cfg.lg.Info("probing before range deletes", zap.Int64("seconds", opts.DeleteRangeProbeSeconds))
+	time.Sleep(time.Duration(opts.DeleteRangeProbeSeconds) * time.Second)
+
+	probe.begin(deleteRangePhaseDelete)
+	var deleted int64
+	dh, ddone := newDeleteRangeHandlers(gcfg, &deleted)
+	dreqGen := func(inflightReqs chan<- Request) {
+		defer close(inflightReqs)
+		pacer := benchrunner.NewPacer(opts.RateLimitRequestsPerSecond)
+		for i := int64(0); i < opts.RequestNumber; i++ {
+			intended := pacer.Wait(context.TODO(), i)
+			inflightReqs <- Request{etcdv3Op: clientv3.OpDelete(deleteRangePrefixOf(i), clientv3.WithPrefix()), intendedStart: intended}
+		}
+	}
+	cfg.lg.Info("deleting ranges", zap.Int64("requests", opts.RequestNumber), zap.Int64("keys-per-request", opts.DeleteRangeKeys))
+	err := cfg.generateReport(gcfg, dh, ddone, dreqGen)
+	cfg.lg.Info("deleted ranges", zap.Int64("deleted-keys", atomic.LoadInt64(&deleted)))
+
+	probe.begin(deleteRangePhaseCompaction)
+	var compactRev int64
+	if err == nil {
+		compactRev, err = compactDeleted(ctx, cli)
+		if err == nil {
+			cfg.lg.Info("compacted deleted revisions", zap.Int64("revision", compactRev), zap.Duration("took", time.Since(probe.started[deleteRangePhaseCompaction])))
+		}
+	}
+	ended := time.Now()
+	cancel()
+	<-probeDonec
+
+	if serr := cfg.saveDeleteRangeSummary(probe, ended, atomic.LoadInt64(&deleted), compactRev); serr != nil {
+		return serr
+	}
+	return err
+}
+
+// compactDeleted physically compacts up to the current revision, so that
+// the deleted keys are removed from the backend before it returns.
+func compactDeleted(ctx context.Context, cli *clientv3.Client) (int64, error) {
+	resp, err := cli.Get(ctx, deleteRangeProbeKey, clientv3.WithCountOnly())
+	if err != nil {
+		return 0, err
+	}
+	rev := resp.Header.Revision
+	if _, err = cli.Compact(ctx, rev, clientv3.WithCompactPhysical()); err != nil {
+		return 0, err
+	}
+	return rev, nil
+}
+
+// newDeleteRangeHandlers creates handlers that delete ranges,
+// counting the deleted keys.
+func newDeleteRangeHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl, deleted *int64) (rhs []ReqHandler, done func()) {
+	clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+		totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+		totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+		compression:  gcfg.ConfigClientMachineBenchmarkOptions.EtcdCompression,
+	})
+	rhs = make([]ReqHandler, len(clients))
+	for i := range clients {
+		kv := clients[i].KV
+		rhs[i] = func(ctx context.Context, req *Request) error {
+			resp, err := kv.Do(ctx, req.etcdv3Op)
+			if err != nil {
+				return err
+			}
+			atomic.AddInt64(deleted, resp.Del().Deleted)
+			return nil
+		}
+	}
+	done = func() {
+		for i := range clients {
+			clients[i].Close()
+		}
+	}
+	return rhs, done
+}
+
+// saveDeleteRangeSummary writes probe latencies by phase.
+func (cfg *Config) saveDeleteRangeSummary(probe *deleteRangeProbe, ended time.Time, deleted, compactRev int64) error {
+	probe.mu.Lock()
+	defer probe.mu.Unlock()
+
+	cols := []dataframe.Column{
+		dataframe.NewColumn("PHASE"),
+		dataframe.NewColumn("SECONDS"),
+		dataframe.NewColumn("PROBE-REQUESTS"),
+		dataframe.NewColumn("PROBE-ERRORS"),
+		dataframe.NewColumn("PROBE-AVERAGE-LATENCY-MS"),
+		dataframe.NewColumn("PROBE-P99-LATENCY-MS"),
+		dataframe.NewColumn("PROBE-MAX-LATENCY-MS"),
+		dataframe.NewColumn("DELETED-KEYS"),
+		dataframe.NewColumn("COMPACT-REVISION"),
+	}
+	for phase, name := range deleteRangePhaseNames {
+		end := ended
+		if phase+1 < len(deleteRangePhaseNames) {
+			end = probe.started[phase+1]
+		}
+		lats := probe.latencies[phase]
+		sort.Float64s(lats)
+		var avg, p99, max float64
+		if len(lats) > 0 {
+			for _, l := range lats {
+				avg += l
+			}
+			avg /= float64(len(lats))
+			p99 = lats[(len(lats)-1)*99/100]
+			max = lats[len(lats)-1]
+		}
+		var phaseDeleted, phaseRev int64
+		switch phase {
+		case deleteRangePhaseDelete:
+			phaseDeleted = deleted
+		case deleteRangePhaseCompaction:
+			phaseRev = compactRev
+		}
+		row := []interface{}{
+			name,
+			fmt.Sprintf("%.3f", end.Sub(probe.started[phase]).Seconds()),
+			len(lats),
+			probe.errors[phase],
+			fmt.Sprintf("%f", 1000*avg),
+			fmt.Sprintf("%f", 1000*p99),
+			fmt.Sprintf("%f", 1000*max),
+			phaseDeleted,
+			phaseRev,
+		}
+		for i := range cols {
+			cols[i].PushBack(dataframe.NewStringValue(row[i]))
+		}
+	}
+
+	fr := dataframe.New()
+	for _, col := range cols {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientDeleteRangeSummaryPath)
+}
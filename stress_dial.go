@@ -0,0 +1,98 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	mrand "math/rand"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// dialVerifyTimeout bounds the request that verifies a new connection.
+const dialVerifyTimeout = 10 * time.Second
+
+// dialRamp paces how client connections are established, so that
+// servers are not overwhelmed by all clients connecting at once and
+// early measurements are not skewed by connection setup.
+type dialRamp struct {
+	concurrency int
+	jitter      time.Duration
+	verify      bool
+}
+
+// ramp is the dial ramp of the running benchmark, set by 'Stress',
+// shared by 'mustCreate*' functions like 'dialTotal'.
+var ramp dialRamp
+
+func newDialRamp(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) dialRamp {
+	if opts == nil {
+		return dialRamp{}
+	}
+	return dialRamp{
+		concurrency: int(opts.DialConcurrency),
+		jitter:      time.Duration(opts.DialJitterMs) * time.Millisecond,
+		// connections are lazy, so a dial slot is
+		// only released once the connection is used
+		verify: opts.VerifyConnections || opts.DialConcurrency > 0,
+	}
+}
+
+// dial calls 'dialFunc' for connections 0 to total-1, at most
+// 'concurrency' at once, each after a random jitter. It returns
+// the first error of 'dialFunc'.
+func (r dialRamp) dial(total int, dialFunc func(i int) error) error {
+	if r.concurrency <= 0 && r.jitter <= 0 && !r.verify {
+		for i := 0; i < total; i++ {
+			if err := dialFunc(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	concurrency := r.concurrency
+	if concurrency <= 0 || concurrency > total {
+		concurrency = total
+	}
+	var (
+		wg   sync.WaitGroup
+		sema = make(chan struct{}, concurrency)
+		errc = make(chan error, total)
+	)
+	for i := 0; i < total; i++ {
+		sema <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sema
+				wg.Done()
+			}()
+			if r.jitter > 0 {
+				time.Sleep(time.Duration(mrand.Int63n(int64(r.jitter))))
+			}
+			errc <- dialFunc(i)
+		}(i)
+	}
+	wg.Wait()
+	close(errc)
+	for err := range errc {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
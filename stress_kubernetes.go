@@ -0,0 +1,245 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	mrand "math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/benchrunner"
+
+	"github.com/coreos/etcd/clientv3"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+const (
+	kubernetesPrefix = "/registry/pods/"
+
+	defaultKubernetesObjects    = 10000
+	defaultKubernetesHotObjects = 100
+	defaultKubernetesListRatio  = 0.1
+	defaultKubernetesWatchers   = 10
+	kubernetesListPageSize      = 500
+)
+
+// kubernetesOptions returns the benchmark options with defaults.
+func kubernetesOptions(gcfg dbtesterpb.ConfigClientMachineAgentControl) dbtesterpb.ConfigClientMachineBenchmarkOptions {
+	opts := *gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.KubernetesObjects == 0 {
+		opts.KubernetesObjects = defaultKubernetesObjects
+	}
+	if opts.KubernetesHotObjects == 0 {
+		opts.KubernetesHotObjects = defaultKubernetesHotObjects
+	}
+	if opts.KubernetesHotObjects > opts.KubernetesObjects {
+		opts.KubernetesHotObjects = opts.KubernetesObjects
+	}
+	if opts.KubernetesListRatio == 0 {
+		opts.KubernetesListRatio = defaultKubernetesListRatio
+	}
+	if opts.KubernetesWatchers == 0 {
+		opts.KubernetesWatchers = defaultKubernetesWatchers
+	}
+	return opts
+}
+
+func kubernetesKey(i int64) string {
+	return fmt.Sprintf("%sdefault/pod-%08d", kubernetesPrefix, i)
+}
+
+// kubernetesWatchStats counts watch events, and resource versions
+// (revisions) that are not increasing.
+type kubernetesWatchStats struct {
+	events     int64
+	violations int64
+}
+
+// benchmarkKubernetes models the Kubernetes API server: objects are created,
+// and then clients LIST all objects with pagination, or update a hot subset of
+// objects with compare-and-swap on their resource versions, while watchers
+// follow all changes. Resource versions seen by each client and watcher must
+// never decrease.
+func (cfg *Config) benchmarkKubernetes(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	opts := kubernetesOptions(gcfg)
+
+	cli := mustCreateConnEtcdv3(gcfg.DatabaseEndpoints)
+	defer cli.Close()
+
+	cfg.lg.Info("creating objects", zap.Int64("objects", opts.KubernetesObjects))
+	var startRev int64
+	for i := int64(0); i < opts.KubernetesObjects; i++ {
+		resp, err := cli.Put(context.Background(), kubernetesKey(i), vals.strings[i%int64(vals.sampleSize)])
+		if err != nil {
+			return err
+		}
+		startRev = resp.Header.Revision
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var (
+		wg sync.WaitGroup
+		ws kubernetesWatchStats
+	)
+	for i := int64(0); i < opts.KubernetesWatchers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lastRev := startRev
+			for wresp := range cli.Watch(ctx, kubernetesPrefix, clientv3.WithPrefix(), clientv3.WithRev(startRev+1)) {
+				for _, ev := range wresp.Events {
+					atomic.AddInt64(&ws.events, 1)
+					if ev.Kv.ModRevision <= lastRev {
+						atomic.AddInt64(&ws.violations, 1)
+					}
+					lastRev = ev.Kv.ModRevision
+				}
+			}
+		}()
+	}
+
+	h, done := newKubernetesHandlers(gcfg, opts)
+	reqGen := func(inflightReqs chan<- Request) { generateKubernetes(opts, vals, inflightReqs) }
+	err := cfg.generateReport(gcfg, h, done, reqGen)
+
+	// let watchers catch up
+	time.Sleep(time.Second)
+	cancel()
+	wg.Wait()
+
+	cfg.lg.Info("watch results",
+		zap.Int64("watchers", opts.KubernetesWatchers),
+		zap.Int64("events", atomic.LoadInt64(&ws.events)),
+		zap.Int64("resource-version-violations", atomic.LoadInt64(&ws.violations)),
+	)
+	if err != nil {
+		return err
+	}
+	if n := atomic.LoadInt64(&ws.violations); n > 0 {
+		return fmt.Errorf("watchers observed %d non-increasing resource versions", n)
+	}
+	return nil
+}
+
+// generateKubernetes generates LIST requests, and updates to hot objects.
+func generateKubernetes(opts dbtesterpb.ConfigClientMachineBenchmarkOptions, vals values, inflightReqs chan<- Request) {
+	defer close(inflightReqs)
+
+	pacer := benchrunner.NewPacer(opts.RateLimitRequestsPerSecond)
+	rnd := mrand.New(mrand.NewSource(time.Now().UnixNano()))
+
+	for i := int64(0); i < opts.RequestNumber; i++ {
+		intended := pacer.Wait(context.TODO(), i)
+		var op clientv3.Op
+		if rnd.Float64() < opts.KubernetesListRatio {
+			op = clientv3.OpGet(kubernetesPrefix, clientv3.WithPrefix(), clientv3.WithLimit(kubernetesListPageSize))
+		} else {
+			op = clientv3.OpPut(kubernetesKey(rnd.Int63n(opts.KubernetesHotObjects)), vals.strings[i%int64(vals.sampleSize)])
+		}
+		inflightReqs <- Request{etcdv3Op: op, intendedStart: intended}
+	}
+}
+
+func newKubernetesHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl, opts dbtesterpb.ConfigClientMachineBenchmarkOptions) (rhs []ReqHandler, done func()) {
+	clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+		totalConns:   opts.ConnectionNumber,
+		totalClients: opts.ClientNumber,
+		compression:  opts.EtcdCompression,
+	})
+	rhs = make([]ReqHandler, len(clients))
+	for i := range clients {
+		rhs[i] = newKubernetesHandler(clients[i].KV)
+	}
+	done = func() {
+		for i := range clients {
+			clients[i].Close()
+		}
+	}
+	return rhs, done
+}
+
+// newKubernetesHandler handles LIST with pagination at a consistent
+// revision, and updates with compare-and-swap on the mod revision,
+// as the API server's GuaranteedUpdate does.
+func newKubernetesHandler(kv clientv3.KV) ReqHandler {
+	var lastRev int64
+	checkRev := func(rev int64) error {
+		if rev < lastRev {
+			return fmt.Errorf("resource version decreased from %d to %d", lastRev, rev)
+		}
+		lastRev = rev
+		return nil
+	}
+
+	return func(ctx context.Context, req *Request) error {
+		op := req.etcdv3Op
+		if op.IsGet() {
+			key, rev := string(op.KeyBytes()), int64(0)
+			for {
+				opts := []clientv3.OpOption{clientv3.WithRange(clientv3.GetPrefixRangeEnd(kubernetesPrefix)), clientv3.WithLimit(kubernetesListPageSize)}
+				if rev > 0 {
+					opts = append(opts, clientv3.WithRev(rev))
+				}
+				resp, err := kv.Get(ctx, key, opts...)
+				if err != nil {
+					return err
+				}
+				if rev == 0 {
+					rev = resp.Header.Revision
+					if err = checkRev(rev); err != nil {
+						return err
+					}
+				}
+				if !resp.More || len(resp.Kvs) == 0 {
+					return nil
+				}
+				key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+			}
+		}
+
+		key := string(op.KeyBytes())
+		for {
+			gresp, err := kv.Get(ctx, key)
+			if err != nil {
+				return err
+			}
+			if err = checkRev(gresp.Header.Revision); err != nil {
+				return err
+			}
+			modRev := int64(0)
+			if len(gresp.Kvs) > 0 {
+				modRev = gresp.Kvs[0].ModRevision
+			}
+			tresp, err := kv.Txn(ctx).
+				If(clientv3.Compare(clientv3.ModRevision(key), "=", modRev)).
+				Then(clientv3.OpPut(key, string(op.ValueBytes()))).
+				Commit()
+			if err != nil {
+				return err
+			}
+			if err = checkRev(tresp.Header.Revision); err != nil {
+				return err
+			}
+			if tresp.Succeeded {
+				return nil
+			}
+			// conflict with another client, retry as the API server does
+		}
+	}
+}
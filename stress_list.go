@@ -0,0 +1,218 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/samuel/go-zookeeper/zk"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+const (
+	defaultListPageSize   = 500
+	defaultListIterations = 10
+)
+
+// listKeyspace loads 'request_number' keys, and then measures full-keyspace
+// enumerations, as controllers listing everything do. Each request of
+// the report is one full enumeration.
+func (cfg *Config) listKeyspace(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	loaded := gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber
+	cfg.lg.Info("loading keys before listing", zap.Int64("keys", loaded))
+	h, done := newWriteHandlers(cfg.lg, gcfg)
+	reqGen := func(inflightReqs chan<- Request) { generateWrites(gcfg, 0, vals, inflightReqs) }
+	b := newBenchmark(loaded, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber, h, done, reqGen)
+	b.setErrorBudget(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.setTracer(cfg.lg, gcfg)
+	b.setClientDelays(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.setPriorities(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.pauser = cfg.pauser
+	b.ctx = cfg.stressCtx
+	b.recorder = cfg.recorder
+	b.phases = cfg.phases
+	b.clientWrites = cfg.clientWrites
+	b.clientReads = cfg.clientReads
+	b.startRequests()
+	b.waitAll()
+	if b.abortErr != nil {
+		return b.abortErr
+	}
+
+	opts := *gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.ListPageSize == 0 {
+		opts.ListPageSize = defaultListPageSize
+	}
+	if opts.ListIterations == 0 {
+		opts.ListIterations = defaultListIterations
+	}
+	opts.RequestNumber = opts.ListIterations
+	copied := gcfg
+	copied.ConfigClientMachineBenchmarkOptions = &opts
+
+	var pages, keys int64
+	lh, ldone := newListHandlers(copied, func(p, k int64) {
+		atomic.AddInt64(&pages, p)
+		atomic.AddInt64(&keys, k)
+	})
+	lreqGen := func(inflightReqs chan<- Request) {
+		defer close(inflightReqs)
+		for i := int64(0); i < opts.ListIterations; i++ {
+			inflightReqs <- Request{}
+		}
+	}
+	cfg.lg.Info("listing keyspace", zap.Int64("iterations", opts.ListIterations), zap.Int64("page-size", opts.ListPageSize))
+	err := cfg.generateReport(copied, lh, ldone, lreqGen)
+	cfg.lg.Info("listed keyspace",
+		zap.Int64("pages-per-iteration", pages/opts.ListIterations),
+		zap.Int64("keys-per-iteration", keys/opts.ListIterations),
+		zap.Int64("loaded-keys", loaded),
+	)
+	return err
+}
+
+// newListHandlers creates handlers that enumerate the whole keyspace,
+// reporting the number of pages and keys of each enumeration.
+func newListHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl, observe func(pages, keys int64)) (rhs []ReqHandler, done func()) {
+	pageSize := gcfg.ConfigClientMachineBenchmarkOptions.ListPageSize
+	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	switch gcfg.DatabaseID {
+	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+			compression:  gcfg.ConfigClientMachineBenchmarkOptions.EtcdCompression,
+		})
+		for i := range clients {
+			rhs[i] = newListEtcd3(clients[i].KV, pageSize, observe)
+		}
+		done = func() {
+			for i := range clients {
+				clients[i].Close()
+			}
+		}
+
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		for i := range rhs {
+			rhs[i] = newListZK(conns[i%len(conns)], pageSize, observe)
+		}
+		done = func() {
+			for i := range conns {
+				conns[i].Close()
+			}
+		}
+
+	case "consul__v1_0_2", "cetcd__beta":
+		conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		for i := range rhs {
+			rhs[i] = newListConsul(conns[i%len(conns)], observe)
+		}
+
+	default:
+		panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
+	}
+	return rhs, done
+}
+
+// newListEtcd3 pages through the keyspace with ranged gets,
+// continuing from the last key of each page.
+func newListEtcd3(conn clientv3.KV, pageSize int64, observe func(pages, keys int64)) ReqHandler {
+	return func(ctx context.Context, req *Request) error {
+		var pages, keys int64
+		key := "\x00"
+		for {
+			resp, err := conn.Get(ctx, key, clientv3.WithFromKey(), clientv3.WithLimit(pageSize))
+			if err != nil {
+				return err
+			}
+			pages++
+			keys += int64(len(resp.Kvs))
+			if !resp.More || len(resp.Kvs) == 0 {
+				break
+			}
+			key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+		}
+		observe(pages, keys)
+		return nil
+	}
+}
+
+// newListZK lists children of the root, and then gets their data
+// in batches of 'pageSize' concurrent requests, since ZooKeeper
+// has no paginated reads.
+func newListZK(conn *zk.Conn, pageSize int64, observe func(pages, keys int64)) ReqHandler {
+	return func(ctx context.Context, req *Request) error {
+		children, _, err := conn.Children("/")
+		if err != nil {
+			return err
+		}
+		var names []string
+		for _, c := range children {
+			if c != "zookeeper" {
+				names = append(names, c)
+			}
+		}
+
+		var pages int64
+		keys := int64(len(names))
+		for len(names) > 0 {
+			n := int(pageSize)
+			if n > len(names) {
+				n = len(names)
+			}
+			var wg sync.WaitGroup
+			errc := make(chan error, n)
+			for _, name := range names[:n] {
+				wg.Add(1)
+				go func(p string) {
+					defer wg.Done()
+					if _, _, gerr := conn.Get(p); gerr != nil && gerr != zk.ErrNoNode {
+						errc <- gerr
+					}
+				}("/" + name)
+			}
+			wg.Wait()
+			close(errc)
+			if gerr := <-errc; gerr != nil {
+				return gerr
+			}
+			pages++
+			names = names[n:]
+		}
+		observe(pages, keys)
+		return nil
+	}
+}
+
+// newListConsul lists all keys with values in one request,
+// since Consul KV has no paginated reads.
+func newListConsul(conn *consulapi.KV, observe func(pages, keys int64)) ReqHandler {
+	return func(ctx context.Context, req *Request) error {
+		kvs, _, err := conn.List("", nil)
+		if err != nil {
+			return err
+		}
+		observe(1, int64(len(kvs)))
+		return nil
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/benchrunner"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/pkg/report"
+	"github.com/gyuho/dataframe"
+	"golang.org/x/net/context"
+)
+
+// readConsistencyRecorder records latencies of serializable and
+// linearizable reads separately, which are interleaved in one run.
+type readConsistencyRecorder struct {
+	mu sync.Mutex
+	// latencies in seconds
+	serializable []float64
+	linearizable []float64
+
+	serializableErrs int64
+	linearizableErrs int64
+}
+
+func (r *readConsistencyRecorder) observe(serializable bool, took time.Duration, err error) {
+	r.mu.Lock()
+	switch {
+	case serializable && err != nil:
+		r.serializableErrs++
+	case serializable:
+		r.serializable = append(r.serializable, took.Seconds())
+	case err != nil:
+		r.linearizableErrs++
+	default:
+		r.linearizable = append(r.linearizable, took.Seconds())
+	}
+	r.mu.Unlock()
+}
+
+// newReadConsistencyHandlers creates etcd handlers that
+// record read latencies by the consistency of each request.
+func newReadConsistencyHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func(), rec *readConsistencyRecorder) {
+	clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+		totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+		totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+		compression:  gcfg.ConfigClientMachineBenchmarkOptions.EtcdCompression,
+	})
+	rec = &readConsistencyRecorder{}
+	rhs = make([]ReqHandler, len(clients))
+	for i := range clients {
+		rhs[i] = newReadConsistencyEtcd3(clients[i].KV, rec)
+	}
+	done = func() {
+		for i := range clients {
+			clients[i].Close()
+		}
+	}
+	return rhs, done, rec
+}
+
+func newReadConsistencyEtcd3(conn clientv3.KV, rec *readConsistencyRecorder) ReqHandler {
+	return func(ctx context.Context, req *Request) error {
+		now := time.Now()
+		_, err := conn.Do(ctx, req.etcdv3Op)
+		rec.observe(req.etcdv3Op.IsSerializable(), time.Since(now), err)
+		return err
+	}
+}
+
+// generateReadConsistency alternates serializable and linearizable reads
+// of the same key, so that both share the rate limit, the clients, and
+// the cluster state over time, and only differ in read consistency.
+func generateReadConsistency(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, inflightReqs chan<- Request) {
+	defer close(inflightReqs)
+
+	pacer := benchrunner.NewPacer(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond)
+
+	for i := int64(0); i < gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; i++ {
+		intended := pacer.Wait(context.TODO(), i)
+		var opts []clientv3.OpOption
+		if i%2 == 0 {
+			opts = append(opts, clientv3.WithSerializable())
+		}
+		inflightReqs <- Request{etcdv3Op: clientv3.OpGet(key, opts...), intendedStart: intended}
+	}
+}
+
+// saveReadConsistency saves serializable and linearizable read latency
+// percentiles side by side, with the cost of linearizability.
+func (cfg *Config) saveReadConsistency(rec *readConsistencyRecorder) error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	cfg.lg.Sugar().Infof("read-consistency results [serializable: %d (errors %d) | linearizable: %d (errors %d)]",
+		len(rec.serializable), rec.serializableErrs, len(rec.linearizable), rec.linearizableErrs)
+
+	sort.Float64s(rec.serializable)
+	sort.Float64s(rec.linearizable)
+	pctls, sers := report.Percentiles(rec.serializable)
+	_, lins := report.Percentiles(rec.linearizable)
+
+	c1 := dataframe.NewColumn("LATENCY-PERCENTILE")
+	c2 := dataframe.NewColumn("SERIALIZABLE-LATENCY-MS")
+	c3 := dataframe.NewColumn("LINEARIZABLE-LATENCY-MS")
+	c4 := dataframe.NewColumn("LINEARIZABLE-OVERHEAD-MS")
+	push := func(name string, ser, lin float64) {
+		c1.PushBack(dataframe.NewStringValue(name))
+		c2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", 1000*ser)))
+		c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", 1000*lin)))
+		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%f", 1000*(lin-ser))))
+	}
+	for i := range pctls {
+		pct := fmt.Sprintf("p%.1f", pctls[i])
+		if strings.HasSuffix(pct, ".0") {
+			pct = strings.Replace(pct, ".0", "", -1)
+		}
+		push(pct, sers[i], lins[i])
+	}
+	push("avg", average(rec.serializable), average(rec.linearizable))
+
+	fr := dataframe.New()
+	for _, col := range []dataframe.Column{c1, c2, c3, c4} {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientReadConsistencyPath)
+}
+
+func average(vs []float64) float64 {
+	if len(vs) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range vs {
+		sum += v
+	}
+	return sum / float64(len(vs))
+}
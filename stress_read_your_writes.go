@@ -0,0 +1,237 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/pkg/report"
+	"github.com/gyuho/dataframe"
+	consulapi "github.com/hashicorp/consul/api"
+	"github.com/samuel/go-zookeeper/zk"
+	"golang.org/x/net/context"
+)
+
+// readYourWritesTimeout is how long to wait for a write
+// to be visible from the other endpoint.
+const readYourWritesTimeout = 10 * time.Second
+
+// rywClient writes to one endpoint, and reads
+// from another endpoint without linearizability.
+type rywClient struct {
+	put func(ctx context.Context, key string, value []byte) error
+	// visible returns true if the key has the value
+	visible func(ctx context.Context, key string, value []byte) (bool, error)
+}
+
+// rywRecorder records how long writes take to become
+// visible to stale reads from other endpoints.
+type rywRecorder struct {
+	mu              sync.Mutex
+	total           int64
+	staleFirstReads int64
+	timeouts        int64
+	// staleness windows of stale first reads, in seconds
+	windows []float64
+}
+
+func (r *rywRecorder) observe(staleFirstRead bool, window time.Duration) {
+	r.mu.Lock()
+	r.total++
+	if staleFirstRead {
+		r.staleFirstReads++
+		r.windows = append(r.windows, window.Seconds())
+	}
+	r.mu.Unlock()
+}
+
+func (r *rywRecorder) timeout() {
+	r.mu.Lock()
+	r.total++
+	r.staleFirstReads++
+	r.timeouts++
+	r.mu.Unlock()
+}
+
+// newReadYourWritesHandlers creates handlers that write a key, and then
+// read it from the next endpoint until the write is visible.
+func newReadYourWritesHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func(), rec *rywRecorder) {
+	var closers []func()
+	readEps := gcfg.DatabaseEndpoints
+	if gcfg.DatabaseID == "zookeeper__r3_5_3_beta" || gcfg.DatabaseID == "zetcd__beta" {
+		readEps = zkReadEndpoints(gcfg)
+	}
+
+	rec = &rywRecorder{}
+	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	for i := range rhs {
+		wep := gcfg.DatabaseEndpoints[i%len(gcfg.DatabaseEndpoints)]
+		rep := readEps[(i+1)%len(readEps)]
+
+		var c rywClient
+		switch gcfg.DatabaseID {
+		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+			dopts := etcdv3CompressionDialOptions(gcfg.ConfigClientMachineBenchmarkOptions.EtcdCompression)
+			wc, rc := mustCreateConnEtcdv3([]string{wep}, dopts...), mustCreateConnEtcdv3([]string{rep}, dopts...)
+			closers = append(closers, func() { wc.Close() }, func() { rc.Close() })
+			c.put = func(ctx context.Context, key string, value []byte) error {
+				_, err := wc.Put(ctx, key, string(value))
+				return err
+			}
+			c.visible = func(ctx context.Context, key string, value []byte) (bool, error) {
+				resp, err := rc.Get(ctx, key, clientv3.WithSerializable())
+				if err != nil {
+					return false, err
+				}
+				return len(resp.Kvs) > 0 && bytes.Equal(resp.Kvs[0].Value, value), nil
+			}
+
+		case "zookeeper__r3_5_3_beta", "zetcd__beta":
+			wc, rc := mustCreateConnsZk([]string{wep}, 1)[0], mustCreateConnsZk([]string{rep}, 1)[0]
+			closers = append(closers, wc.Close, rc.Close)
+			c.put = func(ctx context.Context, key string, value []byte) error {
+				_, err := wc.Create("/"+key, value, zkCreateFlags, zkCreateACL)
+				return err
+			}
+			c.visible = func(ctx context.Context, key string, value []byte) (bool, error) {
+				// no sync, to read from the local replica
+				data, _, err := rc.Get("/" + key)
+				if err == zk.ErrNoNode {
+					return false, nil
+				}
+				if err != nil {
+					return false, err
+				}
+				return bytes.Equal(data, value), nil
+			}
+
+		case "consul__v1_0_2", "cetcd__beta":
+			wc, rc := mustCreateConnsConsul([]string{wep}, 1)[0], mustCreateConnsConsul([]string{rep}, 1)[0]
+			c.put = func(ctx context.Context, key string, value []byte) error {
+				_, err := wc.Put(&consulapi.KVPair{Key: key, Value: value}, nil)
+				return err
+			}
+			c.visible = func(ctx context.Context, key string, value []byte) (bool, error) {
+				kv, _, err := rc.Get(key, &consulapi.QueryOptions{AllowStale: true})
+				if err != nil {
+					return false, err
+				}
+				return kv != nil && bytes.Equal(kv.Value, value), nil
+			}
+
+		default:
+			panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
+		}
+		rhs[i] = newReadYourWrites(c, rec)
+	}
+
+	done = func() {
+		for _, c := range closers {
+			c()
+		}
+	}
+	return rhs, done, rec
+}
+
+func newReadYourWrites(c rywClient, rec *rywRecorder) ReqHandler {
+	return func(ctx context.Context, req *Request) error {
+		key, value := requestKeyValue(req)
+		if err := c.put(ctx, key, value); err != nil {
+			return err
+		}
+		acked := time.Now()
+		for reads := 0; ; reads++ {
+			ok, err := c.visible(ctx, key, value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				rec.observe(reads > 0, time.Since(acked))
+				return nil
+			}
+			if time.Since(acked) > readYourWritesTimeout {
+				rec.timeout()
+				return fmt.Errorf("write %q is not visible after %v", key, readYourWritesTimeout)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+// requestKeyValue returns the key and value of a write request.
+func requestKeyValue(req *Request) (string, []byte) {
+	switch {
+	case req.etcdv3Op.IsPut():
+		return string(req.etcdv3Op.KeyBytes()), req.etcdv3Op.ValueBytes()
+	case req.zkOp.key != "":
+		return strings.TrimPrefix(req.zkOp.key, "/"), req.zkOp.value
+	default:
+		return req.consulOp.key, req.consulOp.value
+	}
+}
+
+// saveReadYourWrites saves the ratio of stale first reads,
+// and the distribution of staleness windows.
+func (cfg *Config) saveReadYourWrites(rec *rywRecorder) error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	ratio := 0.0
+	if rec.total > 0 {
+		ratio = float64(rec.staleFirstReads) / float64(rec.total)
+	}
+	sort.Float64s(rec.windows)
+	pctls, seconds := report.Percentiles(rec.windows)
+
+	fr := dataframe.New()
+	add := func(header string, v interface{}) error {
+		col := dataframe.NewColumn(header)
+		col.PushBack(dataframe.NewStringValue(v))
+		return fr.AddColumn(col)
+	}
+	if err := add("TOTAL-WRITES", rec.total); err != nil {
+		return err
+	}
+	if err := add("STALE-FIRST-READS", rec.staleFirstReads); err != nil {
+		return err
+	}
+	if err := add("STALE-FIRST-READ-RATIO", fmt.Sprintf("%4.4f", ratio)); err != nil {
+		return err
+	}
+	if err := add("VISIBILITY-TIMEOUTS", rec.timeouts); err != nil {
+		return err
+	}
+	for i := range pctls {
+		if err := add(fmt.Sprintf("STALENESS-WINDOW-P%g-MS", pctls[i]), fmt.Sprintf("%4.4f", 1000*seconds[i])); err != nil {
+			return err
+		}
+	}
+	maxWindow := 0.0
+	if len(rec.windows) > 0 {
+		maxWindow = rec.windows[len(rec.windows)-1]
+	}
+	if err := add("STALENESS-WINDOW-MAX-MS", fmt.Sprintf("%4.4f", 1000*maxWindow)); err != nil {
+		return err
+	}
+	return fr.CSVHorizontal(cfg.ConfigClientMachineInitial.ClientReadYourWritesPath)
+}
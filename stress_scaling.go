@@ -0,0 +1,179 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// MembershipChangesColumns defines membership changes columns.
+var MembershipChangesColumns = []string{
+	"UNIX-SECOND",
+	"FROM-MEMBER-NUMBER",
+	"TO-MEMBER-NUMBER",
+	"TOOK-SECONDS",
+}
+
+type membershipChange struct {
+	unixSecond int64
+	from, to   int64
+	took       time.Duration
+}
+
+// memberNumber returns the number of current members
+// when cluster is reconfigured with 'scaling_member_numbers'.
+func (cfg *Config) memberNumber(databaseID string) int64 {
+	if n, ok := cfg.memberNumbers[databaseID]; ok {
+		return n
+	}
+	return cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].ConfigClientMachineBenchmarkOptions.ScalingMemberNumbers[0]
+}
+
+// scaleMembers grows or shrinks the cluster, by the sequence of
+// 'scaling_member_numbers', until all done or 'stopc' is closed.
+func (cfg *Config) scaleMembers(gcfg dbtesterpb.ConfigClientMachineAgentControl, stopc <-chan struct{}) (changes []membershipChange) {
+	switch gcfg.DatabaseID {
+	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+	default:
+		// rejected by 'ReadConfig', for configurations built otherwise
+		cfg.lg.Warn("scaling members is only supported for etcd", zap.String("database-id", gcfg.DatabaseID))
+		return nil
+	}
+	nums := gcfg.ConfigClientMachineBenchmarkOptions.ScalingMemberNumbers
+	interval := time.Duration(gcfg.ConfigClientMachineBenchmarkOptions.ScalingIntervalSeconds) * time.Second
+
+	cur := nums[0]
+	defer func() {
+		if cfg.memberNumbers == nil {
+			cfg.memberNumbers = make(map[string]int64)
+		}
+		cfg.memberNumbers[gcfg.DatabaseID] = cur
+	}()
+
+	for _, n := range nums[1:] {
+		select {
+		case <-stopc:
+			cfg.lg.Info("stopped scaling members", zap.Int64("member-number", cur))
+			return changes
+		case <-time.After(interval):
+		}
+
+		cfg.lg.Info("reconfiguring cluster", zap.Int64("from", cur), zap.Int64("to", n))
+		from, now := cur, time.Now()
+		for cur < n {
+			if err := cfg.addMemberEtcd(gcfg, int(cur)); err != nil {
+				cfg.lg.Warn("failed to add member", zap.Int64("index", cur), zap.Error(err))
+				return changes
+			}
+			cur++
+		}
+		for cur > n {
+			if err := cfg.removeMemberEtcd(gcfg, int(cur-1)); err != nil {
+				cfg.lg.Warn("failed to remove member", zap.Int64("index", cur-1), zap.Error(err))
+				return changes
+			}
+			cur--
+		}
+		took := time.Since(now)
+		cfg.lg.Info("reconfigured cluster", zap.Int64("from", from), zap.Int64("to", cur), zap.Duration("took", took))
+		changes = append(changes, membershipChange{unixSecond: now.Unix(), from: from, to: cur, took: took})
+	}
+	return changes
+}
+
+// addMemberEtcd adds the member of the index, and starts it via the agent.
+func (cfg *Config) addMemberEtcd(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int) error {
+	cli := mustCreateConnEtcdv3(gcfg.DatabaseEndpoints[:idx])
+	defer cli.Close()
+
+	peerURL := fmt.Sprintf("http://%s:%d", gcfg.PeerIPs[idx], 2380+cfg.ConfigClientMachineInitial.PortOffset)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	resp, err := cli.MemberAdd(ctx, []string{peerURL})
+	cancel()
+	if err != nil {
+		return err
+	}
+	cfg.lg.Info("added member", zap.String("peer-url", peerURL), zap.Uint64("id", resp.Member.ID))
+
+	_, err = cfg.sendRequests(cfg.stressContext(), gcfg.DatabaseID, dbtesterpb.Operation_Start, []int{idx}, int64(idx+1), true)
+	return err
+}
+
+// removeMemberEtcd removes the member of the index, and stops it via the agent.
+func (cfg *Config) removeMemberEtcd(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int) error {
+	cli := mustCreateConnEtcdv3(gcfg.DatabaseEndpoints[:idx+1])
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	resp, err := cli.MemberList(ctx)
+	cancel()
+	if err != nil {
+		return err
+	}
+	var id uint64
+	name := fmt.Sprintf("etcd-%d", idx+1)
+	for _, m := range resp.Members {
+		if m.Name == name {
+			id = m.ID
+			break
+		}
+	}
+	if id == 0 {
+		return fmt.Errorf("member %q is not found", name)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	_, err = cli.MemberRemove(ctx, id)
+	cancel()
+	if err != nil {
+		return err
+	}
+	cfg.lg.Info("removed member", zap.String("name", name), zap.Uint64("id", id))
+
+	_, err = cfg.sendRequests(cfg.stressContext(), gcfg.DatabaseID, dbtesterpb.Operation_Stop, []int{idx}, int64(idx), false)
+	return err
+}
+
+func (cfg *Config) saveMembershipChanges(changes []membershipChange) error {
+	if cfg.ConfigClientMachineInitial.ClientMembershipChangesPath == "" {
+		return nil
+	}
+
+	c1 := dataframe.NewColumn(MembershipChangesColumns[0])
+	c2 := dataframe.NewColumn(MembershipChangesColumns[1])
+	c3 := dataframe.NewColumn(MembershipChangesColumns[2])
+	c4 := dataframe.NewColumn(MembershipChangesColumns[3])
+	for _, c := range changes {
+		c1.PushBack(dataframe.NewStringValue(c.unixSecond))
+		c2.PushBack(dataframe.NewStringValue(c.from))
+		c3.PushBack(dataframe.NewStringValue(c.to))
+		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", c.took.Seconds())))
+	}
+
+	fr := dataframe.New()
+	for _, col := range []dataframe.Column{c1, c2, c3, c4} {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientMembershipChangesPath)
+}
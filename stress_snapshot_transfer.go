@@ -0,0 +1,274 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/benchrunner"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// SnapshotTransferSummaryColumns defines snapshot transfer summary columns.
+var SnapshotTransferSummaryColumns = []string{
+	"KEY-NUMBER",
+	"MEMBER-INDEX",
+	"STOPPED-SECONDS",
+	"CATCH-UP-SECONDS",
+	"RECEIVED-SIZE",
+	"RECEIVED-SIZE-BYTES-NUM",
+	"LEADER-BASELINE-AVG-MS",
+	"LEADER-BASELINE-P99-MS",
+	"LEADER-CATCH-UP-AVG-MS",
+	"LEADER-CATCH-UP-P99-MS",
+}
+
+// etcdSnapshotCatchUpEntries is the number of Raft entries etcd keeps
+// after a snapshot for slow followers to catch up without snapshot.
+const etcdSnapshotCatchUpEntries = 5000
+
+// snapshotTransferBaseline is how long the leader latency is
+// measured before restarting the stopped member.
+const snapshotTransferBaseline = 5 * time.Second
+
+// snapshotTransfer stops a follower, writes 'request_number' keys to the
+// rest of the cluster, and restarts the follower. It measures the time for
+// the follower to catch up (e.g. by snapshot transfer), the bytes received
+// by the follower host meanwhile, and the write latency on the leader.
+func (cfg *Config) snapshotTransfer(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	leader, follower := -1, -1
+	for i, r := range memberRoles(gcfg) {
+		switch r {
+		case roleLeader:
+			leader = i
+		case roleFollower:
+			follower = i
+		}
+	}
+	if leader < 0 || follower < 0 {
+		return fmt.Errorf("cannot find leader and follower of %q (leader %d, follower %d)", gcfg.DatabaseID, leader, follower)
+	}
+
+	// clients connect to the members that keep running
+	copied := gcfg
+	copied.DatabaseEndpoints = nil
+	for i, ep := range gcfg.DatabaseEndpoints {
+		if i != follower {
+			copied.DatabaseEndpoints = append(copied.DatabaseEndpoints, ep)
+		}
+	}
+
+	cfg.lg.Info("stopping follower", zap.Int("index", follower), zap.String("endpoint", gcfg.DatabaseEndpoints[follower]))
+	cfg.phases.begin(PhaseFaultInjection)
+	stoppedAt := time.Now()
+	if _, err := cfg.sendRequests(cfg.stressContext(), gcfg.DatabaseID, dbtesterpb.Operation_StopMember, []int{follower}, 0, false); err != nil {
+		return err
+	}
+
+	keyN := gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber
+	cfg.lg.Info("writing keys while follower is stopped", zap.Int64("key-number", keyN))
+	h, done := newWriteHandlers(cfg.lg, copied)
+	reqGen := func(inflightReqs chan<- Request) { generateWrites(copied, 0, vals, inflightReqs) }
+	b := newBenchmark(keyN, copied.ConfigClientMachineBenchmarkOptions.ClientNumber, h, done, reqGen)
+	b.setErrorBudget(copied.ConfigClientMachineBenchmarkOptions)
+	b.setTracer(cfg.lg, copied)
+	b.setClientDelays(copied.ConfigClientMachineBenchmarkOptions)
+	b.setPriorities(copied.ConfigClientMachineBenchmarkOptions)
+	b.pauser = cfg.pauser
+	b.ctx = cfg.stressCtx
+	b.recorder = cfg.recorder
+	b.phases = cfg.phases
+	b.clientWrites = cfg.clientWrites
+	b.clientReads = cfg.clientReads
+	b.startRequests()
+	b.waitAll()
+	if b.abortErr != nil {
+		return b.abortErr
+	}
+
+	probe := startLeaderProbe(cfg.lg, gcfg, leader, keyN)
+	time.Sleep(snapshotTransferBaseline)
+	baseline := probe.take()
+
+	cfg.lg.Info("restarting follower", zap.Int("index", follower))
+	cfg.phases.begin(PhaseRecovery)
+	stopped := time.Since(stoppedAt)
+	resp, err := cfg.sendRequests(cfg.stressContext(), gcfg.DatabaseID, dbtesterpb.Operation_RestartMember, []int{follower}, 0, false)
+	catchUp := probe.stop()
+	if err != nil {
+		return err
+	}
+	cfg.phases.begin(PhaseLoad)
+
+	took := time.Duration(resp[follower].TookNanoseconds)
+	received := resp[follower].ReceivedBytes
+	baseAvg, baseP99 := latencyStats(baseline)
+	catchUpAvg, catchUpP99 := latencyStats(catchUp)
+	cfg.lg.Info("follower caught up",
+		zap.Int64("key-number", keyN),
+		zap.Duration("took", took),
+		zap.String("received", humanize.Bytes(uint64(received))),
+		zap.Duration("leader-baseline-p99", baseP99),
+		zap.Duration("leader-catch-up-p99", catchUpP99),
+	)
+
+	row := []string{
+		fmt.Sprint(keyN),
+		fmt.Sprint(follower),
+		fmt.Sprintf("%4.4f", stopped.Seconds()),
+		fmt.Sprintf("%4.4f", took.Seconds()),
+		humanize.Bytes(uint64(received)),
+		fmt.Sprint(received),
+		fmt.Sprintf("%4.4f", toMillisecond(baseAvg)),
+		fmt.Sprintf("%4.4f", toMillisecond(baseP99)),
+		fmt.Sprintf("%4.4f", toMillisecond(catchUpAvg)),
+		fmt.Sprintf("%4.4f", toMillisecond(catchUpP99)),
+	}
+	fr := dataframe.New()
+	for i, name := range SnapshotTransferSummaryColumns {
+		col := dataframe.NewColumn(name)
+		col.PushBack(dataframe.NewStringValue(row[i]))
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientSnapshotTransferSummaryPath)
+}
+
+// etcdSnapshotCount returns the '--snapshot-count' of etcd,
+// 0 for other databases.
+func etcdSnapshotCount(gcfg dbtesterpb.ConfigClientMachineAgentControl) int64 {
+	switch gcfg.DatabaseID {
+	case "etcd__other":
+		if gcfg.Flag_Etcd_Other != nil {
+			return gcfg.Flag_Etcd_Other.SnapshotCount
+		}
+	case "etcd__tip":
+		if gcfg.Flag_Etcd_Tip != nil {
+			return gcfg.Flag_Etcd_Tip.SnapshotCount
+		}
+	case "etcd__v3_2":
+		if gcfg.Flag_Etcd_V3_2 != nil {
+			return gcfg.Flag_Etcd_V3_2.SnapshotCount
+		}
+	case "etcd__v3_3":
+		if gcfg.Flag_Etcd_V3_3 != nil {
+			return gcfg.Flag_Etcd_V3_3.SnapshotCount
+		}
+	}
+	return 0
+}
+
+// memberRoles returns the current role of each member.
+func memberRoles(gcfg dbtesterpb.ConfigClientMachineAgentControl) []string {
+	switch gcfg.DatabaseID {
+	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		cli := mustCreateConnEtcdv3(gcfg.DatabaseEndpoints)
+		defer cli.Close()
+		return rolesEtcdv3(cli, gcfg.DatabaseEndpoints)
+	case "zookeeper__r3_5_3_beta":
+		return rolesZk(gcfg.DatabaseEndpoints)
+	case "consul__v1_0_2":
+		return rolesConsul(gcfg.DatabaseEndpoints)
+	}
+	return nil
+}
+
+// leaderProbe sends writes to the leader one at a time,
+// and records their latencies.
+type leaderProbe struct {
+	mu   sync.Mutex
+	lats []time.Duration
+
+	stopc chan struct{}
+	donec chan struct{}
+}
+
+// startLeaderProbe starts writing keys after 'startIdx' to the leader.
+func startLeaderProbe(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl, leader int, startIdx int64) *leaderProbe {
+	opts := *gcfg.ConfigClientMachineBenchmarkOptions
+	opts.ClientNumber, opts.ConnectionNumber = 1, 1
+	gcfg.ConfigClientMachineBenchmarkOptions = &opts
+	gcfg.DatabaseEndpoints = []string{gcfg.DatabaseEndpoints[leader]}
+	h, done := newWriteHandlers(lg, gcfg)
+
+	p := &leaderProbe{stopc: make(chan struct{}), donec: make(chan struct{})}
+	go func() {
+		defer close(p.donec)
+		defer done()
+		for i := startIdx; ; i++ {
+			select {
+			case <-p.stopc:
+				return
+			default:
+			}
+			req, err := NewPutRequest(gcfg.DatabaseID, benchrunner.SequentialKey(opts.KeySizeBytes, i), []byte("probe"))
+			if err != nil {
+				lg.Warn("failed to create probe request", zap.Error(err))
+				return
+			}
+			now := time.Now()
+			if err = h[0](context.Background(), &req); err != nil {
+				lg.Warn("leader probe failed", zap.Error(err))
+				time.Sleep(100 * time.Millisecond)
+				continue
+			}
+			p.mu.Lock()
+			p.lats = append(p.lats, time.Since(now))
+			p.mu.Unlock()
+		}
+	}()
+	return p
+}
+
+// take returns the latencies so far, and resets them.
+func (p *leaderProbe) take() []time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	lats := p.lats
+	p.lats = nil
+	return lats
+}
+
+// stop stops the probe, and returns the latencies since last take.
+func (p *leaderProbe) stop() []time.Duration {
+	close(p.stopc)
+	<-p.donec
+	return p.take()
+}
+
+// latencyStats returns the average and 99th percentile latency.
+func latencyStats(lats []time.Duration) (avg, p99 time.Duration) {
+	if len(lats) == 0 {
+		return 0, 0
+	}
+	sorted := make([]time.Duration, len(lats))
+	copy(sorted, lats)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var sum time.Duration
+	for _, l := range sorted {
+		sum += l
+	}
+	return sum / time.Duration(len(sorted)), sorted[(len(sorted)-1)*99/100]
+}
@@ -0,0 +1,322 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/benchrunner"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+const (
+	defaultTenancyReaderKeys = 1000
+
+	// tenancyReaderPrefix is the prefix of keys that the reader reads
+	tenancyReaderPrefix = "tenant-reader-"
+	// tenancyWriterPrefix is the prefix of keys that the bulk writer writes
+	tenancyWriterPrefix = "tenant-writer-"
+)
+
+// Phases of 'tenancy' reads.
+const (
+	tenancyPhaseIsolated = iota
+	tenancyPhaseContended
+)
+
+var tenancyPhaseNames = []string{"isolated", "contended"}
+
+// tenancyOptions returns the benchmark options with defaults.
+func tenancyOptions(gcfg dbtesterpb.ConfigClientMachineAgentControl) dbtesterpb.ConfigClientMachineBenchmarkOptions {
+	opts := *gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.TenancyReaderKeys == 0 {
+		opts.TenancyReaderKeys = defaultTenancyReaderKeys
+	}
+	if opts.TenancyWriterClients == 0 {
+		opts.TenancyWriterClients = opts.ClientNumber
+	}
+	return opts
+}
+
+// tenancyRecorder records reader latencies by whether
+// the bulk writer was running, and the writer throughput.
+type tenancyRecorder struct {
+	// contended is 1 while the bulk writer runs
+	contended int32
+
+	mu        sync.Mutex
+	latencies [][]float64
+	errors    []int64
+
+	writes        int64
+	writeErrors   int64
+	writerStarted time.Time
+	writerStopped time.Time
+}
+
+func (r *tenancyRecorder) phase() int {
+	if atomic.LoadInt32(&r.contended) == 1 {
+		return tenancyPhaseContended
+	}
+	return tenancyPhaseIsolated
+}
+
+func (r *tenancyRecorder) observe(phase int, took time.Duration, err error) {
+	r.mu.Lock()
+	if err != nil {
+		r.errors[phase]++
+	} else {
+		r.latencies[phase] = append(r.latencies[phase], took.Seconds())
+	}
+	r.mu.Unlock()
+}
+
+// wrap records the latencies of the reader handlers.
+func (r *tenancyRecorder) wrap(rhs []ReqHandler) []ReqHandler {
+	wrapped := make([]ReqHandler, len(rhs))
+	for i := range rhs {
+		h := rhs[i]
+		wrapped[i] = func(ctx context.Context, req *Request) error {
+			phase := r.phase()
+			st := time.Now()
+			err := h(ctx, req)
+			r.observe(phase, time.Since(st), err)
+			return err
+		}
+	}
+	return wrapped
+}
+
+// tenancyWriter writes values under the writer prefix as fast as
+// its clients can, until stopped.
+type tenancyWriter struct {
+	stopc chan struct{}
+	wg    sync.WaitGroup
+}
+
+func (cfg *Config) startTenancyWriter(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values, rec *tenancyRecorder) *tenancyWriter {
+	opts := *gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.ConnectionNumber == opts.ClientNumber || opts.ConnectionNumber > opts.TenancyWriterClients {
+		opts.ConnectionNumber = opts.TenancyWriterClients
+	}
+	opts.ClientNumber = opts.TenancyWriterClients
+	gcfg.ConfigClientMachineBenchmarkOptions = &opts
+	h, done := newWriteHandlers(cfg.lg, gcfg)
+
+	w := &tenancyWriter{stopc: make(chan struct{})}
+	var seq int64
+	rec.writerStarted = time.Now()
+	atomic.StoreInt32(&rec.contended, 1)
+	for i := range h {
+		w.wg.Add(1)
+		go func(handler ReqHandler) {
+			defer w.wg.Done()
+			for {
+				select {
+				case <-w.stopc:
+					return
+				default:
+				}
+				n := atomic.AddInt64(&seq, 1)
+				req, err := NewPutRequest(gcfg.DatabaseID, fmt.Sprintf("%s%016d", tenancyWriterPrefix, n), []byte(vals.strings[n%int64(vals.sampleSize)]))
+				if err == nil {
+					err = handler(context.Background(), &req)
+				}
+				if err != nil {
+					atomic.AddInt64(&rec.writeErrors, 1)
+				} else {
+					atomic.AddInt64(&rec.writes, 1)
+				}
+			}
+		}(h[i])
+	}
+	go func() {
+		w.wg.Wait()
+		if done != nil {
+			done()
+		}
+	}()
+	return w
+}
+
+func (w *tenancyWriter) stop(rec *tenancyRecorder) {
+	atomic.StoreInt32(&rec.contended, 0)
+	close(w.stopc)
+	w.wg.Wait()
+	rec.writerStopped = time.Now()
+}
+
+// benchmarkTenancy runs two tenants against disjoint key prefixes: a
+// latency-sensitive reader of 'tenancy_reader_keys' keys, sending
+// 'request_number' reads at 'rate_limit_requests_per_second', and a bulk
+// writer of 'tenancy_writer_clients' clients writing 'value_size_bytes'
+// values without rate limit. The writer starts after the first half of the
+// reads, and 'client_tenancy_summary_path' compares the reader latencies
+// with and without the writer.
+func (cfg *Config) benchmarkTenancy(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	opts := tenancyOptions(gcfg)
+	gcfg.ConfigClientMachineBenchmarkOptions = &opts
+
+	cfg.lg.Info("loading reader keys", zap.Int64("keys", opts.TenancyReaderKeys))
+	h, done := newWriteHandlers(cfg.lg, gcfg)
+	var genErr error
+	reqGen := func(inflightReqs chan<- Request) {
+		defer close(inflightReqs)
+		for i := int64(0); i < opts.TenancyReaderKeys; i++ {
+			req, err := NewPutRequest(gcfg.DatabaseID, fmt.Sprintf("%s%08d", tenancyReaderPrefix, i), []byte(vals.strings[i%int64(vals.sampleSize)]))
+			if err != nil {
+				genErr = err
+				return
+			}
+			inflightReqs <- req
+		}
+	}
+	b := newBenchmark(opts.TenancyReaderKeys, opts.ClientNumber, h, done, reqGen)
+	b.setErrorBudget(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.setTracer(cfg.lg, gcfg)
+	b.setClientDelays(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.setPriorities(gcfg.ConfigClientMachineBenchmarkOptions)
+	b.pauser = cfg.pauser
+	b.ctx = cfg.stressCtx
+	b.recorder = cfg.recorder
+	b.phases = cfg.phases
+	b.clientWrites = cfg.clientWrites
+	b.clientReads = cfg.clientReads
+	b.startRequests()
+	b.waitAll()
+	if genErr != nil {
+		return genErr
+	}
+	if b.abortErr != nil {
+		return b.abortErr
+	}
+
+	rec := &tenancyRecorder{
+		latencies: make([][]float64, len(tenancyPhaseNames)),
+		errors:    make([]int64, len(tenancyPhaseNames)),
+	}
+	rh, rdone := newReadHandlers(gcfg)
+	var writer *tenancyWriter
+	rreqGen := func(inflightReqs chan<- Request) {
+		defer close(inflightReqs)
+		pacer := benchrunner.NewPacer(opts.RateLimitRequestsPerSecond)
+		for i := int64(0); i < opts.RequestNumber; i++ {
+			if i == opts.RequestNumber/2 {
+				cfg.lg.Info("starting bulk writer", zap.Int64("clients", opts.TenancyWriterClients), zap.Int64("value-size", opts.ValueSizeBytes))
+				writer = cfg.startTenancyWriter(gcfg, vals, rec)
+			}
+			intended := pacer.Wait(context.TODO(), i)
+			req, err := NewGetRequest(gcfg.DatabaseID, fmt.Sprintf("%s%08d", tenancyReaderPrefix, i%opts.TenancyReaderKeys), opts.StaleRead)
+			if err != nil {
+				genErr = err
+				return
+			}
+			req.intendedStart = intended
+			inflightReqs <- req
+		}
+	}
+	cfg.lg.Info("reading with and without bulk writer", zap.Int64("requests", opts.RequestNumber))
+	err := cfg.generateReport(gcfg, rec.wrap(rh), rdone, rreqGen)
+	if writer != nil {
+		writer.stop(rec)
+		cfg.lg.Info("stopped bulk writer", zap.Int64("writes", atomic.LoadInt64(&rec.writes)), zap.Int64("errors", atomic.LoadInt64(&rec.writeErrors)))
+	}
+	if genErr != nil {
+		return genErr
+	}
+
+	if serr := cfg.saveTenancySummary(rec); serr != nil {
+		return serr
+	}
+	return err
+}
+
+// saveTenancySummary writes reader latencies with and without the writer,
+// and the interference of the writer on the reader p99 latency.
+func (cfg *Config) saveTenancySummary(rec *tenancyRecorder) error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	cols := []dataframe.Column{
+		dataframe.NewColumn("PHASE"),
+		dataframe.NewColumn("READER-REQUESTS"),
+		dataframe.NewColumn("READER-ERRORS"),
+		dataframe.NewColumn("READER-AVERAGE-LATENCY-MS"),
+		dataframe.NewColumn("READER-P50-LATENCY-MS"),
+		dataframe.NewColumn("READER-P99-LATENCY-MS"),
+		dataframe.NewColumn("WRITER-REQUESTS"),
+		dataframe.NewColumn("WRITER-ERRORS"),
+		dataframe.NewColumn("WRITER-REQUESTS-PER-SECOND"),
+		dataframe.NewColumn("P99-INTERFERENCE-RATIO"),
+	}
+	p99s := make([]float64, len(tenancyPhaseNames))
+	for phase, name := range tenancyPhaseNames {
+		lats := rec.latencies[phase]
+		sort.Float64s(lats)
+		var avg, p50 float64
+		if len(lats) > 0 {
+			for _, l := range lats {
+				avg += l
+			}
+			avg /= float64(len(lats))
+			p50 = lats[(len(lats)-1)/2]
+			p99s[phase] = lats[(len(lats)-1)*99/100]
+		}
+		var writes, writeErrors int64
+		var writesPerSec, ratio float64
+		if phase == tenancyPhaseContended {
+			writes, writeErrors = rec.writes, rec.writeErrors
+			if took := rec.writerStopped.Sub(rec.writerStarted).Seconds(); took > 0 {
+				writesPerSec = float64(writes) / took
+			}
+			if p99s[tenancyPhaseIsolated] > 0 {
+				ratio = p99s[phase] / p99s[tenancyPhaseIsolated]
+			}
+		} else {
+			ratio = 1
+		}
+		row := []interface{}{
+			name,
+			len(lats),
+			rec.errors[phase],
+			fmt.Sprintf("%f", 1000*avg),
+			fmt.Sprintf("%f", 1000*p50),
+			fmt.Sprintf("%f", 1000*p99s[phase]),
+			writes,
+			writeErrors,
+			fmt.Sprintf("%.2f", writesPerSec),
+			fmt.Sprintf("%.3f", ratio),
+		}
+		for i := range cols {
+			cols[i].PushBack(dataframe.NewStringValue(row[i]))
+		}
+	}
+
+	fr := dataframe.New()
+	for _, col := range cols {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientTenancySummaryPath)
+}
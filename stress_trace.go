@@ -0,0 +1,253 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"hash/fnv"
+	"io"
+	mrand "math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+)
+
+// traceHeader is the header of trace files. Values are recorded as
+// their sizes and FNV-1a hashes, intended times as nanoseconds since
+// the intended time of the first operation (empty if not rate-limited).
+var traceHeader = []string{"OP", "KEY", "VALUE-SIZE", "VALUE-HASH", "INTENDED-NS"}
+
+// traceRecord is an operation in a trace file.
+type traceRecord struct {
+	op        string // 'put', 'get' or 'delete'
+	key       string
+	valueSize int64
+	valueHash uint64
+	// intended is the offset of intended time, negative if not rate-limited
+	intended time.Duration
+}
+
+// traceRecorder writes generated requests to a trace file.
+type traceRecorder struct {
+	mu    sync.Mutex
+	w     *csv.Writer
+	first time.Time
+	n     int64
+}
+
+func newTraceRecorder(w io.Writer) (*traceRecorder, error) {
+	r := &traceRecorder{w: csv.NewWriter(w)}
+	if err := r.w.Write(traceHeader); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Record writes every request generated by benchmarks to the trace
+// file at fpath, to be replayed with 'trace' type and 'trace_file'.
+// The returned function flushes and closes the file.
+func (cfg *Config) Record(fpath string) (stop func() error, err error) {
+	f, err := os.OpenFile(fpath, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0777)
+	if err != nil {
+		return nil, err
+	}
+	bw := bufio.NewWriter(f)
+	r, err := newTraceRecorder(bw)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	cfg.recorder = r
+	return func() error {
+		cfg.recorder = nil
+		if err := r.flush(); err != nil {
+			f.Close()
+			return err
+		}
+		if err := bw.Flush(); err != nil {
+			f.Close()
+			return err
+		}
+		cfg.lg.Sugar().Infof("recorded %d operations to %q", r.n, fpath)
+		return f.Close()
+	}, nil
+}
+
+// wrap returns the request generator that records requests
+// before sending them to clients, in the generated order.
+func (r *traceRecorder) wrap(reqGen func(chan<- Request)) func(chan<- Request) {
+	if r == nil {
+		return reqGen
+	}
+	return func(inflightReqs chan<- Request) {
+		reqc := make(chan Request, cap(inflightReqs))
+		go reqGen(reqc)
+		for req := range reqc {
+			r.record(&req)
+			inflightReqs <- req
+		}
+		close(inflightReqs)
+	}
+}
+
+func (r *traceRecorder) record(req *Request) {
+	op, key, value := req.traceOp()
+	h := fnv.New64a()
+	h.Write(value)
+	intended := ""
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !req.intendedStart.IsZero() {
+		if r.first.IsZero() {
+			r.first = req.intendedStart
+		}
+		intended = fmt.Sprintf("%d", int64(req.intendedStart.Sub(r.first)))
+	}
+	r.w.Write([]string{op, key, fmt.Sprintf("%d", len(value)), fmt.Sprintf("%016x", h.Sum64()), intended})
+	r.n++
+}
+
+func (r *traceRecorder) flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.w.Flush()
+	return r.w.Error()
+}
+
+// traceOp returns the operation type, key, and value of the request.
+func (r *Request) traceOp() (op, key string, value []byte) {
+	switch {
+	case r.zkOp.key != "":
+		op = "get"
+		if r.zkOp.value != nil {
+			op = "put"
+		}
+		return op, strings.TrimPrefix(r.zkOp.key, "/"), r.zkOp.value
+	case r.consulOp.key != "":
+		op = "get"
+		if r.consulOp.value != nil {
+			op = "put"
+		}
+		return op, r.consulOp.key, r.consulOp.value
+	}
+	switch {
+	case r.etcdv3Op.IsPut():
+		op = "put"
+	case r.etcdv3Op.IsDelete():
+		op = "delete"
+	default:
+		op = "get"
+	}
+	return op, string(r.etcdv3Op.KeyBytes()), r.etcdv3Op.ValueBytes()
+}
+
+// readTraceFile reads the operations of the trace file.
+func readTraceFile(fpath string) ([]traceRecord, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rows, err := csv.NewReader(bufio.NewReader(f)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 || strings.Join(rows[0], ",") != strings.Join(traceHeader, ",") {
+		return nil, fmt.Errorf("%q is not a trace file (expected header %q)", fpath, traceHeader)
+	}
+	recs := make([]traceRecord, 0, len(rows)-1)
+	for i, row := range rows[1:] {
+		rec := traceRecord{op: row[0], key: row[1], intended: -1}
+		switch rec.op {
+		case "put", "get":
+		default:
+			return nil, fmt.Errorf("%q line %d: %q cannot be replayed", fpath, i+2, rec.op)
+		}
+		if rec.valueSize, err = strconv.ParseInt(row[2], 10, 64); err != nil {
+			return nil, fmt.Errorf("%q line %d: %v", fpath, i+2, err)
+		}
+		if rec.valueHash, err = strconv.ParseUint(row[3], 16, 64); err != nil {
+			return nil, fmt.Errorf("%q line %d: %v", fpath, i+2, err)
+		}
+		if row[4] != "" {
+			ns, err := strconv.ParseInt(row[4], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%q line %d: %v", fpath, i+2, err)
+			}
+			rec.intended = time.Duration(ns)
+		}
+		recs = append(recs, rec)
+	}
+	return recs, nil
+}
+
+// traceValue returns the value to replay, generated from the recorded
+// size and hash, so that every replay writes the same bytes.
+func traceValue(size int64, hash uint64) []byte {
+	const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	rnd := mrand.New(mrand.NewSource(int64(hash)))
+	b := make([]byte, size)
+	for i := range b {
+		b[i] = letterBytes[rnd.Intn(len(letterBytes))]
+	}
+	return b
+}
+
+// newTraceRequest creates the request to replay the operation.
+func newTraceRequest(databaseID string, rec traceRecord) Request {
+	var value []byte
+	if rec.op == "put" {
+		value = traceValue(rec.valueSize, rec.valueHash)
+	}
+	switch databaseID {
+	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		if rec.op == "put" {
+			return Request{etcdv3Op: clientv3.OpPut(rec.key, string(value))}
+		}
+		return Request{etcdv3Op: clientv3.OpGet(rec.key)}
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		return Request{zkOp: zkOp{key: "/" + rec.key, value: value}}
+	case "consul__v1_0_2", "cetcd__beta":
+		return Request{consulOp: consulOp{key: rec.key, value: value}}
+	default:
+		panic(fmt.Sprintf("%q is unknown database ID", databaseID))
+	}
+}
+
+// generateTrace replays the operations in order, waiting until their
+// intended times relative to the start of the replay.
+func generateTrace(gcfg dbtesterpb.ConfigClientMachineAgentControl, recs []traceRecord, inflightReqs chan<- Request) {
+	defer close(inflightReqs)
+
+	start := time.Now()
+	for _, rec := range recs {
+		req := newTraceRequest(gcfg.DatabaseID, rec)
+		if rec.intended >= 0 {
+			req.intendedStart = start.Add(rec.intended)
+			time.Sleep(time.Until(req.intendedStart))
+		}
+		inflightReqs <- req
+	}
+}
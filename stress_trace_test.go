@@ -0,0 +1,81 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_traceRecordReplay(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "dbtester-trace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fpath := filepath.Join(dir, "trace.csv")
+
+	f, err := os.Create(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := newTraceRecorder(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	put, _ := NewPutRequest("zookeeper__r3_5_3_beta", "foo", []byte("bar"))
+	put.intendedStart = now
+	get, _ := NewGetRequest("zookeeper__r3_5_3_beta", "/foo", false)
+	get.intendedStart = now.Add(time.Second)
+	reqGen := r.wrap(func(inflightReqs chan<- Request) {
+		inflightReqs <- put
+		inflightReqs <- get
+		close(inflightReqs)
+	})
+	reqc := make(chan Request, 2)
+	reqGen(reqc)
+	for range reqc {
+	}
+	if err = r.flush(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	recs, err := readTraceFile(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recs) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(recs))
+	}
+	if recs[0].op != "put" || recs[0].key != "foo" || recs[0].valueSize != 3 || recs[0].intended != 0 {
+		t.Fatalf("unexpected put record %+v", recs[0])
+	}
+	if recs[1].op != "get" || recs[1].key != "foo" || recs[1].intended != time.Second {
+		t.Fatalf("unexpected get record %+v", recs[1])
+	}
+
+	// replays write identical values to any database
+	v1 := newTraceRequest("etcd__tip", recs[0]).etcdv3Op.ValueBytes()
+	v2 := newTraceRequest("consul__v1_0_2", recs[0]).consulOp.value
+	if len(v1) != 3 || !bytes.Equal(v1, v2) {
+		t.Fatalf("expected identical 3-byte values, got %q and %q", v1, v2)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// MemberUpgradesColumns defines member upgrades columns.
+var MemberUpgradesColumns = []string{
+	"UNIX-SECOND",
+	"MEMBER-INDEX",
+	"ETCD-GIT-SHA",
+	"RESTART-SECONDS",
+	"DOWNTIME-SECONDS",
+}
+
+type memberUpgrade struct {
+	unixSecond int64
+	idx        int
+	sha        string
+	// restart is the time for the agent to stop and restart the member
+	restart time.Duration
+	// downtime is the time until the member serves client requests again
+	downtime time.Duration
+}
+
+// upgradeMembers restarts etcd members one by one with the binary built
+// at 'upgrade_etcd_git_ref', until all done or 'stopc' is closed.
+func (cfg *Config) upgradeMembers(gcfg dbtesterpb.ConfigClientMachineAgentControl, stopc <-chan struct{}) (upgrades []memberUpgrade) {
+	interval := time.Duration(gcfg.ConfigClientMachineBenchmarkOptions.UpgradeIntervalSeconds) * time.Second
+
+	// member endpoints, not gRPC proxies that clients may connect to
+	endpoints := cfg.DatabaseIDToConfigClientMachineAgentControl[gcfg.DatabaseID].DatabaseEndpoints
+	for i := range gcfg.PeerIPs {
+		select {
+		case <-stopc:
+			cfg.lg.Info("stopped upgrading members", zap.Int("upgraded", len(upgrades)))
+			return upgrades
+		case <-time.After(interval):
+		}
+
+		cfg.lg.Info("upgrading member", zap.Int("index", i), zap.String("etcd-git-ref", gcfg.ConfigClientMachineBenchmarkOptions.UpgradeEtcdGitRef))
+		cfg.phases.begin(PhaseFaultInjection)
+		now := time.Now()
+		rs, err := cfg.sendRequests(cfg.stressContext(), gcfg.DatabaseID, dbtesterpb.Operation_Upgrade, []int{i}, 0, false)
+		if err != nil {
+			cfg.lg.Warn("failed to upgrade member", zap.Int("index", i), zap.Error(err))
+			return upgrades
+		}
+		cfg.phases.begin(PhaseRecovery)
+		// the agent builds the binary before stopping the member,
+		// so downtime starts from the restart that it reports
+		restart, restartedAt := time.Duration(rs[i].TookNanoseconds), time.Now()
+		if err := waitMemberEtcd(endpoints[i], stopc); err != nil {
+			cfg.lg.Warn("upgraded member is not ready", zap.Int("index", i), zap.Error(err))
+			return upgrades
+		}
+		u := memberUpgrade{
+			unixSecond: now.Unix(),
+			idx:        i,
+			sha:        rs[i].EtcdGitSHA,
+			restart:    restart,
+			downtime:   restart + time.Since(restartedAt),
+		}
+		cfg.lg.Info("upgraded member", zap.Int("index", i), zap.String("etcd-git-sha", u.sha), zap.Duration("downtime", u.downtime))
+		upgrades = append(upgrades, u)
+		cfg.phases.begin(PhaseLoad)
+	}
+	return upgrades
+}
+
+// waitMemberEtcd waits until the etcd member at the endpoint
+// answers status requests, or 'stopc' is closed.
+func waitMemberEtcd(ep string, stopc <-chan struct{}) error {
+	cli := mustCreateConnEtcdv3([]string{ep})
+	defer cli.Close()
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		_, err := cli.Status(ctx, ep)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-stopc:
+			return fmt.Errorf("stopped waiting for %q (%v)", ep, err)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func (cfg *Config) saveMemberUpgrades(upgrades []memberUpgrade) error {
+	if cfg.ConfigClientMachineInitial.ClientUpgradesPath == "" {
+		return nil
+	}
+
+	c1 := dataframe.NewColumn(MemberUpgradesColumns[0])
+	c2 := dataframe.NewColumn(MemberUpgradesColumns[1])
+	c3 := dataframe.NewColumn(MemberUpgradesColumns[2])
+	c4 := dataframe.NewColumn(MemberUpgradesColumns[3])
+	c5 := dataframe.NewColumn(MemberUpgradesColumns[4])
+	for _, u := range upgrades {
+		c1.PushBack(dataframe.NewStringValue(u.unixSecond))
+		c2.PushBack(dataframe.NewStringValue(u.idx))
+		c3.PushBack(dataframe.NewStringValue(u.sha))
+		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", u.restart.Seconds())))
+		c5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%4.4f", u.downtime.Seconds())))
+	}
+
+	fr := dataframe.New()
+	for _, col := range []dataframe.Column{c1, c2, c3, c4, c5} {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientUpgradesPath)
+}
@@ -0,0 +1,193 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"golang.org/x/net/context"
+)
+
+// valueCodec compresses values client-side before writes, and
+// decompresses values after reads, to measure the CPU and latency
+// cost of application-level compression. Compression is part of
+// request latencies, as it would be in applications.
+type valueCodec struct {
+	name string
+
+	mu              sync.Mutex
+	compressN       int64
+	compressTook    time.Duration
+	rawBytes        int64
+	compressedBytes int64
+	decompressN     int64
+	decompressTook  time.Duration
+}
+
+// newValueCodec returns nil if values are not compressed.
+func newValueCodec(name string) *valueCodec {
+	switch name {
+	case "", "none":
+		return nil
+	}
+	return &valueCodec{name: name}
+}
+
+func (c *valueCodec) compress(v []byte) ([]byte, error) {
+	now := time.Now()
+	var buf bytes.Buffer
+	switch c.name {
+	case "gzip":
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(v); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown value compression %q", c.name)
+	}
+	took := time.Since(now)
+
+	c.mu.Lock()
+	c.compressN++
+	c.compressTook += took
+	c.rawBytes += int64(len(v))
+	c.compressedBytes += int64(buf.Len())
+	c.mu.Unlock()
+	return buf.Bytes(), nil
+}
+
+func (c *valueCodec) decompress(v []byte) ([]byte, error) {
+	now := time.Now()
+	var rd io.ReadCloser
+	switch c.name {
+	case "gzip":
+		r, err := gzip.NewReader(bytes.NewReader(v))
+		if err != nil {
+			return nil, err
+		}
+		rd = r
+	default:
+		return nil, fmt.Errorf("unknown value compression %q", c.name)
+	}
+	defer rd.Close()
+	raw, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return nil, err
+	}
+	took := time.Since(now)
+
+	c.mu.Lock()
+	c.decompressN++
+	c.decompressTook += took
+	c.mu.Unlock()
+	return raw, nil
+}
+
+// compressValues returns the values compressed, for writes
+// outside of benchmark requests (e.g. the key of 'read').
+func (c *valueCodec) compressValues(vals values) (values, error) {
+	if c == nil {
+		return vals, nil
+	}
+	cv := values{sampleSize: vals.sampleSize}
+	for _, v := range vals.bytes {
+		bts, err := c.compress(v)
+		if err != nil {
+			return vals, err
+		}
+		cv.bytes = append(cv.bytes, bts)
+		cv.strings = append(cv.strings, string(bts))
+	}
+	return cv, nil
+}
+
+// wrapWrites compresses values of write requests before the handlers.
+func (c *valueCodec) wrapWrites(rhs []ReqHandler) []ReqHandler {
+	if c == nil {
+		return rhs
+	}
+	wrapped := make([]ReqHandler, len(rhs))
+	for i := range rhs {
+		rh := rhs[i]
+		wrapped[i] = func(ctx context.Context, req *Request) error {
+			key, value := requestKeyValue(req)
+			cv, err := c.compress(value)
+			if err != nil {
+				return err
+			}
+			switch {
+			case req.etcdv3Op.IsPut():
+				req.etcdv3Op = clientv3.OpPut(key, string(cv))
+			case req.zkOp.key != "":
+				req.zkOp.value = cv
+			default:
+				req.consulOp.value = cv
+			}
+			return rh(ctx, req)
+		}
+	}
+	return wrapped
+}
+
+// wrapReads decompresses values read by the handlers.
+func (c *valueCodec) wrapReads(rhs []ReqHandler) []ReqHandler {
+	if c == nil {
+		return rhs
+	}
+	wrapped := make([]ReqHandler, len(rhs))
+	for i := range rhs {
+		rh := rhs[i]
+		wrapped[i] = func(ctx context.Context, req *Request) error {
+			if err := rh(ctx, req); err != nil {
+				return err
+			}
+			if len(req.readValue) == 0 {
+				return nil
+			}
+			_, err := c.decompress(req.readValue)
+			return err
+		}
+	}
+	return wrapped
+}
+
+// String returns the compression ratio and average CPU time
+// of compression and decompression.
+func (c *valueCodec) String() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ratio, compressMs, decompressMs := 0.0, 0.0, 0.0
+	if c.compressedBytes > 0 {
+		ratio = float64(c.rawBytes) / float64(c.compressedBytes)
+	}
+	if c.compressN > 0 {
+		compressMs = toMillisecond(c.compressTook) / float64(c.compressN)
+	}
+	if c.decompressN > 0 {
+		decompressMs = toMillisecond(c.decompressTook) / float64(c.decompressN)
+	}
+	return fmt.Sprintf("%s (ratio %.2f, compress avg %.4f ms x %d, decompress avg %.4f ms x %d)",
+		c.name, ratio, compressMs, c.compressN, decompressMs, c.decompressN)
+}
@@ -0,0 +1,46 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"testing"
+)
+
+func Test_valueCodec(t *testing.T) {
+	if c := newValueCodec("none"); c != nil {
+		t.Fatalf("expected nil codec, got %+v", c)
+	}
+
+	c := newValueCodec("gzip")
+	raw := bytes.Repeat([]byte("dbtester"), 128)
+	cv, err := c.compress(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cv) >= len(raw) {
+		t.Fatalf("expected compressed value smaller than %d bytes, got %d", len(raw), len(cv))
+	}
+	dv, err := c.decompress(cv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dv, raw) {
+		t.Fatalf("expected %q, got %q", raw, dv)
+	}
+	if c.compressN != 1 || c.decompressN != 1 || c.rawBytes != int64(len(raw)) {
+		t.Fatalf("unexpected stats %s", c)
+	}
+}
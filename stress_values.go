@@ -0,0 +1,207 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	mrand "math/rand"
+	"strconv"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	defaultValueSchemaFields = 8
+	defaultValueSchemaDepth  = 2
+
+	// structuredValueSamples is the number of distinct documents
+	// to cycle through, so that values are not all the same.
+	structuredValueSamples = 100
+)
+
+// docField is a field of a generated document. Its value is
+// a string, int64, bool, float64, or a nested document.
+type docField struct {
+	name string
+	num  uint64
+	v    interface{}
+}
+
+// docFieldNames are field names of generated documents,
+// as in typical API objects.
+var docFieldNames = []string{
+	"id", "name", "namespace", "kind", "version", "created_at", "updated_at", "owner",
+	"labels", "status", "replicas", "enabled", "weight", "description", "spec", "metadata",
+}
+
+// docWords are string values of generated documents. Repeated words
+// compress like real documents, unlike random bytes.
+var docWords = []string{
+	"default", "running", "pending", "ready", "app", "frontend", "backend", "cache",
+	"us-west1", "us-east1", "production", "staging", "v1", "v2", "true", "controller",
+}
+
+// newDocument generates a document of 'fields' fields, nested 'depth' levels.
+func newDocument(rnd *mrand.Rand, fields, depth int64) []docField {
+	doc := make([]docField, fields)
+	for i := range doc {
+		name := docFieldNames[i%len(docFieldNames)]
+		if i >= len(docFieldNames) {
+			name += strconv.Itoa(i / len(docFieldNames))
+		}
+		doc[i] = docField{name: name, num: uint64(i + 1)}
+
+		kind := i % 5
+		if kind == 4 && depth <= 1 {
+			kind = 0
+		}
+		switch kind {
+		case 0:
+			doc[i].v = docWords[rnd.Intn(len(docWords))] + "-" + strconv.Itoa(rnd.Intn(1000))
+		case 1:
+			doc[i].v = rnd.Int63n(1 << 40)
+		case 2:
+			doc[i].v = rnd.Intn(2) == 0
+		case 3:
+			doc[i].v = math.Floor(rnd.Float64()*10000) / 100
+		case 4:
+			doc[i].v = newDocument(rnd, fields, depth-1)
+		}
+	}
+	return doc
+}
+
+// encodeJSON encodes the document as a JSON object, in field order.
+func encodeJSON(buf *bytes.Buffer, doc []docField) {
+	buf.WriteByte('{')
+	for i, f := range doc {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(strconv.Quote(f.name))
+		buf.WriteByte(':')
+		if sub, ok := f.v.([]docField); ok {
+			encodeJSON(buf, sub)
+			continue
+		}
+		bts, _ := json.Marshal(f.v)
+		buf.Write(bts)
+	}
+	buf.WriteByte('}')
+}
+
+// encodeProtobuf encodes the document in protobuf wire format,
+// with field numbers in field order.
+func encodeProtobuf(buf *proto.Buffer, doc []docField) {
+	for _, f := range doc {
+		switch v := f.v.(type) {
+		case string:
+			buf.EncodeVarint(f.num<<3 | proto.WireBytes)
+			buf.EncodeStringBytes(v)
+		case int64:
+			buf.EncodeVarint(f.num<<3 | proto.WireVarint)
+			buf.EncodeVarint(uint64(v))
+		case bool:
+			buf.EncodeVarint(f.num<<3 | proto.WireVarint)
+			if v {
+				buf.EncodeVarint(1)
+			} else {
+				buf.EncodeVarint(0)
+			}
+		case float64:
+			buf.EncodeVarint(f.num<<3 | proto.WireFixed64)
+			buf.EncodeFixed64(math.Float64bits(v))
+		case []docField:
+			sub := proto.NewBuffer(nil)
+			encodeProtobuf(sub, v)
+			buf.EncodeVarint(f.num<<3 | proto.WireBytes)
+			buf.EncodeRawBytes(sub.Bytes())
+		}
+	}
+}
+
+// newStructuredValue returns a serialized document with a list of items,
+// as many as fit in 'size' bytes (at least one).
+func newStructuredValue(rnd *mrand.Rand, encoding string, size, fields, depth int64) []byte {
+	switch encoding {
+	case "json":
+		var buf bytes.Buffer
+		buf.WriteString(`{"items":[`)
+		for n := 0; ; n++ {
+			var item bytes.Buffer
+			if n > 0 {
+				item.WriteByte(',')
+			}
+			encodeJSON(&item, newDocument(rnd, fields, depth))
+			if n > 0 && int64(buf.Len()+item.Len()+2) > size {
+				break
+			}
+			buf.Write(item.Bytes())
+		}
+		buf.WriteString("]}")
+		return buf.Bytes()
+
+	case "protobuf":
+		buf := proto.NewBuffer(nil)
+		for n := 0; ; n++ {
+			item := proto.NewBuffer(nil)
+			encodeProtobuf(item, newDocument(rnd, fields, depth))
+			// repeated message field 1, with its tag and length
+			itemN := len(item.Bytes()) + 1 + proto.SizeVarint(uint64(len(item.Bytes())))
+			if n > 0 && int64(len(buf.Bytes())+itemN) > size {
+				break
+			}
+			buf.EncodeVarint(1<<3 | proto.WireBytes)
+			buf.EncodeRawBytes(item.Bytes())
+		}
+		return buf.Bytes()
+
+	default:
+		panic(fmt.Sprintf("unknown value encoding %q", encoding))
+	}
+}
+
+// newStructuredValues generates distinct documents of the value encoding.
+func newStructuredValues(opts *dbtesterpb.ConfigClientMachineBenchmarkOptions) (v values) {
+	fields, depth := opts.ValueSchemaFields, opts.ValueSchemaDepth
+	if fields == 0 {
+		fields = defaultValueSchemaFields
+	}
+	if depth == 0 {
+		depth = defaultValueSchemaDepth
+	}
+	rnd := mrand.New(mrand.NewSource(1))
+	for i := 0; i < structuredValueSamples; i++ {
+		bts := newStructuredValue(rnd, opts.ValueEncoding, opts.ValueSizeBytes, fields, depth)
+		v.bytes = append(v.bytes, bts)
+		v.strings = append(v.strings, string(bts))
+	}
+	v.sampleSize = structuredValueSamples
+	return v
+}
+
+// isValidValueEncoding returns true if the value encoding is supported.
+func isValidValueEncoding(enc string) bool {
+	switch enc {
+	case "", "random", "json", "protobuf":
+		return true
+	}
+	return false
+}
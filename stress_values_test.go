@@ -0,0 +1,58 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"encoding/json"
+	mrand "math/rand"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+func Test_newStructuredValue(t *testing.T) {
+	rnd := mrand.New(mrand.NewSource(1))
+	for _, size := range []int64{16, 1024, 8192} {
+		bts := newStructuredValue(rnd, "json", size, 8, 2)
+		var doc struct {
+			Items []map[string]interface{} `json:"items"`
+		}
+		if err := json.Unmarshal(bts, &doc); err != nil {
+			t.Fatalf("size %d: invalid JSON %q (%v)", size, bts, err)
+		}
+		if len(doc.Items) == 0 || (len(doc.Items) > 1 && int64(len(bts)) > size) {
+			t.Fatalf("size %d: unexpected %d items in %d bytes", size, len(doc.Items), len(bts))
+		}
+
+		bts = newStructuredValue(rnd, "protobuf", size, 8, 2)
+		items := 0
+		for len(bts) > 0 {
+			tag, n := proto.DecodeVarint(bts)
+			if n == 0 || tag != 1<<3|proto.WireBytes {
+				t.Fatalf("size %d: unexpected tag %d", size, tag)
+			}
+			bts = bts[n:]
+			l, n := proto.DecodeVarint(bts)
+			if n == 0 || uint64(len(bts)-n) < l {
+				t.Fatalf("size %d: invalid item length %d", size, l)
+			}
+			bts = bts[n+int(l):]
+			items++
+		}
+		if items == 0 {
+			t.Fatalf("size %d: no items", size)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/pkg/report"
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	defaultWatcherNumber             = 10000
+	defaultWatchStreamsPerConnection = 1
+
+	// watchCreateTimeout is the timeout to establish each watcher
+	watchCreateTimeout = 10 * time.Second
+)
+
+// watchOptions returns the benchmark options with defaults.
+func watchOptions(gcfg dbtesterpb.ConfigClientMachineAgentControl) dbtesterpb.ConfigClientMachineBenchmarkOptions {
+	opts := *gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.WatcherNumber == 0 {
+		opts.WatcherNumber = defaultWatcherNumber
+	}
+	if opts.WatchStreamsPerConnection == 0 {
+		opts.WatchStreamsPerConnection = defaultWatchStreamsPerConnection
+	}
+	return opts
+}
+
+// watchStats counts established watchers and received events.
+type watchStats struct {
+	mu sync.Mutex
+	// creation latencies in seconds
+	created []float64
+
+	createFailures int64
+	events         int64
+}
+
+// watchStreamContext returns the context of the stream of the watcher.
+// etcd client multiplexes watchers of the same context metadata over
+// one gRPC stream, so distinct metadata opens another stream on the
+// same connection.
+func watchStreamContext(ctx context.Context, stream int64) context.Context {
+	return metadata.NewOutgoingContext(ctx, metadata.Pairs("dbtester-watch-stream", fmt.Sprintf("%d", stream)))
+}
+
+// benchmarkWatch establishes 'watcher_number' watchers over 'connection_number'
+// connections with 'watch_streams_per_connection' streams each, and then writes
+// 'request_number' keys. Watcher i watches i-th key (modulo 'request_number'),
+// so that each watcher receives one event. Only the writes are reported, and
+// watchers are summarized in 'client_watch_summary_path'.
+func (cfg *Config) benchmarkWatch(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	opts := watchOptions(gcfg)
+
+	conns := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+		totalConns:   opts.ConnectionNumber,
+		totalClients: opts.ConnectionNumber,
+		compression:  opts.EtcdCompression,
+	})
+	defer func() {
+		for i := range conns {
+			conns[i].Close()
+		}
+	}()
+
+	cfg.lg.Info("establishing watchers",
+		zap.Int64("watchers", opts.WatcherNumber),
+		zap.Int64("connections", opts.ConnectionNumber),
+		zap.Int64("streams-per-connection", opts.WatchStreamsPerConnection),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	var (
+		ws      watchStats
+		created sync.WaitGroup
+		wg      sync.WaitGroup
+	)
+	now := time.Now()
+	for c := range conns {
+		created.Add(1)
+		go func(c int64) {
+			defer created.Done()
+			// watchers of the connection are spread over its streams
+			for i := c; i < opts.WatcherNumber; i += int64(len(conns)) {
+				stream := (i / int64(len(conns))) % opts.WatchStreamsPerConnection
+				key := benchmarkKey(&opts, i%opts.RequestNumber)
+				wch, ok := cfg.watch(watchStreamContext(ctx, stream), conns[c], key, &ws)
+				if !ok {
+					continue
+				}
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for wresp := range wch {
+						atomic.AddInt64(&ws.events, int64(len(wresp.Events)))
+					}
+				}()
+			}
+		}(int64(c))
+	}
+	created.Wait()
+	cfg.lg.Info("established watchers",
+		zap.Int("watchers", len(ws.created)),
+		zap.Int64("create-failures", ws.createFailures),
+		zap.Duration("took", time.Since(now)),
+	)
+
+	h, done := newWriteHandlers(cfg.lg, gcfg)
+	reqGen := func(inflightReqs chan<- Request) { generateWrites(gcfg, 0, vals, inflightReqs) }
+	err := cfg.generateReport(gcfg, h, done, reqGen)
+
+	// let watchers catch up
+	time.Sleep(time.Second)
+	cancel()
+	wg.Wait()
+
+	if serr := cfg.saveWatchSummary(opts, &ws); serr != nil {
+		return serr
+	}
+	return err
+}
+
+// watch creates a watcher on the key, and waits until it is created.
+func (cfg *Config) watch(ctx context.Context, cli *clientv3.Client, key string, ws *watchStats) (clientv3.WatchChan, bool) {
+	now := time.Now()
+	wch := cli.Watch(ctx, key, clientv3.WithCreatedNotify())
+
+	var err error
+	select {
+	case wresp, ok := <-wch:
+		switch {
+		case !ok:
+			err = fmt.Errorf("watch channel closed")
+		case wresp.Err() != nil:
+			err = wresp.Err()
+		case !wresp.Created:
+			err = fmt.Errorf("watch is not created")
+		}
+	case <-time.After(watchCreateTimeout):
+		err = fmt.Errorf("watch is not created within %v", watchCreateTimeout)
+	}
+
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if err != nil {
+		// log the first failure only, which is likely the server limit
+		if ws.createFailures == 0 {
+			cfg.lg.Warn("failed to create watcher", zap.Int("established", len(ws.created)), zap.Error(err))
+		}
+		ws.createFailures++
+		return nil, false
+	}
+	ws.created = append(ws.created, time.Since(now).Seconds())
+	return wch, true
+}
+
+// saveWatchSummary saves the number of established watchers, creation
+// latencies, and received events against the expected.
+func (cfg *Config) saveWatchSummary(opts dbtesterpb.ConfigClientMachineBenchmarkOptions, ws *watchStats) error {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	// each established watcher receives one event of its key
+	expected := int64(len(ws.created))
+	events := atomic.LoadInt64(&ws.events)
+	cfg.lg.Info("watch results",
+		zap.Int("watchers", len(ws.created)),
+		zap.Int64("events", events),
+		zap.Int64("expected-events", expected),
+	)
+
+	sort.Float64s(ws.created)
+	pctls, secs := report.Percentiles(ws.created)
+
+	fr := dataframe.New()
+	add := func(name string, v interface{}) error {
+		col := dataframe.NewColumn(name)
+		col.PushBack(dataframe.NewStringValue(v))
+		return fr.AddColumn(col)
+	}
+	if err := add("WATCHERS", opts.WatcherNumber); err != nil {
+		return err
+	}
+	if err := add("CONNECTIONS", opts.ConnectionNumber); err != nil {
+		return err
+	}
+	if err := add("STREAMS-PER-CONNECTION", opts.WatchStreamsPerConnection); err != nil {
+		return err
+	}
+	if err := add("ESTABLISHED-WATCHERS", len(ws.created)); err != nil {
+		return err
+	}
+	if err := add("CREATE-FAILURES", ws.createFailures); err != nil {
+		return err
+	}
+	for i := range pctls {
+		if err := add(fmt.Sprintf("CREATE-P%v-LATENCY-MS", pctls[i]), fmt.Sprintf("%f", 1000*secs[i])); err != nil {
+			return err
+		}
+	}
+	if err := add("EVENTS", events); err != nil {
+		return err
+	}
+	if err := add("EXPECTED-EVENTS", expected); err != nil {
+		return err
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientWatchSummaryPath)
+}
@@ -0,0 +1,322 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	mrand "math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/pkg/report"
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+const (
+	defaultWatchCompactionWatchers = 100
+	defaultWatchDisconnectSeconds  = 2
+
+	// watchCompactionKey is the start of the key range
+	// that watchers list and watch, which is all keys
+	watchCompactionKey = "\x00"
+)
+
+// defaultWatchCompactionPolicy compacts often enough that
+// watchers disconnected for a while resume from compacted revisions.
+var defaultWatchCompactionPolicy = dbtesterpb.ConfigCompactionPolicy{
+	Mode:               "revision",
+	IntervalRevisions:  1000,
+	RetentionRevisions: 1000,
+}
+
+// watchCompactionOptions returns the benchmark options with defaults.
+func watchCompactionOptions(gcfg dbtesterpb.ConfigClientMachineAgentControl) dbtesterpb.ConfigClientMachineBenchmarkOptions {
+	opts := *gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.WatcherNumber == 0 {
+		opts.WatcherNumber = defaultWatchCompactionWatchers
+	}
+	if opts.WatchDisconnectSeconds == 0 {
+		opts.WatchDisconnectSeconds = defaultWatchDisconnectSeconds
+	}
+	if opts.CompactionPolicy == nil || opts.CompactionPolicy.Mode == "" {
+		policy := defaultWatchCompactionPolicy
+		opts.CompactionPolicy = &policy
+	}
+	return opts
+}
+
+// watchCompactionStats counts watch resumes, the ones rejected by
+// compaction, and the re-lists that follow.
+type watchCompactionStats struct {
+	mu sync.Mutex
+
+	resumes   int64
+	compacted int64
+	// skipped is the number of revisions between the last revision
+	// of each compacted watcher and the compact revision
+	skipped int64
+	events  int64
+	errors  int64
+
+	// re-list latencies in seconds
+	relists       []float64
+	relistedKeys  int64
+	relistsBySecs map[int64]int64
+
+	violations int64
+}
+
+func (ws *watchCompactionStats) add(f func(ws *watchCompactionStats)) {
+	ws.mu.Lock()
+	f(ws)
+	ws.mu.Unlock()
+}
+
+// benchmarkWatchCompaction models Kubernetes-style reflectors: each watcher
+// lists all keys, and watches from the listed revision. Watchers disconnect
+// for 'watch_disconnect_seconds' at random, and resume from their last
+// revision, while 'request_number' writes are compacted by 'compaction_policy'.
+// Watchers that fall behind compaction get ErrCompacted, and re-list. Watch
+// events must be of increasing revisions without duplicates, a compact
+// revision must be after the resume revision, and a re-list must include the
+// compact revision. Only the writes are reported, and watchers are summarized
+// in 'client_watch_compaction_summary_path'.
+func (cfg *Config) benchmarkWatchCompaction(gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	opts := watchCompactionOptions(gcfg)
+	gcfg.ConfigClientMachineBenchmarkOptions = &opts
+
+	conns := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+		totalConns:   opts.ConnectionNumber,
+		totalClients: opts.ConnectionNumber,
+		compression:  opts.EtcdCompression,
+	})
+	defer func() {
+		for i := range conns {
+			conns[i].Close()
+		}
+	}()
+
+	cfg.compactor = startCompactor(cfg.lg, gcfg)
+	defer func() {
+		cfg.compactor.stop()
+		cfg.compactor = nil
+	}()
+
+	cfg.lg.Info("starting watchers",
+		zap.Int64("watchers", opts.WatcherNumber),
+		zap.Int64("disconnect-seconds", opts.WatchDisconnectSeconds),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	var (
+		ws = watchCompactionStats{relistsBySecs: make(map[int64]int64)}
+		wg sync.WaitGroup
+	)
+	disconnect := time.Duration(opts.WatchDisconnectSeconds) * time.Second
+	for i := int64(0); i < opts.WatcherNumber; i++ {
+		wg.Add(1)
+		go func(cli *clientv3.Client, seed int64) {
+			defer wg.Done()
+			runReflector(ctx, cli, disconnect, mrand.New(mrand.NewSource(seed)), &ws)
+		}(conns[i%int64(len(conns))], time.Now().UnixNano()+i)
+	}
+
+	h, done := newWriteHandlers(cfg.lg, gcfg)
+	reqGen := func(inflightReqs chan<- Request) { generateWrites(gcfg, 0, vals, inflightReqs) }
+	err := cfg.generateReport(gcfg, h, done, reqGen)
+
+	cancel()
+	wg.Wait()
+
+	if serr := cfg.saveWatchCompactionSummary(opts, len(cfg.compactor.eventsSnapshot()), &ws); serr != nil {
+		return serr
+	}
+	if err != nil {
+		return err
+	}
+	if ws.violations > 0 {
+		return fmt.Errorf("watchers observed %d compaction or revision violations", ws.violations)
+	}
+	return nil
+}
+
+// runReflector lists and watches until the context is canceled. It watches for
+// a random duration up to the disconnect period, and then disconnects for
+// the period before resuming from the last revision. It re-lists right away
+// when the resume revision has been compacted.
+func runReflector(ctx context.Context, cli *clientv3.Client, disconnect time.Duration, rnd *mrand.Rand, ws *watchCompactionStats) {
+	rev, ok := relist(ctx, cli, 0, ws)
+	for ok {
+		wctx, wcancel := context.WithTimeout(ctx, time.Duration(rnd.Int63n(int64(disconnect)))+time.Millisecond)
+		ws.add(func(ws *watchCompactionStats) { ws.resumes++ })
+
+		compactRev := int64(0)
+		for wresp := range cli.Watch(wctx, watchCompactionKey, clientv3.WithFromKey(), clientv3.WithRev(rev+1)) {
+			if wresp.CompactRevision != 0 {
+				compactRev = wresp.CompactRevision
+				break
+			}
+			if wresp.Err() != nil {
+				if wctx.Err() == nil {
+					ws.add(func(ws *watchCompactionStats) { ws.errors++ })
+				}
+				break
+			}
+			// writes are single puts, so that each revision has one event
+			violations := int64(0)
+			for _, ev := range wresp.Events {
+				if ev.Kv.ModRevision <= rev {
+					violations++
+				}
+				rev = ev.Kv.ModRevision
+			}
+			ws.add(func(ws *watchCompactionStats) {
+				ws.events += int64(len(wresp.Events))
+				ws.violations += violations
+			})
+		}
+		wcancel()
+
+		if compactRev != 0 {
+			prev := rev
+			ws.add(func(ws *watchCompactionStats) {
+				ws.compacted++
+				ws.skipped += compactRev - prev - 1
+				if compactRev <= prev+1 {
+					ws.violations++
+				}
+			})
+			rev, ok = relist(ctx, cli, compactRev, ws)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(disconnect):
+		}
+	}
+}
+
+// relist gets all keys, and returns the revision to watch from. The
+// revision must not be before the compact revision, if any. It returns
+// false when the context is canceled.
+func relist(ctx context.Context, cli *clientv3.Client, compactRev int64, ws *watchCompactionStats) (int64, bool) {
+	for {
+		now := time.Now()
+		resp, err := cli.Get(ctx, watchCompactionKey, clientv3.WithFromKey())
+		if ctx.Err() != nil {
+			return 0, false
+		}
+		if err != nil {
+			ws.add(func(ws *watchCompactionStats) { ws.errors++ })
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+		took := time.Since(now)
+		ws.add(func(ws *watchCompactionStats) {
+			// the initial list is not a re-list
+			if compactRev == 0 {
+				return
+			}
+			ws.relists = append(ws.relists, took.Seconds())
+			ws.relistedKeys += int64(len(resp.Kvs))
+			ws.relistsBySecs[now.Unix()]++
+			if resp.Header.Revision < compactRev {
+				ws.violations++
+			}
+		})
+		return resp.Header.Revision, true
+	}
+}
+
+// saveWatchCompactionSummary saves the number of compacted resumes,
+// re-list latencies, and the peak re-lists per second.
+func (cfg *Config) saveWatchCompactionSummary(opts dbtesterpb.ConfigClientMachineBenchmarkOptions, compactions int, ws *watchCompactionStats) error {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	peak := int64(0)
+	for _, n := range ws.relistsBySecs {
+		if peak < n {
+			peak = n
+		}
+	}
+	cfg.lg.Info("watch-compaction results",
+		zap.Int64("watchers", opts.WatcherNumber),
+		zap.Int("compactions", compactions),
+		zap.Int64("resumes", ws.resumes),
+		zap.Int64("compacted-resumes", ws.compacted),
+		zap.Int("relists", len(ws.relists)),
+		zap.Int64("peak-relists-per-second", peak),
+		zap.Int64("violations", ws.violations),
+	)
+
+	sort.Float64s(ws.relists)
+	pctls, secs := report.Percentiles(ws.relists)
+
+	fr := dataframe.New()
+	add := func(name string, v interface{}) error {
+		col := dataframe.NewColumn(name)
+		col.PushBack(dataframe.NewStringValue(v))
+		return fr.AddColumn(col)
+	}
+	if err := add("WATCHERS", opts.WatcherNumber); err != nil {
+		return err
+	}
+	if err := add("DISCONNECT-SECONDS", opts.WatchDisconnectSeconds); err != nil {
+		return err
+	}
+	if err := add("COMPACTIONS", compactions); err != nil {
+		return err
+	}
+	if err := add("RESUMES", ws.resumes); err != nil {
+		return err
+	}
+	if err := add("COMPACTED-RESUMES", ws.compacted); err != nil {
+		return err
+	}
+	if err := add("SKIPPED-REVISIONS", ws.skipped); err != nil {
+		return err
+	}
+	if err := add("RELISTS", len(ws.relists)); err != nil {
+		return err
+	}
+	if err := add("RELISTED-KEYS", ws.relistedKeys); err != nil {
+		return err
+	}
+	if err := add("PEAK-RELISTS-PER-SECOND", peak); err != nil {
+		return err
+	}
+	for i := range pctls {
+		if err := add(fmt.Sprintf("RELIST-P%v-LATENCY-MS", pctls[i]), fmt.Sprintf("%f", 1000*secs[i])); err != nil {
+			return err
+		}
+	}
+	if err := add("EVENTS", ws.events); err != nil {
+		return err
+	}
+	if err := add("ERRORS", ws.errors); err != nil {
+		return err
+	}
+	if err := add("VIOLATIONS", ws.violations); err != nil {
+		return err
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientWatchCompactionSummaryPath)
+}
@@ -0,0 +1,87 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+// totalKeysTimeout is the timeout of counting keys on each member.
+const totalKeysTimeout = time.Minute
+
+// checkTotalKeys counts the keys of the benchmark namespace on each member
+// after 'write'. Mismatches with 'request_number' are logged, or returned
+// if 'fail_on_total_keys_mismatch' is set.
+func (cfg *Config) checkTotalKeys(gcfg dbtesterpb.ConfigClientMachineAgentControl) error {
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	expected := opts.RequestNumber
+	if opts.SameKey {
+		expected = 1
+	}
+	ns := keyNamespace(opts)
+
+	cfg.lg.Info("checking total keys on", zap.Strings("endpoints", gcfg.DatabaseEndpoints), zap.String("namespace", ns))
+	var (
+		counts map[string]int64
+		err    error
+	)
+	switch gcfg.DatabaseID {
+	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		counts, err = getTotalKeysEtcdv3(cfg.lg, gcfg.DatabaseEndpoints, ns)
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		counts, err = getTotalKeysZk(cfg.lg, gcfg.DatabaseEndpoints, opts)
+	case "consul__v1_0_2", "cetcd__beta":
+		counts, err = getTotalKeysConsul(cfg.lg, gcfg.DatabaseEndpoints, ns)
+	default:
+		return fmt.Errorf("%q is unknown database ID", gcfg.DatabaseID)
+	}
+	if err != nil {
+		if opts.FailOnTotalKeysMismatch {
+			return err
+		}
+		cfg.lg.Warn("failed to check total keys", zap.Error(err))
+		return nil
+	}
+
+	eps := make([]string, 0, len(counts))
+	for ep := range counts {
+		eps = append(eps, ep)
+	}
+	sort.Strings(eps)
+	var mismatches []string
+	for _, ep := range eps {
+		cfg.lg.Sugar().Infof("expected write total results [expected_total: %d | database: %q | endpoint: %q | number_of_keys: %d]",
+			expected, gcfg.DatabaseID, ep, counts[ep])
+		if counts[ep] != expected {
+			mismatches = append(mismatches, fmt.Sprintf("%d on %q", counts[ep], ep))
+		}
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+	err = fmt.Errorf("expected %d keys in namespace %q, got %s", expected, ns, strings.Join(mismatches, ", "))
+	if opts.FailOnTotalKeysMismatch {
+		return err
+	}
+	cfg.lg.Warn("total keys mismatch", zap.Error(err))
+	return nil
+}
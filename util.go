@@ -15,14 +15,13 @@
 package dbtester
 
 import (
-	"fmt"
 	"io"
 	"io/ioutil"
-	mrand "math/rand"
 	"net/http"
 	"os"
 	"strings"
 	"time"
+	"unicode"
 )
 
 func toMillisecond(d time.Duration) float64 {
@@ -68,6 +67,18 @@ func toFile(txt, fpath string) error {
 }
 
 // exist returns true if the file or directory exists.
+// testNameFromTitle converts the test title to a directory name,
+// lowercase alphanumerics separated by '-'.
+func testNameFromTitle(title string) string {
+	ss := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '.'
+	})
+	if len(ss) == 0 {
+		return "dbtester"
+	}
+	return strings.Join(ss, "-")
+}
+
 func exist(fpath string) bool {
 	st, err := os.Stat(fpath)
 	if err != nil {
@@ -94,39 +105,6 @@ func gracefulClose(resp *http.Response) {
 	resp.Body.Close()
 }
 
-// sequentialKey returns '00012' when size is 5 and num is 12.
-func sequentialKey(size, num int64) string {
-	txt := fmt.Sprintf("%d", num)
-	if len(txt) > int(size) {
-		return txt
-	}
-	delta := int(size) - len(txt)
-	return strings.Repeat("0", delta) + txt
-}
-
 func sameKey(size int64) string {
 	return strings.Repeat("a", int(size))
 }
-
-func randBytes(bytesN int64) []byte {
-	const (
-		letterBytes   = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
-		letterIdxBits = 6                    // 6 bits to represent a letter index
-		letterIdxMask = 1<<letterIdxBits - 1 // All 1-bits, as many as letterIdxBits
-		letterIdxMax  = 63 / letterIdxBits   // # of letter indices fitting in 63 bits
-	)
-	src := mrand.NewSource(time.Now().UnixNano())
-	b := make([]byte, bytesN)
-	for i, cache, remain := bytesN-1, src.Int63(), letterIdxMax; i >= 0; {
-		if remain == 0 {
-			cache, remain = src.Int63(), letterIdxMax
-		}
-		if idx := int(cache & letterIdxMask); idx < len(letterBytes) {
-			b[i] = letterBytes[idx]
-			i--
-		}
-		cache >>= letterIdxBits
-		remain--
-	}
-	return b
-}
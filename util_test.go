@@ -17,6 +17,8 @@ package dbtester
 import (
 	"reflect"
 	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
 )
 
 func Test_assignRequest(t *testing.T) {
@@ -36,3 +38,80 @@ func Test_assignRequest(t *testing.T) {
 		t.Fatalf("sum must be %d, got %d", total, cur)
 	}
 }
+
+func Test_testNameFromTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		name  string
+	}{
+		{"Write 1M keys, 256-byte key, 1KB value", "write-1m-keys-256-byte-key-1kb-value"},
+		{"etcd v3.3 (Go 1.9)", "etcd-v3.3-go-1.9"},
+		{"", "dbtester"},
+	}
+	for i, tt := range tests {
+		if name := testNameFromTitle(tt.title); name != tt.name {
+			t.Errorf("#%d: expected %q, got %q", i, tt.name, name)
+		}
+	}
+}
+
+func Test_benchmarkKey(t *testing.T) {
+	tests := []struct {
+		opts dbtesterpb.ConfigClientMachineBenchmarkOptions
+		num  int64
+		key  string
+	}{
+		{dbtesterpb.ConfigClientMachineBenchmarkOptions{KeySizeBytes: 5}, 12, "00012"},
+		{dbtesterpb.ConfigClientMachineBenchmarkOptions{KeySizeBytes: 5, KeyPathDepth: 2, KeyPathFanout: 10}, 73, "3/7/00073"},
+		{dbtesterpb.ConfigClientMachineBenchmarkOptions{KeySizeBytes: 3, KeyPathDepth: 1, KeyPathFanout: 16}, 35, "03/035"},
+		{dbtesterpb.ConfigClientMachineBenchmarkOptions{KeySizeBytes: 5, KeyPrefix: "bench/a", KeyPathDepth: 1, KeyPathFanout: 10}, 73, "bench/a/3/00073"},
+		{dbtesterpb.ConfigClientMachineBenchmarkOptions{KeySizeBytes: 9, KeyEncoding: dbtesterpb.KeyEncodingBinary}, 258, "\x00\x00\x00\x00\x00\x00\x00\x01\x02"},
+	}
+	for i, tt := range tests {
+		if key := benchmarkKey(&tt.opts, tt.num); key != tt.key {
+			t.Errorf("#%d: expected %q, got %q", i, tt.key, key)
+		}
+	}
+
+	dirs := keyDirs(&dbtesterpb.ConfigClientMachineBenchmarkOptions{KeyPathDepth: 2, KeyPathFanout: 2})
+	expected := []string{"/0", "/1", "/0/0", "/0/1", "/1/0", "/1/1"}
+	if !reflect.DeepEqual(dirs, expected) {
+		t.Fatalf("expected %q, got %q", expected, dirs)
+	}
+	if n := numKeyDirs(2, 2); n != int64(len(expected)) {
+		t.Fatalf("expected %d directories, got %d", len(expected), n)
+	}
+
+	dirs = keyDirs(&dbtesterpb.ConfigClientMachineBenchmarkOptions{KeyPrefix: "bench/a", KeyPathDepth: 1, KeyPathFanout: 2})
+	expected = []string{"/bench", "/bench/a", "/bench/a/0", "/bench/a/1"}
+	if !reflect.DeepEqual(dirs, expected) {
+		t.Fatalf("expected %q, got %q", expected, dirs)
+	}
+}
+
+func Test_zoneEndpoints(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		DatabaseEndpoints: []string{"a:2379", "b:2379", "c:2379"},
+		EndpointZones:     []string{"z1", "z2", "z1"},
+	}
+	if eps := zoneEndpoints(gcfg, gcfg.DatabaseEndpoints); !reflect.DeepEqual(eps, gcfg.DatabaseEndpoints) {
+		t.Fatalf("expected all endpoints without client zone, got %q", eps)
+	}
+
+	gcfg.ClientZone = "z1"
+	if eps := zoneEndpoints(gcfg, gcfg.DatabaseEndpoints); !reflect.DeepEqual(eps, []string{"a:2379", "c:2379"}) {
+		t.Fatalf("expected same-zone endpoints, got %q", eps)
+	}
+
+	gcfg.ZoneSpilloverPercent = 10
+	eps := zoneEndpoints(gcfg, gcfg.DatabaseEndpoints)
+	remote := 0
+	for _, ep := range eps {
+		if ep == "b:2379" {
+			remote++
+		}
+	}
+	if len(eps) != zoneSlots || remote != 10 {
+		t.Fatalf("expected 10 of %d slots in other zones, got %d of %d", zoneSlots, remote, len(eps))
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"plugin"
+	"sort"
+	"sync"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/benchrunner"
+
+	"golang.org/x/net/context"
+)
+
+// Workload generates requests for 'custom' benchmark type.
+type Workload interface {
+	// Next returns the next request to send. It returns false
+	// when there are no more requests.
+	Next() (Request, bool)
+	// Done is called after all requests are generated.
+	Done()
+}
+
+// WorkloadFunc creates a Workload from the benchmark configuration.
+type WorkloadFunc func(gcfg dbtesterpb.ConfigClientMachineAgentControl) (Workload, error)
+
+var (
+	workloadsMu sync.Mutex
+	workloads   = make(map[string]WorkloadFunc)
+)
+
+// RegisterWorkload registers a custom workload by name.
+// Go plugins should call this in their 'init' function.
+func RegisterWorkload(name string, fn WorkloadFunc) {
+	workloadsMu.Lock()
+	defer workloadsMu.Unlock()
+	if fn == nil {
+		panic("dbtester: RegisterWorkload with nil WorkloadFunc")
+	}
+	if _, ok := workloads[name]; ok {
+		panic(fmt.Sprintf("dbtester: workload %q is already registered", name))
+	}
+	workloads[name] = fn
+}
+
+// RegisteredWorkloads returns the sorted names of registered workloads.
+func RegisteredWorkloads() []string {
+	workloadsMu.Lock()
+	defer workloadsMu.Unlock()
+	ns := make([]string, 0, len(workloads))
+	for k := range workloads {
+		ns = append(ns, k)
+	}
+	sort.Strings(ns)
+	return ns
+}
+
+// newWorkload loads the plugin, if any, and creates the named workload.
+func newWorkload(gcfg dbtesterpb.ConfigClientMachineAgentControl) (Workload, error) {
+	opts := gcfg.ConfigClientMachineBenchmarkOptions
+	if opts.WorkloadPluginPath != "" {
+		// plugin 'init' registers the workload
+		if _, err := plugin.Open(opts.WorkloadPluginPath); err != nil {
+			return nil, fmt.Errorf("failed to open workload plugin %q (%v)", opts.WorkloadPluginPath, err)
+		}
+	}
+
+	workloadsMu.Lock()
+	fn, ok := workloads[opts.WorkloadName]
+	workloadsMu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("workload %q is not registered (registered %q)", opts.WorkloadName, RegisteredWorkloads())
+	}
+	return fn(gcfg)
+}
+
+// newCustomHandlers creates handlers that run any request,
+// writes if request has a value, reads otherwise.
+func newCustomHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
+	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	switch gcfg.DatabaseID {
+	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
+		clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+			compression:  gcfg.ConfigClientMachineBenchmarkOptions.EtcdCompression,
+		})
+		for i := range clients {
+			rhs[i] = newPutEtcd3(clients[i].KV)
+		}
+		done = func() {
+			for i := range clients {
+				clients[i].Close()
+			}
+		}
+
+	case "zookeeper__r3_5_3_beta", "zetcd__beta":
+		conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		for i := range rhs {
+			put, get := newPutCreateZK(conns[i%len(conns)]), newGetZK(conns[i%len(conns)])
+			rhs[i] = func(ctx context.Context, req *Request) error {
+				if req.zkOp.value != nil {
+					return put(ctx, req)
+				}
+				return get(ctx, req)
+			}
+		}
+		done = func() {
+			for i := range conns {
+				conns[i].Close()
+			}
+		}
+
+	case "consul__v1_0_2", "cetcd__beta":
+		conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+		for i := range rhs {
+			put, get := newPutConsul(conns[i%len(conns)]), newGetConsul(conns[i%len(conns)])
+			rhs[i] = func(ctx context.Context, req *Request) error {
+				if req.consulOp.value != nil {
+					return put(ctx, req)
+				}
+				return get(ctx, req)
+			}
+		}
+
+	default:
+		panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
+	}
+	return rhs, done
+}
+
+func generateCustom(gcfg dbtesterpb.ConfigClientMachineAgentControl, wl Workload, inflightReqs chan<- Request) {
+	defer func() {
+		close(inflightReqs)
+		wl.Done()
+	}()
+
+	pacer := benchrunner.NewPacer(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond)
+
+	for i := int64(0); i < gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; i++ {
+		req, ok := wl.Next()
+		if !ok {
+			return
+		}
+		intended := pacer.Wait(context.TODO(), i)
+		req.intendedStart = intended
+		inflightReqs <- req
+	}
+}